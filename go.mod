@@ -5,8 +5,10 @@ go 1.15
 require (
 	github.com/33cn/chain33 v1.67.4-0.20220722090050-f04f8bab7f42
 	github.com/33cn/plugin v1.67.4-0.20220714095200-e39c121a83d7
+	github.com/BurntSushi/toml v0.3.1
 	github.com/btcsuite/btcd v0.22.0-beta
 	github.com/golang/protobuf v1.5.2
+	github.com/hashicorp/golang-lru v0.5.5-0.20210104140557-80c98217689d
 	github.com/libp2p/go-libp2p v0.15.0
 	github.com/libp2p/go-libp2p-autonat v0.4.2
 	github.com/libp2p/go-libp2p-circuit v0.4.0
@@ -18,9 +20,11 @@ require (
 	github.com/panjf2000/gnet v1.4.3
 	github.com/phoreproject/bls v0.0.0-20200525203911-a88a5ae26844
 	github.com/pkg/errors v0.9.1
+	github.com/rcrowley/go-metrics v0.0.0-20190826022208-cac0b30c2563
 	github.com/spf13/cobra v1.1.1
 	github.com/stretchr/testify v1.7.0
-	golang.org/x/crypto v0.0.0-20220622213112-05595931fe9d // indirect
+	github.com/syndtr/goleveldb v1.0.1-0.20210819022825-2ae1ddf74ef7
+	golang.org/x/crypto v0.0.0-20220622213112-05595931fe9d
 	golang.org/x/net v0.0.0-20220225172249-27dd8689420f
 	golang.org/x/sys v0.0.0-20220721230656-c6bc011c0c49 // indirect
 	google.golang.org/grpc v1.40.0
@@ -7,6 +7,8 @@ import (
 	_ "github.com/yccproject/ycc/plugin"
 
 	"flag"
+	"fmt"
+	"os"
 	"runtime/debug"
 
 	"github.com/33cn/chain33/util/cli"
@@ -15,6 +17,9 @@ import (
 var percent = flag.Int("p", 0, "SetGCPercent")
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "nettest" {
+		os.Exit(runNettest(os.Args[2:]))
+	}
 	flag.Parse()
 	if *percent < 0 || *percent > 100 {
 		*percent = 0
@@ -22,5 +27,16 @@ func main() {
 	if *percent > 0 {
 		debug.SetGCPercent(*percent)
 	}
-	cli.RunChain33("ycc", yccconfig)
+	defCfg, err := applyRole(*role, "ycc", yccconfig)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "role error:", err)
+		os.Exit(1)
+	}
+	done, err := recoverDataDirs("ycc", defCfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "datadir error:", err)
+		os.Exit(1)
+	}
+	cli.RunChain33("ycc", defCfg)
+	done()
 }
@@ -0,0 +1,367 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	tml "github.com/BurntSushi/toml"
+	"github.com/libp2p/go-libp2p"
+	autonat "github.com/libp2p/go-libp2p-autonat"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+const (
+	defaultP2PPort       = 13801
+	defaultConsensusPort = 10801
+	// 共识节点启动后把自己的 multiaddr 写到当前目录的这个文件
+	pos33PeerAddrFile = "pos33peeraddr.txt"
+)
+
+// checkReply 检查服务的返回: GET <service>?port=<port>, 服务从外部连接请求者的 ip 和端口
+type checkReply struct {
+	IP        string `json:"ip"`
+	Reachable bool   `json:"reachable"`
+}
+
+type netPort struct {
+	name  string
+	addr  string // 配置的绑定地址
+	port  int
+	peers []string // 用来回拨的 libp2p 节点
+}
+
+type nettest struct {
+	service string
+	timeout time.Duration
+	public  map[string]bool // 外部看到的 ip
+}
+
+// runNettest ycc nettest: 检查配置的 p2p, 共识和 rpc 端口能不能从外部连接, 诊断 NAT 和防火墙的问题,
+// 打印别的节点应该使用的 multiaddr. libp2p 端口通过 boot peers/seeds 的 AutoNAT 回拨检查, 需要先停止节点;
+// rpc 端口需要 -service 指定的检查服务
+func runNettest(args []string) int {
+	fs := flag.NewFlagSet("nettest", flag.ExitOnError)
+	conf := fs.String("f", "ycc.toml", "config file")
+	service := fs.String("service", "", "check service url, GET <service>?port=<port> returns {\"ip\":\"...\",\"reachable\":true}")
+	timeout := fs.Duration("timeout", time.Second*15, "timeout of each check")
+	fs.Parse(args)
+
+	path := *conf
+	if !filepath.IsAbs(path) {
+		// 和 RunChain33 一样, 相对路径是相对程序所在的目录
+		dir, err := filepath.Abs(filepath.Dir(os.Args[0]))
+		if err == nil {
+			path = filepath.Join(dir, path)
+		}
+	}
+	cfg := make(map[string]interface{})
+	if _, err := tml.DecodeFile(path, &cfg); err != nil {
+		fmt.Fprintln(os.Stderr, "read config error:", err)
+		return 1
+	}
+	t := &nettest{service: *service, timeout: *timeout, public: make(map[string]bool)}
+
+	fmt.Println("local addresses:")
+	for _, ip := range localIPs() {
+		fmt.Println("  ", ip)
+	}
+
+	ports := []*netPort{
+		{name: "p2p", port: intKey(cfg, "p2p.sub.dht.port", defaultP2PPort), peers: stringsKey(cfg, "p2p.sub.dht.seeds")},
+		{name: "consensus", port: intKey(cfg, "consensus.sub.pos33.listenPort", defaultConsensusPort), peers: stringsKey(cfg, "consensus.sub.pos33.bootPeers")},
+	}
+	for _, k := range []string{"rpc.jrpcBindAddr", "rpc.grpcBindAddr"} {
+		if v, ok := getKey(cfg, k); ok {
+			addr := fmt.Sprint(v)
+			_, port, err := net.SplitHostPort(addr)
+			if err != nil {
+				continue
+			}
+			p, _ := strconv.Atoi(port)
+			ports = append(ports, &netPort{name: strings.TrimSuffix(strings.TrimPrefix(k, "rpc."), "BindAddr"), addr: addr, port: p})
+		}
+	}
+
+	ok := true
+	for _, p := range ports {
+		fmt.Printf("\n%s port %d:\n", p.name, p.port)
+		if !t.check(p) {
+			ok = false
+		}
+	}
+	t.printAddrs(ports)
+	if !ok {
+		return 1
+	}
+	return 0
+}
+
+func intKey(cfg map[string]interface{}, key string, def int) int {
+	v, ok := getKey(cfg, key)
+	if !ok {
+		return def
+	}
+	switch n := v.(type) {
+	case int64:
+		return int(n)
+	case int:
+		return n
+	}
+	return def
+}
+
+func stringsKey(cfg map[string]interface{}, key string) []string {
+	v, _ := getKey(cfg, key)
+	vs, _ := v.([]interface{})
+	var ss []string
+	for _, s := range vs {
+		ss = append(ss, fmt.Sprint(s))
+	}
+	return ss
+}
+
+// localIPs 本机网卡上的全局单播地址
+func localIPs() []net.IP {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return nil
+	}
+	var ips []net.IP
+	for _, a := range addrs {
+		ipn, ok := a.(*net.IPNet)
+		if ok && ipn.IP.IsGlobalUnicast() {
+			ips = append(ips, ipn.IP)
+		}
+	}
+	return ips
+}
+
+func isLocalOnly(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return host == "localhost" || (ip != nil && ip.IsLoopback())
+}
+
+func (t *nettest) check(p *netPort) bool {
+	if p.addr != "" && isLocalOnly(p.addr) {
+		fmt.Println("   bound to", p.addr, "only, not reachable from outside (by design)")
+		return true
+	}
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", p.port))
+	if err != nil {
+		fmt.Println("   in use, the node is running. stop it to test reachability of this port")
+		return t.checkService(p.port)
+	}
+	l.Close()
+
+	if p.addr == "" && len(p.peers) > 0 {
+		return t.checkDialBack(p)
+	}
+	if t.service == "" {
+		fmt.Println("   skipped, use -service to check this port")
+		return true
+	}
+	// 检查服务连接时需要有程序在监听
+	l, err = net.Listen("tcp", fmt.Sprintf(":%d", p.port))
+	if err != nil {
+		fmt.Println("   listen error:", err)
+		return false
+	}
+	defer l.Close()
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			c.Close()
+		}
+	}()
+	return t.checkService(p.port)
+}
+
+func (t *nettest) checkService(port int) bool {
+	if t.service == "" {
+		return true
+	}
+	sep := "?"
+	if strings.Contains(t.service, "?") {
+		sep = "&"
+	}
+	cli := &http.Client{Timeout: t.timeout}
+	resp, err := cli.Get(fmt.Sprintf("%s%sport=%d", t.service, sep, port))
+	if err != nil {
+		fmt.Println("   check service error:", err)
+		return false
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		fmt.Println("   check service error:", err)
+		return false
+	}
+	var r checkReply
+	if err = json.Unmarshal(body, &r); err != nil {
+		fmt.Println("   check service reply error:", err)
+		return false
+	}
+	if r.IP != "" {
+		t.public[r.IP] = true
+	}
+	if !r.Reachable {
+		fmt.Printf("   NOT reachable at %s from the check service\n", net.JoinHostPort(r.IP, strconv.Itoa(port)))
+		t.diagnose(r.IP, port)
+		return false
+	}
+	fmt.Printf("   reachable at %s\n", net.JoinHostPort(r.IP, strconv.Itoa(port)))
+	return true
+}
+
+// checkDialBack 在端口上启动一个临时的 libp2p 节点, 请求 peers 通过 AutoNAT 回拨
+func (t *nettest) checkDialBack(p *netPort) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), t.timeout)
+	defer cancel()
+	h, err := libp2p.New(ctx,
+		libp2p.ListenAddrStrings(
+			fmt.Sprintf("/ip4/0.0.0.0/tcp/%d", p.port),
+			fmt.Sprintf("/ip6/::/tcp/%d", p.port),
+		),
+		libp2p.NATPortMap(),
+	)
+	if err != nil {
+		fmt.Println("   start libp2p error:", err)
+		return false
+	}
+	defer h.Close()
+
+	cli := autonat.NewAutoNATClient(h, nil)
+	asked := 0
+	for _, s := range p.peers {
+		pi, err := peerInfo(s)
+		if err != nil {
+			continue
+		}
+		if err = h.Connect(ctx, *pi); err != nil {
+			fmt.Println("   connect", pi.ID.Pretty()[:16], "error:", err)
+			continue
+		}
+		asked++
+		a, err := cli.DialBack(ctx, pi.ID)
+		if err == nil {
+			fmt.Println("   reachable at", a, "(dialed back by", pi.ID.Pretty()[:16]+")")
+			t.addPublic(a)
+			return true
+		}
+		if autonat.IsDialError(err) {
+			fmt.Println("   NOT reachable, dial back by", pi.ID.Pretty()[:16], "failed:", err)
+			t.diagnose(observedIP(h), p.port)
+			return false
+		}
+		fmt.Println("   dial back by", pi.ID.Pretty()[:16], "error:", err)
+	}
+	if asked == 0 {
+		fmt.Println("   no peer connected, check the outgoing network and the peers in the config")
+		return false
+	}
+	fmt.Println("   unknown, peers don't provide AutoNAT, use -service to check this port")
+	return true
+}
+
+func peerInfo(s string) (*peer.AddrInfo, error) {
+	ma, err := multiaddr.NewMultiaddr(s)
+	if err != nil {
+		return nil, err
+	}
+	return peer.AddrInfoFromP2pAddr(ma)
+}
+
+func isPrivate(ip net.IP) bool {
+	for _, n := range []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "100.64.0.0/10"} {
+		_, ipn, _ := net.ParseCIDR(n)
+		if ipn.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// observedIP peer 看到的本机的 ip
+func observedIP(h host.Host) string {
+	// identify 之后 h.Addrs 包含 peer 观察到的地址
+	for _, a := range h.Addrs() {
+		ip, err := a.ValueForProtocol(multiaddr.P_IP4)
+		if err != nil {
+			continue
+		}
+		if p := net.ParseIP(ip); p != nil && p.IsGlobalUnicast() && !isPrivate(p) {
+			return ip
+		}
+	}
+	return ""
+}
+
+func (t *nettest) addPublic(a multiaddr.Multiaddr) {
+	for _, code := range []int{multiaddr.P_IP4, multiaddr.P_IP6} {
+		if ip, err := a.ValueForProtocol(code); err == nil {
+			t.public[ip] = true
+		}
+	}
+}
+
+// diagnose 根据外部的 ip 和本机的地址判断是 NAT 还是防火墙
+func (t *nettest) diagnose(public string, port int) {
+	local := false
+	for _, ip := range localIPs() {
+		if ip.String() == public {
+			local = true
+		}
+	}
+	switch {
+	case public == "":
+		fmt.Printf("   check the firewall allows incoming tcp %d, or forward the port on the router\n", port)
+	case local:
+		fmt.Printf("   %s is on this machine, the firewall (iptables/security group) blocks incoming tcp %d\n", public, port)
+	default:
+		fmt.Printf("   behind NAT (public ip %s is not on this machine), forward tcp %d on the router to this machine, or enable upnp\n", public, port)
+	}
+}
+
+// printAddrs 别的节点连接本节点应该使用的 multiaddr
+func (t *nettest) printAddrs(ports []*netPort) {
+	if len(t.public) == 0 {
+		return
+	}
+	id := "<peer id>"
+	if data, err := ioutil.ReadFile(pos33PeerAddrFile); err == nil {
+		if pi, err := peerInfo(strings.TrimSpace(string(data))); err == nil {
+			id = pi.ID.Pretty()
+		}
+	}
+	fmt.Println("\nmultiaddrs for peers (bootPeers of other nodes):")
+	for ip := range t.public {
+		proto := "ip4"
+		if strings.Contains(ip, ":") {
+			proto = "ip6"
+		}
+		for _, p := range ports {
+			if p.name == "consensus" {
+				fmt.Printf("   /%s/%s/tcp/%d/p2p/%s\n", proto, ip, p.port, id)
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package pos33
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	manage "github.com/33cn/chain33/system/dapp/manage/executor"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const maxAdvisories = 64
+
+// advisories 管理员签名的网络公告, 节点之间转发, 通过 rpc, 日志和 webhook 通知运维
+type advisories struct {
+	mu      sync.Mutex
+	mp      map[string]*pt.Pos33Advisory
+	webhook string
+}
+
+func newAdvisories(webhook string) *advisories {
+	return &advisories{mp: make(map[string]*pt.Pos33Advisory), webhook: webhook}
+}
+
+// add 返回 false 表示已经收到过
+func (a *advisories) add(k string, m *pt.Pos33Advisory) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, ok := a.mp[k]; ok {
+		return false
+	}
+	if len(a.mp) >= maxAdvisories {
+		var ok string
+		for k, v := range a.mp {
+			if ok == "" || v.Time < a.mp[ok].Time {
+				ok = k
+			}
+		}
+		delete(a.mp, ok)
+	}
+	a.mp[k] = m
+	return true
+}
+
+func (a *advisories) list() []*pt.Pos33Advisory {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := time.Now().Unix()
+	var ms []*pt.Pos33Advisory
+	for k, m := range a.mp {
+		if m.Expire > 0 && m.Expire < now {
+			delete(a.mp, k)
+			continue
+		}
+		ms = append(ms, m)
+	}
+	sort.Slice(ms, func(i, j int) bool { return ms[i].Time > ms[j].Time })
+	return ms
+}
+
+func (a *advisories) notify(m *pt.Pos33Advisory) {
+	if a.webhook == "" {
+		return
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return
+	}
+	resp, err := http.Post(a.webhook, "application/json", bytes.NewReader(data))
+	if err != nil {
+		plog.Error("advisory webhook error", "err", err)
+		return
+	}
+	resp.Body.Close()
+}
+
+func (n *node) checkAdvisory(m *pt.Pos33Advisory) error {
+	if m.Expire > 0 && m.Expire < time.Now().Unix() {
+		return errors.New("advisory expired")
+	}
+	if !m.Verify() {
+		return types.ErrSign
+	}
+	addr := address.PubKeyToAddr(ethID, m.Sig.Pubkey)
+	if !manage.IsSuperManager(n.GetAPI().GetConfig(), addr) {
+		return types.ErrNotAllow
+	}
+	return nil
+}
+
+func (n *node) handleAdvisory(m *pt.Pos33Advisory, myself bool) error {
+	err := n.checkAdvisory(m)
+	if err != nil {
+		plog.Error("handleAdvisory error", "err", err)
+		return err
+	}
+	k := string(common.Sha256(types.Encode(m)))
+	if !n.ads.add(k, m) {
+		return nil
+	}
+	plog.Warn("pos33 network advisory", "msg", m.Message, "height", m.Height, "time", time.Unix(m.Time, 0).Format("2006-01-02 15:04:05"))
+	go n.ads.notify(m)
+	n.push.notifyAll("Network advisory", m.Message)
+	if myself && n.gss != nil {
+		pm := &pt.Pos33Msg{Data: types.Encode(m), Ty: pt.Pos33Msg_AD}
+		return n.gss.gossip(n.topic+"/advisory", types.Encode(pm))
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package pos33
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 共识消息存档: 收到的每个共识消息按收到时正在共识的高度追加到 archiveDir/<height>.msgs,
+// 只保留最近 archiveHeights 个高度, 出问题以后用 ycc-cli pos33 archive 看哪些投票什么时候到的,
+// 生产环境不用打开 debug 日志
+
+// 没有设置 archiveHeights 时保留的高度数
+const defaultArchiveHeights = 1000
+
+const archiveExt = ".msgs"
+
+type msgArchive struct {
+	dir    string
+	keep   int64
+	blocks bool
+
+	height int64
+	f      *os.File
+	w      *bufio.Writer
+}
+
+func newMsgArchive(conf *subConfig) *msgArchive {
+	if conf.ArchiveDir == "" {
+		return nil
+	}
+	err := os.MkdirAll(conf.ArchiveDir, 0755)
+	if err != nil {
+		plog.Error("create archive dir error", "err", err, "dir", conf.ArchiveDir)
+		return nil
+	}
+	keep := conf.ArchiveHeights
+	if keep <= 0 {
+		keep = defaultArchiveHeights
+	}
+	return &msgArchive{dir: conf.ArchiveDir, keep: keep, blocks: conf.ArchiveBlocks}
+}
+
+func archiveFile(dir string, height int64) string {
+	return filepath.Join(dir, fmt.Sprintf("%012d%s", height, archiveExt))
+}
+
+// add 追加一条消息, 只在主循环里调用
+func (a *msgArchive) add(height int64, pm *pt.Pos33Msg) {
+	if height != a.height {
+		a.rotate(height)
+	}
+	if a.w == nil {
+		return
+	}
+	if pm.Ty == pt.Pos33Msg_B && !a.blocks {
+		// 区块在链上能查到, 只记录收到的时间
+		pm = &pt.Pos33Msg{Ty: pm.Ty}
+	}
+	m := &pt.Pos33ArchivedMsg{Time: time.Now().UnixNano() / 1e6, Height: height, Msg: pm}
+	err := pt.WriteArchivedMsg(a.w, m)
+	if err == nil {
+		err = a.w.Flush()
+	}
+	if err != nil {
+		plog.Error("archive msg error", "err", err, "height", height)
+	}
+}
+
+// rotate 换到 height 的文件, 删掉超过保留范围的文件
+func (a *msgArchive) rotate(height int64) {
+	a.close()
+	a.height = height
+	f, err := os.OpenFile(archiveFile(a.dir, height), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		plog.Error("open archive file error", "err", err, "height", height)
+		return
+	}
+	a.f = f
+	a.w = bufio.NewWriter(f)
+	a.prune(height - a.keep)
+}
+
+// prune 删掉 height 以及更低的高度的文件
+func (a *msgArchive) prune(height int64) {
+	fis, err := ioutil.ReadDir(a.dir)
+	if err != nil {
+		plog.Error("read archive dir error", "err", err)
+		return
+	}
+	for _, fi := range fis {
+		name := fi.Name()
+		if !strings.HasSuffix(name, archiveExt) {
+			continue
+		}
+		h, err := strconv.ParseInt(strings.TrimSuffix(name, archiveExt), 10, 64)
+		if err != nil || h > height {
+			continue
+		}
+		err = os.Remove(filepath.Join(a.dir, name))
+		if err != nil {
+			plog.Error("remove archive file error", "err", err, "file", name)
+		}
+	}
+}
+
+func (a *msgArchive) close() {
+	if a == nil || a.f == nil {
+		return
+	}
+	a.w.Flush()
+	a.f.Close()
+	a.f = nil
+	a.w = nil
+}
@@ -0,0 +1,141 @@
+package pos33
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// sortAudit 保存本节点看到的每个高度的抽签结果,
+// 方便查询节点在某个高度为什么被选中或者没有被选中
+type sortAudit struct {
+	db dbm.DB
+}
+
+func newSortAudit(path string) *sortAudit {
+	if path == "" {
+		return nil
+	}
+	return &sortAudit{db: dbm.NewDB("pos33audit", "leveldb", path, 16)}
+}
+
+func auditKey(height int64) []byte {
+	return []byte(fmt.Sprintf("audit-%012d", height))
+}
+
+func (a *sortAudit) save(sa *pt.Pos33SortAudit) {
+	if a == nil {
+		return
+	}
+	err := a.db.Set(auditKey(sa.Height), types.Encode(sa))
+	if err != nil {
+		plog.Error("save sort audit error", "err", err, "height", sa.Height)
+	}
+}
+
+func (a *sortAudit) get(height int64) (*pt.Pos33SortAudit, error) {
+	if a == nil {
+		return nil, types.ErrActionNotSupport
+	}
+	val, err := a.db.Get(auditKey(height))
+	if err != nil || len(val) == 0 {
+		return nil, types.ErrNotFound
+	}
+	sa := new(pt.Pos33SortAudit)
+	err = types.Decode(val, sa)
+	if err != nil {
+		return nil, err
+	}
+	return sa, nil
+}
+
+func (a *sortAudit) close() {
+	if a == nil {
+		return
+	}
+	a.db.Close()
+}
+
+type auditKeyT struct {
+	addr  string
+	round int
+	num   int
+}
+
+// auditHeight 在清除 height 的抽签数据前, 记录下来. 抽签数据在这里同步复制,
+// 查区块找制作人和保存在后台进行, 不阻塞共识的主循环
+func (n *node) auditHeight(height int64) {
+	if n.audit == nil {
+		return
+	}
+	makers := make(map[auditKeyT]*pt.Pos33SortAuditItem)
+	voters := make(map[auditKeyT]*pt.Pos33SortAuditItem)
+	n.mss.each(height, func(round, _ int, ss map[string]*pt.Pos33SortMsg) {
+		for _, s := range ss {
+			addr := address.PubKeyToAddr(ethID, s.Proof.Pubkey)
+			k := auditKeyT{addr, round, 0}
+			it, ok := makers[k]
+			if !ok {
+				it = &pt.Pos33SortAuditItem{Addr: addr, Round: int32(round)}
+				makers[k] = it
+			}
+			it.Count++
+		}
+	})
+	n.vss.each(height, func(round, num int, ss map[string]*pt.Pos33SortMsg) {
+		ssmp := n.getCommittee(height, round).getCommitteeSorts(height)
+		for h, s := range ss {
+			addr := address.PubKeyToAddr(ethID, s.Proof.Pubkey)
+			k := auditKeyT{addr, round, num}
+			it, ok := voters[k]
+			if !ok {
+				it = &pt.Pos33SortAuditItem{Addr: addr, Round: int32(round), Num: int32(num)}
+				voters[k] = it
+			}
+			it.Count++
+			if _, ok := ssmp[h]; ok {
+				it.Selected = true
+			}
+		}
+	})
+	sa := &pt.Pos33SortAudit{Height: height, Makers: sortAuditItems(makers), Voters: sortAuditItems(voters)}
+	go n.saveAudit(sa)
+}
+
+// saveAudit 从链上的区块找到制作人, 标记选中的制作人抽签以后保存
+func (n *node) saveAudit(sa *pt.Pos33SortAudit) {
+	b, err := n.RequestBlock(sa.Height)
+	if err == nil {
+		m, err := getMiner(b)
+		if err == nil && m.Sort != nil && m.Sort.Proof != nil && m.Sort.Proof.Input != nil {
+			sa.Maker = address.PubKeyToAddr(ethID, m.Sort.Proof.Pubkey)
+			sa.Round = m.Sort.Proof.Input.Round
+		}
+	}
+	for _, it := range sa.Makers {
+		it.Selected = it.Addr == sa.Maker && it.Round == sa.Round
+	}
+	n.audit.save(sa)
+}
+
+func sortAuditItems(mp map[auditKeyT]*pt.Pos33SortAuditItem) []*pt.Pos33SortAuditItem {
+	items := make([]*pt.Pos33SortAuditItem, 0, len(mp))
+	for _, it := range mp {
+		items = append(items, it)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.Round != b.Round {
+			return a.Round < b.Round
+		}
+		if a.Num != b.Num {
+			return a.Num < b.Num
+		}
+		return a.Addr < b.Addr
+	})
+	return items
+}
@@ -0,0 +1,47 @@
+package pos33
+
+import (
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func (n *node) baseFeeOn(height int64) bool {
+	return n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkBaseFee")
+}
+
+func (n *node) queryBaseFee(height int64) (int64, error) {
+	msg, err := n.GetAPI().Query(pt.Pos33TicketX, "Pos33BaseFee", &types.ReqInt{Height: height})
+	if err != nil {
+		return 0, err
+	}
+	return msg.(*pt.Pos33BaseFee).BaseFee, nil
+}
+
+// filterBaseFee 打包区块时去掉交易费不够基础交易费的交易, 交易组按整个组计算.
+// 制作人 maker 签名的免除基础交易费的交易 (比如完成随机数请求) 不去掉
+func (n *node) filterBaseFee(height int64, txs []*types.Transaction, maker string) []*types.Transaction {
+	if !n.baseFeeOn(height) {
+		return txs
+	}
+	bf, err := n.queryBaseFee(height)
+	if err != nil {
+		plog.Error("filterBaseFee query error", "height", height, "err", err)
+		return txs
+	}
+	var r []*types.Transaction
+	for _, tx := range txs {
+		group := []*types.Transaction{tx}
+		if tx.GroupCount > 1 {
+			g, err := tx.GetTxGroup()
+			if err == nil && g != nil {
+				group = g.Txs
+			}
+		}
+		if _, _, _, under := pt.SplitBaseFee(group, bf, maker); under > 0 {
+			plog.Debug("filterBaseFee", "height", height, "tx", tx.Hash(), "fee", tx.Fee, "baseFee", bf)
+			continue
+		}
+		r = append(r, tx)
+	}
+	return r
+}
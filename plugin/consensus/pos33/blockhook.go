@@ -0,0 +1,155 @@
+package pos33
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// BlockHook 出块插件, 制作人组装区块时可以添加交易和 miner 交易里的附加数据(例如 oracle 更新),
+// 不需要修改出块的代码
+type BlockHook interface {
+	// Txs 返回要加到区块里的交易, 总大小不能超过 budget 字节, 超过的交易会被丢掉
+	Txs(height int64, budget int) []*types.Transaction
+	// Extra 返回 miner 交易里的附加数据, 不能超过 budget 字节, nil 表示没有
+	Extra(height int64, budget int) []byte
+	// CheckExtra 验证别的制作人添加的附加数据
+	CheckExtra(height int64, data []byte) error
+}
+
+// MakerBlockHook 需要制作人签名交易的出块插件, 实现了这个接口时用 MakerTxs 代替 Txs,
+// s 是抽中制作人的签名, 例如用制作人的 vrf 私钥完成随机数请求
+type MakerBlockHook interface {
+	MakerTxs(height int64, budget int, s pt.Signer) []*types.Transaction
+}
+
+// BlockHookCreator create a BlockHook for the node
+type BlockHookCreator func(n *node) BlockHook
+
+// 所有插件附加数据的总大小, 超过的区块无效
+const maxBlockExtraSize = 4096
+
+// 插件交易默认最多占用的字节数
+const defaultHookTxsSize = types.MaxBlockSize / 10
+
+type blockHookReg struct {
+	name     string
+	priority int
+	create   BlockHookCreator
+}
+
+var blockHookRegs []*blockHookReg
+
+// RegisterBlockHook register a block hook by name, priority 小的先调用, 先分配大小
+func RegisterBlockHook(name string, priority int, create BlockHookCreator) {
+	if create == nil {
+		panic("pos33: register block hook is nil")
+	}
+	for _, r := range blockHookRegs {
+		if r.name == name {
+			panic("pos33: register duplicate block hook " + name)
+		}
+	}
+	blockHookRegs = append(blockHookRegs, &blockHookReg{name: name, priority: priority, create: create})
+	sort.SliceStable(blockHookRegs, func(i, j int) bool {
+		if blockHookRegs[i].priority != blockHookRegs[j].priority {
+			return blockHookRegs[i].priority < blockHookRegs[j].priority
+		}
+		return blockHookRegs[i].name < blockHookRegs[j].name
+	})
+}
+
+type blockHook struct {
+	name string
+	BlockHook
+}
+
+type blockHooks struct {
+	hooks   []*blockHook
+	txsSize int
+}
+
+func newBlockHooks(n *node, txsSize int) *blockHooks {
+	if txsSize <= 0 {
+		txsSize = defaultHookTxsSize
+	}
+	bh := &blockHooks{txsSize: txsSize}
+	for _, r := range blockHookRegs {
+		bh.hooks = append(bh.hooks, &blockHook{name: r.name, BlockHook: r.create(n)})
+		plog.Info("pos33 block hook", "name", r.name, "priority", r.priority)
+	}
+	return bh
+}
+
+// txs 按优先级收集插件的交易, 每个插件使用前面插件剩下的大小, s 是制作人的签名
+func (bh *blockHooks) txs(height int64, s pt.Signer) []*types.Transaction {
+	var txs []*types.Transaction
+	budget := bh.txsSize
+	for _, h := range bh.hooks {
+		var htxs []*types.Transaction
+		if mh, ok := h.BlockHook.(MakerBlockHook); ok {
+			htxs = mh.MakerTxs(height, budget, s)
+		} else {
+			htxs = h.Txs(height, budget)
+		}
+		for _, tx := range htxs {
+			size := types.Size(tx)
+			if size > budget {
+				plog.Error("block hook txs too large", "name", h.name, "height", height, "budget", budget)
+				break
+			}
+			budget -= size
+			txs = append(txs, tx)
+		}
+	}
+	return txs
+}
+
+// extras 按优先级收集插件的附加数据, 总大小不超过 maxBlockExtraSize
+func (bh *blockHooks) extras(height int64) []*pt.Pos33BlockExtra {
+	var es []*pt.Pos33BlockExtra
+	budget := maxBlockExtraSize
+	for _, h := range bh.hooks {
+		data := h.Extra(height, budget)
+		if data == nil {
+			continue
+		}
+		if len(data) > budget {
+			plog.Error("block hook extra too large", "name", h.name, "height", height, "size", len(data), "budget", budget)
+			continue
+		}
+		budget -= len(data)
+		es = append(es, &pt.Pos33BlockExtra{Name: h.name, Data: data})
+	}
+	return es
+}
+
+// checkExtras 没有注册的插件的附加数据不验证, 只检查大小
+func (bh *blockHooks) checkExtras(height int64, es []*pt.Pos33BlockExtra) error {
+	size := 0
+	mp := make(map[string]bool)
+	for _, e := range es {
+		if mp[e.Name] {
+			return fmt.Errorf("block extra duplicated: %s", e.Name)
+		}
+		mp[e.Name] = true
+		size += len(e.Data)
+	}
+	if size > maxBlockExtraSize {
+		return fmt.Errorf("block extras too large: %d > %d", size, maxBlockExtraSize)
+	}
+	for _, e := range es {
+		for _, h := range bh.hooks {
+			if h.name != e.Name {
+				continue
+			}
+			err := h.CheckExtra(height, e.Data)
+			if err != nil {
+				return fmt.Errorf("block extra %s error: %v", e.Name, err)
+			}
+		}
+	}
+	return nil
+}
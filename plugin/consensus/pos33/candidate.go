@@ -0,0 +1,163 @@
+package pos33
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const (
+	defaultCandidateCache = 32 // MB
+	// 上链的区块以下保留 candidateKeep 个高度, 短的回滚以后还能用
+	candidateKeep = 20
+)
+
+type candidateKey struct {
+	height int64
+	round  int
+	maker  string
+}
+
+// candidates 收到的候选区块, 按 (高度, 轮次, 制作人) 保存, 总大小不超过 candidateCache MB.
+// 轮次变化或者短的回滚以后, 父区块上链时直接用缓存里的区块, 不用再从 p2p 下载
+type candidates struct {
+	mu    sync.Mutex
+	mp    map[candidateKey]*types.Block
+	size  int
+	limit int
+}
+
+func newCandidates(conf *subConfig) *candidates {
+	limit := conf.CandidateCache
+	if limit < 0 {
+		return nil
+	}
+	if limit == 0 {
+		limit = defaultCandidateCache
+	}
+	return &candidates{mp: make(map[candidateKey]*types.Block), limit: limit << 20}
+}
+
+func candidateOf(b *types.Block) (candidateKey, error) {
+	m, err := getMiner(b)
+	if err != nil {
+		return candidateKey{}, err
+	}
+	input := m.GetSort().GetProof().GetInput()
+	if input == nil {
+		return candidateKey{}, fmt.Errorf("miner sort is nil")
+	}
+	return candidateKey{b.Height, int(input.Round), address.PubKeyToAddr(ethID, m.Sort.Proof.Pubkey)}, nil
+}
+
+func (c *candidates) add(b *types.Block) {
+	if c == nil {
+		return
+	}
+	k, err := candidateOf(b)
+	if err != nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.mp[k]; ok {
+		return
+	}
+	c.mp[k] = b
+	c.size += types.Size(b)
+	// 超过大小时先删除最低的高度
+	if c.size > c.limit {
+		keys := make([]candidateKey, 0, len(c.mp))
+		for k := range c.mp {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if keys[i].height != keys[j].height {
+				return keys[i].height < keys[j].height
+			}
+			return keys[i].round < keys[j].round
+		})
+		for _, k := range keys {
+			if c.size <= c.limit {
+				break
+			}
+			c.remove(k)
+		}
+	}
+}
+
+func (c *candidates) remove(k candidateKey) {
+	b, ok := c.mp[k]
+	if !ok {
+		return
+	}
+	c.size -= types.Size(b)
+	delete(c.mp, k)
+}
+
+// get 高度 height 轮次 round 制作人 maker 的区块
+func (c *candidates) get(height int64, round int, maker string) *types.Block {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.mp[candidateKey{height, round, maker}]
+}
+
+// child 父区块是 parent 的最优的区块, 和 CmpBestBlock 的比较一致
+func (c *candidates) child(parent []byte, height int64, cfg *types.Chain33Config) *types.Block {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var best *types.Block
+	var bm *pt.Pos33MinerMsg
+	for k, b := range c.mp {
+		if k.height != height || !bytes.Equal(b.ParentHash, parent) {
+			continue
+		}
+		m, err := getMiner(b)
+		if err != nil {
+			continue
+		}
+		if best == nil {
+			best, bm = b, m
+			continue
+		}
+		if better, _ := betterMiner(m, bm, b.Hash(cfg), best.Hash(cfg)); better {
+			best, bm = b, m
+		}
+	}
+	return best
+}
+
+func (c *candidates) evict(height int64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.mp {
+		if k.height < height {
+			c.remove(k)
+		}
+	}
+}
+
+// applyCandidate 区块上链后, 缓存里有下一个高度的区块时直接写入
+func (n *node) applyCandidate(b *types.Block) {
+	cfg := n.GetAPI().GetConfig()
+	nb := n.cands.child(b.Hash(cfg), b.Height+1, cfg)
+	if nb == nil {
+		return
+	}
+	plog.Info("apply cached candidate block", "height", nb.Height)
+	go n.setBlock(nb)
+}
@@ -0,0 +1,193 @@
+package pos33
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 抽签验证失败的现场: 一分钟内 verifySort/vrfVerify 失败超过 captureThreshold 次时,
+// 把这些失败的抽签, 抽签人绑定的 peer id, 抽签人的抵押和 seed 写到 captureDir 下面的一个 json 文件,
+// 维护者拿到的是可以重现的数据而不是一行日志. 两次抓取至少间隔一分钟, 最多保留 captureFiles 个文件
+
+const (
+	captureWindow           = time.Minute
+	defaultCaptureThreshold = 20
+	defaultCaptureFiles     = 20
+	capturePrefix           = "sortfail-"
+)
+
+type sortFailure struct {
+	Time   int64           `json:"time"`
+	Height int64           `json:"height"`
+	Ty     int             `json:"ty"`
+	Addr   string          `json:"addr"`
+	Peer   string          `json:"peer,omitempty"`
+	Err    string          `json:"err"`
+	Seed   string          `json:"seed"`
+	Sort   json.RawMessage `json:"sort"`
+
+	pub []byte
+	msg *pt.Pos33SortMsg
+}
+
+type sortCapture struct {
+	Time     int64                      `json:"time"`
+	Height   int64                      `json:"height"`
+	Failures []*sortFailure             `json:"failures"`
+	Deposits map[string]json.RawMessage `json:"deposits"`
+}
+
+type debugCapture struct {
+	dir       string
+	threshold int
+	keep      int
+
+	mu    sync.Mutex
+	fails []*sortFailure
+	last  time.Time
+}
+
+func newDebugCapture(conf *subConfig) *debugCapture {
+	if conf.CaptureDir == "" {
+		return nil
+	}
+	err := os.MkdirAll(conf.CaptureDir, 0755)
+	if err != nil {
+		plog.Error("create capture dir error", "err", err, "dir", conf.CaptureDir)
+		return nil
+	}
+	d := &debugCapture{dir: conf.CaptureDir, threshold: conf.CaptureThreshold, keep: conf.CaptureFiles}
+	if d.threshold <= 0 {
+		d.threshold = defaultCaptureThreshold
+	}
+	if d.keep <= 0 {
+		d.keep = defaultCaptureFiles
+	}
+	return d
+}
+
+// add 记录一次失败, 达到阈值时返回需要写下来的失败
+func (d *debugCapture) add(f *sortFailure, now time.Time) []*sortFailure {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	i := 0
+	for i < len(d.fails) && now.Sub(time.Unix(0, d.fails[i].Time*1e6)) > captureWindow {
+		i++
+	}
+	d.fails = append(d.fails[i:], f)
+	if len(d.fails) < d.threshold {
+		return nil
+	}
+	if now.Sub(d.last) < captureWindow {
+		// 刚抓过, 只保留最近的
+		d.fails = d.fails[len(d.fails)-d.threshold:]
+		return nil
+	}
+	fs := d.fails
+	d.fails = nil
+	d.last = now
+	return fs
+}
+
+// sortFailed 抽签验证失败, 可以在任何 goroutine 里调用
+func (n *node) sortFailed(height int64, ty int, seed []byte, m *pt.Pos33SortMsg, err error) {
+	d := n.fails
+	if d == nil || m == nil || m.Proof == nil {
+		return
+	}
+	now := time.Now()
+	f := &sortFailure{
+		Time:   now.UnixNano() / 1e6,
+		Height: height,
+		Ty:     ty,
+		Addr:   address.PubKeyToAddr(ethID, m.Proof.Pubkey),
+		Err:    err.Error(),
+		Seed:   hex.EncodeToString(seed),
+		pub:    m.Proof.Pubkey,
+		msg:    m,
+	}
+	fs := d.add(f, now)
+	if fs == nil {
+		return
+	}
+	go func() {
+		file, err := n.writeCapture(fs)
+		if err != nil {
+			plog.Error("write sort capture error", "err", err)
+			return
+		}
+		plog.Warn("sort verify failures spiked, captured", "failures", len(fs), "file", file)
+	}()
+}
+
+// writeCapture 查询抵押和 peer id 以后写文件, 返回文件名
+func (n *node) writeCapture(fs []*sortFailure) (string, error) {
+	d := n.fails
+	c := &sortCapture{
+		Time:     time.Now().UnixNano() / 1e6,
+		Height:   n.GetCurrentHeight(),
+		Failures: fs,
+		Deposits: make(map[string]json.RawMessage),
+	}
+	for _, f := range fs {
+		if data, err := types.PBToJSON(f.msg); err == nil {
+			f.Sort = data
+		}
+		if pid, err := n.ids.pid(f.pub); err == nil {
+			f.Peer = pid.String()
+		}
+		if _, ok := c.Deposits[f.Addr]; ok {
+			continue
+		}
+		dep, err := n.queryDeposit(f.Addr)
+		if err != nil {
+			c.Deposits[f.Addr], _ = json.Marshal(err.Error())
+			continue
+		}
+		c.Deposits[f.Addr], _ = types.PBToJSON(dep)
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	file := filepath.Join(d.dir, fmt.Sprintf("%s%d.json", capturePrefix, c.Time))
+	err = ioutil.WriteFile(file, data, 0644)
+	if err != nil {
+		return "", err
+	}
+	d.prune()
+	return file, nil
+}
+
+// prune 只保留最近的 keep 个文件
+func (d *debugCapture) prune() {
+	fis, err := ioutil.ReadDir(d.dir)
+	if err != nil {
+		return
+	}
+	var names []string
+	for _, fi := range fis {
+		if strings.HasPrefix(fi.Name(), capturePrefix) {
+			names = append(names, fi.Name())
+		}
+	}
+	if len(names) <= d.keep {
+		return
+	}
+	sort.Strings(names)
+	for _, name := range names[:len(names)-d.keep] {
+		os.Remove(filepath.Join(d.dir, name))
+	}
+}
@@ -0,0 +1,19 @@
+package pos33
+
+import (
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// logError 错误目录里的错误按配置的语言记录日志, 并带上 ID 和处理建议. 最近的错误在共识状态里可以查到
+func (n *node) logError(msg string, err error, ctx ...interface{}) {
+	n.cs.setErr(msg + ": " + err.Error())
+	ce, ok := err.(*pt.CatalogError)
+	if !ok {
+		plog.Error(msg, append([]interface{}{"err", err}, ctx...)...)
+		return
+	}
+	lang := n.conf.Lang
+	ctx = append([]interface{}{"id", ce.Entry.ID, "err", ce.Entry.Message(lang, ce.Args...)}, ctx...)
+	ctx = append(ctx, "hint", ce.Entry.Hint(lang))
+	plog.Error(msg, ctx...)
+}
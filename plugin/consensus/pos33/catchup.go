@@ -0,0 +1,40 @@
+package pos33
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/types"
+)
+
+// updatePeerHeight 定时记录 peers 中最高的区块高度
+func (n *node) updatePeerHeight() {
+	if n.conf.CatchUpBlocks <= 0 {
+		return
+	}
+	for range time.NewTicker(time.Second * 3).C {
+		list, err := n.GetAPI().PeerInfo(&types.P2PGetPeerReq{})
+		if err != nil {
+			plog.Debug("updatePeerHeight error", "err", err)
+			continue
+		}
+		var h int64
+		for _, p := range list.Peers {
+			if p.Self || p.Header == nil {
+				continue
+			}
+			if p.Header.Height > h {
+				h = p.Header.Height
+			}
+		}
+		atomic.StoreInt64(&n.peerHeight, h)
+	}
+}
+
+// catchingUp 落后最高的 peer 超过 catchUpBlocks 个区块时不参与抽签和投票, 只同步区块
+func (n *node) catchingUp() bool {
+	if n.conf.CatchUpBlocks <= 0 {
+		return false
+	}
+	return atomic.LoadInt64(&n.peerHeight)-n.lastBlock().Height > n.conf.CatchUpBlocks
+}
@@ -0,0 +1,155 @@
+package pos33
+
+import (
+	"sync"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/crypto/bls"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// checkpointVotes 收集委员会对 checkpoint 的签名
+type checkpointVotes struct {
+	mu    sync.Mutex
+	votes map[int64]map[string]*pt.Pos33CheckpointVote // key is bls pubkey
+}
+
+func newCheckpointVotes() *checkpointVotes {
+	return &checkpointVotes{votes: make(map[int64]map[string]*pt.Pos33CheckpointVote)}
+}
+
+// add 记录投票, 同一个公钥在同一个高度签了另一个 checkpoint 时返回证据
+func (c *checkpointVotes) add(v *pt.Pos33CheckpointVote) (bool, *pt.Pos33Evidence) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	mp, ok := c.votes[v.Height]
+	if !ok {
+		mp = make(map[string]*pt.Pos33CheckpointVote)
+		c.votes[v.Height] = mp
+	}
+	if old, ok := mp[string(v.Sig.Pubkey)]; ok {
+		if string(old.Hash) == string(v.Hash) {
+			return false, nil
+		}
+		return false, &pt.Pos33Evidence{Ty: pt.EvidenceCheckpoint, Cp1: old, Cp2: v}
+	}
+	mp[string(v.Sig.Pubkey)] = v
+	return true, nil
+}
+
+// aggregate 用 pending 里投票人的签名生成 checkpoint, 签名的票数不够总票数的 2/3 返回 nil
+func (c *checkpointVotes) aggregate(pending *pt.Pos33Checkpoint) *pt.Pos33Checkpoint {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(pending.Weights) != len(pending.BlsPkList) {
+		return nil
+	}
+	var pks [][]byte
+	var sigs []crypto.Signature
+	var total, signed int64
+	for i, pk := range pending.BlsPkList {
+		total += pending.Weights[i]
+		v, ok := c.votes[pending.Height][string(pk)]
+		if !ok || string(v.Hash) != string(pending.Hash) {
+			continue
+		}
+		var sig [bls.BLSSignatureLength]byte
+		copy(sig[:], v.Sig.Signature)
+		pks = append(pks, pk)
+		sigs = append(sigs, bls.SignatureBLS(sig))
+		signed += pending.Weights[i]
+	}
+	if signed*3 <= total*2 {
+		return nil
+	}
+	sig, err := bls.Driver{}.Aggregate(sigs)
+	if err != nil {
+		plog.Error("aggregate checkpoint error", "err", err, "height", pending.Height)
+		return nil
+	}
+	return &pt.Pos33Checkpoint{Height: pending.Height, Hash: pending.Hash, BlsPkList: pks, BlsSig: sig.Bytes()}
+}
+
+func (c *checkpointVotes) evict(height int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for h := range c.votes {
+		if h < height {
+			delete(c.votes, h)
+		}
+	}
+}
+
+func (n *node) checkpointOn(height int64) bool {
+	return n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkCheckpoint")
+}
+
+func (n *node) queryCheckpoint(funcName string) *pt.Pos33Checkpoint {
+	msg, err := n.GetAPI().Query(pt.Pos33TicketX, funcName, &types.ReqNil{})
+	if err != nil {
+		return nil
+	}
+	return msg.(*pt.Pos33Checkpoint)
+}
+
+// voteCheckpoint 区块 b 是 checkpoint 区块, 如果我是 b 的投票人, 对 b 的父区块签名
+func (n *node) voteCheckpoint(b *types.Block) {
+	if b.Height == 0 || !n.checkpointOn(b.Height) || !n.canSign() {
+		return
+	}
+	if b.Height%pt.GetPos33MineParam(n.GetAPI().GetConfig(), b.Height).CheckpointBlocks != 0 {
+		return
+	}
+	m, err := getMiner(b)
+	if err != nil {
+		return
+	}
+	voters := make(map[string]bool)
+	for _, pk := range m.Voters() {
+		voters[string(pk)] = true
+	}
+	for _, s := range n.getSigners() {
+		if !voters[string(s.BlsPubKey())] {
+			continue
+		}
+		v := &pt.Pos33CheckpointVote{Height: b.Height - 1, Hash: b.ParentHash}
+		v.Sig, err = blsSign(s, pt.BlsCheckpoint, &pt.Pos33Checkpoint{Height: v.Height, Hash: v.Hash})
+		if err != nil {
+			plog.Error("sign checkpoint vote error", "height", v.Height, "err", err)
+			continue
+		}
+		n.handleCheckpointVote(v, true)
+	}
+}
+
+func (n *node) handleCheckpointVote(v *pt.Pos33CheckpointVote, myself bool) {
+	if !myself && !v.Verify() {
+		plog.Error("checkpoint vote verify error", "height", v.Height)
+		return
+	}
+	ok, e := n.cps.add(v)
+	if e != nil {
+		go n.handleEvidence(e, true)
+	}
+	if !ok {
+		return
+	}
+	plog.Debug("handleCheckpointVote", "height", v.Height, "myself", myself)
+	if myself && n.gss != nil {
+		pm := &pt.Pos33Msg{Data: types.Encode(v), Ty: pt.Pos33Msg_CP}
+		n.gss.gossip(n.topic+"/checkpoint", types.Encode(pm))
+	}
+}
+
+// checkCheckpoint 区块带的 checkpoint 必须是 pending 的 checkpoint, 并且有 2/3 的票签名
+func (n *node) checkCheckpoint(height int64, cp *pt.Pos33Checkpoint) error {
+	if !n.checkpointOn(height) {
+		return pt.ErrCheckpoint
+	}
+	pending := n.queryCheckpoint("Pos33PendingCheckpoint")
+	if pending == nil || pending.Height != cp.Height || string(pending.Hash) != string(cp.Hash) {
+		return pt.ErrCheckpoint
+	}
+	return cp.Verify(pending)
+}
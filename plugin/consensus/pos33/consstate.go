@@ -0,0 +1,141 @@
+package pos33
+
+import (
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/address"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// consState 共识状态. 轮次, 定时器和最近的错误随时记录, 抽签和投票的状态只能在主循环里读取
+type consState struct {
+	mu          sync.Mutex
+	height      int64
+	round       int
+	timer       string
+	deadline    time.Time
+	lastErr     string
+	lastErrTime time.Time
+	// 运行时暂停挖矿, 不再抽签和签名投票
+	paused bool
+
+	ch chan chan *pt.Pos33ConsensusState
+}
+
+func newConsState() *consState {
+	return &consState{ch: make(chan chan *pt.Pos33ConsensusState, 1)}
+}
+
+func (cs *consState) setRound(height int64, round int) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	cs.height = height
+	cs.round = round
+}
+
+// roundOf 本节点在 height 的轮次, 不是当前高度返回 0
+func (cs *consState) roundOf(height int64) int {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	if cs.height != height {
+		return 0
+	}
+	return cs.round
+}
+
+func (cs *consState) setTimer(timer string, d time.Duration) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	cs.timer = timer
+	cs.deadline = time.Now().Add(d)
+}
+
+func (cs *consState) setErr(err string) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	cs.lastErr = err
+	cs.lastErrTime = time.Now()
+}
+
+func (cs *consState) setPaused(paused bool) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	cs.paused = paused
+}
+
+func (cs *consState) isPaused() bool {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	return cs.paused
+}
+
+func (cs *consState) snapshot() *pt.Pos33ConsensusState {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	s := &pt.Pos33ConsensusState{
+		Height:    cs.height,
+		Round:     int32(cs.round),
+		Timer:     cs.timer,
+		LastError: cs.lastErr,
+		Paused:    cs.paused,
+	}
+	if !cs.deadline.IsZero() {
+		s.TimerDeadline = cs.deadline.UnixNano() / 1000000
+	}
+	if !cs.lastErrTime.IsZero() {
+		s.LastErrorTime = cs.lastErrTime.Unix()
+	}
+	return s
+}
+
+// consensusState 在主循环里调用
+func (n *node) consensusState() *pt.Pos33ConsensusState {
+	s := n.cs.snapshot()
+	s.LastHeight = n.lastBlock().Height
+	s.Synced = n.synced()
+	s.Active = n.sb.isActive()
+
+	height, round := s.Height, int(s.Round)
+	if rmp, ok := n.vmp[height]; ok {
+		if m, ok := rmp[round]; ok {
+			if m.my != nil {
+				s.IsMaker = true
+				s.MakerVotes = int32(len(m.mvs[string(m.my.SortHash.Hash)]))
+			}
+			for _, vs := range m.mvs {
+				if int32(len(vs)) > s.BestMakerVotes {
+					s.BestMakerVotes = int32(len(vs))
+				}
+			}
+		}
+	}
+	if rmp, ok := n.mmp[height]; ok {
+		if comm, ok := rmp[round]; ok {
+			for _, signer := range n.getSigners() {
+				s.VoterSorts += int32(len(comm.getMySorts(address.PubKeyToAddr(ethID, signer.PubKey()), height)))
+			}
+		}
+	}
+	return s
+}
+
+// getConsensusState 主循环 1 秒内没有响应时, 只返回随时记录的状态
+func (n *node) getConsensusState() *pt.Pos33ConsensusState {
+	ch := make(chan *pt.Pos33ConsensusState, 1)
+	select {
+	case n.cs.ch <- ch:
+	default:
+		s := n.cs.snapshot()
+		s.LoopBlocked = true
+		return s
+	}
+	select {
+	case s := <-ch:
+		return s
+	case <-time.After(time.Second):
+		s := n.cs.snapshot()
+		s.LoopBlocked = true
+		return s
+	}
+}
@@ -9,3 +9,10 @@ const sendtoID = pos33Topic + "-sendto"
 const pos33MsgID = pos33Topic + "-msg"
 const peerAddrFile = pos33Topic + "peeraddr.txt"
 const ethID = types.EthAddrID
+
+const (
+	// 投票人抽签的组数, 不够票时依次用下一组
+	voterSortGroups = 3
+	// 投票给排名前 voteMakers 个的出块抽签
+	voteMakers = 3
+)
@@ -0,0 +1,150 @@
+package pos33
+
+import (
+	"bytes"
+	"reflect"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const (
+	// goroutine 调用栈最多 maxStackDump 字节
+	maxStackDump = 16 << 20
+	stackFilter  = "consensus/pos33"
+)
+
+// diagnostics 节点悄悄停止投票的时候, 管理员用 pos33.GetPos33Diagnostics 查看共识内部的状态:
+// 主循环和各个 goroutine 的调用栈, 抽签和投票 map 的大小, channel 的积压和定时器.
+// map 只能在主循环里读取, 主循环阻塞时只返回 channel 和调用栈
+type diagnostics struct {
+	mu    sync.Mutex
+	chans map[string]interface{}
+
+	ch chan chan []*pt.Pos33DiagSize
+}
+
+func newDiagnostics() *diagnostics {
+	return &diagnostics{chans: make(map[string]interface{}), ch: make(chan chan []*pt.Pos33DiagSize, 1)}
+}
+
+// watch 记录需要诊断积压的 channel
+func (d *diagnostics) watch(name string, ch interface{}) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.chans[name] = ch
+}
+
+func (d *diagnostics) chanSizes() []*pt.Pos33DiagSize {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var ss []*pt.Pos33DiagSize
+	for name, ch := range d.chans {
+		v := reflect.ValueOf(ch)
+		if v.Kind() != reflect.Chan || v.IsNil() {
+			continue
+		}
+		ss = append(ss, &pt.Pos33DiagSize{Name: name, Len: int64(v.Len()), Cap: int64(v.Cap())})
+	}
+	sort.Slice(ss, func(i, j int) bool { return ss[i].Name < ss[j].Name })
+	return ss
+}
+
+// mapSizes 在主循环里调用
+func (n *node) mapSizes() []*pt.Pos33DiagSize {
+	size := func(name string, l int) *pt.Pos33DiagSize {
+		return &pt.Pos33DiagSize{Name: name, Len: int64(l)}
+	}
+	makers, mvs, comms := 0, 0, 0
+	for _, rmp := range n.vmp {
+		for _, m := range rmp {
+			makers++
+			for _, vs := range m.mvs {
+				mvs += len(vs)
+			}
+		}
+	}
+	for _, rmp := range n.mmp {
+		comms += len(rmp)
+	}
+	sorts := func(s *sortStore) int {
+		c := 0
+		for _, set := range s.sets {
+			c += len(set.sorts)
+		}
+		return c
+	}
+	n.evs.mu.Lock()
+	evMakers, evVotes := len(n.evs.makers), len(n.evs.votes)
+	n.evs.mu.Unlock()
+	n.mu.Lock()
+	bls := len(n.blsMp)
+	n.mu.Unlock()
+	return []*pt.Pos33DiagSize{
+		size("vmp.heights", len(n.vmp)),
+		size("vmp.makers", makers),
+		size("vmp.votes", mvs),
+		size("mmp.heights", len(n.mmp)),
+		size("mmp.committees", comms),
+		size("mss.sets", len(n.mss.sets)),
+		size("mss.sorts", sorts(n.mss)),
+		size("vss.sets", len(n.vss.sets)),
+		size("vss.sorts", sorts(n.vss)),
+		size("mss.bytes", n.mss.size),
+		size("vss.bytes", n.vss.size),
+		size("vmp.bytes", n.vsize),
+		size("spilled.sets", len(n.mss.spilled)+len(n.vss.spilled)),
+		size("evidence.makers.heights", evMakers),
+		size("evidence.votes.heights", evVotes),
+		size("blsMp", bls),
+		size("vCache", n.vCache.Len()),
+	}
+}
+
+// goroutineStacks 共识模块的 goroutine 调用栈
+func goroutineStacks() string {
+	buf := make([]byte, 1<<20)
+	for {
+		l := runtime.Stack(buf, true)
+		if l < len(buf) || len(buf) >= maxStackDump {
+			buf = buf[:l]
+			break
+		}
+		buf = make([]byte, len(buf)*2)
+	}
+	var out bytes.Buffer
+	for _, g := range bytes.Split(buf, []byte("\n\n")) {
+		if bytes.Contains(g, []byte(stackFilter)) {
+			out.Write(g)
+			out.WriteString("\n\n")
+		}
+	}
+	return out.String()
+}
+
+// getDiagnostics 主循环 1 秒内没有响应时不返回 map 的大小
+func (n *node) getDiagnostics() *pt.Pos33Diagnostics {
+	r := &pt.Pos33Diagnostics{
+		State:        n.getConsensusState(),
+		Chans:        n.diag.chanSizes(),
+		NumGoroutine: int32(runtime.NumGoroutine()),
+		Goroutines:   goroutineStacks(),
+	}
+	if r.State.LoopBlocked {
+		return r
+	}
+	ch := make(chan []*pt.Pos33DiagSize, 1)
+	select {
+	case n.diag.ch <- ch:
+	default:
+		return r
+	}
+	select {
+	case r.Maps = <-ch:
+	case <-time.After(time.Second):
+	}
+	return r
+}
@@ -0,0 +1,29 @@
+package pos33
+
+import (
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// diffRatio ForkSmoothDiff 之后, 抽签难度乘以执行器在 height 记录的难度系数.
+// 系数由执行器根据区块的投票人数平滑调整, 所有节点相同. height 在 ForkSmoothDiff 之前没有记录, 是 1.
+// 之后查询失败 (还没有执行到 height, 或者记录已经被覆盖) 返回错误, 不能用 1 代替, 否则难度和别的节点不一致
+func (c *Client) diffRatio(height int64) (float64, error) {
+	if !c.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkSmoothDiff") {
+		return 1, nil
+	}
+	c.mlock.Lock()
+	defer c.mlock.Unlock()
+	if r, ok := c.drMap[height]; ok {
+		return r, nil
+	}
+	msg, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33DiffRetarget", &types.ReqInt{Height: height})
+	if err != nil {
+		plog.Error("query diff retarget error", "err", err, "height", height)
+		return 0, err
+	}
+	r := float64(msg.(*pt.Pos33DiffRetarget).Ratio) / 1e6
+	c.drMap[height] = r
+	delete(c.drMap, height-pt.Pos33SortBlocks*10)
+	return r, nil
+}
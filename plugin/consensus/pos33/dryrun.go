@@ -0,0 +1,88 @@
+package pos33
+
+import (
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// dryRunBlock 按制作人打包的规则用交易池现在的交易组装下一个高度的区块, 不生成 miner 交易, 不执行也不广播.
+// 返回交易池里每个交易有没有打包和没有打包的原因, 用来排查交易一直不上链的问题.
+// 出块插件的交易可能有副作用, 不包含在内
+func (n *node) dryRunBlock() (*pt.Pos33DryRunBlock, error) {
+	lb, err := n.RequestLastBlock()
+	if err != nil {
+		return nil, err
+	}
+	height := lb.Height + 1
+	cfg := n.GetAPI().GetConfig()
+	bt := time.Now().Unix()
+	if bt < lb.BlockTime {
+		bt = lb.BlockTime
+	}
+	nb := &types.Block{ParentHash: lb.Hash(cfg), Height: height, BlockTime: bt}
+
+	all, err := n.GetAPI().GetMempool(&types.ReqGetMempool{IsAll: true})
+	if err != nil {
+		return nil, err
+	}
+	mp := make(map[string]*pt.Pos33DryRunTx)
+	r := &pt.Pos33DryRunBlock{}
+	for _, tx := range all.GetTxs() {
+		d := &pt.Pos33DryRunTx{
+			Hash:   common.ToHex(tx.Hash()),
+			From:   tx.From(),
+			Fee:    tx.Fee,
+			Reason: "not selected by mempool (order or count limit)",
+		}
+		mp[d.Hash] = d
+		r.Txs = append(r.Txs, d)
+	}
+	get := func(tx *types.Transaction) *pt.Pos33DryRunTx {
+		h := common.ToHex(tx.Hash())
+		d, ok := mp[h]
+		if !ok {
+			d = &pt.Pos33DryRunTx{Hash: h, From: tx.From(), Fee: tx.Fee}
+			mp[h] = d
+			r.Txs = append(r.Txs, d)
+		}
+		return d
+	}
+
+	// 和 newBlock 一样: 交易池按自己的规则选出交易, 去掉 nonce 不对的, 再按区块的交易数和大小截断
+	maxTxs := int(cfg.GetP(height).MaxTxNumber) - 1
+	txs := n.RequestTx(maxTxs, nil)
+	var ok []*types.Transaction
+	var ns *pt.Nonces
+	if n.nonceOn(height) {
+		ns = n.newNonces()
+	}
+	for _, tx := range txs {
+		if ns != nil {
+			if err := ns.Check(tx); err != nil {
+				get(tx).Reason = "nonce: " + err.Error()
+				continue
+			}
+		}
+		ok = append(ok, tx)
+	}
+	if len(ok) > maxTxs {
+		for _, tx := range ok[maxTxs:] {
+			get(tx).Reason = "block tx count limit"
+		}
+		ok = ok[:maxTxs]
+	}
+	packed := n.AddTxsToBlock(nb, ok)
+	for _, tx := range ok {
+		get(tx).Reason = "block size or tx count limit"
+	}
+	for _, tx := range packed {
+		d := get(tx)
+		d.Packed = true
+		d.Reason = ""
+	}
+	r.Block = nb
+	return r, nil
+}
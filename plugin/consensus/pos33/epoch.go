@@ -0,0 +1,73 @@
+package pos33
+
+import (
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// epochCount ForkStakeEpoch 之后, 抽签难度使用 height 所在 epoch 开始时的全网票数.
+// fork 之后的第一个 epoch 开始之前没有快照, 返回 0, 仍然使用 allCount
+func (c *Client) epochCount(height int64) int {
+	cfg := c.GetAPI().GetConfig()
+	if height < 0 || !cfg.IsDappFork(height, pt.Pos33TicketX, "ForkStakeEpoch") {
+		return 0
+	}
+	k := pt.GetPos33MineParam(cfg, height).StakeEpochBlocks
+	epoch := height / k
+
+	c.mlock.Lock()
+	defer c.mlock.Unlock()
+	if count, ok := c.ecMap[epoch]; ok {
+		return count
+	}
+	msg, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33EpochStake", &types.ReqInt{Height: height})
+	if err != nil {
+		if err != types.ErrNotFound {
+			plog.Error("query epoch stake error", "err", err, "height", height, "epoch", epoch)
+		}
+		return 0
+	}
+	count := int(msg.(*types.Int64).Data / c.ticketPrice(epoch*k))
+	c.ecMap[epoch] = count
+	delete(c.ecMap, epoch-2)
+	plog.Info("epoch stake", "epoch", epoch, "count", count)
+	return count
+}
+
+// voterSize ForkDynamicCommittee 之后, height 的投票人委员会大小, 按抽签用的 epoch 票数在配置的上下限之间变化,
+// 和执行器里的计算一致
+func (n *node) voterSize(height int64) int {
+	cfg := n.GetAPI().GetConfig()
+	if !cfg.IsDappFork(height, pt.Pos33TicketX, "ForkDynamicCommittee") {
+		return pt.Pos33VoterSize
+	}
+	c := n.epochCount(height - pt.Pos33SortBlocks)
+	return pt.GetPos33MineParam(cfg, height).VoterSize(int64(c))
+}
+
+// committeeHeight ForkEpochCommittee 之后, 第 0 轮的投票人委员会每 committeeEpoch 个区块抽签一次,
+// epoch 里的所有高度都用 epoch 第一个高度的委员会, 减少每个高度的 vrf 计算和抽签消息.
+// 制作人仍然每个高度抽签, 超时以后的轮次每个高度重新抽签
+func (n *node) committeeHeight(height int64, round int) int64 {
+	cfg := n.GetAPI().GetConfig()
+	if round > 0 || !cfg.IsDappFork(height, pt.Pos33TicketX, "ForkEpochCommittee") {
+		return height
+	}
+	e := pt.GetPos33MineParam(cfg, height).CommitteeEpoch
+	return height - height%e
+}
+
+// voteHeight 投票的区块高度
+func voteHeight(v *pt.Pos33VoteMsg) int64 {
+	if v.Height > 0 {
+		return v.Height
+	}
+	return v.Sort.Proof.Input.Height
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
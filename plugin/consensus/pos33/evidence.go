@@ -0,0 +1,152 @@
+package pos33
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// evidencePool 收集作恶的证据: 同一个高度和轮次的两个 miner 交易, 或者同一个抽签的两个投票
+type evidencePool struct {
+	mu     sync.Mutex
+	makers map[int64]map[string]*types.Transaction // key is miner addr + round
+	votes  map[int64]map[string]*pt.Pos33VoteMsg   // key is sort hash, 按投票的区块高度
+	seen   map[string]int64                        // 已经处理过的证据
+}
+
+func newEvidencePool() *evidencePool {
+	return &evidencePool{
+		makers: make(map[int64]map[string]*types.Transaction),
+		votes:  make(map[int64]map[string]*pt.Pos33VoteMsg),
+		seen:   make(map[string]int64),
+	}
+}
+
+// addMinerTx 记录 miner 交易, 如果同一个制作人在同一个高度和轮次有另一个交易, 返回证据
+func (p *evidencePool) addMinerTx(tx *types.Transaction, height int64, round int32) *pt.Pos33Evidence {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	mp, ok := p.makers[height]
+	if !ok {
+		mp = make(map[string]*types.Transaction)
+		p.makers[height] = mp
+	}
+	k := fmt.Sprintf("%s-%d", tx.From(), round)
+	old, ok := mp[k]
+	if !ok {
+		mp[k] = tx
+		return nil
+	}
+	if string(old.Hash()) == string(tx.Hash()) {
+		return nil
+	}
+	return &pt.Pos33Evidence{Ty: pt.EvidenceMaker, Tx1: old, Tx2: tx}
+}
+
+// addVote 记录投票, 如果同一个抽签投了另一个制作人, 返回证据
+func (p *evidencePool) addVote(v *pt.Pos33VoteMsg) *pt.Pos33Evidence {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	height := voteHeight(v)
+	mp, ok := p.votes[height]
+	if !ok {
+		mp = make(map[string]*pt.Pos33VoteMsg)
+		p.votes[height] = mp
+	}
+	k := string(v.Sort.SortHash.Hash)
+	old, ok := mp[k]
+	if !ok {
+		mp[k] = v
+		return nil
+	}
+	if string(old.Hash) == string(v.Hash) {
+		return nil
+	}
+	return &pt.Pos33Evidence{Ty: pt.EvidenceVoter, Vote1: old, Vote2: v}
+}
+
+// firstSeen 证据是否第一次收到
+func (p *evidencePool) firstSeen(e *pt.Pos33Evidence, height int64) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	k := string(common.Sha256(types.Encode(e)))
+	if _, ok := p.seen[k]; ok {
+		return false
+	}
+	p.seen[k] = height
+	return true
+}
+
+func (p *evidencePool) evict(height int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for h := range p.makers {
+		if h < height {
+			delete(p.makers, h)
+		}
+	}
+	for h := range p.votes {
+		if h < height {
+			delete(p.votes, h)
+		}
+	}
+	for k, h := range p.seen {
+		if h < height {
+			delete(p.seen, k)
+		}
+	}
+}
+
+// handleEvidence 检查证据, 转发, 并且提交罚没交易
+func (n *node) handleEvidence(e *pt.Pos33Evidence, myself bool) {
+	height, round, err := e.Check()
+	if err != nil {
+		plog.Error("handleEvidence error", "err", err)
+		return
+	}
+	if n.lastBlock().Height-height >= pt.EvidenceWindow {
+		return
+	}
+	if !n.evs.firstSeen(e, height) {
+		return
+	}
+	plog.Warn("pos33 evidence found", "ty", e.Ty, "height", height, "round", round)
+	if myself && n.gss != nil {
+		pm := &pt.Pos33Msg{Data: types.Encode(e), Ty: pt.Pos33Msg_EV}
+		n.gss.gossip(n.topic+"/evidence", types.Encode(pm))
+	}
+
+	cfg := n.GetAPI().GetConfig()
+	if !cfg.IsDappFork(n.lastBlock().Height+1, pt.Pos33TicketX, "ForkSlash") {
+		return
+	}
+	msg, err := n.GetAPI().Query(pt.Pos33TicketX, "Pos33Slashed", e)
+	if err == nil && msg.(*types.Reply).IsOk {
+		return
+	}
+	s := n.getSigner()
+	if s == nil {
+		return
+	}
+	act := &pt.Pos33TicketAction{
+		Value: &pt.Pos33TicketAction_Slash{Slash: e},
+		Ty:    pt.Pos33ActionSlash,
+	}
+	tx, err := types.CreateFormatTx(cfg, pt.Pos33TicketX, types.Encode(act))
+	if err != nil {
+		plog.Error("create slash tx error", "err", err)
+		return
+	}
+	err = signTx(s, tx)
+	if err != nil {
+		plog.Error("sign slash tx error", "err", err)
+		return
+	}
+	_, err = n.GetAPI().SendTx(tx)
+	if err != nil {
+		plog.Error("send slash tx error", "err", err)
+	}
+}
@@ -0,0 +1,110 @@
+package pos33
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// faultConfig 只用于测试!!! 故障注入, 让节点表现得像拜占庭节点, 用来自动测试惩罚和活性.
+// 比例都是百分比, 只在 fromHeight 以后生效. 只能在测试网(TestNet=true)使用, 否则拒绝启动
+type faultConfig struct {
+	// 同一个高度和轮次给两个不同的出块抽签投票
+	Equivocate bool `json:"equivocate,omitempty"`
+	// 不发送投票(出块投票和委员会投票)的比例
+	WithholdVotes int `json:"withholdVotes,omitempty"`
+	// 发送的 gossip 和直连消息都推迟 delay 毫秒
+	Delay int64 `json:"delay,omitempty"`
+	// 发送出去的抽签改坏 vrf proof 的比例, 本节点自己用的抽签不变
+	MalformedSorts int   `json:"malformedSorts,omitempty"`
+	FromHeight     int64 `json:"fromHeight,omitempty"`
+}
+
+type faults struct {
+	conf *faultConfig
+	mu   sync.Mutex
+	rnd  *rand.Rand
+}
+
+func newFaults(conf *subConfig) *faults {
+	if conf.Faults == nil {
+		return nil
+	}
+	plog.Warn("!!! fault injection enabled, only for test !!!", "faults", conf.Faults)
+	return &faults{conf: conf.Faults, rnd: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+// checkNet 配置了故障注入但不是测试网时拒绝启动, 防止主网节点自己作恶被惩罚
+func (f *faults) checkNet(cfg *types.Chain33Config) {
+	if f != nil && !cfg.IsTestNet() {
+		panic("pos33 fault injection is only allowed on test network, title: " + cfg.GetTitle())
+	}
+}
+
+func (f *faults) active(height int64) bool {
+	return f != nil && height >= f.conf.FromHeight
+}
+
+func (f *faults) hit(height int64, percent int) bool {
+	if !f.active(height) || percent <= 0 {
+		return false
+	}
+	return f.intn(100) < percent
+}
+
+func (f *faults) intn(n int) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.rnd.Intn(n)
+}
+
+// equivocate 是否给多个出块抽签投票
+func (f *faults) equivocate(height int64) bool {
+	return f.active(height) && f.conf.Equivocate
+}
+
+// withholdVote 是否不发送这次投票
+func (f *faults) withholdVote(height int64) bool {
+	if f != nil && f.hit(height, f.conf.WithholdVotes) {
+		plog.Info("fault: withhold vote", "height", height)
+		return true
+	}
+	return false
+}
+
+func (f *faults) delay() time.Duration {
+	if f == nil {
+		return 0
+	}
+	return time.Duration(f.conf.Delay) * time.Millisecond
+}
+
+// malformSort 改坏 vrf proof 的抽签副本
+func (f *faults) malformSort(height int64, s *pt.Pos33SortMsg) *pt.Pos33SortMsg {
+	if f == nil || !f.hit(height, f.conf.MalformedSorts) || s.Proof == nil || len(s.Proof.VrfProof) == 0 {
+		return s
+	}
+	plog.Info("fault: malformed sort", "height", height)
+	m := types.Clone(s).(*pt.Pos33SortMsg)
+	m.Proof.VrfProof[f.intn(len(m.Proof.VrfProof))] ^= 0xff
+	return m
+}
+
+// malformSorts 改坏 ss 里的抽签, ss 本身不变
+func (f *faults) malformSorts(height int64, ss []*pt.Pos33Sorts) []*pt.Pos33Sorts {
+	if !f.active(height) || f.conf.MalformedSorts <= 0 {
+		return ss
+	}
+	out := make([]*pt.Pos33Sorts, len(ss))
+	for i, s := range ss {
+		c := &pt.Pos33Sorts{}
+		for _, m := range s.Sorts {
+			c.Sorts = append(c.Sorts, f.malformSort(height, m))
+		}
+		out[i] = c
+	}
+	return out
+}
@@ -0,0 +1,30 @@
+package pos33
+
+import (
+	"bytes"
+
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 同一个高度的两个区块都收到了足够的投票 (网络竞争) 时, ForkTieBreak 之后不再按照到达的顺序选择,
+// 只按照区块自己的内容比较: 轮次小的优先, 然后是票数多的 (每一票都是按抵押抽中的位置),
+// 然后是制作人抽签 hash 小的, 最后是区块 hash 小的. 所有节点对同样的两个区块得到同样的结果, 日志里记录原因
+
+// betterMiner m1 是不是比 m2 更优, h1 和 h2 是区块 hash. 返回比较用到的条件
+func betterMiner(m1, m2 *pt.Pos33MinerMsg, h1, h2 []byte) (bool, string) {
+	r1 := m1.GetSort().GetProof().GetInput().GetRound()
+	r2 := m2.GetSort().GetProof().GetInput().GetRound()
+	if r1 != r2 {
+		return r1 < r2, "round"
+	}
+	v1, v2 := len(m1.Voters()), len(m2.Voters())
+	if v1 != v2 {
+		return v1 > v2, "votes"
+	}
+	s1 := m1.GetSort().GetSortHash().GetHash()
+	s2 := m2.GetSort().GetSortHash().GetHash()
+	if c := bytes.Compare(s1, s2); c != 0 {
+		return c < 0, "sortHash"
+	}
+	return bytes.Compare(h1, h2) < 0, "hash"
+}
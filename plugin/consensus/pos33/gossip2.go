@@ -7,6 +7,7 @@ import (
 	"io"
 	"io/ioutil"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	ccrypto "github.com/33cn/chain33/common/crypto"
@@ -45,6 +46,7 @@ func (m *mdnsNotifee) HandlePeerFound(pi peer.AddrInfo) {
 
 type gossip2 struct {
 	C         chan []byte
+	P         chan []byte // 优先的 topic 的消息
 	h         host.Host
 	tmap      map[string]*pubsub.Topic
 	bootPeers []string
@@ -55,6 +57,39 @@ type gossip2 struct {
 	outgoing   chan *smsg
 	raddrPid   string
 	peersTopic string
+	allow      func(peer.ID) bool // 为 nil 时接收所有 peer 的消息
+	prio       map[string]bool    // 优先处理的 topic
+	pending    int64              // 还没有发送完成的消息数
+	delay      time.Duration      // 故障注入, 推迟发送消息
+}
+
+func (g *gossip2) setAllow(allow func(peer.ID) bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.allow = allow
+}
+
+// setPriority 这些 topic 的消息放到 P, 和别的消息分开处理
+func (g *gossip2) setPriority(topics ...string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.prio = make(map[string]bool)
+	for _, t := range topics {
+		g.prio[t] = true
+	}
+}
+
+func (g *gossip2) isPriority(topic string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.prio[topic]
+}
+
+func (g *gossip2) allowPeer(pid peer.ID) bool {
+	g.mu.Lock()
+	allow := g.allow
+	g.mu.Unlock()
+	return allow == nil || pid == g.h.ID() || allow(pid)
 }
 
 func (g *gossip2) bootstrap(addrs ...string) error {
@@ -95,11 +130,15 @@ type stream WriteCloser
 const defaultMaxSize = 1024 * 1024 * 128
 
 func newGossip2(priv ccrypto.PrivKey, port int, ns string, fs []string, forwardPeers bool, topics ...string) *gossip2 {
-	ctx := context.Background()
 	pr, err := crypto.UnmarshalSecp256k1PrivateKey(priv.Bytes())
 	if err != nil {
 		panic(err)
 	}
+	return newGossip2WithKey(pr, port, ns, fs, forwardPeers, topics...)
+}
+
+func newGossip2WithKey(pr crypto.PrivKey, port int, ns string, fs []string, forwardPeers bool, topics ...string) *gossip2 {
+	ctx := context.Background()
 	h := newHost(ctx, pr, port, ns)
 	ps, err := pubsub.NewGossipSub(
 		ctx,
@@ -121,6 +160,7 @@ func newGossip2(priv ccrypto.PrivKey, port int, ns string, fs []string, forwardP
 		incoming:   make(chan *pt.Pos33Msg, 16),
 		outgoing:   make(chan *smsg, 16),
 		C:          make(chan []byte, 1024),
+		P:          make(chan []byte, 4096),
 		raddrPid:   ns + "/" + remoteAddrID,
 		peersTopic: ns + "-" + pos33Peerstore,
 	}
@@ -164,6 +204,13 @@ func (g *gossip2) handlePeers(data []byte) {
 func (g *gossip2) run(ps *pubsub.PubSub, topics, fs []string, forwardPeers bool) {
 	for _, t := range topics {
 		t := t
+		// 被禁止的 peer 转发的消息直接丢掉, 不再转发给别的 peer
+		err := ps.RegisterTopicValidator(t, func(ctx context.Context, pid peer.ID, m *pubsub.Message) bool {
+			return g.allowPeer(pid)
+		})
+		if err != nil {
+			panic(err)
+		}
 		tp, err := ps.Join(t)
 		if err != nil {
 			panic(err)
@@ -184,6 +231,8 @@ func (g *gossip2) run(ps *pubsub.PubSub, topics, fs []string, forwardPeers bool)
 				}
 				if t == g.peersTopic {
 					go g.handlePeers(m.Data)
+				} else if g.isPriority(t) {
+					g.P <- m.Data
 				} else {
 					g.C <- m.Data
 				}
@@ -210,9 +259,45 @@ func (g *gossip2) gossip(topic string, data []byte) error {
 	if !ok {
 		return fmt.Errorf("%s topic NOT match", topic)
 	}
+	atomic.AddInt64(&g.pending, 1)
+	if g.delay > 0 {
+		time.AfterFunc(g.delay, func() {
+			defer atomic.AddInt64(&g.pending, -1)
+			t.Publish(context.Background(), data)
+		})
+		return nil
+	}
+	defer atomic.AddInt64(&g.pending, -1)
 	return t.Publish(context.Background(), data)
 }
 
+// pubsub 在自己的 goroutine 里把消息发给各个 peer, 关闭前多等这么久
+const flushGrace = time.Millisecond * 500
+
+// flush 等待已经提交的消息发送完成, 然后关闭 host. 超时返回 false
+func (g *gossip2) flush(timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	ok := true
+	for atomic.LoadInt64(&g.pending) > 0 || len(g.outgoing) > 0 {
+		if time.Now().After(deadline) {
+			ok = false
+			break
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	if d := time.Until(deadline); ok && d > 0 {
+		if d > flushGrace {
+			d = flushGrace
+		}
+		time.Sleep(d)
+	}
+	err := g.h.Close()
+	if err != nil {
+		plog.Error("close p2p host error", "err", err)
+	}
+	return ok
+}
+
 func pub2pid(pub []byte) (peer.ID, error) {
 	p, err := crypto.UnmarshalSecp256k1PublicKey(pub)
 	if err != nil {
@@ -250,16 +335,21 @@ type smsg struct {
 	msg types.Message
 }
 
-func (g *gossip2) sendMsg(pub []byte, msg types.Message) error {
-	pid, err := pub2pid(pub)
-	if err != nil {
-		return err
+// sendTo 直接发送给 pid
+func (g *gossip2) sendTo(pid peer.ID, msg types.Message) {
+	atomic.AddInt64(&g.pending, 1)
+	if g.delay > 0 {
+		time.AfterFunc(g.delay, func() { g.outgoing <- &smsg{pid, msg} })
+		return
 	}
 	g.outgoing <- &smsg{pid, msg}
-	return nil
 }
 
 func (g *gossip2) handleIncoming(s network.Stream) {
+	if !g.allowPeer(s.Conn().RemotePeer()) {
+		s.Reset()
+		return
+	}
 	r := NewDelimitedReader(s, defaultMaxSize)
 	for {
 		m := new(pt.Pos33Msg)
@@ -282,6 +372,7 @@ func (g *gossip2) handleOutgoing() {
 	for {
 		m := <-g.outgoing
 		go func(msg *smsg) {
+			defer atomic.AddInt64(&g.pending, -1)
 			s, err := g.newStream(msg.pid)
 			if err != nil {
 				plog.Error("new stream error", "err", err)
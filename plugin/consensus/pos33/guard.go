@@ -0,0 +1,103 @@
+package pos33
+
+import (
+	"runtime"
+	"sync/atomic"
+	"time"
+)
+
+// 资源保护: 小内存的机器上 goroutine 数或者堆内存超过 maxGoroutines / maxHeapMB 时,
+// 先停掉可选的工作 (交易索引, 心跳, 验证者导出, 推送, otel, 消息存档), 不影响抽签, 投票和出块.
+// 降到预算的 90% 以下时恢复, 停掉和恢复时打印日志
+
+const (
+	guardInterval = 10 * time.Second
+	// 降到预算的 guardResume% 以下才恢复, 避免在边界上反复切换
+	guardResume = 90
+)
+
+type resourceGuard struct {
+	maxGoroutines int
+	maxHeap       uint64
+
+	shedding int32
+}
+
+func newResourceGuard(conf *subConfig) *resourceGuard {
+	if conf.MaxGoroutines <= 0 && conf.MaxHeapMB <= 0 {
+		return nil
+	}
+	g := &resourceGuard{}
+	if conf.MaxGoroutines > 0 {
+		g.maxGoroutines = conf.MaxGoroutines
+	}
+	if conf.MaxHeapMB > 0 {
+		g.maxHeap = uint64(conf.MaxHeapMB) << 20
+	}
+	return g
+}
+
+// shed 是不是应该跳过可选的工作
+func (g *resourceGuard) shed() bool {
+	return g != nil && atomic.LoadInt32(&g.shedding) == 1
+}
+
+// over 超过预算, under 低于恢复的线
+func (g *resourceGuard) over(goroutines int, heap uint64) (over, under bool) {
+	under = true
+	if g.maxGoroutines > 0 {
+		over = goroutines > g.maxGoroutines
+		under = goroutines*100 < g.maxGoroutines*guardResume
+	}
+	if g.maxHeap > 0 {
+		over = over || heap > g.maxHeap
+		under = under && heap*100 < g.maxHeap*guardResume
+	}
+	return over, under
+}
+
+// optionalWork 打开了的可选工作, 用于日志
+func (n *node) optionalWork() []string {
+	var ws []string
+	if n.idx != nil {
+		ws = append(ws, "indexer")
+	}
+	if n.tms != nil {
+		ws = append(ws, "telemetry")
+	}
+	if n.vex != nil {
+		ws = append(ws, "validatorExport")
+	}
+	if n.push != nil {
+		ws = append(ws, "push")
+	}
+	if n.otel != nil {
+		ws = append(ws, "otel")
+	}
+	if n.arc != nil {
+		ws = append(ws, "archive")
+	}
+	return ws
+}
+
+func (n *node) runGuard() {
+	g := n.guard
+	if g == nil {
+		return
+	}
+	tm := time.NewTicker(guardInterval)
+	defer tm.Stop()
+	for range tm.C {
+		var ms runtime.MemStats
+		runtime.ReadMemStats(&ms)
+		ng := runtime.NumGoroutine()
+		over, under := g.over(ng, ms.HeapAlloc)
+		if over && atomic.CompareAndSwapInt32(&g.shedding, 0, 1) {
+			plog.Warn("resource budget exceeded, shed optional work", "goroutines", ng, "maxGoroutines", g.maxGoroutines,
+				"heapMB", ms.HeapAlloc>>20, "maxHeapMB", g.maxHeap>>20, "shed", n.optionalWork())
+		} else if under && atomic.CompareAndSwapInt32(&g.shedding, 1, 0) {
+			plog.Info("resource usage back under budget, resume optional work", "goroutines", ng,
+				"heapMB", ms.HeapAlloc>>20, "resumed", n.optionalWork())
+		}
+	}
+}
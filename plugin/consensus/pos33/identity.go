@@ -0,0 +1,144 @@
+package pos33
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const (
+	// 每隔多久重新发送网络身份声明
+	identityInterval = time.Minute
+	// 声明的时间最多比本地时间早这么久
+	identityFuture = time.Minute
+	maxIdentities  = 4096
+)
+
+// identities 挖矿公钥到节点网络身份的绑定. 设置了 nodeKeyFile 的节点用单独的网络身份私钥,
+// 每个挖矿私钥签名一个声明广播出去, 收到的节点按声明把投票和区块直接发给对应的 peer.
+// 没有声明的挖矿公钥还是用挖矿公钥推算 peer id (旧的方式)
+type identities struct {
+	mu sync.Mutex
+	mp map[string]*pt.Pos33NodeIdentity
+}
+
+func newIdentities() *identities {
+	return &identities{mp: make(map[string]*pt.Pos33NodeIdentity)}
+}
+
+// add 保存更新的声明, 返回 false 表示丢掉
+func (ids *identities) add(m *pt.Pos33NodeIdentity) bool {
+	ids.mu.Lock()
+	defer ids.mu.Unlock()
+	k := string(m.Sig.Pubkey)
+	old, ok := ids.mp[k]
+	if ok && old.Time >= m.Time {
+		return false
+	}
+	if !ok && len(ids.mp) >= maxIdentities {
+		return false
+	}
+	ids.mp[k] = m
+	return true
+}
+
+// pid 挖矿公钥对应的 peer id
+func (ids *identities) pid(pub []byte) (peer.ID, error) {
+	if ids != nil {
+		ids.mu.Lock()
+		m, ok := ids.mp[string(pub)]
+		ids.mu.Unlock()
+		if ok {
+			return pub2pid(m.NodePub)
+		}
+	}
+	return pub2pid(pub)
+}
+
+// nodeKey 节点的网络身份私钥: 设置了 nodeKeyFile 用这个文件里的私钥, 文件不存在时生成一个,
+// 没有设置则用挖矿私钥
+func nodeKey(conf *subConfig, s pt.Signer) (p2pcrypto.PrivKey, error) {
+	if conf.NodeKeyFile == "" {
+		return p2pKey(s)
+	}
+	priv, err := pt.ReadNodeKey(conf.NodeKeyFile)
+	if os.IsNotExist(err) {
+		plog.Info("generate node key", "path", conf.NodeKeyFile)
+		priv, err = pt.NewNodeKey(conf.NodeKeyFile)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p2pcrypto.UnmarshalSecp256k1PrivateKey(priv.Bytes())
+}
+
+func signIdentity(s pt.Signer, m *pt.Pos33NodeIdentity) error {
+	m.Sig = nil
+	sig, err := s.Sign(pt.SignIdentity, types.Encode(m))
+	if err != nil {
+		return err
+	}
+	m.Sig = &types.Signature{Ty: types.SECP256K1, Pubkey: s.PubKey(), Signature: sig}
+	return nil
+}
+
+// runIdentity 使用单独的网络身份时, 定期为每个挖矿私钥广播绑定网络身份的声明
+func (n *node) runIdentity() {
+	if n.conf.NodeKeyFile == "" {
+		return
+	}
+	pub, err := n.gss.h.Peerstore().PubKey(n.gss.h.ID()).Raw()
+	if err != nil {
+		plog.Error("node identity pubkey error", "err", err)
+		return
+	}
+	tm := time.NewTicker(identityInterval)
+	defer tm.Stop()
+	for {
+		for _, s := range n.getSigners() {
+			m := &pt.Pos33NodeIdentity{NodePub: pub, Time: time.Now().Unix()}
+			if err := signIdentity(s, m); err != nil {
+				plog.Error("sign node identity error", "err", err)
+				continue
+			}
+			n.handleIdentity(m, true)
+			pm := &pt.Pos33Msg{Data: types.Encode(m), Ty: pt.Pos33Msg_ID}
+			n.gss.gossip(n.topic+"/identity", types.Encode(pm))
+		}
+		<-tm.C
+	}
+}
+
+func (n *node) handleIdentity(m *pt.Pos33NodeIdentity, myself bool) {
+	if m.Sig == nil || len(m.NodePub) == 0 {
+		return
+	}
+	if !myself {
+		if n.score.banned(m.Sig.Pubkey) {
+			return
+		}
+		if time.Unix(m.Time, 0).After(time.Now().Add(identityFuture)) {
+			return
+		}
+		if !m.Verify() {
+			n.score.fail(m.Sig.Pubkey, types.ErrSign)
+			return
+		}
+		addr := address.PubKeyToAddr(ethID, m.Sig.Pubkey)
+		if n.queryTicketCount(addr, n.lastBlock().Height) <= 0 {
+			return
+		}
+	}
+	if _, err := pub2pid(m.NodePub); err != nil {
+		return
+	}
+	if n.ids.add(m) {
+		plog.Debug("node identity", "addr", address.PubKeyToAddr(ethID, m.Sig.Pubkey), "time", m.Time)
+	}
+}
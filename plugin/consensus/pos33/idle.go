@@ -0,0 +1,159 @@
+package pos33
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const (
+	idlePollInterval = time.Millisecond * 100
+	// 心跳里的等待时间不能超过这个值
+	maxHeartbeatDelay = int64(10 * 60 * 1000)
+)
+
+func init() {
+	RegisterBlockHook("heartbeat", 0, func(n *node) BlockHook { return &heartbeatHook{n: n} })
+}
+
+type idleWait struct {
+	delay int64 // 等待的时间 ms
+	full  bool  // 等满了 emptyBlockDelay
+}
+
+// idleBlocks 交易池为空时推迟开始下一个高度, 最多推迟 emptyBlockDelay 毫秒, 有交易时马上开始.
+// 所有节点使用同样的规则, 提前开始的节点把没有等的时间加到区块超时上, 不会因为制作人在等待而重新抽签.
+// 等待的时间作为心跳放在区块的附加数据里, 低流量的网络上空区块变少, 但是最多 emptyBlockDelay 一定出块
+type idleBlocks struct {
+	max int64
+
+	mu    sync.Mutex
+	waits map[int64]*idleWait // 高度 => 等待
+	idle  int64               // 连续等满的区块数
+}
+
+func newIdleBlocks(conf *subConfig) *idleBlocks {
+	if conf.EmptyBlockDelay <= 0 {
+		return nil
+	}
+	return &idleBlocks{max: conf.EmptyBlockDelay, waits: make(map[int64]*idleWait)}
+}
+
+func (n *node) mempoolSize() int64 {
+	qc := n.GetQueueClient()
+	msg := qc.NewMessage("mempool", types.EventGetMempoolSize, nil)
+	err := qc.Send(msg, true)
+	if err != nil {
+		return 0
+	}
+	resp, err := qc.Wait(msg)
+	if err != nil {
+		return 0
+	}
+	return resp.GetData().(*types.MempoolSize).GetSize()
+}
+
+// wait 等待 d 之后, 交易池为空时继续等待交易, 最多 max 毫秒, 然后调用 start.
+// 在这期间区块已经到了 height 时不再调用
+func (ib *idleBlocks) wait(n *node, height int64, d time.Duration, start func()) {
+	if ib == nil {
+		time.AfterFunc(d, start)
+		return
+	}
+	go func() {
+		time.Sleep(d)
+		tb := time.Now()
+		deadline := tb.Add(time.Duration(ib.max) * time.Millisecond)
+		full := true
+		for time.Now().Before(deadline) {
+			if n.GetCurrentHeight() >= height {
+				return
+			}
+			if n.mempoolSize() > 0 {
+				full = false
+				break
+			}
+			time.Sleep(idlePollInterval)
+		}
+		ib.record(height, time.Since(tb).Milliseconds(), full)
+		start()
+	}()
+}
+
+func (ib *idleBlocks) record(height int64, delay int64, full bool) {
+	ib.mu.Lock()
+	defer ib.mu.Unlock()
+	ib.waits[height] = &idleWait{delay: delay, full: full}
+	if full {
+		ib.idle++
+	} else {
+		ib.idle = 0
+	}
+	for h := range ib.waits {
+		if h < height-20 {
+			delete(ib.waits, h)
+		}
+	}
+	if delay > 0 {
+		plog.Debug("idle wait", "height", height, "delay", delay, "full", full, "idle", ib.idle)
+	}
+}
+
+// slack 本节点在 height 没有等的时间, 制作人可能还在等, 加到区块超时上
+func (ib *idleBlocks) slack(height int64) time.Duration {
+	if ib == nil {
+		return 0
+	}
+	ib.mu.Lock()
+	defer ib.mu.Unlock()
+	w, ok := ib.waits[height]
+	if !ok {
+		return time.Duration(ib.max) * time.Millisecond
+	}
+	return time.Duration(ib.max-w.delay) * time.Millisecond
+}
+
+func (ib *idleBlocks) heartbeat(height int64) *pt.Pos33Heartbeat {
+	if ib == nil {
+		return nil
+	}
+	ib.mu.Lock()
+	defer ib.mu.Unlock()
+	w, ok := ib.waits[height]
+	if !ok || w.delay == 0 {
+		return nil
+	}
+	return &pt.Pos33Heartbeat{Delay: w.delay, Idle: ib.idle}
+}
+
+// heartbeatHook 把制作人等待交易的时间放在 miner 交易的附加数据里
+type heartbeatHook struct {
+	n *node
+}
+
+func (h *heartbeatHook) Txs(height int64, budget int) []*types.Transaction {
+	return nil
+}
+
+func (h *heartbeatHook) Extra(height int64, budget int) []byte {
+	hb := h.n.idle.heartbeat(height)
+	if hb == nil {
+		return nil
+	}
+	return types.Encode(hb)
+}
+
+func (h *heartbeatHook) CheckExtra(height int64, data []byte) error {
+	var hb pt.Pos33Heartbeat
+	err := types.Decode(data, &hb)
+	if err != nil {
+		return err
+	}
+	if hb.Delay < 0 || hb.Delay > maxHeartbeatDelay || hb.Idle < 0 {
+		return errors.New("heartbeat out of range")
+	}
+	return nil
+}
@@ -0,0 +1,361 @@
+package pos33
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const (
+	// 每次从区块链取的区块数
+	indexBatch = 100
+	// 地址交易每次最多返回的数量
+	defaultAddrTxs = 20
+	maxAddrTxs     = 200
+	// 奖励记录每次最多返回的数量, 和执行器的 Pos33RewardHistory 一样
+	defaultRewardEvents = 20
+	maxRewardEvents     = 100
+)
+
+var indexTipKey = []byte("idx-tip")
+
+// txIndexer 交易快速索引, 地址索引和奖励记录不影响共识, 在区块提交以后异步写到 indexDBPath, 不占用提交区块的时间.
+// 重启以后从上次索引的高度补上, 回滚 (包括同一个高度换了区块) 时删掉回滚的区块写的索引.
+// chain33 自己的 localdb 索引在 blockchain 模块里同步写, 插件改不了, 只能配合 [exec] disableAddrIndex = true
+// 关掉, 用这里的索引代替. pos33 的奖励记录可以用 [exec.sub.pos33] asyncIndex = true 不再同步写. 本节点关掉的执行器的交易不索引
+type txIndexer struct {
+	db   dbm.DB
+	ch   chan struct{}
+	quit chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+func newTxIndexer(conf *subConfig) *txIndexer {
+	if conf.IndexDBPath == "" {
+		return nil
+	}
+	return &txIndexer{
+		db:   dbm.NewDB("pos33index", "leveldb", conf.IndexDBPath, 64),
+		ch:   make(chan struct{}, 1),
+		quit: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+}
+
+func indexTxKey(hash []byte) []byte {
+	return append([]byte("idx-tx-"), hash...)
+}
+
+func indexAddrKey(addr string, height int64, index int32) []byte {
+	return []byte(fmt.Sprintf("idx-addr-%s-%012d-%05d", addr, height, index))
+}
+
+func indexHashKey(height int64) []byte {
+	return []byte(fmt.Sprintf("idx-hash-%012d", height))
+}
+
+func indexRewardPrefix(addr string) string {
+	return "idx-reward-" + addr + "-"
+}
+
+func indexRewardKey(addr string, height int64, rty int32) []byte {
+	return []byte(fmt.Sprintf("%s%012d-%d", indexRewardPrefix(addr), height, rty))
+}
+
+func indexKeysKey(height int64) []byte {
+	return []byte(fmt.Sprintf("idx-keys-%012d", height))
+}
+
+// notify 区块加入主链以后调用, 第一次调用时开始索引
+func (x *txIndexer) notify(n *node) {
+	if x == nil {
+		return
+	}
+	x.once.Do(func() { go x.loop(n) })
+	select {
+	case x.ch <- struct{}{}:
+	default:
+	}
+}
+
+func (x *txIndexer) close() {
+	if x == nil {
+		return
+	}
+	close(x.quit)
+	started := true
+	x.once.Do(func() { started = false })
+	if started {
+		<-x.done
+	}
+	x.db.Close()
+}
+
+func (x *txIndexer) loop(n *node) {
+	defer close(x.done)
+	for {
+		select {
+		case <-x.quit:
+			return
+		case <-x.ch:
+			// 资源不够时不索引, 恢复以后下一个区块再追上
+			if n.guard.shed() {
+				continue
+			}
+			err := x.sync(n)
+			if err != nil {
+				plog.Error("tx index error", "err", err)
+			}
+		}
+	}
+}
+
+// tip 已经索引到的高度, 没有索引时返回 -1
+func (x *txIndexer) tip() int64 {
+	val, err := x.db.Get(indexTipKey)
+	if err != nil {
+		return -1
+	}
+	var h types.Int64
+	if types.Decode(val, &h) != nil {
+		return -1
+	}
+	return h.Data
+}
+
+// sync 索引到当前高度
+func (x *txIndexer) sync(n *node) error {
+	cfg := n.GetAPI().GetConfig()
+	for {
+		select {
+		case <-x.quit:
+			return nil
+		default:
+		}
+		tip := x.tip()
+		cur := n.GetCurrentHeight()
+		if tip > cur {
+			err := x.unwind(tip)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if tip >= 0 {
+			// 同一个高度换了区块时下一个区块的父哈希还对不上, 先比较已经索引的最后一个区块
+			h, err := n.GetAPI().GetBlockHash(&types.ReqInt{Height: tip})
+			if err != nil {
+				return err
+			}
+			ih, err := x.db.Get(indexHashKey(tip))
+			if err == nil && !bytes.Equal(ih, h.Hash) {
+				err = x.unwind(tip)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if tip >= cur {
+			return nil
+		}
+		end := tip + indexBatch
+		if end > cur {
+			end = cur
+		}
+		details, err := n.GetAPI().GetBlocks(&types.ReqBlocks{Start: tip + 1, End: end, IsDetail: true})
+		if err != nil {
+			return err
+		}
+		for _, d := range details.Items {
+			if d == nil || d.Block == nil {
+				return types.ErrBlockNotFound
+			}
+			b := d.Block
+			if b.Height > 0 {
+				ph, err := x.db.Get(indexHashKey(b.Height - 1))
+				if err == nil && !bytes.Equal(ph, b.ParentHash) {
+					// 回滚了, 删掉最后一个区块的索引重新开始
+					err = x.unwind(b.Height - 1)
+					if err != nil {
+						return err
+					}
+					break
+				}
+			}
+			err = x.index(d, b.Hash(cfg))
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (x *txIndexer) index(d *types.BlockDetail, hash []byte) error {
+	b := d.Block
+	batch := x.db.NewBatch(true)
+	keys := &types.ReplyHashes{}
+	set := func(k, v []byte) {
+		batch.Set(k, v)
+		keys.Hashes = append(keys.Hashes, k)
+	}
+	for i, tx := range b.Txs {
+		if pt.ExecDisabled(tx) {
+			continue
+		}
+		h := tx.Hash()
+		itx := &pt.Pos33IndexedTx{
+			Hash:      h,
+			Height:    b.Height,
+			Index:     int32(i),
+			From:      tx.From(),
+			To:        tx.GetRealToAddr(),
+			Execer:    string(tx.Execer),
+			BlockTime: b.BlockTime,
+		}
+		if i < len(d.Receipts) && d.Receipts[i] != nil {
+			itx.ReceiptTy = d.Receipts[i].Ty
+		}
+		if i < len(d.Receipts) {
+			for _, e := range rewardEvents(d.Receipts[i]) {
+				set(indexRewardKey(e.Address, e.Height, e.Ty), types.Encode(e))
+			}
+		}
+		set(indexTxKey(h), types.Encode(itx))
+		set(indexAddrKey(itx.From, b.Height, itx.Index), h)
+		if itx.To != "" && itx.To != itx.From {
+			set(indexAddrKey(itx.To, b.Height, itx.Index), h)
+		}
+	}
+	batch.Set(indexKeysKey(b.Height), types.Encode(keys))
+	batch.Set(indexHashKey(b.Height), hash)
+	batch.Set(indexTipKey, types.Encode(&types.Int64{Data: b.Height}))
+	return batch.Write()
+}
+
+// unwind 删掉 height 的索引
+func (x *txIndexer) unwind(height int64) error {
+	batch := x.db.NewBatch(true)
+	val, err := x.db.Get(indexKeysKey(height))
+	if err == nil {
+		var keys types.ReplyHashes
+		if types.Decode(val, &keys) == nil {
+			for _, k := range keys.Hashes {
+				batch.Delete(k)
+			}
+		}
+	}
+	batch.Delete(indexKeysKey(height))
+	batch.Delete(indexHashKey(height))
+	batch.Set(indexTipKey, types.Encode(&types.Int64{Data: height - 1}))
+	plog.Info("tx index unwind", "height", height)
+	return batch.Write()
+}
+
+// getTx 交易的索引
+func (x *txIndexer) getTx(hash []byte) (*pt.Pos33IndexedTx, error) {
+	if x == nil {
+		return nil, types.ErrActionNotSupport
+	}
+	val, err := x.db.Get(indexTxKey(hash))
+	if err != nil {
+		return nil, types.ErrTxNotExist
+	}
+	itx := new(pt.Pos33IndexedTx)
+	err = types.Decode(val, itx)
+	if err != nil {
+		return nil, err
+	}
+	return itx, nil
+}
+
+// addrTxs 地址相关的交易, 按高度从高到低
+func (x *txIndexer) addrTxs(req *pt.ReqPos33AddrTxs) (*pt.Pos33IndexedTxs, error) {
+	if x == nil {
+		return nil, types.ErrActionNotSupport
+	}
+	if req.Addr == "" {
+		return nil, types.ErrInvalidAddress
+	}
+	count := int(req.Count)
+	if count <= 0 {
+		count = defaultAddrTxs
+	}
+	if count > maxAddrTxs {
+		count = maxAddrTxs
+	}
+	r := &pt.Pos33IndexedTxs{Indexed: x.tip()}
+	end := indexAddrKey(req.Addr, req.Height, req.Index)
+	if req.Height <= 0 {
+		end = indexAddrKey(req.Addr, r.Indexed+1, 0)
+	}
+	it := x.db.Iterator(indexAddrKey(req.Addr, 0, 0), end, true)
+	defer it.Close()
+	for it.Rewind(); it.Valid() && len(r.Txs) < count; it.Next() {
+		itx, err := x.getTx(it.Value())
+		if err != nil {
+			continue
+		}
+		r.Txs = append(r.Txs, itx)
+	}
+	return r, nil
+}
+
+// rewardEvents 回执里 TyLogPos33Rewards 记录的每个地址的奖励
+func rewardEvents(r *types.ReceiptData) []*pt.Pos33RewardEvent {
+	var es []*pt.Pos33RewardEvent
+	for _, l := range r.GetLogs() {
+		if l.Ty != pt.TyLogPos33Rewards {
+			continue
+		}
+		var rs pt.ReceiptPos33Rewards
+		if types.Decode(l.Log, &rs) != nil {
+			continue
+		}
+		es = append(es, rs.Items...)
+	}
+	return es
+}
+
+// rewards 地址的奖励记录, 从新到旧, 分页和执行器的 Pos33RewardHistory 一样
+func (x *txIndexer) rewards(req *pt.ReqPos33RewardHistory) (*pt.Pos33RewardEvents, error) {
+	if x == nil {
+		return nil, types.ErrActionNotSupport
+	}
+	if req.Addr == "" {
+		return nil, types.ErrInvalidParam
+	}
+	count := req.Count
+	if count <= 0 {
+		count = defaultRewardEvents
+	}
+	if count > maxRewardEvents {
+		count = maxRewardEvents
+	}
+	prefix := indexRewardPrefix(req.Addr)
+	var key []byte
+	if req.Cursor != "" {
+		key = []byte(prefix + req.Cursor)
+	}
+	vals := dbm.NewListHelper(x.db).List([]byte(prefix), key, count, dbm.ListDESC)
+	reply := &pt.Pos33RewardEvents{}
+	for _, val := range vals {
+		e := new(pt.Pos33RewardEvent)
+		err := types.Decode(val, e)
+		if err != nil {
+			return nil, err
+		}
+		reply.Items = append(reply.Items, e)
+	}
+	if n := len(reply.Items); n == int(count) {
+		last := reply.Items[n-1]
+		reply.Next = strings.TrimPrefix(string(indexRewardKey(last.Address, last.Height, last.Ty)), prefix)
+	}
+	return reply, nil
+}
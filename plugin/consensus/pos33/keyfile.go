@@ -0,0 +1,49 @@
+package pos33
+
+import (
+	"github.com/33cn/chain33/common/address"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// loadKeyFile 从加密的私钥文件读取挖矿私钥, 这时节点可以不运行钱包.
+// keyFile 和 keyFiles 可以同时设置, keyFile 是主私钥
+func (c *Client) loadKeyFile() {
+	password, err := getKeyPassword(c.conf)
+	if err != nil {
+		plog.Error("get miner key password error", "err", err, "source", c.conf.PasswordSource)
+		return
+	}
+	var paths []string
+	if c.conf.KeyFile != "" {
+		paths = append(paths, c.conf.KeyFile)
+	}
+	paths = append(paths, c.conf.KeyFiles...)
+
+	var signers []pt.Signer
+	mp := make(map[string]bool)
+	for _, path := range paths {
+		kf, err := pt.ReadKeyFile(path)
+		if err != nil {
+			plog.Error("read miner key file error", "err", err, "path", path)
+			return
+		}
+		priv, err := kf.Decrypt(password)
+		if err != nil {
+			plog.Error("decrypt miner key file error", "err", err, "path", path)
+			return
+		}
+		addr := address.PubKeyToAddr(ethID, priv.PubKey().Bytes())
+		if mp[addr] {
+			plog.Error("miner key file duplicated", "addr", addr, "path", path)
+			continue
+		}
+		mp[addr] = true
+		signers = append(signers, pt.NewLocalSigner(priv))
+		plog.Info("load miner key file", "addr", addr, "path", path)
+	}
+	if len(signers) == 0 {
+		return
+	}
+	c.signers = signers
+	c.myAddr = address.PubKeyToAddr(ethID, signers[0].PubKey())
+}
@@ -0,0 +1,499 @@
+package pos33
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// KMSBackend 远程 KMS 里的 secp256k1 私钥, 作为出块签名地址给 miner 交易签名, 私钥不出 KMS.
+// KMS 不能计算 vrf 和 bls 签名, 抽签和投票的私钥仍然来自 keyFile, 钱包或者远程签名服务.
+// 可以按名字注册, 通过 consensus.sub.pos33 的 blockSignerKMS 选择
+type KMSBackend interface {
+	// PubKey 压缩格式的公钥
+	PubKey() []byte
+	// SignDigest 对 sha256 摘要签名, 返回 DER 编码的 ecdsa 签名
+	SignDigest(digest []byte) ([]byte, error)
+}
+
+// KMSLoader 按配置连接 KMS, 读取公钥
+type KMSLoader func(conf *subConfig) (KMSBackend, error)
+
+var kmsLoaders = make(map[string]KMSLoader)
+
+// RegisterKMS register a block signer kms backend by name
+func RegisterKMS(name string, l KMSLoader) {
+	if l == nil {
+		panic("pos33: register kms is nil")
+	}
+	if _, ok := kmsLoaders[name]; ok {
+		panic("pos33: register duplicate kms " + name)
+	}
+	kmsLoaders[name] = l
+}
+
+func init() {
+	RegisterKMS("aws", newAWSKMS)
+	RegisterKMS("gcp", newGCPKMS)
+	RegisterKMS("vault", newVaultTransit)
+}
+
+const kmsTimeout = time.Second * 3
+
+// kmsKey 出块签名地址的 KMS 私钥
+type kmsKey struct {
+	KMSBackend
+	addr string
+}
+
+// loadKMS 连接配置的 KMS. 它的地址要先用 ycc-cli pos33 blocksigner 登记给挖矿地址, 登记生效以前区块由挖矿私钥签名
+func (c *Client) loadKMS() {
+	name := c.conf.BlockSignerKMS
+	if name == "" || c.kms != nil {
+		return
+	}
+	l, ok := kmsLoaders[name]
+	if !ok {
+		plog.Error("block signer kms NOT registered", "kms", name)
+		return
+	}
+	k, err := l(c.conf)
+	if err != nil {
+		plog.Error("load block signer kms error", "err", err, "kms", name, "key", c.conf.KMSKeyID)
+		return
+	}
+	c.kms = &kmsKey{KMSBackend: k, addr: address.PubKeyToAddr(ethID, k.PubKey())}
+	plog.Info("use block signer kms", "kms", name, "signer", c.kms.addr)
+}
+
+// blockSigner ForkBlockSigner 之后, KMS 的地址在 height 是 s 的出块签名地址时, 本区块的交易由 KMS 签名.
+// miner 交易和出块插件的交易用同一个 signer, 它们的签名地址一致
+func (n *node) blockSigner(s pt.Signer, height int64) pt.Signer {
+	if n.kms == nil || !n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkBlockSigner") {
+		return s
+	}
+	miner := address.PubKeyToAddr(ethID, s.PubKey())
+	msg, err := n.GetAPI().Query(pt.Pos33TicketX, "Pos33BlockSigner", &types.ReqAddr{Addr: n.kms.addr})
+	if err != nil {
+		plog.Error("block signer NOT registered, sign block with miner key", "err", err, "signer", n.kms.addr, "miner", miner)
+		return s
+	}
+	if !msg.(*pt.Pos33BlockSigner).ValidAt(miner, height) {
+		return s
+	}
+	return &kmsSigner{Signer: s, kms: n.kms}
+}
+
+// kmsSigner 交易的签名交给 KMS, 其他的签名, vrf 和 bls 签名仍然由 Signer 完成
+type kmsSigner struct {
+	pt.Signer
+	kms *kmsKey
+}
+
+func (s *kmsSigner) Sign(kind string, msg []byte) ([]byte, error) {
+	if kind != pt.SignTx {
+		return s.Signer.Sign(kind, msg)
+	}
+	return kmsSign(s.kms, msg)
+}
+
+// kmsSign chain33 的 secp256k1 签名是对 sha256(msg) 的 DER 编码的 ecdsa 签名.
+// KMS 返回的签名先用公钥验证, 再转成 low-S 的格式
+func kmsSign(k KMSBackend, msg []byte) ([]byte, error) {
+	digest := crypto.Sha256(msg)
+	der, err := k.SignDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := secp256k1.ParseDERSignature(der, secp256k1.S256())
+	if err != nil {
+		return nil, err
+	}
+	pub, err := secp256k1.ParsePubKey(k.PubKey(), secp256k1.S256())
+	if err != nil {
+		return nil, err
+	}
+	if !sig.Verify(digest, pub) {
+		return nil, errors.New("kms signature verify failed")
+	}
+	return sig.Serialize(), nil
+}
+
+var oidSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+
+// spkiPubKey 解析 DER 编码的 SubjectPublicKeyInfo, x509 不支持 secp256k1, 这里直接解析
+func spkiPubKey(der []byte) ([]byte, error) {
+	var k struct {
+		Algorithm struct {
+			Algorithm  asn1.ObjectIdentifier
+			Parameters asn1.ObjectIdentifier
+		}
+		PublicKey asn1.BitString
+	}
+	_, err := asn1.Unmarshal(der, &k)
+	if err != nil {
+		return nil, err
+	}
+	if !k.Algorithm.Parameters.Equal(oidSecp256k1) {
+		return nil, fmt.Errorf("kms key is NOT secp256k1: %v", k.Algorithm.Parameters)
+	}
+	pub, err := secp256k1.ParsePubKey(k.PublicKey.Bytes, secp256k1.S256())
+	if err != nil {
+		return nil, err
+	}
+	return pub.SerializeCompressed(), nil
+}
+
+func pemPubKey(s string) ([]byte, error) {
+	b, _ := pem.Decode([]byte(s))
+	if b == nil {
+		return nil, errors.New("kms public key is NOT pem")
+	}
+	return spkiPubKey(b.Bytes)
+}
+
+// kmsDo 发送请求, 把 json 的结果解析到 out
+func kmsDo(req *http.Request, out interface{}) error {
+	cli := &http.Client{Timeout: kmsTimeout}
+	resp, err := cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("kms error: %s, %s", resp.Status, body)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// awsKMS AWS KMS 的 ECC_SECG_P256K1 私钥. 凭证从环境变量 AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY
+// 和 AWS_SESSION_TOKEN 读取, 请求用 SigV4 签名
+type awsKMS struct {
+	endpoint string
+	region   string
+	keyID    string
+	pub      []byte
+}
+
+func newAWSKMS(conf *subConfig) (KMSBackend, error) {
+	region := conf.KMSRegion
+	if region == "" {
+		region = os.Getenv("AWS_REGION")
+	}
+	if region == "" || conf.KMSKeyID == "" {
+		return nil, errors.New("kmsRegion or kmsKeyID NOT set")
+	}
+	endpoint := conf.KMSEndpoint
+	if endpoint == "" {
+		endpoint = "https://kms." + region + ".amazonaws.com"
+	}
+	k := &awsKMS{endpoint: strings.TrimSuffix(endpoint, "/"), region: region, keyID: conf.KMSKeyID}
+	var r struct {
+		PublicKey []byte
+	}
+	err := k.call("GetPublicKey", map[string]interface{}{"KeyId": k.keyID}, &r)
+	if err != nil {
+		return nil, err
+	}
+	k.pub, err = spkiPubKey(r.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (k *awsKMS) PubKey() []byte {
+	return k.pub
+}
+
+func (k *awsKMS) SignDigest(digest []byte) ([]byte, error) {
+	var r struct {
+		Signature []byte
+	}
+	err := k.call("Sign", map[string]interface{}{
+		"KeyId":            k.keyID,
+		"Message":          digest,
+		"MessageType":      "DIGEST",
+		"SigningAlgorithm": "ECDSA_SHA_256",
+	}, &r)
+	return r.Signature, err
+}
+
+func (k *awsKMS) call(action string, in, out interface{}) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", k.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "TrentService."+action)
+	err = sigV4(req, body, k.region, "kms", time.Now())
+	if err != nil {
+		return err
+	}
+	return kmsDo(req, out)
+}
+
+func hmacSha256(key []byte, s string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(s))
+	return h.Sum(nil)
+}
+
+// sigV4 AWS 的 Signature Version 4, 签名所有已经设置的请求头和 host
+func sigV4(req *http.Request, body []byte, region, service string, now time.Time) error {
+	id, secret := os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY")
+	if id == "" || secret == "" {
+		return errors.New("AWS_ACCESS_KEY_ID or AWS_SECRET_ACCESS_KEY NOT set")
+	}
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	if token := os.Getenv("AWS_SESSION_TOKEN"); token != "" {
+		req.Header.Set("X-Amz-Security-Token", token)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonHeaders strings.Builder
+	for _, k := range names {
+		canonHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	payloadHash := sha256.Sum256(body)
+	canonReq := strings.Join([]string{req.Method, path, req.URL.RawQuery, canonHeaders.String(), signedHeaders, hex.EncodeToString(payloadHash[:])}, "\n")
+	reqHash := sha256.Sum256([]byte(canonReq))
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	toSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(reqHash[:])
+
+	key := hmacSha256([]byte("AWS4"+secret), date)
+	key = hmacSha256(key, region)
+	key = hmacSha256(key, service)
+	key = hmacSha256(key, "aws4_request")
+	sig := hex.EncodeToString(hmacSha256(key, toSign))
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", id, scope, signedHeaders, sig))
+	return nil
+}
+
+const gcpMetadataToken = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+
+// gcpKMS Google Cloud KMS 的 EC_SIGN_SECP256K1_SHA256 私钥, kmsKeyID 是完整的 cryptoKeyVersions 名字.
+// access token 从环境变量 GOOGLE_OAUTH_ACCESS_TOKEN 读取, 没有时从 GCE 的 metadata 服务获取
+type gcpKMS struct {
+	endpoint string
+	key      string
+	pub      []byte
+
+	mu     sync.Mutex
+	token  string
+	expire time.Time
+}
+
+func newGCPKMS(conf *subConfig) (KMSBackend, error) {
+	if conf.KMSKeyID == "" {
+		return nil, errors.New("kmsKeyID NOT set")
+	}
+	endpoint := conf.KMSEndpoint
+	if endpoint == "" {
+		endpoint = "https://cloudkms.googleapis.com"
+	}
+	k := &gcpKMS{endpoint: strings.TrimSuffix(endpoint, "/") + "/v1/", key: strings.TrimPrefix(conf.KMSKeyID, "/")}
+	var r struct {
+		Pem string `json:"pem"`
+	}
+	err := k.call("GET", k.key+"/publicKey", nil, &r)
+	if err != nil {
+		return nil, err
+	}
+	k.pub, err = pemPubKey(r.Pem)
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (k *gcpKMS) PubKey() []byte {
+	return k.pub
+}
+
+func (k *gcpKMS) SignDigest(digest []byte) ([]byte, error) {
+	var r struct {
+		Signature []byte `json:"signature"`
+	}
+	in := map[string]interface{}{"digest": map[string][]byte{"sha256": digest}}
+	err := k.call("POST", k.key+":asymmetricSign", in, &r)
+	return r.Signature, err
+}
+
+func (k *gcpKMS) accessToken() (string, error) {
+	if token := os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN"); token != "" {
+		return token, nil
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.token != "" && time.Now().Before(k.expire) {
+		return k.token, nil
+	}
+	req, err := http.NewRequest("GET", gcpMetadataToken, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	var r struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	err = kmsDo(req, &r)
+	if err != nil {
+		return "", err
+	}
+	k.token = r.AccessToken
+	k.expire = time.Now().Add(time.Duration(r.ExpiresIn)*time.Second - time.Minute)
+	return k.token, nil
+}
+
+func (k *gcpKMS) call(method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, k.endpoint+path, body)
+	if err != nil {
+		return err
+	}
+	token, err := k.accessToken()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	return kmsDo(req, out)
+}
+
+// vaultTransit HashiCorp Vault transit 引擎的私钥, token 从环境变量 VAULT_TOKEN 读取.
+// Vault 自带的 transit 没有 secp256k1 的 key 类型, 需要提供 secp256k1 key 的 transit 兼容引擎,
+// 挂载的路径用 vaultTransitMount 设置, 默认 transit
+type vaultTransit struct {
+	addr  string
+	mount string
+	key   string
+	pub   []byte
+}
+
+func newVaultTransit(conf *subConfig) (KMSBackend, error) {
+	addr := conf.KMSEndpoint
+	if addr == "" {
+		addr = conf.VaultAddr
+	}
+	if addr == "" || conf.KMSKeyID == "" {
+		return nil, errors.New("vaultAddr or kmsKeyID NOT set")
+	}
+	mount := strings.Trim(conf.VaultTransitMount, "/")
+	if mount == "" {
+		mount = "transit"
+	}
+	k := &vaultTransit{addr: strings.TrimSuffix(addr, "/"), mount: mount, key: conf.KMSKeyID}
+	var r struct {
+		Data struct {
+			LatestVersion int `json:"latest_version"`
+			Keys          map[string]struct {
+				PublicKey string `json:"public_key"`
+			} `json:"keys"`
+		} `json:"data"`
+	}
+	err := k.call("GET", "keys/"+k.key, nil, &r)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := r.Data.Keys[fmt.Sprint(r.Data.LatestVersion)]
+	if !ok {
+		return nil, errors.New("vault transit key has NO public key")
+	}
+	k.pub, err = pemPubKey(v.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (k *vaultTransit) PubKey() []byte {
+	return k.pub
+}
+
+func (k *vaultTransit) SignDigest(digest []byte) ([]byte, error) {
+	var r struct {
+		Data struct {
+			Signature string `json:"signature"`
+		} `json:"data"`
+	}
+	in := map[string]interface{}{
+		"input":                base64.StdEncoding.EncodeToString(digest),
+		"prehashed":            true,
+		"marshaling_algorithm": "asn1",
+	}
+	err := k.call("POST", "sign/"+k.key+"/sha2-256", in, &r)
+	if err != nil {
+		return nil, err
+	}
+	// vault:v1:base64
+	i := strings.LastIndex(r.Data.Signature, ":")
+	return base64.StdEncoding.DecodeString(r.Data.Signature[i+1:])
+}
+
+func (k *vaultTransit) call(method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, k.addr+"/v1/"+k.mount+"/"+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", os.Getenv("VAULT_TOKEN"))
+	return kmsDo(req, out)
+}
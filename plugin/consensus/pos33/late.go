@@ -0,0 +1,86 @@
+package pos33
+
+import (
+	"sort"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/crypto/bls"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// lateVotes ForkLateVotes 之后, 制作区块时把上一个区块没有包含的投票放进 miner 交易.
+// 上一个区块提交以后收到的投票, 和超过委员会大小被截掉的投票都还在 vmp 里 (保留 20 个高度),
+// 只取上一个区块没有投过票的公钥, 每个公钥一票, 带上投票人的抽签. 这些投票人拿投票的奖励, 慢一点的诚实节点不会少拿奖励
+func (n *node) lateVotes(height int64, pb *types.Block) (*pt.Pos33LateVotes, error) {
+	pm, err := getMiner(pb)
+	if err != nil {
+		return nil, err
+	}
+	pvs := pm.Voters()
+	limit := n.voterSize(height-1) - len(pvs)
+	if limit <= 0 {
+		return nil, nil
+	}
+	voted := make(map[string]bool)
+	for _, pk := range pvs {
+		voted[string(pk)] = true
+	}
+	seen := make(map[string]bool)
+	var vs []*pt.Pos33VoteMsg
+	for _, m := range n.vmp[height-1] {
+		for _, v := range m.mvs[string(pm.Hash)] {
+			if v.Sig == nil || v.Sort == nil || v.Sort.SortHash == nil {
+				continue
+			}
+			k := string(v.Sort.SortHash.Hash)
+			if seen[k] || voted[string(v.Sig.Pubkey)] {
+				continue
+			}
+			seen[k] = true
+			voted[string(v.Sig.Pubkey)] = true
+			vs = append(vs, v)
+		}
+	}
+	if len(vs) == 0 {
+		return nil, nil
+	}
+	sort.Sort(pt.Votes(vs))
+	if len(vs) > limit {
+		vs = vs[:limit]
+	}
+	l := &pt.Pos33LateVotes{Height: height - 1, Hash: pm.Hash}
+	var sigs []crypto.Signature
+	for _, v := range vs {
+		l.BlsPkList = append(l.BlsPkList, v.Sig.Pubkey)
+		l.Sorts = append(l.Sorts, v.Sort)
+		var sig [bls.BLSSignatureLength]byte
+		copy(sig[:], v.Sig.Signature)
+		sigs = append(sigs, bls.SignatureBLS(sig))
+	}
+	blsSig, err := bls.Driver{}.Aggregate(sigs)
+	if err != nil {
+		return nil, err
+	}
+	l.BlsSig = blsSig.Bytes()
+	plog.Debug("late votes", "height", height, "votes", len(vs))
+	return l, nil
+}
+
+// checkLateSorts 迟到投票人的抽签: 上一个区块同一轮的委员会, vrf 和难度, 以及 bls 公钥绑定的是抽签的地址
+func (n *node) checkLateSorts(l *pt.Pos33LateVotes, pm *pt.Pos33MinerMsg) error {
+	round := int(pm.Sort.Proof.Input.Round)
+	ch := n.committeeHeight(l.Height, round)
+	for i, pk := range l.BlsPkList {
+		s := l.Sorts[i]
+		if s.Proof.Input.Height != ch {
+			return pt.ErrCatSortHeight.New(s.Proof.Input.Height, ch)
+		}
+		v := &pt.Pos33VoteMsg{Hash: l.Hash, Sort: s, Sig: &types.Signature{Pubkey: pk}}
+		err := n.checkVote(v, l.Hash, Voter)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package pos33
+
+import (
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// missedSorts 出块的轮次之前, 每一轮收到的 hash 最小的 maker 抽签. 这些制作人抽中了但是没有出块,
+// 放在 miner 交易里, 执行器记录到地址上
+func (n *node) missedSorts(height int64, round int) []*pt.Pos33SortMsg {
+	if !n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkMissedMaker") {
+		return nil
+	}
+	var ss []*pt.Pos33SortMsg
+	for r := 0; r < round; r++ {
+		mss := n.mss.Best(height, r, 0, 1)
+		if len(mss) > 0 {
+			ss = append(ss, mss[0])
+		}
+	}
+	if len(ss) > pt.Pos33MaxMissedMakers {
+		ss = ss[len(ss)-pt.Pos33MaxMissedMakers:]
+	}
+	return ss
+}
+
+// checkMissed 验证 miner 交易里没有出块的 maker 抽签
+func (n *node) checkMissed(height int64, act *pt.Pos33MinerMsg) error {
+	if len(act.Missed) == 0 {
+		return nil
+	}
+	if !n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkMissedMaker") {
+		return pt.ErrMissedMaker
+	}
+	err := act.CheckMissed(height)
+	if err != nil {
+		return err
+	}
+	for _, s := range act.Missed {
+		err = n.checkSort(s, 0)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package pos33
+
+import (
+	"sort"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	metrics "github.com/rcrowley/go-metrics"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 抽签和投票的内存限制: msgCache MB 平分给 maker 的抽签, 委员会的抽签和投票.
+// 抽签超过限制时写到 msgSpillPath, 投票超过限制时丢掉最老的高度 (上链的区块和以后的高度不丢)
+
+// 没有设置 msgCache 时, 抽签和投票最多使用的内存 (MB)
+const defaultMsgCache = 64
+
+// openMsgSpill 打开写抽签的 db, 上次运行留下的数据没有用, 全部删掉
+func openMsgSpill(conf *subConfig) dbm.DB {
+	if conf.MsgSpillPath == "" {
+		return nil
+	}
+	db := dbm.NewDB("pos33msgs", "leveldb", conf.MsgSpillPath, 16)
+	it := db.Iterator(nil, nil, false)
+	batch := db.NewBatch(true)
+	for it.Rewind(); it.Valid(); it.Next() {
+		batch.Delete(append([]byte{}, it.Key()...))
+	}
+	it.Close()
+	err := batch.Write()
+	if err != nil {
+		plog.Error("clear msg spill db error", "err", err)
+	}
+	return db
+}
+
+// boundStores 按配置设置抽签的内存限制和 db
+func (n *node) boundStores(conf *subConfig) {
+	limit := conf.MsgCache
+	if limit <= 0 {
+		limit = defaultMsgCache
+	}
+	part := (limit << 20) / 3
+	db := openMsgSpill(conf)
+	n.mss.bound(part, db, "mss")
+	n.vss.bound(part, db, "vss")
+	n.vlimit = part
+	n.spill = db
+}
+
+// addVoteSize 记录投票占用的内存, 超过限制时从最低的高度开始丢掉投票
+func (n *node) addVoteSize(m *pt.Pos33VoteMsg) {
+	n.vsize += types.Size(m)
+	if n.vlimit <= 0 || n.vsize <= n.vlimit {
+		return
+	}
+	keep := n.lastBlock().Height
+	var hs []int64
+	for h := range n.vmp {
+		if h < keep {
+			hs = append(hs, h)
+		}
+	}
+	sort.Slice(hs, func(i, j int) bool { return hs[i] < hs[j] })
+	for _, h := range hs {
+		if n.vsize <= n.vlimit*3/4 {
+			break
+		}
+		n.dropVotes(h)
+		metrics.GetOrRegisterCounter("pos33.msgstore.votes.dropped", nil).Inc(1)
+	}
+}
+
+// dropVotes 删掉 height 的投票
+func (n *node) dropVotes(height int64) {
+	for _, m := range n.vmp[height] {
+		for _, vs := range m.mvs {
+			for _, v := range vs {
+				n.vsize -= types.Size(v)
+			}
+		}
+	}
+	delete(n.vmp, height)
+}
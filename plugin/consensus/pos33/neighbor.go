@@ -0,0 +1,203 @@
+package pos33
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const defaultNeighborProbe = 10
+
+// neighbors 定期 ping 所有连接的 peer, 延迟记录在 peerstore 里(EWMA).
+// 投票直接发给期望的出块节点, 没有直连的通过延迟最低的 voteRelays 个 peer 转发,
+// 不再向整个网络广播, 减少出块前等待投票的时间抖动. voteRelays 为 0 时和原来一样广播
+type neighbors struct {
+	h        host.Host
+	relays   int
+	interval time.Duration
+
+	mu      sync.Mutex
+	dialing map[peer.ID]bool
+}
+
+func newNeighbors(conf *subConfig) *neighbors {
+	if conf.VoteRelays <= 0 {
+		return nil
+	}
+	interval := time.Duration(conf.NeighborProbe) * time.Second
+	if interval <= 0 {
+		interval = defaultNeighborProbe * time.Second
+	}
+	return &neighbors{relays: conf.VoteRelays, interval: interval, dialing: make(map[peer.ID]bool)}
+}
+
+func (nb *neighbors) start(h host.Host) {
+	if nb == nil {
+		return
+	}
+	nb.h = h
+	go nb.probe()
+}
+
+// probe 每个 interval ping 一次所有连接的 peer
+func (nb *neighbors) probe() {
+	tm := time.NewTicker(nb.interval)
+	defer tm.Stop()
+	for range tm.C {
+		for _, pid := range nb.h.Network().Peers() {
+			go func(pid peer.ID) {
+				ctx, cancel := context.WithTimeout(context.Background(), nb.interval)
+				defer cancel()
+				// ping.Ping 会把 RTT 记录到 peerstore
+				r := <-ping.Ping(ctx, nb.h, pid)
+				if r.Error != nil {
+					plog.Debug("probe peer error", "pid", pid.String(), "err", r.Error)
+				}
+			}(pid)
+		}
+	}
+}
+
+func (nb *neighbors) connected(pid peer.ID) bool {
+	return nb.h.Network().Connectedness(pid) == network.Connected
+}
+
+// dial 后台连接 peerstore 里有地址的出块节点, 下一次可以直接发送
+func (nb *neighbors) dial(pid peer.ID) {
+	if len(nb.h.Peerstore().Addrs(pid)) == 0 {
+		return
+	}
+	nb.mu.Lock()
+	defer nb.mu.Unlock()
+	if nb.dialing[pid] {
+		return
+	}
+	nb.dialing[pid] = true
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), nb.interval)
+		defer cancel()
+		err := nb.h.Connect(ctx, nb.h.Peerstore().PeerInfo(pid))
+		if err != nil {
+			plog.Debug("dial maker error", "pid", pid.String(), "err", err)
+		}
+		nb.mu.Lock()
+		delete(nb.dialing, pid)
+		nb.mu.Unlock()
+	}()
+}
+
+// fastest 延迟最低的 n 个连接的 peer, 没有测量过延迟的排在后面
+func (nb *neighbors) fastest(n int, exclude map[peer.ID]bool) []peer.ID {
+	ps := nb.h.Peerstore()
+	var pids []peer.ID
+	for _, pid := range nb.h.Network().Peers() {
+		if !exclude[pid] {
+			pids = append(pids, pid)
+		}
+	}
+	sort.Slice(pids, func(i, j int) bool {
+		li, lj := ps.LatencyEWMA(pids[i]), ps.LatencyEWMA(pids[j])
+		if li == 0 || lj == 0 {
+			return li != 0
+		}
+		return li < lj
+	})
+	if len(pids) > n {
+		pids = pids[:n]
+	}
+	return pids
+}
+
+// voteTargetPubs height 和 round 期望的出块节点的公钥, 不包括本节点.
+// 投票发给排名前 voteMakers 个的出块抽签, 和 voteMaker 投票的制作人一样
+func (n *node) voteTargetPubs(height int64, round int) [][]byte {
+	var pubs [][]byte
+	for _, s := range n.mss.Best(height, round, 0, voteMakers) {
+		if n.signerOf(s.Proof.Pubkey) == nil {
+			pubs = append(pubs, s.Proof.Pubkey)
+		}
+	}
+	return pubs
+}
+
+// routeVotes 把投票发给期望的出块节点, 返回 false 时调用者需要广播
+func (n *node) routeVotes(height int64, round int, pm *pt.Pos33Msg) bool {
+	nb := n.nb
+	if nb == nil || nb.h == nil {
+		return false
+	}
+	pubs := n.voteTargetPubs(height, round)
+	if len(pubs) == 0 {
+		return false
+	}
+	sent := false
+	targets := make(map[peer.ID]bool)
+	var far []peer.ID
+	for _, pub := range pubs {
+		pid, err := n.ids.pid(pub)
+		if err != nil {
+			continue
+		}
+		targets[pid] = true
+		if nb.connected(pid) {
+			n.gss.sendTo(pid, pm)
+			sent = true
+			continue
+		}
+		far = append(far, pid)
+		nb.dial(pid)
+	}
+	if len(far) > 0 {
+		relays := nb.fastest(nb.relays, targets)
+		for _, pid := range relays {
+			n.gss.sendTo(pid, pm)
+		}
+		sent = sent || len(relays) > 0
+	}
+	return sent
+}
+
+// relayVotes 收到别的节点直接发来的投票, 本节点不是期望的出块节点时转发出去:
+// 连接着出块节点就直接发, 否则广播. 不会再转发给别的 relay
+func (n *node) relayVotes(pm *pt.Pos33Msg) {
+	nb := n.nb
+	if nb == nil || nb.h == nil || pm.Ty != pt.Pos33Msg_MV {
+		return
+	}
+	var m pt.Pos33MakerVotes
+	if types.Decode(pm.Data, &m) != nil || len(m.Mvs) == 0 || len(m.Mvs[0].Vs) == 0 {
+		return
+	}
+	v := m.Mvs[0].Vs[0]
+	if v.GetSort().GetProof().GetInput() == nil {
+		return
+	}
+	height, round := voteHeight(v), int(v.Sort.Proof.Input.Round)
+	if n.lastBlock().Height >= height {
+		return
+	}
+	for _, s := range n.mss.Best(height, round, 0, voteMakers) {
+		if n.signerOf(s.Proof.Pubkey) != nil {
+			return
+		}
+	}
+	direct := false
+	for _, pub := range n.voteTargetPubs(height, round) {
+		pid, err := n.ids.pid(pub)
+		if err == nil && nb.connected(pid) {
+			n.gss.sendTo(pid, pm)
+			direct = true
+		}
+	}
+	if !direct {
+		n.gss.gossip(n.topic+"/makervotes", types.Encode(pm))
+	}
+}
@@ -6,15 +6,18 @@ import (
 	"math"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/plugin/plugin/crypto/bls"
 	"github.com/golang/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
 
 	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
 )
@@ -31,9 +34,8 @@ type maker struct {
 
 // 验证委员会
 type committee struct {
-	myss           [3][]*pt.Pos33SortMsg               // 我的抽签
-	mss            map[string]*pt.Pos33SortMsg         // 我接收到的maker 的抽签
-	css            map[int]map[string]*pt.Pos33SortMsg // 我收到committee的抽签
+	round          int
+	myss           map[int][]*pt.Pos33SortMsg // 我的抽签
 	ssmp           map[string]*pt.Pos33SortMsg
 	svmp           map[string]int  // 验证委员会的投票
 	sortCheckedMap map[string]bool // key is sort_hash, val is checked
@@ -57,8 +59,9 @@ func (n *node) getmaker(height int64, round int) *maker {
 }
 
 func (c *committee) setCommittee(height int64) {
+	must := pt.MustVotes(c.n.voterSize(height))
 	for k, n := range c.svmp {
-		if n < pt.Pos33MustVotes {
+		if n < must {
 			delete(c.svmp, k)
 		}
 	}
@@ -74,8 +77,8 @@ func (n *node) getCommittee(height int64, round int) *committee {
 	m, ok := rmp[round]
 	if !ok {
 		m = &committee{
-			mss:            make(map[string]*pt.Pos33SortMsg),
-			css:            make(map[int]map[string]*pt.Pos33SortMsg),
+			round:          round,
+			myss:           make(map[int][]*pt.Pos33SortMsg),
 			ssmp:           make(map[string]*pt.Pos33SortMsg),
 			svmp:           make(map[string]int),
 			sortCheckedMap: make(map[string]bool),
@@ -87,7 +90,7 @@ func (n *node) getCommittee(height int64, round int) *committee {
 }
 
 func (c *committee) getMySorts(myaddr string, height int64) []*pt.Pos33SortMsg {
-	ssmp := c.getCommitteeSorts()
+	ssmp := c.getCommitteeSorts(height)
 	var ss []*pt.Pos33SortMsg
 	for _, s := range ssmp {
 		addr := address.PubKeyToAddr(ethID, s.Proof.Pubkey)
@@ -99,30 +102,19 @@ func (c *committee) getMySorts(myaddr string, height int64) []*pt.Pos33SortMsg {
 	return ss
 }
 
-func getSorts(mp map[string]*pt.Pos33SortMsg, num int) []*pt.Pos33SortMsg {
-	var ss []*pt.Pos33SortMsg
-	for _, s := range mp {
-		ss = append(ss, s)
-	}
-	if len(ss) > num {
-		sort.Sort(pt.Sorts(ss))
-		ss = ss[:num]
-	}
-	return ss
-}
-
-func (m *maker) checkVotes(height int64, vs []*pt.Pos33VoteMsg) (int, error) {
-	if height > 0 && len(vs) < 17 {
-		return 0, errors.New("checkVotes error: NOT enough votes")
+func (m *maker) checkVotes(height int64, vs []*pt.Pos33VoteMsg, must int) (int, error) {
+	if height > 0 && len(vs) < must {
+		return 0, pt.ErrCatVotesEnough.New()
 	}
 	return len(vs), nil
 }
 
-func (c *committee) getCommitteeSorts() map[string]*pt.Pos33SortMsg {
-	num := int(pt.Pos33VoterSize)
+func (c *committee) getCommitteeSorts(height int64) map[string]*pt.Pos33SortMsg {
+	num := c.n.voterSize(height)
 	var ss []*pt.Pos33SortMsg
-	for i := 0; num > 0 && i < 3; i++ {
-		ss1 := getSorts(c.css[i], num)
+	ch := c.n.committeeHeight(height, c.round)
+	for i := 0; num > 0 && i < voterSortGroups; i++ {
+		ss1 := c.n.vss.Best(ch, c.round, i, num)
 		ss = append(ss, ss1...)
 		num -= len(ss1)
 	}
@@ -160,26 +152,86 @@ type node struct {
 
 	vmp map[int64]map[int]*maker
 	mmp map[int64]map[int]*committee
+	mss *sortStore        // 收到的 maker 的抽签
+	vss *sortStore        // 收到的 committee 的抽签
 	bch chan *types.Block // for add block
 
 	vCh    chan vArg
+	vCache *lru.Cache // 已经验证过的投票签名
+	seen   *seenCache // gossip 收到过的消息
 	sortCh chan *sortArg
+	sorter Sorter
+	audit  *sortAudit
+	wal    *consensusWAL
+	ads    *advisories
+	vex    *validatorExport
+	push   *pushService
+	otel   *blockTracer
+	pipe   *pipeline
+	idle   *idleBlocks
+	part   *participation
+	evs    *evidencePool
+	cps    *checkpointVotes
+	sb     *standby
+	bhs    *blockHooks
+	score  *peerScore
+	ids    *identities // 挖矿公钥绑定的网络身份
+	nb     *neighbors  // 投票的直连和转发
+	cands  *candidates
+	faults *faults // 只用于测试
+	tms    *telemetries
+	reorg  *reorgWatch
+	idx    *txIndexer
+	vals   *validatorStatus
+	win    *msgWindow
+	sv     *syncVerifier
+	arc    *msgArchive // 共识消息存档
+	guard  *resourceGuard
+	fails  *debugCapture // 抽签验证失败的现场
+	quota  *sortQuota
+	cs     *consState
+	diag   *diagnostics
+	pause  chan chan struct{} // 关闭时让主循环停止处理新的事件
 
 	mu    sync.Mutex
 	blsMp map[string]string
 
+	vsize  int    // vmp 里投票的字节数
+	vlimit int    // vmp 里投票最多的字节数
+	spill  dbm.DB // 内存放不下的抽签
+
+	voted map[int64]map[int][]*pt.Pos33Votes // 自己在每个高度和轮次的投票
+
 	maxSortHeight int64
+	peerHeight    int64 // peers 中最高的区块高度
 	pid           string
 	topic         string
 }
 
+const voteCacheSize = 1024 * 16
+
 func newNode(conf *subConfig) *node {
+	vCache, err := lru.New(voteCacheSize)
+	if err != nil {
+		panic(err)
+	}
 	return &node{
 		mmp:    make(map[int64]map[int]*committee),
+		mss:    newSortStore(1),
+		vss:    newSortStore(pt.Pos33VoterSize),
 		vmp:    make(map[int64]map[int]*maker),
+		voted:  make(map[int64]map[int][]*pt.Pos33Votes),
 		bch:    make(chan *types.Block, 16),
 		blsMp:  make(map[string]string),
 		vCh:    make(chan vArg, 8),
+		vCache: vCache,
+		seen:   newSeenCache(),
+		cs:     newConsState(),
+		diag:   newDiagnostics(),
+		pause:  make(chan chan struct{}),
+		evs:    newEvidencePool(),
+		cps:    newCheckpointVotes(),
+		ids:    newIdentities(),
 		sortCh: make(chan *sortArg, 8),
 	}
 }
@@ -192,10 +244,10 @@ func (n *node) lastBlock() *types.Block {
 	return b
 }
 
-func (n *node) minerTx(height int64, round int, sm *pt.Pos33SortMsg, vs []*pt.Pos33VoteMsg, priv crypto.PrivKey) (*types.Transaction, error) {
-	if len(vs) > pt.Pos33VoterSize {
+func (n *node) minerTx(height int64, round int, seed []byte, sm *pt.Pos33SortMsg, vs []*pt.Pos33VoteMsg, s pt.Signer) (*types.Transaction, error) {
+	if size := n.voterSize(height); len(vs) > size {
 		sort.Sort(pt.Votes(vs))
-		vs = vs[:pt.Pos33VoterSize]
+		vs = vs[:size]
 	}
 	var pklist [][]byte
 	var sigs []crypto.Signature
@@ -229,13 +281,47 @@ func (n *node) minerTx(height int64, round int, sm *pt.Pos33SortMsg, vs []*pt.Po
 	}
 
 	cfg := n.GetAPI().GetConfig()
+	if cfg.IsDappFork(height, pt.Pos33TicketX, "ForkVrfSeed") {
+		m := act.GetMiner()
+		m.SeedHash, m.SeedProof, err = s.Vrf(types.Encode(seedInput(seed, height)))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.IsDappFork(height, pt.Pos33TicketX, "ForkBlsAggregate") {
+		act.GetMiner().CompactVoters()
+	}
+	if n.checkpointOn(height) {
+		if pending := n.queryCheckpoint("Pos33PendingCheckpoint"); pending != nil && len(pending.BlsPkList) > 0 {
+			act.GetMiner().Checkpoint = n.cps.aggregate(pending)
+		}
+	}
+	act.GetMiner().Extras = n.bhs.extras(height)
+	act.GetMiner().Missed = n.missedSorts(height, round)
+	if cfg.IsDappFork(height, pt.Pos33TicketX, "ForkVoteCommitment") || cfg.IsDappFork(height, pt.Pos33TicketX, "ForkLateVotes") {
+		pb, err := n.RequestBlock(height - 1)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.IsDappFork(height, pt.Pos33TicketX, "ForkLateVotes") {
+			act.GetMiner().Late, err = n.lateVotes(height, pb)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if cfg.IsDappFork(height, pt.Pos33TicketX, "ForkVoteCommitment") {
+			act.GetMiner().Commitment = pt.VoteCommitment(act.GetMiner(), prevVotersRoot(pb))
+		}
+	}
 	tx, err := types.CreateFormatTx(cfg, "pos33", types.Encode(act))
 	if err != nil {
 		return nil, err
 	}
 
-	signID := types.EncodeSignID(types.SECP256K1, ethID)
-	tx.Sign(signID, priv)
+	err = signTx(s, tx)
+	if err != nil {
+		return nil, err
+	}
 	plog.Debug("make a minerTx", "nvs", len(vs), "height", height, "fee", tx.Fee, "from", tx.From())
 	return tx, nil
 }
@@ -245,10 +331,10 @@ func (n *node) blockDiff(lb *types.Block, w int) uint32 {
 	return powLimitBits
 }
 
-func (n *node) newBlock(lastBlock *types.Block, txs []*types.Transaction, height int64) (*types.Block, error) {
+func (n *node) newBlock(lastBlock *types.Block, txs []*types.Transaction, height int64, s pt.Signer) (*types.Block, error) {
 	if lastBlock.Height+1 != height {
 		plog.Error("newBlock height error", "lastHeight", lastBlock.Height, "height", height)
-		return nil, fmt.Errorf("the last block too low")
+		return nil, pt.ErrCatLastBlock.New()
 	}
 
 	bt := time.Now().Unix()
@@ -263,8 +349,24 @@ func (n *node) newBlock(lastBlock *types.Block, txs []*types.Transaction, height
 		BlockTime:  bt,
 	}
 
-	maxTxs := int(cfg.GetP(height).MaxTxNumber)
-	txs = append(txs, n.RequestTx(maxTxs, nil)...)
+	var maker string
+	if len(txs) > 0 {
+		maker = txs[0].From()
+	}
+	// 出块插件的交易在 miner 交易之后, 交易池的交易之前
+	htxs := n.filterBaseFee(height, n.bhs.txs(height, s), maker)
+	txs = append(txs, htxs...)
+	maxTxs := int(cfg.GetP(height).MaxTxNumber) - len(htxs)
+	mtxs, ok := n.pipe.take(height, nb.ParentHash)
+	if !ok {
+		mtxs = n.filterNonce(height, n.RequestTx(maxTxs, nil))
+	}
+	mtxs = n.filterBaseFee(height, mtxs, maker)
+	if len(mtxs) > maxTxs {
+		mtxs = mtxs[:maxTxs]
+	}
+	traceTxs(mtxs, height, "packed by maker")
+	txs = append(txs, mtxs...)
 	txs = n.AddTxsToBlock(nb, txs)
 
 	nb.Txs = txs
@@ -272,21 +374,27 @@ func (n *node) newBlock(lastBlock *types.Block, txs []*types.Transaction, height
 }
 
 func (n *node) makeBlock(height int64, round int, sort *pt.Pos33SortMsg, vs []*pt.Pos33VoteMsg) (*types.Block, error) {
-	priv := n.getPriv()
-	if priv == nil {
+	// 用抽中制作人的私钥签名
+	s := n.signerOf(sort.Proof.Pubkey)
+	if s == nil {
 		panic("can't go here")
 	}
+	s = n.blockSigner(s, height)
 
 	lb, err := n.RequestBlock(height - 1)
 	if err != nil {
 		return nil, err
 	}
-	tx, err := n.minerTx(height, round, sort, vs, priv)
+	seed, err := getMinerSeed(lb)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := n.minerTx(height, round, seed, sort, vs, s)
 	if err != nil {
 		return nil, err
 	}
 
-	nb, err := n.newBlock(lb, []*Tx{tx}, height)
+	nb, err := n.newBlock(lb, []*Tx{tx}, height, s)
 	if err != nil {
 		return nil, err
 	}
@@ -316,10 +424,14 @@ func (n *node) broadcastComm(height int64, round int, msg types.Message) {
 			continue
 		}
 		mp[string(pub)] = true
-		if string(pub) == string(n.getPriv().PubKey().Bytes()) {
+		if n.signerOf(pub) != nil {
 			continue
 		}
-		n.gss.sendMsg(pub, msg)
+		pid, err := n.ids.pid(pub)
+		if err != nil {
+			continue
+		}
+		n.gss.sendTo(pid, msg)
 	}
 }
 
@@ -363,13 +475,30 @@ func (n *node) pushBlock(b *types.Block) {
 func (n *node) clear(height int64) {
 	for h := range n.mmp {
 		if h < height-20 {
+			n.recordParticipation(h)
+			n.auditHeight(h)
 			delete(n.mmp, h)
 		}
 	}
+	n.mss.evict(height - 20)
+	n.vss.evict(min64(height-20, n.committeeHeight(height+1, 0)))
+	n.evs.evict(height - 20)
+	n.seen.evict(height - 20)
+	n.quota.evict(height - 20)
+	n.pipe.evict(height)
+	n.otel.evict(height)
+	n.wal.prune(height - 20)
+	n.cands.evict(height - candidateKeep)
+	n.cps.evict(height - pt.Pos33CheckpointBlocks*2)
 
 	for h := range n.vmp {
 		if h < height-20 {
-			delete(n.vmp, h)
+			n.dropVotes(h)
+		}
+	}
+	for h := range n.voted {
+		if h < height-20 {
+			delete(n.voted, h)
 		}
 	}
 }
@@ -378,7 +507,15 @@ func (n *node) prepareOK(height int64) bool {
 	if height < 10 {
 		return true
 	}
-	return n.IsCaughtUp() && /*n.allCount(height) > 0 &&*/ n.queryTicketCount(n.myAddr, height-10) > 0
+	if !n.IsCaughtUp() {
+		return false
+	}
+	for _, s := range n.getSigners() {
+		if n.queryTicketCount(address.PubKeyToAddr(ethID, s.PubKey()), height-10) > 0 {
+			return true
+		}
+	}
+	return false
 }
 
 func (n *node) checkBlock(b, pb *types.Block) error {
@@ -387,18 +524,18 @@ func (n *node) checkBlock(b, pb *types.Block) error {
 		return fmt.Errorf("check block height error")
 	}
 	if !n.prepareOK(b.Height) {
-		return nil
+		return n.sv.submit(b, pb)
 	}
 	if len(b.Txs) == 0 {
 		return fmt.Errorf("nil block error")
 	}
-	if b.Txs[0].From() == n.myAddr {
+	if n.isMyAddr(b.Txs[0].From()) {
 		return nil
 	}
 
 	err := n.blockCheck(b)
 	if err != nil {
-		plog.Error("blockCheck error", "err", err, "height", b.Height)
+		n.logError("blockCheck error", err, "height", b.Height)
 		return err
 	}
 	return nil
@@ -408,11 +545,26 @@ func (n *node) runVerifyVotes() {
 	for i := 0; i < 8; i++ {
 		go func() {
 			for v := range n.vCh {
-				v.ch <- v.v.Verify()
+				v.ch <- n.verifyVote(v.v)
 			}
 		}()
 	}
 }
+
+// verifyVote 同一个投票会从不同的节点收到, 验证结果按消息 hash 缓存, 每个投票只验证一次签名
+func (n *node) verifyVote(v *pt.Pos33VoteMsg) bool {
+	if v.Sig == nil || v.Sort == nil || v.Sort.Proof == nil || v.Sort.Proof.Input == nil {
+		return false
+	}
+	k := string(common.Sha256(types.Encode(v)))
+	if ok, has := n.vCache.Get(k); has {
+		return ok.(bool)
+	}
+	ok := v.Verify()
+	n.vCache.Add(k, ok)
+	return ok
+}
+
 func (n *node) verifyVotes(vs []*pt.Pos33VoteMsg) bool {
 	ch := make(chan bool, len(vs))
 	defer close(ch)
@@ -456,21 +608,21 @@ func (n *node) checkVotes(vs []*pt.Pos33VoteMsg, ty int, hash []byte, h int64, c
 	round := v0.Sort.Proof.Input.Round
 
 	if checkEnough {
-		if len(vs) < pt.Pos33MustVotes {
-			return errors.New("checkVotes error: NOT enough votes")
+		if len(vs) < pt.MustVotes(n.voterSize(height)) {
+			return pt.ErrCatVotesEnough.New()
 		}
 	}
 
 	if !n.verifyVotes(vs) {
 		plog.Error("verifyVotes error", "height", height)
-		return errors.New("verifyVotes error")
+		return pt.ErrCatVotesVerify.New()
 	}
 
 	for _, v := range vs {
 		ht := v.Sort.Proof.Input.Height
 		rd := v.Sort.Proof.Input.Round
 		if ht != height || rd != round {
-			return errors.New("checkVotes error: height, round or num NOT same")
+			return pt.ErrCatVotesRound.New()
 		}
 		err := n.checkVote(v, hash, ty)
 		if err != nil {
@@ -493,7 +645,7 @@ func (n *node) getMinerList() []string {
 
 func (n *node) checkVote(v *pt.Pos33VoteMsg, hash []byte, ty int) error {
 	if string(v.Hash) != string(hash) {
-		return errors.New("vote hash NOT right")
+		return pt.ErrCatVoteHash.New()
 	}
 
 	blsAddr := address.PubKeyToAddr(ethID, v.Sig.Pubkey)
@@ -510,12 +662,37 @@ func (n *node) checkVote(v *pt.Pos33VoteMsg, hash []byte, ty int) error {
 	}
 	sortAddr := address.PubKeyToAddr(ethID, v.Sort.Proof.Pubkey)
 	if addr != sortAddr {
-		return errors.New("Pos33BindAddr NOT match")
+		return pt.ErrCatBindAddr.New()
 	}
 
 	return n.checkSort(v.Sort, Voter)
 }
 
+// checkBlsCounts 展开 BlsCounts 之前检查票数: 总票数不超过委员会大小, 每个投票人的票数不超过抽签时的票数
+func (n *node) checkBlsCounts(height int64, act *pt.Pos33MinerMsg) error {
+	err := act.CheckCounts(n.voterSize(height))
+	if err != nil {
+		return err
+	}
+	if len(act.BlsCounts) == 0 {
+		return nil
+	}
+	sh := n.committeeHeight(height, int(act.Sort.Proof.Input.Round))
+	if sh <= pt.Pos33SortBlocks {
+		return nil
+	}
+	for i, pk := range act.BlsPkList {
+		addr := n.blsToAddr(pk)
+		if addr == "" {
+			return pt.ErrCatBindAddr.New()
+		}
+		if int64(act.BlsCounts[i]) > n.queryTicketCount(addr, sh-pt.Pos33SortBlocks) {
+			return pt.ErrBlsCounts
+		}
+	}
+	return nil
+}
+
 func (n *node) blockCheck(b *types.Block) error {
 	height := b.Height
 	pb, err := n.RequestBlock(height - 1)
@@ -536,25 +713,112 @@ func (n *node) blockCheck(b *types.Block) error {
 	if act.Sort == nil || act.Sort.Proof == nil || act.Sort.Proof.Input == nil {
 		return fmt.Errorf("miner tx error")
 	}
-	if len(act.BlsPkList) < pt.Pos33MustVotes {
-		return fmt.Errorf("NOT enought votes")
+	if len(act.BlsCounts) > 0 && !n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkBlsAggregate") {
+		return fmt.Errorf("bls counts NOT support")
+	}
+	err = n.checkBlsCounts(height, act)
+	if err != nil {
+		plog.Error("blockCheck bls counts error", "err", err, "height", b.Height)
+		return err
+	}
+	if len(act.Voters()) < pt.MustVotes(n.voterSize(height)) {
+		return pt.ErrCatVotesEnough.New()
 	}
 	round := int(act.Sort.Proof.Input.Round)
 
 	plog.Debug("block check", "height", b.Height, "from", b.Txs[0].From()[:16])
 	err = n.checkSort(act.Sort, 0)
 	if err != nil {
-		plog.Error("blockCheck error", "err", err, "height", b.Height, "round", round)
+		n.logError("blockCheck error", err, "height", b.Height, "round", round)
+		return err
+	}
+	if e := n.evs.addMinerTx(b.Txs[0], height, int32(round)); e != nil {
+		go n.handleEvidence(e, true)
+	}
+	if act.Checkpoint != nil {
+		err = n.checkCheckpoint(height, act.Checkpoint)
+		if err != nil {
+			plog.Error("blockCheck checkpoint error", "err", err, "height", b.Height)
+			return err
+		}
+	}
+
+	err = n.bhs.checkExtras(height, act.Extras)
+	if err != nil {
+		plog.Error("blockCheck extras error", "err", err, "height", b.Height)
+		return err
+	}
+	err = n.checkMissed(height, act)
+	if err != nil {
+		plog.Error("blockCheck missed makers error", "err", err, "height", b.Height)
 		return err
 	}
 
-	if round >= 3 {
-		return nil
+	if n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkVoteCommitment") {
+		err = act.CheckCommitment(prevVotersRoot(pb))
+		if err != nil {
+			plog.Error("blockCheck vote commitment error", "err", err, "height", b.Height)
+			return err
+		}
+	}
+
+	if act.Late != nil {
+		if !n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkLateVotes") {
+			return pt.ErrLateVotes
+		}
+		pm, err := getMiner(pb)
+		if err != nil {
+			return err
+		}
+		err = act.CheckLate(height, pm, n.voterSize(height-1))
+		if err != nil {
+			plog.Error("blockCheck late votes error", "err", err, "height", b.Height)
+			return err
+		}
+		err = n.checkLateSorts(act.Late, pm)
+		if err != nil {
+			plog.Error("blockCheck late votes sort error", "err", err, "height", b.Height)
+			return err
+		}
+	}
+
+	if n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkVrfSeed") {
+		err = checkMinerSeed(act, pb)
+		if err != nil {
+			n.logError("blockCheck error", err, "height", b.Height, "round", round)
+			return err
+		}
 	}
 
 	return act.Verify()
 }
 
+func seedInput(seed []byte, height int64) *pt.VrfInput {
+	return &pt.VrfInput{Seed: seed, Height: height, Ty: Seed}
+}
+
+// checkMinerSeed 验证制作人用上一个种子计算的 vrf, 防止制作人挑选种子
+func checkMinerSeed(m *pt.Pos33MinerMsg, pb *types.Block) error {
+	if len(m.SeedHash) == 0 || len(m.SeedProof) == 0 {
+		return pt.ErrCatMinerSeed.New()
+	}
+	seed, err := getMinerSeed(pb)
+	if err != nil {
+		return err
+	}
+	in := types.Encode(seedInput(seed, pb.Height+1))
+	return vrfVerify(m.Sort.Proof.Pubkey, in, m.SeedProof, m.SeedHash)
+}
+
+// prevVotersRoot 上一个区块的投票人 merkle root, 轻客户端用它把相邻区块的承诺串起来
+func prevVotersRoot(pb *types.Block) []byte {
+	m, err := getMiner(pb)
+	if err != nil || m.Commitment == nil {
+		return nil
+	}
+	return m.Commitment.VotersRoot
+}
+
 func getMinerSeed(b *types.Block) ([]byte, error) {
 	seed := zeroHash[:]
 	if b.Height > pt.Pos33SortBlocks {
@@ -562,6 +826,9 @@ func getMinerSeed(b *types.Block) ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
+		if len(m.SeedHash) > 0 {
+			return m.SeedHash, nil
+		}
 		sort := m.Sort
 		seed = sort.SortHash.Hash
 	}
@@ -581,8 +848,7 @@ func (n *node) reSortition(height int64, round int) bool {
 		plog.Error("reSortition error", "height", height, "round", round, "err", err)
 		return false
 	}
-	n.sortMaker(seed, height, round)
-	n.sortCommittee(seed, height, round)
+	n.sortAll(seed, height, round)
 	return true
 }
 
@@ -593,8 +859,16 @@ func (n *node) sortition(b *types.Block, round int) {
 		plog.Error("reSortition error", "height", height, "round", round, "err", err)
 		return
 	}
+	n.sortAll(seed, height, round)
+}
+
+// sortAll 制作人和投票人抽签, 抽签发出后开始等待其他节点的抽签
+func (n *node) sortAll(seed []byte, height int64, round int) {
+	n.otel.begin(height, round, spanSortition)
 	n.sortMaker(seed, height, round)
 	n.sortCommittee(seed, height, round)
+	n.otel.end(height, round, spanSortition)
+	n.otel.begin(height, round, spanSortGossip)
 }
 
 func (n *node) firstSortition() {
@@ -607,22 +881,31 @@ func (n *node) firstSortition() {
 }
 
 func (n *node) sortCommittee(seed []byte, height int64, round int) {
+	if !n.canSign() {
+		return
+	}
+	if n.committeeHeight(height, round) != height {
+		return
+	}
 	var vss []*pt.Pos33Sorts
 	c := n.getCommittee(height, round)
-	for i := 0; i < 3; i++ {
-		ss := n.voterSort(seed, height, round, Voter, i)
-		if len(ss) == 0 {
-			continue
+	// 每个私钥的抽签分开发送, 一组抽签只有一个公钥
+	for _, s := range n.getSigners() {
+		for i := 0; i < voterSortGroups; i++ {
+			ss := n.voterSort(s, seed, height, round, Voter, i)
+			if len(ss) == 0 {
+				continue
+			}
+			c.myss[i] = append(c.myss[i], ss...)
+			vss = append(vss, &pt.Pos33Sorts{Sorts: ss})
 		}
-		c.myss[i] = ss
-		vss = append(vss, &pt.Pos33Sorts{Sorts: ss})
 	}
 	n.sendVoterSort(vss, height, round, int(pt.Pos33Msg_VS))
 }
 
 func (n *node) sortMaker(seed []byte, height int64, round int) {
 	plog.Debug("sortMaker", "height", height, "round", round)
-	if n.conf.OnlyVoter {
+	if n.conf.OnlyVoter || !n.canSign() {
 		return
 	}
 	s := n.makerSort(seed, height, round)
@@ -646,16 +929,33 @@ func (n *node) getSortSeed(height int64) ([]byte, error) {
 	return getMinerSeed(sb)
 }
 
-func (n *node) getDiff(height int64, round int, isMaker bool) float64 {
-	height -= pt.Pos33SortBlocks
-	w := n.allCount(height)
+// sortRetries 制作人抽签的次数, 由 mver.consensus.pos33.sortRetries 配置.
+// 第 i 次用 SortHash.Num = i 抽签, 前面都没抽中才用下一次, 验证时 Num 不能超过这个次数
+func (n *node) sortRetries(height int64) int {
+	return int(pt.GetPos33MineParam(n.GetAPI().GetConfig(), height).SortRetries)
+}
+
+func (n *node) getDiff(height int64, round int, isMaker bool) (float64, error) {
+	smooth := n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkSmoothDiff")
 	size := pt.Pos33MakerSize
 	if !isMaker {
-		size = pt.Pos33VoterSize
+		size = n.voterSize(height)
+	}
+	height -= pt.Pos33SortBlocks
+	w := n.allCount(height)
+	if c := n.epochCount(height); c > 0 {
+		w = c
 	}
 	diff := float64(size) / float64(w)
+	if smooth {
+		r, err := n.diffRatio(height)
+		if err != nil {
+			return 0, err
+		}
+		diff *= r
+	}
 	diff *= math.Pow(1.1, float64(round))
-	return diff
+	return diff, nil
 }
 
 func (n *node) handleVoterSorts(ms []*pt.Pos33Sorts, myself bool, ty int) {
@@ -678,31 +978,36 @@ func (n *node) handleVoterSort(ss []*pt.Pos33SortMsg, myself bool, ty int) bool
 	if height > 0 && height <= n.lastBlock().Height {
 		return false
 	}
+	if !myself && height > n.lastBlock().Height+pt.Pos33SortBlocks*2 {
+		plog.Error("handleVoterSort height too hight", "height", height)
+		return false
+	}
 
 	round := int(s0.Proof.Input.Round)
 	num := int(s0.SortHash.Num)
-	if num >= 3 {
-		plog.Error("handleVoterSort error: sort num >=3", "height", height, "round", round, "num", num, "addr", address.PubKeyToAddr(ethID, s0.Proof.Pubkey)[:16])
+	if num >= voterSortGroups {
+		plog.Error("handleVoterSort error: sort num too large", "height", height, "round", round, "num", num, "addr", address.PubKeyToAddr(ethID, s0.Proof.Pubkey)[:16])
 		return false
 	}
 
-	comm := n.getCommittee(height, round)
-	mp, ok := comm.css[num]
-	if !ok {
-		mp = make(map[string]*pt.Pos33SortMsg)
-		comm.css[num] = mp
+	if !myself && n.score.banned(s0.Proof.Pubkey) {
+		return false
 	}
-
-	for _, s := range mp {
-		if string(s.Proof.Pubkey) == string(s0.Proof.Pubkey) {
-			return true
-		}
+	n.seeMine(s0.Proof.Pubkey, myself)
+	n.getCommittee(height, round)
+	if n.vss.hasSender(height, round, num, s0.Proof.Pubkey) {
+		return true
 	}
-
 	for _, s := range ss {
-		mp[string(s.SortHash.Hash)] = s
+		if s.Proof == nil || s.SortHash == nil {
+			return false
+		}
+	}
+	if !n.takeQuota(s0.Proof.Pubkey, height, quotaVoter, len(ss), myself) {
+		return false
 	}
-	// plog.Debug("handleVoterSort", "all", len(comm.css[num]), "nvs", len(ss), "height", height, "round", round, "num", num, "ty", ty, "addr", address.PubKeyToAddr(ethID,s0.Proof.Pubkey)[:16])
+	n.vss.add(height, round, num, ss)
+	// plog.Debug("handleVoterSort", "all", n.vss.count(height, round, num), "nvs", len(ss), "height", height, "round", round, "num", num, "ty", ty, "addr", address.PubKeyToAddr(ethID,s0.Proof.Pubkey)[:16])
 	return true
 }
 
@@ -721,10 +1026,13 @@ func (n *node) handleVoteMsg(ms []*pt.Pos33VoteMsg, myself bool, ty int) {
 		return
 	}
 
-	height := m0.Sort.Proof.Input.Height
+	height := voteHeight(m0)
 	round := int(m0.Sort.Proof.Input.Round)
 	num := int(m0.Sort.SortHash.Num)
-	if num >= 3 {
+	if m0.Sort.Proof.Input.Height != n.committeeHeight(height, round) {
+		return
+	}
+	if num >= voterSortGroups {
 		return
 	}
 
@@ -732,31 +1040,50 @@ func (n *node) handleVoteMsg(ms []*pt.Pos33VoteMsg, myself bool, ty int) {
 		return
 	}
 
+	if !myself && n.score.banned(m0.Sort.Proof.Pubkey) {
+		return
+	}
+	n.seeMine(m0.Sort.Proof.Pubkey, myself)
 	maker := n.getmaker(height, round)
 
 	// repeat msg
 	if maker.findVm(string(m0.Hash), string(m0.Sig.Pubkey)) {
 		return
 	}
-	if len(ms) == 0 {
+	if !n.takeQuota(m0.Sort.Proof.Pubkey, height, quotaVote, len(ms), myself) {
 		return
 	}
 
 	err := n.checkVotes(ms, ty, m0.Hash, height, false, true)
 	if err != nil {
-		plog.Error("checkVotes error", "err", err, "height", height)
+		n.logError("checkVotes error", err, "height", height)
+		if !myself {
+			n.scoreFail(m0.Sort.Proof.Pubkey, err)
+		}
 		return
 	}
+	if !myself {
+		n.score.ok(m0.Sort.Proof.Pubkey)
+	}
 
 	for _, m := range ms {
 		if m.Round != m0.Round {
 			return
 		}
-		if string(m.Hash) != string(m0.Hash) {
+		if string(m.Hash) != string(m0.Hash) || m.Height != m0.Height {
 			return
 		}
 
 		maker.mvs[string(m.Hash)] = append(maker.mvs[string(m.Hash)], m)
+		n.addVoteSize(m)
+		if e := n.evs.addVote(m); e != nil {
+			// 投票的签名只签了制作人的抽签 hash, 证据要带上两个出块抽签证明是同一个高度和轮次
+			e.Maker1 = n.mss.get(height, round, 0, e.Vote1.Hash)
+			e.Maker2 = n.mss.get(height, round, 0, e.Vote2.Hash)
+			if e.Maker1 != nil && e.Maker2 != nil {
+				go n.handleEvidence(e, true)
+			}
+		}
 	}
 
 	vs := maker.mvs[string(m0.Hash)]
@@ -769,6 +1096,9 @@ func (n *node) handleVoteMsg(ms []*pt.Pos33VoteMsg, myself bool, ty int) {
 }
 
 func (n *node) tryMakeBlock(height int64, round int) {
+	if !n.canSign() {
+		return
+	}
 	maker := n.getmaker(height, round)
 	if maker.my == nil {
 		return
@@ -781,25 +1111,45 @@ func (n *node) tryMakeBlock(height int64, round int) {
 
 	plog.Debug("try make block", "height", height, "round", round, "nvs", nvs)
 
-	_, err := maker.checkVotes(height, vs)
+	_, err := maker.checkVotes(height, vs, pt.MustVotes(n.voterSize(height)))
 	if err != nil {
-		plog.Error("tryMakerBlock checkVotes error", "err", err, "height", height, "round", round)
+		n.logError("tryMakerBlock checkVotes error", err, "height", height, "round", round)
 		return
 	}
 
 	maker.selected = true
+	n.otel.end(height, round, spanVotes, "votes", nvs)
+
+	// 重启前或者轮次变化前已经在这个高度和轮次做过区块, 重发同一个区块
+	nb := n.wal.getBlock(height, round)
+	if nb == nil {
+		nb = n.cands.get(height, round, address.PubKeyToAddr(ethID, maker.my.Proof.Pubkey))
+	}
+	if nb != nil {
+		plog.Info("wal replay block", "height", height, "round", round)
+		n.broadcastBlock(nb, round)
+		maker.ok = true
+		return
+	}
 
-	nb, err := n.makeBlock(height, round, maker.my, vs)
-	if err != nil && round < 3 {
-		plog.Error("makeBlock error", "err", err, "height", height)
+	n.otel.begin(height, round, spanAssembly)
+	nb, err = n.makeBlock(height, round, maker.my, vs)
+	n.otel.end(height, round, spanAssembly, "txs", len(nb.GetTxs()))
+	if err != nil {
+		n.logError("makeBlock error", err, "height", height)
 		return
 	}
+	if nb != nil {
+		n.wal.saveBlock(nb, round)
+	}
 	n.broadcastBlock(nb, round)
 	maker.ok = true
 }
 
 func (n *node) handleBlockMsg(m *pt.Pos33BlockMsg, myself bool) {
 	plog.Debug("handleBlockMsg", "height", m.B.Height, "time", time.Now().Format("15:04:05.00000"))
+	n.otel.begin(m.B.Height, n.cs.roundOf(m.B.Height), spanCommit)
+	n.cands.add(m.B)
 	n.setBlock(m.B)
 }
 
@@ -813,8 +1163,14 @@ func checkTime(t int64) bool {
 }
 
 func (n *node) handleCommittee(m *pt.Pos33SortsVote, self bool) {
+	if !self && m.Sig != nil && n.score.banned(m.Sig.Pubkey) {
+		return
+	}
 	if !self && !m.Verify() {
 		plog.Error("handleVotesCount error, signature verify false")
+		if m.Sig != nil {
+			n.score.fail(m.Sig.Pubkey, errors.New("committee vote signature error"))
+		}
 		return
 	}
 
@@ -849,95 +1205,112 @@ func (n *node) handleCommittee(m *pt.Pos33SortsVote, self bool) {
 
 func (n *node) voteCommittee(height int64, round int) {
 	comm := n.getCommittee(height, round)
-	css := comm.getCommitteeSorts()
+	css := comm.getCommitteeSorts(height)
 	var ss [][]byte
 	for k := range css {
 		ss = append(ss, []byte(k))
 	}
 
-	myss := comm.getMySorts(n.myAddr, height)
-	if len(myss) == 0 {
-		return
-	}
-
-	m := &pt.Pos33SortsVote{
-		MySorts:     myss,
-		SelectSorts: ss,
-		Height:      height,
-		Round:       int32(round),
-	}
-	m.Sign(n.getPriv())
+	// 每个私钥单独投票, 签名的公钥和抽签的公钥一致
+	for _, s := range n.getSigners() {
+		myss := comm.getMySorts(address.PubKeyToAddr(ethID, s.PubKey()), height)
+		if len(myss) == 0 {
+			continue
+		}
 
-	plog.Debug("voteCommittee", "height", height, "nmySelect", len(ss), "nv", len(m.MySorts))
-	n.handleCommittee(m, true)
+		m := &pt.Pos33SortsVote{
+			MySorts:     myss,
+			SelectSorts: ss,
+			Height:      height,
+			Round:       int32(round),
+		}
+		err := signSortsVote(s, m)
+		if err != nil {
+			plog.Error("sign committee vote error", "height", height, "round", round, "err", err)
+			continue
+		}
 
-	pm := &pt.Pos33Msg{
-		Data: types.Encode(m),
-		Ty:   pt.Pos33Msg_CV,
-	}
-	data := types.Encode(pm)
-	n.gss.gossip(n.topic+"/committee", data)
-}
+		plog.Debug("voteCommittee", "height", height, "nmySelect", len(ss), "nv", len(m.MySorts))
+		n.handleCommittee(m, true)
+		if n.faults.withholdVote(height) {
+			continue
+		}
 
-func signVotes(priv crypto.PrivKey, vs []*pt.Pos33VoteMsg) {
-	ch := make(chan *pt.Pos33VoteMsg)
-	wg := new(sync.WaitGroup)
-	for i := 0; i < 8; i++ {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			for v := range ch {
-				v.Sign(priv)
-			}
-		}()
-	}
-	for _, v := range vs {
-		ch <- v
+		pm := &pt.Pos33Msg{
+			Data: types.Encode(m),
+			Ty:   pt.Pos33Msg_CV,
+		}
+		data := types.Encode(pm)
+		n.gss.gossip(n.topic+"/committee", data)
 	}
-	close(ch)
-	wg.Wait()
 }
 
 func (n *node) voteMaker(height int64, round int) {
+	if !n.canSign() {
+		return
+	}
 	comm := n.getCommittee(height, round)
 	n.voteCommittee(height, round)
 
-	var mss []*pt.Pos33SortMsg
-	for _, s := range comm.mss {
-		mss = append(mss, s)
-	}
-
+	mss := n.mss.Best(height, round, 0, voteMakers)
+	n.otel.end(height, round, spanSortGossip, "makers", len(mss))
 	if len(mss) == 0 {
 		return
 	}
-	sort.Sort(pt.Sorts(mss))
 
-	myss := comm.getMySorts(n.myAddr, height)
+	// 已经在这个高度和轮次投过票 (区块重新执行, 或者重启前记在 wal 里), 重发同样的投票,
+	// 不会再给另一个制作人签名
+	mvs := n.voted[height][round]
+	if len(mvs) == 0 {
+		mvs = n.wal.getVotes(height, round)
+	}
+	if len(mvs) > 0 {
+		plog.Info("replay votes", "height", height, "round", round)
+		n.sendMaketVotes(mvs, int(pt.Pos33Msg_MV))
+		return
+	}
 
-	var mvs []*pt.Pos33Votes
-	for i, s := range mss {
-		if i == 3 {
-			break
-		}
-		var vs []*pt.Pos33VoteMsg
-		for _, mys := range myss {
-			v := &pt.Pos33VoteMsg{
-				Hash: s.SortHash.Hash,
-				Sort: mys,
+	for _, signer := range n.getSigners() {
+		myss := comm.getMySorts(address.PubKeyToAddr(ethID, signer.PubKey()), height)
+		for _, s := range mss {
+			var vs []*pt.Pos33VoteMsg
+			for _, mys := range myss {
+				v := &pt.Pos33VoteMsg{
+					Hash: s.SortHash.Hash,
+					Sort: mys,
+				}
+				if mys.Proof.Input.Height != height {
+					v.Height = height
+				}
+				vs = append(vs, v)
+			}
+			if len(vs) == 0 {
+				continue
+			}
+			// 同一个投票人对同一个 hash 的 bls 签名是一样的, 只需要签一次
+			sig, err := blsSign(signer, pt.BlsVote, s)
+			if err != nil {
+				plog.Error("sign vote error", "height", height, "round", round, "err", err)
+				return
+			}
+			for _, v := range vs {
+				v.Sig = sig
+			}
+			mvs = append(mvs, &pt.Pos33Votes{Vs: vs})
+			plog.Debug("vote maker", "addr", address.PubKeyToAddr(ethID, s.Proof.Pubkey)[:16], "height", height, "round", round, "time", time.Now().Format("15:04:05.00000"))
+			if !n.faults.equivocate(height) {
+				break
 			}
-			vs = append(vs, v)
-		}
-		if len(vs) == 0 {
-			continue
 		}
-		signVotes(n.getPriv(), vs)
-		mvs = append(mvs, &pt.Pos33Votes{Vs: vs})
-		plog.Debug("vote maker", "addr", address.PubKeyToAddr(ethID, s.Proof.Pubkey)[:16], "height", height, "round", round, "time", time.Now().Format("15:04:05.00000"))
-		break
 	}
-	if len(mvs) == 0 {
+	if len(mvs) == 0 || n.faults.withholdVote(height) {
 		return
 	}
+	if n.voted[height] == nil {
+		n.voted[height] = make(map[int][]*pt.Pos33Votes)
+	}
+	n.voted[height][round] = mvs
+	n.wal.saveVotes(height, round, mvs)
 	n.sendMaketVotes(mvs, int(pt.Pos33Msg_MV))
 }
 
@@ -947,8 +1320,11 @@ func (n *node) sendMaketVotes(mvs []*pt.Pos33Votes, ty int) {
 		Data: types.Encode(m),
 		Ty:   pt.Pos33Msg_Ty(ty),
 	}
-	data := types.Encode(pm)
-	n.gss.gossip(n.topic+"/makervotes", data)
+	v := mvs[0].Vs[0]
+	if !n.routeVotes(voteHeight(v), int(v.Sort.Proof.Input.Round), pm) {
+		data := types.Encode(pm)
+		n.gss.gossip(n.topic+"/makervotes", data)
+	}
 	n.handleMakerVotes(mvs, true, ty)
 }
 
@@ -964,8 +1340,8 @@ func (n *node) handleMakerSort(m *pt.Pos33SortMsg, myself bool) {
 			return
 		}
 		if n.lastBlock().Height >= height {
-			err := fmt.Errorf("sort msg too late, lbHeight=%d, sortHeight=%d", n.lastBlock().Height, height)
-			plog.Error("handleSort error", "err", err)
+			err := pt.ErrCatSortLate.New(n.lastBlock().Height, height)
+			n.logError("handleSort error", err)
 			return
 		}
 		if !checkTime(m.SortHash.Time) {
@@ -973,13 +1349,24 @@ func (n *node) handleMakerSort(m *pt.Pos33SortMsg, myself bool) {
 			return
 		}
 	}
+	if !myself && n.score.banned(m.Proof.Pubkey) {
+		return
+	}
+	if int(m.SortHash.Num) >= n.sortRetries(height) {
+		plog.Error("handleMakerSort error: sort num too large", "height", height, "num", m.SortHash.Num, "addr", address.PubKeyToAddr(ethID, m.Proof.Pubkey)[:16])
+		return
+	}
+	n.seeMine(m.Proof.Pubkey, myself)
+	if !n.takeQuota(m.Proof.Pubkey, height, quotaMaker, 1, myself) {
+		return
+	}
 	round := int(m.Proof.Input.Round)
-	comm := n.getCommittee(height, round)
-	comm.mss[string(m.SortHash.Hash)] = m
+	n.getCommittee(height, round)
+	n.mss.add(height, round, 0, []*pt.Pos33SortMsg{m})
 	if round > 0 && height > n.maxSortHeight {
 		n.maxSortHeight = height
 	}
-	plog.Debug("handleMakerSort", "nmss", len(comm.mss), "height", height, "round", round, "addr", address.PubKeyToAddr(ethID, m.Proof.Pubkey)[:16])
+	plog.Debug("handleMakerSort", "nmss", n.mss.count(height, round, 0), "height", height, "round", round, "addr", address.PubKeyToAddr(ethID, m.Proof.Pubkey)[:16])
 }
 
 func (n *node) checkSort(s *pt.Pos33SortMsg, ty int) error {
@@ -997,14 +1384,22 @@ func (n *node) checkSort(s *pt.Pos33SortMsg, ty int) error {
 		return err
 	}
 	if s == nil {
-		return fmt.Errorf("sortMsg error")
+		return pt.ErrCatSortMsg.New()
 	}
 	if s.Proof == nil || s.Proof.Input == nil || s.SortHash == nil {
-		return fmt.Errorf("sortMsg error")
+		return pt.ErrCatSortMsg.New()
+	}
+	groups := voterSortGroups
+	if ty == Maker {
+		groups = n.sortRetries(height)
+	}
+	if int(s.SortHash.Num) >= groups {
+		return pt.ErrCatSortMsg.New()
 	}
 
 	err = n.verifySort(height, ty, seed, s)
 	if err != nil {
+		n.sortFailed(height, ty, seed, s, err)
 		return err
 	}
 	// comm.sortCheckedMap[k] = true
@@ -1024,6 +1419,9 @@ func (n *node) handlePos33Msg(pm *pt.Pos33Msg) bool {
 	if pm == nil {
 		return false
 	}
+	if n.arc != nil && !n.guard.shed() {
+		n.arc.add(n.lastBlock().Height+1, pm)
+	}
 	switch pm.Ty {
 	case pt.Pos33Msg_MS:
 		var m pt.Pos33SortMsg
@@ -1032,6 +1430,9 @@ func (n *node) handlePos33Msg(pm *pt.Pos33Msg) bool {
 			plog.Error(err.Error())
 			return false
 		}
+		if m.Proof == nil || m.Proof.Input == nil || !n.win.accept(m.Proof.Input.Height, n.lastBlock().Height) {
+			return false
+		}
 		n.handleMakerSort(&m, false)
 	case pt.Pos33Msg_VS:
 		var m pt.Pos33VoteSorts
@@ -1040,7 +1441,7 @@ func (n *node) handlePos33Msg(pm *pt.Pos33Msg) bool {
 			plog.Error(err.Error())
 			return false
 		}
-		n.handleVoterSorts(m.VoteSorts, false, int(pm.Ty))
+		n.handleVoterSorts(n.win.filterVoterSorts(m.VoteSorts, n.lastBlock().Height), false, int(pm.Ty))
 	case pt.Pos33Msg_MV:
 		var m pt.Pos33MakerVotes
 		err := types.Decode(pm.Data, &m)
@@ -1048,7 +1449,7 @@ func (n *node) handlePos33Msg(pm *pt.Pos33Msg) bool {
 			plog.Error(err.Error())
 			return false
 		}
-		n.handleMakerVotes(m.Mvs, false, int(pm.Ty))
+		n.handleMakerVotes(n.win.filterMakerVotes(m.Mvs, n.lastBlock().Height), false, int(pm.Ty))
 	case pt.Pos33Msg_B:
 		var m pt.Pos33BlockMsg
 		err := types.Decode(pm.Data, &m)
@@ -1056,6 +1457,9 @@ func (n *node) handlePos33Msg(pm *pt.Pos33Msg) bool {
 			plog.Error(err.Error())
 			return false
 		}
+		if m.B == nil || !n.win.accept(m.B.Height, n.lastBlock().Height) {
+			return false
+		}
 		n.handleBlockMsg(&m, false)
 	case pt.Pos33Msg_CV:
 		var m pt.Pos33SortsVote
@@ -1064,7 +1468,50 @@ func (n *node) handlePos33Msg(pm *pt.Pos33Msg) bool {
 			plog.Error(err.Error())
 			return false
 		}
+		if !n.win.accept(m.Height, n.lastBlock().Height) {
+			return false
+		}
 		n.handleCommittee(&m, false)
+	case pt.Pos33Msg_AD:
+		var m pt.Pos33Advisory
+		err := types.Decode(pm.Data, &m)
+		if err != nil {
+			plog.Error(err.Error())
+			return false
+		}
+		n.handleAdvisory(&m, false)
+	case pt.Pos33Msg_EV:
+		var m pt.Pos33Evidence
+		err := types.Decode(pm.Data, &m)
+		if err != nil {
+			plog.Error(err.Error())
+			return false
+		}
+		go n.handleEvidence(&m, false)
+	case pt.Pos33Msg_CP:
+		var m pt.Pos33CheckpointVote
+		err := types.Decode(pm.Data, &m)
+		if err != nil {
+			plog.Error(err.Error())
+			return false
+		}
+		n.handleCheckpointVote(&m, false)
+	case pt.Pos33Msg_TM:
+		var m pt.Pos33Telemetry
+		err := types.Decode(pm.Data, &m)
+		if err != nil {
+			plog.Error(err.Error())
+			return false
+		}
+		n.handleTelemetry(&m, false)
+	case pt.Pos33Msg_ID:
+		var m pt.Pos33NodeIdentity
+		err := types.Decode(pm.Data, &m)
+		if err != nil {
+			plog.Error(err.Error())
+			return false
+		}
+		n.handleIdentity(&m, false)
 	default:
 		panic("not support this message type")
 	}
@@ -1072,27 +1519,39 @@ func (n *node) handlePos33Msg(pm *pt.Pos33Msg) bool {
 	return true
 }
 
-// handleGossipMsg multi-goroutine verify pos33 message
-func (n *node) handleGossipMsg() chan *pt.Pos33Msg {
+// handleGossipMsg multi-goroutine verify pos33 message.
+// 抽签, 投票和区块的 topic 有单独的 goroutine 和 channel, 不会被别的消息(公告, 证据, checkpoint)堵住
+func (n *node) handleGossipMsg() (chan *pt.Pos33Msg, chan *pt.Pos33Msg) {
 	num := 4
 	ch := make(chan *pt.Pos33Msg, num*128)
-	for i := 0; i < num; i++ {
-		go func() {
-			for {
-				data := <-n.gss.C
-				pm, err := unmarshal(data)
-				if err != nil {
-					plog.Error(err.Error())
-					continue
-				}
-				ch <- pm
+	pch := make(chan *pt.Pos33Msg, num*512)
+	worker := func(in chan []byte, out chan *pt.Pos33Msg) {
+		for {
+			data := <-in
+			if !n.seen.add(n.GetCurrentHeight(), data) {
+				continue
 			}
-		}()
+			pm, err := unmarshal(data)
+			if err != nil {
+				plog.Error(err.Error())
+				continue
+			}
+			out <- pm
+		}
 	}
-	return ch
+	for i := 0; i < num; i++ {
+		go worker(n.gss.P, pch)
+	}
+	for i := 0; i < num/2; i++ {
+		go worker(n.gss.C, ch)
+	}
+	return pch, ch
 }
 
 func (n *node) synced() bool {
+	if n.catchingUp() {
+		return false
+	}
 	return n.IsCaughtUp() || n.lastBlock().Height+3 > n.maxSortHeight
 }
 
@@ -1114,6 +1573,15 @@ func (n *node) getPID() {
 	}
 }
 
+// 共识延迟敏感的 topic, 优先处理
+var pos33PrioTopics = []string{
+	"/makersorts",
+	"/votersorts",
+	"/makervotes",
+	"/block",
+	"/committee",
+}
+
 var pos33Topics = []string{
 	"/makersorts",
 	"/votersorts",
@@ -1121,18 +1589,25 @@ var pos33Topics = []string{
 	// "/blockvotes",
 	"/block",
 	"/committee",
+	"/advisory",
+	"/evidence",
+	"/checkpoint",
+	"/telemetry",
+	"/identity",
 }
 
 func (n *node) runLoop() {
+	n.faults.checkNet(n.GetAPI().GetConfig())
 	lb, err := n.RequestLastBlock()
 	if err != nil {
 		panic(err)
 	}
 
 	go n.getPID()
-	priv := n.getPriv()
-	if priv == nil {
-		panic("can't go here")
+	go n.updatePeerHeight()
+	pr, err := nodeKey(n.conf, n.getSigner())
+	if err != nil {
+		panic(err)
 	}
 
 	title := n.GetAPI().GetConfig().GetTitle()
@@ -1144,8 +1619,29 @@ func (n *node) runLoop() {
 		topics = append(topics, n.topic+t)
 	}
 
-	n.gss = newGossip2(priv, n.conf.ListenPort, ns, n.conf.ForwardServers, n.conf.ForwardPeers, topics...)
-	msgch := n.handleGossipMsg()
+	n.gss = newGossip2WithKey(pr, n.conf.ListenPort, ns, n.conf.ForwardServers, n.conf.ForwardPeers, topics...)
+	var prio []string
+	for _, t := range pos33PrioTopics {
+		prio = append(prio, n.topic+t)
+	}
+	n.gss.setPriority(prio...)
+	if n.score != nil {
+		n.gss.setAllow(n.score.allowPeer)
+	}
+	n.nb.start(n.gss.h)
+	n.gss.delay = n.faults.delay()
+	go n.runTelemetry()
+	go n.runGuard()
+	go n.runIdentity()
+	pch, msgch := n.handleGossipMsg()
+	n.diag.watch("gossip.P", n.gss.P)
+	n.diag.watch("gossip.C", n.gss.C)
+	n.diag.watch("gossip.incoming", n.gss.incoming)
+	n.diag.watch("pch", pch)
+	n.diag.watch("msgch", msgch)
+	n.diag.watch("bch", n.bch)
+	n.diag.watch("vCh", n.vCh)
+	n.diag.watch("sortCh", n.sortCh)
 	if len(n.conf.BootPeers) > 0 {
 		n.gss.bootstrap(n.conf.BootPeers...)
 	}
@@ -1175,33 +1671,69 @@ func (n *node) runLoop() {
 	nch := make(chan int64, 1)
 
 	round := 0
-	blockTimeout := time.Second * 5
-	resortTimeout := time.Second * 3
-	blockD := int64(900)
+	rt := newRoundTimeout(n.conf.RoundTimeoutFloor, n.conf.RoundTimeoutCeiling)
 
 	for {
 		if !isSync {
-			time.Sleep(time.Millisecond * 1000)
+			select {
+			case ch := <-n.cs.ch:
+				ch <- n.consensusState()
+				continue
+			case ch := <-n.diag.ch:
+				ch <- n.mapSizes()
+				continue
+			case ack := <-n.pause:
+				n.waitDone(ack)
+				return
+			case <-time.After(time.Millisecond * 1000):
+			}
 			plog.Debug("NOT sync .......")
 			isSync = n.synced()
 			continue
 		}
+		// 先处理共识消息, 没有时再等别的事件
+		select {
+		case msg := <-pch:
+			n.handlePos33Msg(msg)
+			continue
+		case msg := <-n.gss.incoming:
+			n.handlePos33Msg(msg)
+			n.relayVotes(msg)
+			continue
+		default:
+		}
 		select {
 		case isSync = <-syncCh:
 		case <-n.done:
 			plog.Debug("pos33 consensus run loop stoped")
 			return
+		case ack := <-n.pause:
+			n.waitDone(ack)
+			return
+		case msg := <-pch:
+			n.handlePos33Msg(msg)
 		case msg := <-msgch:
 			n.handlePos33Msg(msg)
 		case msg := <-n.gss.incoming:
 			n.handlePos33Msg(msg)
+			n.relayVotes(msg)
+		case ch := <-n.cs.ch:
+			ch <- n.consensusState()
+		case ch := <-n.diag.ch:
+			ch <- n.mapSizes()
 		case height := <-tch:
 			if height == n.lastBlock().Height+1 {
 				round++
 				plog.Info("block timeout", "height", height, "round", round)
-				n.reSortition(height, round)
+				warnWriteStall(height, round, rt.block())
+				n.cs.setRound(height, round)
+				if n.reSortition(height, round) {
+					n.prefetchIfMaker(n.lastBlock(), height, round)
+				}
 				tt := time.Now()
-				time.AfterFunc(resortTimeout, func() {
+				d := rt.resort()
+				n.cs.setTimer("resort", d)
+				time.AfterFunc(d, func() {
 					nh := n.lastBlock().Height + 1
 					if nh > height {
 						return
@@ -1214,9 +1746,15 @@ func (n *node) runLoop() {
 			n.getCommittee(height, round).setCommittee(height)
 			cb := n.GetCurrentBlock()
 			if cb.Height == height-1 {
+				n.cs.setRound(height, round)
 				n.makeNewBlock(height, round)
 				tt := time.Now()
-				time.AfterFunc(blockTimeout, func() {
+				d := rt.block()
+				if round == 0 {
+					d += n.idle.slack(height)
+				}
+				n.cs.setTimer("block", d)
+				time.AfterFunc(d, func() {
 					if n.GetCurrentHeight() >= height {
 						return
 					}
@@ -1228,14 +1766,28 @@ func (n *node) runLoop() {
 			if b.Height < n.GetCurrentHeight() {
 				break
 			}
+			if n.catchingUp() {
+				plog.Info("catching up, skip sortition and voting", "height", b.Height, "peer height", atomic.LoadInt64(&n.peerHeight))
+				n.clear(b.Height)
+				isSync = false
+				break
+			}
+			if n.guard.shed() {
+				// 不发送, 直接丢掉这个高度的 span
+				n.otel.evict(b.Height + 1)
+			} else {
+				n.otel.commit(b.Height, round)
+			}
 			round = 0
 			n.handleNewBlock(b)
+			blockD := n.blockTime(b.Height + 1)
 			d := blockD
 			if b.Height > 0 {
 				m, err := getMiner(b)
 				if err != nil {
 					panic("can't go here")
 				}
+				rt.observe(m.BlockTime)
 				d = m.BlockTime + blockD - time.Now().UnixNano()/1000000
 				if d < 0 {
 					d = 0
@@ -1245,7 +1797,9 @@ func (n *node) runLoop() {
 				}
 			}
 			plog.Debug("after ms make next block", "d", d, "height", b.Height, "time", time.Now().Format("15:04:05.00000"))
-			time.AfterFunc(time.Millisecond*time.Duration(d), func() {
+			n.cs.setRound(b.Height+1, 0)
+			n.cs.setTimer("next", time.Millisecond*time.Duration(d))
+			n.idle.wait(n, b.Height+1, time.Millisecond*time.Duration(d), func() {
 				nch <- b.Height + 1
 			})
 			if b.Height%100 == 0 {
@@ -1266,14 +1820,18 @@ func (n *node) handleNewBlock(b *types.Block) {
 		}
 	} else {
 		n.sortition(b, round)
+		n.voteCheckpoint(b)
 	}
+	n.prefetchIfMaker(b, b.Height+1, round)
 	n.voteMaker(b.Height+pt.Pos33SortBlocks/2, round)
+	n.applyCandidate(b)
 	n.clear(b.Height)
 	plog.Debug("handleNewBlock cost", "height", b.Height, "cost", time.Since(tb))
 }
 
 func (n *node) makeNewBlock(height int64, round int) {
 	plog.Debug("makeNewBlock", "height", height, "round", round, "time", time.Now().Format("15:04:05.00000"))
+	n.otel.begin(height, round, spanVotes)
 	if round > 0 {
 		// if timeout, only vote, handle vote will make new block
 		n.voteMaker(height, round)
@@ -1284,7 +1842,7 @@ func (n *node) makeNewBlock(height int64, round int) {
 
 func (n *node) sendVoterSort(ss []*pt.Pos33Sorts, height int64, round, ty int) {
 	m := &pt.Pos33VoteSorts{
-		VoteSorts: ss,
+		VoteSorts: n.faults.malformSorts(height, ss),
 	}
 	pm := &pt.Pos33Msg{
 		Data: types.Encode(m),
@@ -1296,7 +1854,7 @@ func (n *node) sendVoterSort(ss []*pt.Pos33Sorts, height int64, round, ty int) {
 
 func (n *node) sendMakerSort(m *pt.Pos33SortMsg, height int64, round int) {
 	pm := &pt.Pos33Msg{
-		Data: types.Encode(m),
+		Data: types.Encode(n.faults.malformSort(height, m)),
 		Ty:   pt.Pos33Msg_MS,
 	}
 	n.gss.gossip(n.topic+"/makersorts", types.Encode(pm))
@@ -0,0 +1,38 @@
+package pos33
+
+import (
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func (n *node) nonceOn(height int64) bool {
+	return n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkAccountNonce")
+}
+
+func (n *node) newNonces() *pt.Nonces {
+	return pt.NewNonces(func(addr string) (int64, error) {
+		msg, err := n.GetAPI().Query(pt.Pos33TicketX, "Pos33NextNonce", &types.ReqString{Data: addr})
+		if err != nil {
+			return 0, err
+		}
+		return msg.(*types.Int64).Data, nil
+	})
+}
+
+// filterNonce 打包区块时去掉 nonce 不对的交易
+func (n *node) filterNonce(height int64, txs []*types.Transaction) []*types.Transaction {
+	if !n.nonceOn(height) {
+		return txs
+	}
+	ns := n.newNonces()
+	var r []*types.Transaction
+	for _, tx := range txs {
+		err := ns.Check(tx)
+		if err != nil {
+			plog.Debug("filterNonce", "height", height, "tx", tx.Hash(), "nonce", tx.Nonce, "err", err)
+			continue
+		}
+		r = append(r, tx)
+	}
+	return r
+}
@@ -0,0 +1,290 @@
+package pos33
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// 区块生命周期的 span
+const (
+	spanBlock      = "pos33.block"
+	spanSortition  = "sortition"
+	spanSortGossip = "sort gossip"
+	spanVotes      = "vote collection"
+	spanAssembly   = "block assembly"
+	spanCommit     = "block commit"
+)
+
+const (
+	defaultOtlpService = "ycc"
+	otlpBatchSize      = 512
+	otlpFlushInterval  = time.Second * 2
+)
+
+// OTLP/HTTP json 格式, 只用到 span 的一部分字段
+type otlpValue struct {
+	StringValue string `json:"stringValue,omitempty"`
+	IntValue    string `json:"intValue,omitempty"`
+}
+
+type otlpAttr struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpSpan struct {
+	TraceID      string     `json:"traceId"`
+	SpanID       string     `json:"spanId"`
+	ParentSpanID string     `json:"parentSpanId,omitempty"`
+	Name         string     `json:"name"`
+	Kind         int        `json:"kind"`
+	Start        string     `json:"startTimeUnixNano"`
+	End          string     `json:"endTimeUnixNano"`
+	Attributes   []otlpAttr `json:"attributes,omitempty"`
+}
+
+func otlpAttrs(kvs ...interface{}) []otlpAttr {
+	var as []otlpAttr
+	for i := 0; i+1 < len(kvs); i += 2 {
+		a := otlpAttr{Key: fmt.Sprint(kvs[i])}
+		switch v := kvs[i+1].(type) {
+		case int:
+			a.Value.IntValue = strconv.Itoa(v)
+		case int64:
+			a.Value.IntValue = strconv.FormatInt(v, 10)
+		default:
+			a.Value.StringValue = fmt.Sprint(v)
+		}
+		as = append(as, a)
+	}
+	return as
+}
+
+type heightSpans struct {
+	root *otlpSpan
+	open map[string]*otlpSpan // name/round => span
+}
+
+// blockTracer 记录每个高度的抽签, 抽签的广播, 收集投票, 打包区块和写入区块所用的时间,
+// 用 OTLP/HTTP(json) 发送到 otlpEndpoint. 同一个高度的 trace id 在所有节点上相同,
+// 在 collector 里可以看到不同节点的同一个高度. 出块间隔变长时用来查找时间花在了哪一步
+type blockTracer struct {
+	endpoint string
+	resource []otlpAttr
+	client   *http.Client
+	ch       chan *otlpSpan
+
+	mu sync.Mutex
+	hs map[int64]*heightSpans
+}
+
+func newBlockTracer(conf *subConfig) *blockTracer {
+	if conf.OtlpEndpoint == "" {
+		return nil
+	}
+	service := conf.OtlpService
+	if service == "" {
+		service = defaultOtlpService
+	}
+	host, _ := os.Hostname()
+	t := &blockTracer{
+		endpoint: conf.OtlpEndpoint,
+		resource: otlpAttrs("service.name", service, "host.name", host),
+		client:   &http.Client{Timeout: time.Second * 5},
+		ch:       make(chan *otlpSpan, otlpBatchSize*4),
+		hs:       make(map[int64]*heightSpans),
+	}
+	go t.run()
+	return t
+}
+
+func traceID(height int64) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("pos33-%d", height)))
+	return hex.EncodeToString(h[:16])
+}
+
+func spanID() string {
+	var b [8]byte
+	rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func (t *blockTracer) spans(height int64) *heightSpans {
+	hs, ok := t.hs[height]
+	if !ok {
+		root := &otlpSpan{
+			TraceID:    traceID(height),
+			SpanID:     spanID(),
+			Name:       spanBlock,
+			Kind:       1,
+			Start:      unixNano(time.Now()),
+			Attributes: otlpAttrs("height", height),
+		}
+		hs = &heightSpans{root: root, open: make(map[string]*otlpSpan)}
+		t.hs[height] = hs
+	}
+	return hs
+}
+
+// begin 开始 height 高度 round 轮次的一个 span, 已经开始的不重复开始
+func (t *blockTracer) begin(height int64, round int, name string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	hs := t.spans(height)
+	key := fmt.Sprintf("%s/%d", name, round)
+	if _, ok := hs.open[key]; ok {
+		return
+	}
+	hs.open[key] = &otlpSpan{
+		TraceID:      hs.root.TraceID,
+		SpanID:       spanID(),
+		ParentSpanID: hs.root.SpanID,
+		Name:         name,
+		Kind:         1,
+		Start:        unixNano(time.Now()),
+		Attributes:   otlpAttrs("height", height, "round", round),
+	}
+}
+
+// end 结束一个 span 并发送, kvs 是附加的属性
+func (t *blockTracer) end(height int64, round int, name string, kvs ...interface{}) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	hs, ok := t.hs[height]
+	if !ok {
+		return
+	}
+	key := fmt.Sprintf("%s/%d", name, round)
+	s, ok := hs.open[key]
+	if !ok {
+		return
+	}
+	delete(hs.open, key)
+	s.End = unixNano(time.Now())
+	s.Attributes = append(s.Attributes, otlpAttrs(kvs...)...)
+	t.export(s)
+}
+
+// commit 区块写入后结束这个高度所有的 span
+func (t *blockTracer) commit(height int64, round int) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	hs, ok := t.hs[height]
+	if !ok {
+		return
+	}
+	delete(t.hs, height)
+	now := unixNano(time.Now())
+	for _, s := range hs.open {
+		s.End = now
+		if s.Name != spanCommit {
+			s.Attributes = append(s.Attributes, otlpAttrs("unfinished", "true")...)
+		}
+		t.export(s)
+	}
+	hs.root.End = now
+	hs.root.Attributes = append(hs.root.Attributes, otlpAttrs("round", round)...)
+	t.export(hs.root)
+}
+
+// evict 删除没有等到区块的高度, 不发送
+func (t *blockTracer) evict(height int64) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for h := range t.hs {
+		if h < height {
+			delete(t.hs, h)
+		}
+	}
+}
+
+func (t *blockTracer) export(s *otlpSpan) {
+	select {
+	case t.ch <- s:
+	default:
+		// collector 太慢时丢掉, 不影响共识
+	}
+}
+
+func (t *blockTracer) run() {
+	tk := time.NewTicker(otlpFlushInterval)
+	defer tk.Stop()
+	var batch []*otlpSpan
+	var failed bool
+	for {
+		select {
+		case s := <-t.ch:
+			batch = append(batch, s)
+			if len(batch) < otlpBatchSize {
+				continue
+			}
+		case <-tk.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+		err := t.post(batch)
+		batch = nil
+		// 只在第一次失败和恢复时打印日志
+		if err != nil && !failed {
+			plog.Error("otlp export error", "endpoint", t.endpoint, "err", err)
+		} else if err == nil && failed {
+			plog.Info("otlp export recovered", "endpoint", t.endpoint)
+		}
+		failed = err != nil
+	}
+}
+
+func (t *blockTracer) post(spans []*otlpSpan) error {
+	req := map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{"attributes": t.resource},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]string{"name": "pos33"},
+						"spans": spans,
+					},
+				},
+			},
+		},
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	resp, err := t.client.Post(t.endpoint, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("otlp status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package pos33
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const defaultParticipationWindow = 1000
+
+// participation 记录每个地址每个高度的出块和投票, 保存在 participationDBPath,
+// 按窗口累计后用 pos33.GetPos33Participation 查询, 委托人和矿工用来观察矿工是否可靠.
+// 抽签是本节点收到的, 网络不好时和别的节点看到的可能不同
+type participation struct {
+	db     dbm.DB
+	window int64
+}
+
+func newParticipation(conf *subConfig) *participation {
+	if conf.ParticipationDBPath == "" {
+		return nil
+	}
+	window := conf.ParticipationWindow
+	if window <= 0 {
+		window = defaultParticipationWindow
+	}
+	return &participation{db: dbm.NewDB("pos33part", "leveldb", conf.ParticipationDBPath, 16), window: window}
+}
+
+func partKey(addr string, height int64) []byte {
+	return []byte(fmt.Sprintf("part-%s-%012d", addr, height))
+}
+
+func (p *participation) close() {
+	if p == nil {
+		return
+	}
+	p.db.Close()
+}
+
+// blsToAddr 投票的 bls 公钥对应的挖矿地址
+func (n *node) blsToAddr(pk []byte) string {
+	blsAddr := address.PubKeyToAddr(ethID, pk)
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	addr, ok := n.blsMp[blsAddr]
+	if !ok {
+		msg, err := n.GetAPI().Query(pt.Pos33TicketX, "Pos33BlsAddr", &types.ReqAddr{Addr: blsAddr})
+		if err != nil {
+			return ""
+		}
+		addr = msg.(*types.ReplyString).Data
+		n.blsMp[blsAddr] = addr
+	}
+	return addr
+}
+
+// recordParticipation 在清除 height 的抽签数据前, 记录每个地址的出块和投票
+func (n *node) recordParticipation(height int64) {
+	p := n.part
+	if p == nil {
+		return
+	}
+	b, err := n.RequestBlock(height)
+	if err != nil {
+		return
+	}
+	m, err := getMiner(b)
+	if err != nil || m.Sort.GetProof().GetInput() == nil {
+		return
+	}
+	round := int(m.Sort.Proof.Input.Round)
+
+	mp := make(map[string]*pt.Pos33Participation)
+	get := func(addr string) *pt.Pos33Participation {
+		r, ok := mp[addr]
+		if !ok {
+			r = &pt.Pos33Participation{Addr: addr, From: height, To: height}
+			mp[addr] = r
+		}
+		return r
+	}
+	get(address.PubKeyToAddr(ethID, m.Sort.Proof.Pubkey)).Made++
+	for r := 0; r <= round; r++ {
+		if ss := n.mss.Best(height, r, 0, 1); len(ss) > 0 {
+			get(address.PubKeyToAddr(ethID, ss[0].Proof.Pubkey)).Expected++
+		}
+	}
+	for _, s := range n.getCommittee(height, round).getCommitteeSorts(height) {
+		get(address.PubKeyToAddr(ethID, s.Proof.Pubkey)).Elected++
+	}
+	for _, pk := range m.Voters() {
+		if addr := n.blsToAddr(pk); addr != "" {
+			get(addr).Cast++
+		}
+	}
+
+	batch := p.db.NewBatch(false)
+	for addr, r := range mp {
+		batch.Set(partKey(addr, height), types.Encode(r))
+		// 删除窗口以外的记录
+		it := p.db.Iterator(partKey(addr, 0), partKey(addr, height-p.window), false)
+		for it.Rewind(); it.Valid(); it.Next() {
+			batch.Delete(it.Key())
+		}
+		it.Close()
+	}
+	err = batch.Write()
+	if err != nil {
+		plog.Error("save participation error", "err", err, "height", height)
+	}
+}
+
+// get 地址在最近 window 个区块的参与情况
+func (p *participation) get(addr string, window, height int64) (*pt.Pos33Participation, error) {
+	if p == nil {
+		return nil, types.ErrActionNotSupport
+	}
+	if window <= 0 || window > p.window {
+		window = p.window
+	}
+	r := &pt.Pos33Participation{Addr: addr, From: height - window + 1, To: height}
+	if r.From < 0 {
+		r.From = 0
+	}
+	it := p.db.Iterator(partKey(addr, r.From), partKey(addr, height+1), false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		var h pt.Pos33Participation
+		if types.Decode(it.Value(), &h) != nil {
+			continue
+		}
+		r.Made += h.Made
+		r.Expected += h.Expected
+		r.Cast += h.Cast
+		r.Elected += h.Elected
+	}
+	return r, nil
+}
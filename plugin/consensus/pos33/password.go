@@ -0,0 +1,127 @@
+package pos33
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// PasswordSource 读取挖矿私钥文件的密码, 私钥文件用这个密码加密保存在本地.
+// 只提供密码, 私钥总是在本地解密: 抽签的 vrf 和 bls 投票私钥都需要原始私钥, 不能交给远程的 KMS 签名.
+// 出块的 miner 交易可以用 blockSignerKMS 交给 KMS 签名, 见 kms.go.
+// 可以按名字注册, 通过 consensus.sub.pos33 的 passwordSource 选择
+type PasswordSource func(conf *subConfig) (string, error)
+
+const defaultPasswordSource = "env"
+
+var passwordSources = make(map[string]PasswordSource)
+
+// RegisterPasswordSource register a key file password source by name
+func RegisterPasswordSource(name string, ps PasswordSource) {
+	if ps == nil {
+		panic("pos33: register password source is nil")
+	}
+	if _, ok := passwordSources[name]; ok {
+		panic("pos33: register duplicate password source " + name)
+	}
+	passwordSources[name] = ps
+}
+
+func getKeyPassword(conf *subConfig) (string, error) {
+	name := conf.PasswordSource
+	if name == "" {
+		name = defaultPasswordSource
+	}
+	ps, ok := passwordSources[name]
+	if !ok {
+		return "", fmt.Errorf("password source NOT registered: %s", name)
+	}
+	return ps(conf)
+}
+
+func init() {
+	RegisterPasswordSource(defaultPasswordSource, envPassword)
+	RegisterPasswordSource("vault", vaultPassword)
+	RegisterPasswordSource("exec", execPassword)
+}
+
+// envPassword 从环境变量读取密码, 读取后清除
+func envPassword(conf *subConfig) (string, error) {
+	env := conf.KeyPasswordEnv
+	if env == "" {
+		env = pt.DefaultKeyPasswordEnv
+	}
+	password, ok := os.LookupEnv(env)
+	if !ok {
+		return "", fmt.Errorf("miner key password NOT set, env %s", env)
+	}
+	os.Unsetenv(env)
+	return password, nil
+}
+
+// vaultPassword 从 HashiCorp Vault 的 kv 读取密码, token 从环境变量 VAULT_TOKEN 读取
+func vaultPassword(conf *subConfig) (string, error) {
+	if conf.VaultAddr == "" || conf.VaultPath == "" {
+		return "", errors.New("vaultAddr or vaultPath NOT set")
+	}
+	req, err := http.NewRequest("GET", strings.TrimSuffix(conf.VaultAddr, "/")+"/v1/"+strings.TrimPrefix(conf.VaultPath, "/"), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", os.Getenv("VAULT_TOKEN"))
+	cli := &http.Client{Timeout: time.Second * 10}
+	resp, err := cli.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault read error: %s", resp.Status)
+	}
+
+	// kv v1: {"data": {...}}, kv v2: {"data": {"data": {...}}}
+	var r struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&r)
+	if err != nil {
+		return "", err
+	}
+	data := r.Data
+	if d, ok := data["data"].(map[string]interface{}); ok {
+		data = d
+	}
+	field := conf.VaultField
+	if field == "" {
+		field = "password"
+	}
+	password, ok := data[field].(string)
+	if !ok {
+		return "", fmt.Errorf("vault field NOT found: %s", field)
+	}
+	return password, nil
+}
+
+// execPassword 运行外部命令, 用它的输出作为密码.
+// 例如用 aws kms decrypt 或者 gcloud kms decrypt 解密保存在本地的密码文件, 这里不直接调用 KMS
+func execPassword(conf *subConfig) (string, error) {
+	if len(conf.KeyPasswordCmd) == 0 {
+		return "", errors.New("keyPasswordCmd NOT set")
+	}
+	var stderr bytes.Buffer
+	cmd := exec.Command(conf.KeyPasswordCmd[0], conf.KeyPasswordCmd[1:]...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("run keyPasswordCmd error: %v, %s", err, stderr.String())
+	}
+	return strings.TrimRight(string(out), "\r\n"), nil
+}
@@ -0,0 +1,159 @@
+package pos33
+
+import (
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 默认值: 60 秒内至少 20 个失败并且失败率超过 50% 时禁止 600 秒
+const (
+	defaultBanFailures = 20
+	defaultBanFailRate = 50
+	defaultBanWindow   = 60
+	defaultBanSeconds  = 600
+)
+
+type score struct {
+	start  time.Time
+	total  int
+	fails  int
+	banned time.Time // 禁止到这个时间
+}
+
+// peerScore 按发送者的公钥统计验证失败的共识消息, 失败太多的发送者在一段时间内被忽略.
+// 节点的 p2p 身份就是挖矿公钥, 被禁止的公钥对应的 peer 发来的 gossip 和直连消息也直接丢掉
+type peerScore struct {
+	mu     sync.Mutex
+	scores map[string]*score
+	pids   map[peer.ID]time.Time
+	ids    *identities
+
+	failures int
+	rate     int
+	window   time.Duration
+	banTime  time.Duration
+}
+
+func newPeerScore(conf *subConfig) *peerScore {
+	if conf.BanFailures < 0 {
+		return nil
+	}
+	ps := &peerScore{
+		scores:   make(map[string]*score),
+		pids:     make(map[peer.ID]time.Time),
+		failures: conf.BanFailures,
+		rate:     conf.BanFailRate,
+		window:   time.Duration(conf.BanWindow) * time.Second,
+		banTime:  time.Duration(conf.BanSeconds) * time.Second,
+	}
+	if ps.failures == 0 {
+		ps.failures = defaultBanFailures
+	}
+	if ps.rate <= 0 {
+		ps.rate = defaultBanFailRate
+	}
+	if ps.window <= 0 {
+		ps.window = defaultBanWindow * time.Second
+	}
+	if ps.banTime <= 0 {
+		ps.banTime = defaultBanSeconds * time.Second
+	}
+	return ps
+}
+
+func (ps *peerScore) get(pub []byte, now time.Time) *score {
+	s, ok := ps.scores[string(pub)]
+	if !ok || now.Sub(s.start) > ps.window && now.After(s.banned) {
+		s = &score{start: now}
+		ps.scores[string(pub)] = s
+	}
+	return s
+}
+
+// ok 消息验证通过
+func (ps *peerScore) ok(pub []byte) {
+	if ps == nil || len(pub) == 0 {
+		return
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.get(pub, time.Now()).total++
+}
+
+// fail 消息验证失败, 达到阈值时禁止这个公钥和对应的 peer
+func (ps *peerScore) fail(pub []byte, err error) {
+	if ps == nil || len(pub) == 0 {
+		return
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	now := time.Now()
+	s := ps.get(pub, now)
+	s.total++
+	s.fails++
+	if now.Before(s.banned) || s.fails < ps.failures || s.fails*100 < s.total*ps.rate {
+		return
+	}
+	s.banned = now.Add(ps.banTime)
+	if pid, err := ps.ids.pid(pub); err == nil {
+		ps.pids[pid] = s.banned
+	}
+	plog.Error("ban consensus msg sender", "addr", address.PubKeyToAddr(ethID, pub), "fails", s.fails, "total", s.total, "until", s.banned.Format("15:04:05"), "err", err)
+}
+
+// banned 公钥是否被禁止
+func (ps *peerScore) banned(pub []byte) bool {
+	if ps == nil {
+		return false
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	s, ok := ps.scores[string(pub)]
+	return ok && time.Now().Before(s.banned)
+}
+
+// allowPeer gossip 收到消息时检查转发的 peer
+func (ps *peerScore) allowPeer(pid peer.ID) bool {
+	if ps == nil {
+		return true
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	t, ok := ps.pids[pid]
+	if !ok {
+		return true
+	}
+	if time.Now().After(t) {
+		delete(ps.pids, pid)
+		return true
+	}
+	return false
+}
+
+// list 被禁止的公钥地址和解禁时间
+func (ps *peerScore) list() []*pt.Pos33BannedPeer {
+	if ps == nil {
+		return nil
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	now := time.Now()
+	var bs []*pt.Pos33BannedPeer
+	for pub, s := range ps.scores {
+		if now.Before(s.banned) {
+			bs = append(bs, &pt.Pos33BannedPeer{Addr: address.PubKeyToAddr(ethID, []byte(pub)), Fails: int32(s.fails), Total: int32(s.total), Until: s.banned.Unix()})
+		}
+	}
+	return bs
+}
+
+// scoreFail 只有验证失败(错误目录里的错误)计入, 查询失败之类本地的错误不算
+func (n *node) scoreFail(pub []byte, err error) {
+	if _, ok := err.(*pt.CatalogError); ok {
+		n.score.fail(pub, err)
+	}
+}
@@ -0,0 +1,97 @@
+package pos33
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+)
+
+// 预取的交易在这个时间内有效, 超过后打包时重新取
+const pipelineTTL = time.Second * 5
+
+type prefetched struct {
+	parent []byte
+	txs    []*types.Transaction
+	at     time.Time
+}
+
+// pipeline 本节点抽中下一个高度的制作人时, 在等待投票的同时预取交易池的交易并检查 nonce,
+// 收到足够的投票后只需要生成 miner 交易, 组装区块和签名.
+// 预取的交易只在父区块相同时使用
+type pipeline struct {
+	mu sync.Mutex
+	mp map[int64]*prefetched
+}
+
+func newPipeline(conf *subConfig) *pipeline {
+	if conf.DisablePipeline {
+		return nil
+	}
+	return &pipeline{mp: make(map[int64]*prefetched)}
+}
+
+// prefetch 在后台预取 parent 之后 height 高度的交易, 去掉 parent 已经打包的交易
+func (p *pipeline) prefetch(n *node, parent *types.Block, height int64) {
+	if p == nil {
+		return
+	}
+	cfg := n.GetAPI().GetConfig()
+	hash := parent.Hash(cfg)
+	var packed [][]byte
+	for _, tx := range parent.Txs {
+		packed = append(packed, tx.Hash())
+	}
+	go func() {
+		tb := time.Now()
+		txs := n.filterNonce(height, n.RequestTx(int(cfg.GetP(height).MaxTxNumber), packed))
+		p.mu.Lock()
+		p.mp[height] = &prefetched{parent: hash, txs: txs, at: time.Now()}
+		p.mu.Unlock()
+		plog.Debug("pipeline prefetched", "height", height, "parent", common.ToHex(hash)[:16], "ntx", len(txs), "cost", time.Since(tb))
+	}()
+}
+
+// take 取出 height 高度预取的交易, 父区块不同或者过期时返回 false
+func (p *pipeline) take(height int64, parent []byte) ([]*types.Transaction, bool) {
+	if p == nil {
+		return nil, false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pf, ok := p.mp[height]
+	if !ok {
+		return nil, false
+	}
+	delete(p.mp, height)
+	if !bytes.Equal(pf.parent, parent) || time.Since(pf.at) > pipelineTTL {
+		return nil, false
+	}
+	return pf.txs, true
+}
+
+func (p *pipeline) evict(height int64) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for h := range p.mp {
+		if h < height {
+			delete(p.mp, h)
+		}
+	}
+}
+
+// prefetchIfMaker 本节点是 height 高度 round 轮次的制作人时预取交易
+func (n *node) prefetchIfMaker(parent *types.Block, height int64, round int) {
+	if n.pipe == nil || parent == nil || parent.Height != height-1 {
+		return
+	}
+	if n.getmaker(height, round).my == nil {
+		return
+	}
+	n.pipe.prefetch(n, parent, height)
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/queue"
@@ -28,12 +29,17 @@ type Client struct {
 	n    *node
 
 	// clock  sync.Mutex
-	priv   crypto.PrivKey
-	myAddr string
+	// 挖矿私钥, 第一个是主私钥, 用作 gossip 的节点身份
+	signers []pt.Signer
+	myAddr  string
+	// 出块签名地址的 KMS 私钥, 没有配置时为 nil
+	kms *kmsKey
 
 	mlock sync.Mutex
 	acMap map[int64]int
 	tcMap map[int64]map[string]int64
+	ecMap map[int64]int     // epoch 的全网票数
+	drMap map[int64]float64 // 高度的难度系数
 
 	done chan struct{}
 }
@@ -62,6 +68,132 @@ type subConfig struct {
 	TrubleMaker bool `json:"trubleMaker,omitempty"`
 	// only for test
 	CheckFutureBlockHeight int64 `json:"checkFutureBlockHeight,omitempty"`
+	// 抽签规则的名字, 默认 vrf
+	Sorter string `json:"sorter,omitempty"`
+	// 保存每个高度抽签结果的数据库路径, 为空不保存
+	AuditDBPath string `json:"auditDBPath,omitempty"`
+	// 记录自己签名的区块和投票, 重启后不会签名冲突的消息
+	WalDBPath string `json:"walDBPath,omitempty"`
+	// 落后最高的 peer 超过这么多区块时不参与抽签和投票, 0 表示不限制
+	CatchUpBlocks int64 `json:"catchUpBlocks,omitempty"`
+	// 收到网络公告时 POST 到这个地址
+	AdvisoryWebhook string `json:"advisoryWebhook,omitempty"`
+	// 自适应的轮次超时的下限和上限(毫秒), 0 使用默认值 2000 和 30000
+	RoundTimeoutFloor   int64 `json:"roundTimeoutFloor,omitempty"`
+	RoundTimeoutCeiling int64 `json:"roundTimeoutCeiling,omitempty"`
+	// 节点网络身份(libp2p)的私钥文件, 不存在时自动生成, 删除或者用 ycc-cli pos33 nodekey 轮换后重启生效.
+	// 为空时用挖矿私钥作为网络身份
+	NodeKeyFile string `json:"nodeKeyFile,omitempty"`
+	// 从加密的私钥文件读取挖矿私钥, 不通过钱包
+	KeyFile string `json:"keyFile,omitempty"`
+	// 多个挖矿私钥文件, 一个节点为每个私钥抽签和投票, 密码来源和 keyFile 相同
+	KeyFiles []string `json:"keyFiles,omitempty"`
+	// 私钥文件密码的来源: env(默认), vault, exec
+	PasswordSource string   `json:"passwordSource,omitempty"`
+	KeyPasswordEnv string   `json:"keyPasswordEnv,omitempty"`
+	KeyPasswordCmd []string `json:"keyPasswordCmd,omitempty"`
+	VaultAddr      string   `json:"vaultAddr,omitempty"`
+	VaultPath      string   `json:"vaultPath,omitempty"`
+	VaultField     string   `json:"vaultField,omitempty"`
+	// 远程签名服务的地址 unix:///path/to/sock 或者 tls://host:port (ycc-cli pos33 signer 启动), 设置后挖矿私钥不在节点上.
+	// remoteSignerCA 是 tls 服务证书的 ca, 不设置用系统的根证书
+	RemoteSigner      string `json:"remoteSigner,omitempty"`
+	RemoteSignerToken string `json:"remoteSignerToken,omitempty"`
+	RemoteSignerCA    string `json:"remoteSignerCA,omitempty"`
+	// 出块签名的 KMS: aws, gcp 或者 vault(transit), 为空不用. ForkBlockSigner 之后 miner 交易由 KMS 里的
+	// secp256k1 私钥签名, 它的地址先用 ycc-cli pos33 blocksigner 登记给挖矿地址. 抽签和投票的私钥不变.
+	// kmsKeyID: aws 是 key id 或者 arn, gcp 是 cryptoKeyVersions 的完整名字, vault 是 transit 的 key 名字.
+	// kmsEndpoint 为空时用各自默认的地址, vault 用 vaultAddr
+	BlockSignerKMS    string `json:"blockSignerKMS,omitempty"`
+	KMSKeyID          string `json:"kmsKeyID,omitempty"`
+	KMSRegion         string `json:"kmsRegion,omitempty"`
+	KMSEndpoint       string `json:"kmsEndpoint,omitempty"`
+	VaultTransitMount string `json:"vaultTransitMount,omitempty"`
+	// 作为矿池的 worker: 矿池地址(和 remoteSigner 的格式一样, 也可以是 tcp://host:port), worker token 和 tls 的 ca
+	Pool      string `json:"pool,omitempty"`
+	PoolToken string `json:"poolToken,omitempty"`
+	PoolCA    string `json:"poolCA,omitempty"`
+	// 日志里错误信息和处理建议的语言: en(默认) 或者 zh
+	Lang string `json:"lang,omitempty"`
+	// 出块插件的交易最多占用的字节数, 0 使用默认值(区块大小的 1/10)
+	BlockHookTxsSize int `json:"blockHookTxsSize,omitempty"`
+	// banWindow 秒内验证失败的共识消息达到 banFailures 个并且失败率超过 banFailRate% 时,
+	// 禁止发送者 banSeconds 秒. banFailures 小于 0 不禁止
+	BanFailures int   `json:"banFailures,omitempty"`
+	BanFailRate int   `json:"banFailRate,omitempty"`
+	BanWindow   int64 `json:"banWindow,omitempty"`
+	BanSeconds  int64 `json:"banSeconds,omitempty"`
+	// 每个发送者每个高度的抽签和投票配额按 sortQuotaRounds 个轮次计算(默认 8), 超过的消息丢掉并计入 ban 的失败数,
+	// 小于 0 不限制
+	SortQuotaRounds int `json:"sortQuotaRounds,omitempty"`
+	// 关闭时等待已经签名的消息发送完成的最长时间(毫秒), 默认 3000
+	ShutdownTimeout int64 `json:"shutdownTimeout,omitempty"`
+	// 热备模式: 两台机器使用同一个挖矿私钥, 主节点停止出块 standbyTimeout 秒后备用节点接管
+	Standby bool `json:"standby,omitempty"`
+	// 共享的 lease 文件, 设置后由 lease 决定哪台机器工作, 不设置则观察网络上自己私钥的消息
+	LeaseFile      string `json:"leaseFile,omitempty"`
+	StandbyTimeout int64  `json:"standbyTimeout,omitempty"`
+	// 每 validatorExportBlocks(默认 100) 个区块导出一次签名的验证人集合 json,
+	// 写到 validatorExportFile 或者在 validatorExportAddr 的 /validators 提供, 都不设置则不导出
+	ValidatorExportFile   string `json:"validatorExportFile,omitempty"`
+	ValidatorExportAddr   string `json:"validatorExportAddr,omitempty"`
+	ValidatorExportBlocks int64  `json:"validatorExportBlocks,omitempty"`
+	// 手机钱包推送的中继地址(转发到 fcm/apns), 设备注册保存在 pushDBPath, 都设置时才开启
+	PushRelay      string `json:"pushRelay,omitempty"`
+	PushRelayToken string `json:"pushRelayToken,omitempty"`
+	PushDBPath     string `json:"pushDBPath,omitempty"`
+	// 区块生命周期的 trace 用 OTLP/HTTP(json) 发送到 otlpEndpoint, 例如 http://localhost:4318/v1/traces,
+	// otlpService 是 service.name(默认 ycc), 不设置 otlpEndpoint 则不记录
+	OtlpEndpoint string `json:"otlpEndpoint,omitempty"`
+	OtlpService  string `json:"otlpService,omitempty"`
+	// 不在抽中制作人后预取交易池的交易, 收到足够的投票后再取
+	DisablePipeline bool `json:"disablePipeline,omitempty"`
+	// 交易池为空时最多推迟 emptyBlockDelay 毫秒开始下一个高度, 等待的时间作为心跳记录在区块里,
+	// 0 表示不推迟. 网络上所有节点要使用相同的值
+	EmptyBlockDelay int64 `json:"emptyBlockDelay,omitempty"`
+	// 每个地址出块和投票的记录保存在 participationDBPath, 保留最近 participationWindow(默认 1000) 个区块, 为空不记录
+	ParticipationDBPath string `json:"participationDBPath,omitempty"`
+	ParticipationWindow int64  `json:"participationWindow,omitempty"`
+	// 投票直接发给期望的出块节点, 没有直连时通过延迟最低的 voteRelays 个 peer 转发, 0 表示广播.
+	// 每 neighborProbe(默认 10) 秒 ping 一次所有连接的 peer 测量延迟
+	VoteRelays    int   `json:"voteRelays,omitempty"`
+	NeighborProbe int64 `json:"neighborProbe,omitempty"`
+	// 候选区块缓存的大小(MB), 默认 32, 小于 0 不缓存
+	CandidateCache int `json:"candidateCache,omitempty"`
+	// 验证者之间每 telemetryInterval 秒发送一次签名的心跳, 建立验证者的在线地图, 0 表示不发送也不接收
+	TelemetryInterval int64 `json:"telemetryInterval,omitempty"`
+	// 只用于测试!!! 故障注入, 见 faultConfig
+	Faults *faultConfig `json:"faults,omitempty"`
+	// 拒绝回滚超过 maxReorgDepth 个区块的分叉, 0 不限制. 发生回滚时 POST json 到 reorgWebhook
+	MaxReorgDepth int64  `json:"maxReorgDepth,omitempty"`
+	ReorgWebhook  string `json:"reorgWebhook,omitempty"`
+	// 交易快速索引和地址索引在区块提交以后异步写到 indexDBPath, 为空不建索引
+	IndexDBPath string `json:"indexDBPath,omitempty"`
+	// 接收的抽签, 投票和区块消息的高度窗口 [当前高度-msgWindowPast, 当前高度+msgWindowFuture], 0 使用默认值
+	MsgWindowPast   int64 `json:"msgWindowPast,omitempty"`
+	MsgWindowFuture int64 `json:"msgWindowFuture,omitempty"`
+	// 同步区块时并行验证区块里的 vrf 证明和投票签名的 worker 数, 0 使用 cpu 的个数, 小于 0 不验证
+	SyncVerifyWorkers int `json:"syncVerifyWorkers,omitempty"`
+	// 提交区块 N 之前等 N-syncVerifyLag 和之前的区块验证完, 默认 64
+	SyncVerifyLag int `json:"syncVerifyLag,omitempty"`
+	// 抽签和投票最多使用的内存 (MB), 默认 64
+	MsgCache int `json:"msgCache,omitempty"`
+	// 内存放不下的抽签写到 msgSpillPath, 为空直接丢掉
+	MsgSpillPath string `json:"msgSpillPath,omitempty"`
+	// 收到的共识消息按高度存档到这个目录, 用 ycc-cli pos33 archive 查看, 为空不存档
+	ArchiveDir string `json:"archiveDir,omitempty"`
+	// 存档保留的高度数, 默认 1000
+	ArchiveHeights int64 `json:"archiveHeights,omitempty"`
+	// 存档里保存区块的内容, 默认只记录收到区块的时间
+	ArchiveBlocks bool `json:"archiveBlocks,omitempty"`
+	// goroutine 数或者堆内存 (MB) 超过预算时停掉可选的工作 (索引, 心跳, 导出, 推送, otel, 存档), 0 不限制
+	MaxGoroutines int `json:"maxGoroutines,omitempty"`
+	MaxHeapMB     int `json:"maxHeapMB,omitempty"`
+	// 一分钟内抽签验证失败超过 captureThreshold(默认 20) 次时, 把失败的抽签, peer id, 抵押和 seed 写到 captureDir,
+	// 最多保留 captureFiles(默认 20) 个文件, 为空不抓取
+	CaptureDir       string `json:"captureDir,omitempty"`
+	CaptureThreshold int    `json:"captureThreshold,omitempty"`
+	CaptureFiles     int    `json:"captureFiles,omitempty"`
 }
 
 // New create pos33 consensus client
@@ -80,17 +212,60 @@ func New(cfg *types.Consensus, sub []byte) queue.Module {
 		conf:       &subcfg,
 		acMap:      make(map[int64]int),
 		tcMap:      make(map[int64]map[string]int64),
+		ecMap:      make(map[int64]int),
+		drMap:      make(map[int64]float64),
 		done:       make(chan struct{}),
 	}
 	client.n.Client = client
+	client.n.sorter = newSorter(subcfg.Sorter, n)
+	client.n.audit = newSortAudit(subcfg.AuditDBPath)
+	client.n.part = newParticipation(&subcfg)
+	client.n.wal = newConsensusWAL(subcfg.WalDBPath)
+	client.n.sb = newStandby(&subcfg)
+	client.n.bhs = newBlockHooks(n, subcfg.BlockHookTxsSize)
+	client.n.score = newPeerScore(&subcfg)
+	if client.n.score != nil {
+		client.n.score.ids = client.n.ids
+	}
+	client.n.quota = newSortQuota(&subcfg)
+	client.n.ads = newAdvisories(subcfg.AdvisoryWebhook)
+	client.n.vex = newValidatorExport(n, &subcfg)
+	client.n.push = newPushService(&subcfg)
+	client.n.otel = newBlockTracer(&subcfg)
+	client.n.pipe = newPipeline(&subcfg)
+	client.n.idle = newIdleBlocks(&subcfg)
+	client.n.nb = newNeighbors(&subcfg)
+	client.n.cands = newCandidates(&subcfg)
+	client.n.faults = newFaults(&subcfg)
+	client.n.tms = newTelemetries(&subcfg)
+	client.n.reorg = newReorgWatch(&subcfg)
+	client.n.idx = newTxIndexer(&subcfg)
+	client.n.vals = newValidatorStatus()
+	client.n.win = newMsgWindow(&subcfg)
+	client.n.sv = newSyncVerifier(client.n, &subcfg)
+	client.n.boundStores(&subcfg)
+	client.n.arc = newMsgArchive(&subcfg)
+	client.n.guard = newResourceGuard(&subcfg)
+	client.n.fails = newDebugCapture(&subcfg)
 	c.SetChild(client)
 	return client
 }
 
 // Close is close the client
 func (client *Client) Close() {
+	client.n.shutdown()
 	client.done <- struct{}{}
 	client.BaseClient.Close()
+	client.n.audit.close()
+	client.n.part.close()
+	client.n.wal.close()
+	client.n.push.close()
+	client.n.idx.close()
+	client.n.sv.close()
+	client.n.arc.close()
+	if client.n.spill != nil {
+		client.n.spill.Close()
+	}
 	plog.Debug("pos33 consensus closed")
 }
 
@@ -110,6 +285,9 @@ func (client *Client) BlockCheck(parent *types.Block, current *types.Block) erro
 	if current.Height >= client.conf.CheckFutureBlockHeight && current.BlockTime-types.Now().Unix() > 5 {
 		return types.ErrFutureBlock
 	}
+	if err := client.n.checkReorg(current); err != nil {
+		return err
+	}
 	return client.n.checkBlock(current, parent)
 }
 
@@ -138,17 +316,50 @@ func privFromBytes(privkey []byte) (crypto.PrivKey, error) {
 	return cr.PrivKeyFromBytes(privkey)
 }
 
-func (client *Client) getPriv() crypto.PrivKey {
-	if client.priv == nil {
+func (client *Client) getSigner() pt.Signer {
+	if len(client.signers) == 0 {
 		plog.Error("Wallet LOCKED or not Set mining account")
 		return nil
 	}
-	return client.priv
+	return client.signers[0]
+}
+
+// getSigners 所有的挖矿私钥
+func (client *Client) getSigners() []pt.Signer {
+	return client.signers
+}
+
+// signerOf 按公钥找到我的私钥, 不是我的返回 nil
+func (client *Client) signerOf(pub []byte) pt.Signer {
+	for _, s := range client.signers {
+		if string(s.PubKey()) == string(pub) {
+			return s
+		}
+	}
+	return nil
+}
+
+// isMyAddr addr 是不是我的挖矿地址
+func (client *Client) isMyAddr(addr string) bool {
+	for _, s := range client.signers {
+		if address.PubKeyToAddr(ethID, s.PubKey()) == addr {
+			return true
+		}
+	}
+	return false
 }
 
 func (c *Client) AddBlock(b *types.Block) error {
+	c.n.watchReorg(b)
 	c.n.addBlock(b)
 	c.updateTicketCount(b)
+	if !c.n.guard.shed() {
+		c.n.vex.onBlock(b)
+		c.n.push.onBlock(c.n, b)
+	}
+	traceTxs(b.Txs, b.Height, "block added")
+	c.n.idx.notify(c.n)
+	c.n.vals.onBlock(c.n, b)
 	return nil
 }
 
@@ -223,6 +434,18 @@ func (c *Client) getMiner() {
 	if c.myAddr != "" {
 		return
 	}
+	if c.conf.Pool != "" {
+		c.loadPoolSigner()
+		return
+	}
+	if c.conf.RemoteSigner != "" {
+		c.loadRemoteSigner()
+		return
+	}
+	if c.conf.KeyFile != "" || len(c.conf.KeyFiles) > 0 {
+		c.loadKeyFile()
+		return
+	}
 
 	resp, err := c.GetAPI().ExecWalletFunc("pos33", "WalletGetMiner", &types.ReqNil{})
 	if err != nil {
@@ -230,24 +453,55 @@ func (c *Client) getMiner() {
 		return
 	}
 	w := resp.(*types.ReplyString)
-	c.priv, err = privFromBytes([]byte(w.Data))
+	priv, err := privFromBytes([]byte(w.Data))
 	if err != nil {
 		plog.Error("privFromBytes", "err", err)
 		return
 	}
-	c.myAddr = address.PubKeyToAddr(ethID, c.priv.PubKey().Bytes())
+	c.signers = []pt.Signer{pt.NewLocalSigner(priv)}
+	c.myAddr = address.PubKeyToAddr(ethID, priv.PubKey().Bytes())
 	plog.Debug("getMiner", "addr", c.myAddr)
 }
 
 func (c *Client) queryEntrustCount(miner string, height int64) int64 {
+	if miner == "" {
+		return 0
+	}
 	msg, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33ConsigneeEntrust", &types.ReqAddr{Addr: miner})
 	if err != nil {
 		plog.Error("query Pos33Consignee error", "error", err, "height", height, "miner", miner)
 		return 0
 	}
 	consignee := msg.(*pt.Pos33Consignee)
-	price := pt.GetPos33MineParam(c.GetAPI().GetConfig(), c.GetCurrentHeight()).GetTicketPrice()
-	return consignee.Amount / price
+	return consignee.Amount / c.ticketPrice(c.GetCurrentHeight())
+}
+
+// stakeAddr ForkMinerBind 之后, 绑定的热地址用冷地址的票抽签, 绑定了的冷地址自己不能抽签, 返回空.
+// height 是抽签用的高度, 按那时的绑定算
+func (c *Client) stakeAddr(addr string, height int64) string {
+	if !c.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkMinerBind") {
+		return addr
+	}
+	msg, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33MinerBind", &types.ReqAddr{Addr: addr})
+	if err != nil {
+		return addr
+	}
+	return msg.(*pt.Pos33MinerBind).StakeAddr(addr, height)
+}
+
+// ticketPrice height 生效的票价, ForkManagePrice 之后可能被 manage 执行器修改过
+func (c *Client) ticketPrice(height int64) int64 {
+	cfg := c.GetAPI().GetConfig()
+	price := pt.GetPos33MineParam(cfg, height).GetTicketPrice()
+	if !cfg.IsDappFork(height, pt.Pos33TicketX, "ForkManagePrice") {
+		return price
+	}
+	msg, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33TicketPrice", &types.ReqInt{Height: height})
+	if err != nil {
+		plog.Error("query ticket price error", "err", err, "height", height)
+		return price
+	}
+	return msg.(*types.Int64).Data
 }
 
 func (c *Client) queryTicketCount(addr string, height int64) int64 {
@@ -285,7 +539,7 @@ func (c *Client) queryMinerTicketCount(addr string, height int64) int64 {
 	count := int64(0)
 	cfg := c.GetAPI().GetConfig()
 	if cfg.IsDappFork(height, pt.Pos33TicketX, "UseEntrust") {
-		count = c.queryEntrustCount(addr, height)
+		count = c.queryEntrustCount(c.stakeAddr(addr, height), height)
 	} else {
 		msg, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33TicketCount", &types.ReqAddr{Addr: addr})
 		if err != nil {
@@ -318,7 +572,7 @@ func (c *Client) queryAllPos33Count(height int64) int {
 	}
 	count := msg.(*types.Int64).Data
 	if useAmount {
-		count = count / pt.GetPos33MineParam(cfg, height).GetTicketPrice()
+		count = count / c.ticketPrice(height)
 	}
 	c.acMap[height] = int(count)
 	return int(count)
@@ -327,7 +581,11 @@ func (c *Client) queryAllPos33Count(height int64) int {
 func (client *Client) myCount() int {
 	client.getMiner()
 	height := client.GetCurrentHeight()
-	return int(client.queryTicketCount(client.myAddr, height))
+	count := 0
+	for _, s := range client.getSigners() {
+		count += int(client.queryTicketCount(address.PubKeyToAddr(ethID, s.PubKey()), height))
+	}
+	return count
 }
 
 // CreateBlock will start run
@@ -337,6 +595,7 @@ func (client *Client) CreateBlock() {
 		panic(err)
 	}
 	client.getMiner()
+	client.loadKMS()
 	gtm := client.GetGenesisBlockTime()
 	plog.Debug("CreateBlock", "block 0 time", b.BlockTime, "genesis time", gtm)
 	if b.BlockTime != gtm {
@@ -460,6 +719,14 @@ func (client *Client) setBlock(b *types.Block) error {
 	return nil
 }
 
+// blockMaker 区块的出块人是抽签的地址, ForkBlockSigner 之后 miner 交易可能由登记的出块签名地址签名
+func blockMaker(b *types.Block, m *pt.Pos33MinerMsg) string {
+	if addr := pt.MinerTxMaker(m); addr != "" {
+		return addr
+	}
+	return b.Txs[0].From()
+}
+
 func getMiner(b *types.Block) (*pt.Pos33MinerMsg, error) {
 	if b == nil {
 		return nil, fmt.Errorf("b is nil")
@@ -525,7 +792,15 @@ func (client *Client) CmpBestBlock(newBlock *types.Block, cmpBlock *types.Block)
 		return false
 	}
 
-	plog.Debug("block cmp", "nv1", len(m1.BlsPkList), "nv2", len(m2.BlsPkList))
+	plog.Debug("block cmp", "nv1", len(m1.Voters()), "nv2", len(m2.Voters()))
+	cfg := client.GetAPI().GetConfig()
+	if cfg.IsDappFork(newBlock.Height, pt.Pos33TicketX, "ForkTieBreak") {
+		h1, h2 := newBlock.Hash(cfg), cmpBlock.Hash(cfg)
+		better, reason := betterMiner(m1, m2, h1, h2)
+		plog.Info("block tie break", "height", newBlock.Height, "new", common.ToHex(h1)[:16], "cmp", common.ToHex(h2)[:16],
+			"better", better, "reason", reason)
+		return better
+	}
 	return true
 
 	// vw1 := voteWeight(m1.Votes)
@@ -545,3 +820,116 @@ func (client *Client) Query_FlushPos33Ticket(req *types.ReqNil) (types.Message,
 func (client *Client) Query_GetMinerList(req *types.ReqNil) (types.Message, error) {
 	return &types.ReplyStrings{Datas: client.n.getMinerList()}, nil
 }
+
+// Query_GetSortAudit get sortition result of height
+func (client *Client) Query_GetSortAudit(req *types.ReqInt) (types.Message, error) {
+	sa, err := client.n.audit.get(req.Height)
+	if err != nil {
+		return nil, err
+	}
+	return sa, nil
+}
+
+// Query_GetParticipation get blocks made and votes cast of an address in recent blocks
+func (client *Client) Query_GetParticipation(req *pt.ReqPos33Participation) (types.Message, error) {
+	// 抽签数据清除时才记录, 最近 21 个区块还没有记录
+	r, err := client.n.part.get(req.Addr, req.Window, client.GetCurrentHeight()-21)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Query_GetIndexedTx get tx from the async tx index
+func (client *Client) Query_GetIndexedTx(req *types.ReqHash) (types.Message, error) {
+	r, err := client.n.idx.getTx(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Query_GetIndexedRewards get reward events of an address from the async index
+func (client *Client) Query_GetIndexedRewards(req *pt.ReqPos33RewardHistory) (types.Message, error) {
+	r, err := client.n.idx.rewards(req)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Query_GetIndexedAddrTxs get txs of an address from the async tx index
+func (client *Client) Query_GetIndexedAddrTxs(req *pt.ReqPos33AddrTxs) (types.Message, error) {
+	r, err := client.n.idx.addrTxs(req)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Query_GetLiveness get the validator liveness map seen by this node
+func (client *Client) Query_GetLiveness(req *types.ReqNil) (types.Message, error) {
+	r := client.n.liveness()
+	if r == nil {
+		return nil, types.ErrActionNotSupport
+	}
+	return r, nil
+}
+
+// Query_DryRunBlock assemble a block from mempool without broadcasting it
+func (client *Client) Query_DryRunBlock(req *types.ReqNil) (types.Message, error) {
+	r, err := client.n.dryRunBlock()
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Query_GetDiagnostics dump pos33 internal diagnostics
+func (client *Client) Query_GetDiagnostics(req *types.ReqNil) (types.Message, error) {
+	return client.n.getDiagnostics(), nil
+}
+
+// Query_GetConsensusState get live consensus state of this node
+func (client *Client) Query_GetConsensusState(req *types.ReqNil) (types.Message, error) {
+	return client.n.getConsensusState(), nil
+}
+
+// Query_GetValidatorStatus get whether the validators of this node are healthy
+func (client *Client) Query_GetValidatorStatus(req *types.ReqNil) (types.Message, error) {
+	return client.n.getValidatorStatus(), nil
+}
+
+// Query_SetMinerPause pause or resume the miner at runtime
+func (client *Client) Query_SetMinerPause(req *pt.ReqPos33MinerPause) (types.Message, error) {
+	client.n.setMinerPause(req.Pause)
+	return &types.Reply{IsOk: true}, nil
+}
+
+// Query_GetBannedPeers get consensus msg senders banned by this node
+func (client *Client) Query_GetBannedPeers(req *types.ReqNil) (types.Message, error) {
+	return &pt.Pos33BannedPeers{Items: client.n.score.list()}, nil
+}
+
+// Query_GetAdvisories get network advisories
+func (client *Client) Query_GetAdvisories(req *types.ReqNil) (types.Message, error) {
+	return &pt.Pos33Advisories{Items: client.n.ads.list()}, nil
+}
+
+// Query_RegisterPush register or remove a device for push notifications of an address
+func (client *Client) Query_RegisterPush(req *pt.Pos33PushDevice) (types.Message, error) {
+	err := client.n.push.register(req)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Reply{IsOk: true}, nil
+}
+
+// Query_SendAdvisory broadcast a signed network advisory
+func (client *Client) Query_SendAdvisory(req *pt.Pos33Advisory) (types.Message, error) {
+	err := client.n.handleAdvisory(req, true)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Reply{IsOk: true}, nil
+}
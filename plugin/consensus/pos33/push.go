@@ -0,0 +1,309 @@
+package pos33
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 注册请求的签名时间和节点时间最多相差的秒数
+const pushMaxTimeDiff = 600
+
+// 每个地址最多注册的设备数
+const maxPushDevices = 8
+
+// 等待发送的通知, 满了直接丢掉
+const pushQueueSize = 1024
+
+// pushMsg 发给推送中继的 json, 中继负责转发给 fcm 或者 apns
+type pushMsg struct {
+	Token    string            `json:"token"`
+	Platform string            `json:"platform"`
+	Title    string            `json:"title"`
+	Body     string            `json:"body"`
+	Data     map[string]string `json:"data,omitempty"`
+}
+
+// pushService 手机钱包的推送: 保存地址注册的设备, 地址收到转账, 地址的矿工被罚没,
+// 或者收到网络公告时, 通过配置的中继发送通知
+type pushService struct {
+	db         dbm.DB
+	relay      string
+	relayToken string
+	ch         chan *pushMsg
+
+	mu      sync.Mutex
+	devices map[string]map[string]*pt.Pos33PushDevice // addr => token => device
+}
+
+func newPushService(conf *subConfig) *pushService {
+	if conf.PushRelay == "" || conf.PushDBPath == "" {
+		return nil
+	}
+	p := &pushService{
+		db:         dbm.NewDB("pos33push", "leveldb", conf.PushDBPath, 16),
+		relay:      conf.PushRelay,
+		relayToken: conf.PushRelayToken,
+		ch:         make(chan *pushMsg, pushQueueSize),
+		devices:    make(map[string]map[string]*pt.Pos33PushDevice),
+	}
+	it := p.db.Iterator([]byte("push-"), nil, false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		d := new(pt.Pos33PushDevice)
+		if types.Decode(it.Value(), d) != nil {
+			continue
+		}
+		p.addDevice(d)
+	}
+	go p.sendLoop()
+	return p
+}
+
+func pushKey(addr, token string) []byte {
+	return []byte(fmt.Sprintf("push-%s-%s", addr, token))
+}
+
+func (p *pushService) addDevice(d *pt.Pos33PushDevice) {
+	mp, ok := p.devices[d.Addr]
+	if !ok {
+		mp = make(map[string]*pt.Pos33PushDevice)
+		p.devices[d.Addr] = mp
+	}
+	mp[d.Token] = d
+}
+
+func (p *pushService) removeDevice(addr, token string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if mp, ok := p.devices[addr]; ok {
+		delete(mp, token)
+		if len(mp) == 0 {
+			delete(p.devices, addr)
+		}
+	}
+	err := p.db.Delete(pushKey(addr, token))
+	if err != nil {
+		plog.Error("push device delete error", "err", err, "addr", addr)
+	}
+}
+
+// register 注册或者取消注册, 只有地址的私钥能为这个地址注册
+func (p *pushService) register(d *pt.Pos33PushDevice) error {
+	if p == nil {
+		return types.ErrActionNotSupport
+	}
+	if d.Token == "" || (d.Platform != "fcm" && d.Platform != "apns") {
+		return types.ErrInvalidParam
+	}
+	now := time.Now().Unix()
+	if d.Time > now+pushMaxTimeDiff || d.Time < now-pushMaxTimeDiff {
+		return pt.ErrTime
+	}
+	if !d.Verify() {
+		return types.ErrSign
+	}
+	if address.PubKeyToAddr(ethID, d.Sig.Pubkey) != d.Addr {
+		return types.ErrInvalidAddress
+	}
+	if d.Remove {
+		p.removeDevice(d.Addr, d.Token)
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if mp := p.devices[d.Addr]; len(mp) >= maxPushDevices && mp[d.Token] == nil {
+		return pt.ErrTooManyRequests
+	}
+	err := p.db.Set(pushKey(d.Addr, d.Token), types.Encode(d))
+	if err != nil {
+		return err
+	}
+	p.addDevice(d)
+	return nil
+}
+
+// notify 通知地址注册的所有设备
+func (p *pushService) notify(addr, title, body string, data map[string]string) {
+	p.mu.Lock()
+	var ms []*pushMsg
+	for _, d := range p.devices[addr] {
+		ms = append(ms, &pushMsg{Token: d.Token, Platform: d.Platform, Title: title, Body: body, Data: data})
+	}
+	p.mu.Unlock()
+	for _, m := range ms {
+		p.enqueue(m)
+	}
+}
+
+// notifyAll 通知所有设备, 用于网络公告
+func (p *pushService) notifyAll(title, body string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	var ms []*pushMsg
+	for _, mp := range p.devices {
+		for _, d := range mp {
+			ms = append(ms, &pushMsg{Token: d.Token, Platform: d.Platform, Title: title, Body: body})
+		}
+	}
+	p.mu.Unlock()
+	for _, m := range ms {
+		p.enqueue(m)
+	}
+}
+
+func (p *pushService) enqueue(m *pushMsg) {
+	select {
+	case p.ch <- m:
+	default:
+		plog.Error("push queue full, drop notification", "title", m.Title)
+	}
+}
+
+func (p *pushService) sendLoop() {
+	for m := range p.ch {
+		p.send(m)
+	}
+}
+
+// send 中继返回 404 或者 410 表示设备 token 已经失效, 删除这个设备
+func (p *pushService) send(m *pushMsg) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return
+	}
+	req, err := http.NewRequest("POST", p.relay, bytes.NewReader(data))
+	if err != nil {
+		plog.Error("push relay error", "err", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.relayToken != "" {
+		req.Header.Set("Authorization", "Bearer "+p.relayToken)
+	}
+	client := &http.Client{Timeout: time.Second * 10}
+	resp, err := client.Do(req)
+	if err != nil {
+		plog.Error("push relay error", "err", err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		p.mu.Lock()
+		var addr string
+		for a, mp := range p.devices {
+			if _, ok := mp[m.Token]; ok {
+				addr = a
+				break
+			}
+		}
+		p.mu.Unlock()
+		if addr != "" {
+			p.removeDevice(addr, m.Token)
+		}
+		return
+	}
+	if resp.StatusCode != http.StatusOK {
+		plog.Error("push relay error", "status", resp.Status)
+	}
+}
+
+func (p *pushService) registered(addr string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.devices[addr]) > 0
+}
+
+// onBlock 区块里转给注册地址的 coins 交易, 和罚没注册地址的交易
+func (p *pushService) onBlock(n *node, b *types.Block) {
+	if p == nil {
+		return
+	}
+	cfg := n.GetAPI().GetConfig()
+	height := fmt.Sprintf("%d", b.Height)
+	for _, tx := range b.Txs {
+		switch string(tx.Execer) {
+		case cfg.ExecName(cty.CoinsX):
+			var act cty.CoinsAction
+			if types.Decode(tx.Payload, &act) != nil || act.Ty != cty.CoinsActionTransfer {
+				continue
+			}
+			tr := act.GetTransfer()
+			if tr == nil || !p.registered(tr.To) {
+				continue
+			}
+			amount := types.FormatAmount2FloatDisplay(tr.Amount, cfg.GetCoinPrecision(), true)
+			p.notify(tr.To, "Incoming transfer", fmt.Sprintf("Received %s %s from %s", amount, cfg.GetCoinSymbol(), tx.From()), map[string]string{
+				"type":   "transfer",
+				"hash":   common.ToHex(tx.Hash()),
+				"amount": amount,
+				"height": height,
+			})
+		case pt.Pos33TicketX:
+			var act pt.Pos33TicketAction
+			if types.Decode(tx.Payload, &act) != nil || act.Ty != pt.Pos33ActionSlash {
+				continue
+			}
+			addr := n.evidenceOffender(act.GetSlash())
+			if addr == "" || !p.registered(addr) {
+				continue
+			}
+			p.notify(addr, "Validator slashed", fmt.Sprintf("Miner %s was slashed at height %s", addr, height), map[string]string{
+				"type":   "slash",
+				"hash":   common.ToHex(tx.Hash()),
+				"height": height,
+			})
+		}
+	}
+}
+
+// evidenceOffender 作恶证据里的矿工地址, 投票的 bls 公钥查绑定的地址
+func (n *node) evidenceOffender(e *pt.Pos33Evidence) string {
+	if e == nil {
+		return ""
+	}
+	if e.Ty == pt.EvidenceMaker {
+		if e.Tx1 == nil {
+			return ""
+		}
+		var act pt.Pos33TicketAction
+		if types.Decode(e.Tx1.Payload, &act) == nil {
+			if addr := pt.MinerTxMaker(act.GetMiner()); addr != "" {
+				return addr
+			}
+		}
+		return e.Tx1.From()
+	}
+	sig := e.GetVote1().GetSig()
+	if e.Ty == pt.EvidenceCheckpoint {
+		sig = e.GetCp1().GetSig()
+	}
+	if sig == nil {
+		return ""
+	}
+	msg, err := n.GetAPI().Query(pt.Pos33TicketX, "Pos33BlsAddr", &types.ReqAddr{Addr: address.PubKeyToAddr(ethID, sig.Pubkey)})
+	if err != nil {
+		return ""
+	}
+	return msg.(*types.ReplyString).Data
+}
+
+func (p *pushService) close() {
+	if p == nil {
+		return
+	}
+	p.db.Close()
+}
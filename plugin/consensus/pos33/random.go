@@ -0,0 +1,76 @@
+package pos33
+
+import (
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	rt "github.com/yccproject/ycc/plugin/dapp/random/types"
+)
+
+func init() {
+	RegisterBlockHook("random", 10, func(n *node) BlockHook { return &randomHook{n: n} })
+}
+
+// randomHook 制作区块时完成上一个区块的随机数请求, 输出由本区块的种子得到, fee 给自己.
+// 更早的请求 fee 给别的制作人, 由请求人自己完成. fee 不够交易费的请求不完成
+type randomHook struct {
+	n *node
+}
+
+func (h *randomHook) Txs(height int64, budget int) []*types.Transaction {
+	return nil
+}
+
+func (h *randomHook) Extra(height int64, budget int) []byte {
+	return nil
+}
+
+func (h *randomHook) CheckExtra(height int64, data []byte) error {
+	return nil
+}
+
+func (h *randomHook) MakerTxs(height int64, budget int, s pt.Signer) []*types.Transaction {
+	if s == nil {
+		return nil
+	}
+	cfg := h.n.GetAPI().GetConfig()
+	if !cfg.IsDappFork(height, rt.RandomX, "Enable") || !cfg.IsDappFork(height, pt.Pos33TicketX, "ForkVrfSeed") {
+		return nil
+	}
+	resp, err := h.n.GetAPI().Query(rt.RandomX, "PendingRandoms", &types.ReqNil{})
+	if err != nil {
+		plog.Error("query pending randoms error", "err", err, "height", height)
+		return nil
+	}
+	var txs []*types.Transaction
+	for _, r := range resp.(*rt.ReplyRandomRecords).Records {
+		if r.Height+1 != height || r.Deadline < height {
+			continue
+		}
+		act := &rt.RandomAction{
+			Ty:    rt.RandomActionFulfill,
+			Value: &rt.RandomAction_Fulfill{Fulfill: &rt.RandomFulfill{Id: r.Id}},
+		}
+		tx, err := types.CreateFormatTx(cfg, rt.RandomX, types.Encode(act))
+		if err != nil {
+			plog.Error("random fulfill tx error", "err", err, "height", height)
+			return txs
+		}
+		if tx.Fee > r.Fee {
+			continue
+		}
+		err = signTx(s, tx)
+		if err != nil {
+			return txs
+		}
+		size := types.Size(tx)
+		if size > budget {
+			break
+		}
+		budget -= size
+		txs = append(txs, tx)
+	}
+	if len(txs) > 0 {
+		plog.Debug("random fulfill", "height", height, "n", len(txs))
+	}
+	return txs
+}
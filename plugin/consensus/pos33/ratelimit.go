@@ -0,0 +1,115 @@
+package pos33
+
+import (
+	"sync"
+
+	"github.com/33cn/chain33/common/address"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 默认每个高度在本节点当前轮次之外再允许的轮次数: 一个发送者在一个高度最多 (8+当前轮次) 个制作人抽签,
+// 投票人抽签和投票最多各 (8+当前轮次)*抽签重试次数*票数 个
+const defaultSortQuotaRounds = 8
+
+const (
+	quotaMaker = iota
+	quotaVoter
+	quotaVote
+)
+
+type quota struct {
+	count int64 // 发送者在 height-Pos33SortBlocks 的票数
+	used  [3]int64
+	drops int
+}
+
+// sortQuota 按发送者公钥和高度限制抽签和投票消息的数量, 上限由发送者的票数决定,
+// 超过的消息在进入候选存储之前丢掉, 并且计入 peerScore.
+// 防止有票的节点用以后轮次的无用抽签淹没网络
+type sortQuota struct {
+	mu     sync.Mutex
+	mp     map[int64]map[string]*quota
+	rounds int64
+}
+
+func newSortQuota(conf *subConfig) *sortQuota {
+	if conf.SortQuotaRounds < 0 {
+		return nil
+	}
+	q := &sortQuota{mp: make(map[int64]map[string]*quota), rounds: int64(conf.SortQuotaRounds)}
+	if q.rounds == 0 {
+		q.rounds = defaultSortQuotaRounds
+	}
+	return q
+}
+
+// limit 返回每种消息在一个高度的上限, 长时间没有出块轮次增加时上限跟着增加
+func (q *sortQuota) limit(n *node, height int64, ty int, count int64) int64 {
+	rounds := q.rounds + int64(n.cs.roundOf(height))
+	switch ty {
+	case quotaMaker:
+		if count == 0 {
+			return 0
+		}
+		return rounds
+	default:
+		return rounds * voterSortGroups * count
+	}
+}
+
+// take 发送者在 height 使用 num 个 ty 类型的配额, 超过上限返回错误和这个高度丢掉的消息数
+func (q *sortQuota) take(n *node, pub []byte, height int64, ty int, num int) (int, error) {
+	if q == nil || height <= pt.Pos33SortBlocks {
+		return 0, nil
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	hm, ok := q.mp[height]
+	if !ok {
+		hm = make(map[string]*quota)
+		q.mp[height] = hm
+	}
+	u, ok := hm[string(pub)]
+	if !ok {
+		// 每个发送者每个高度只查询一次票数
+		addr := address.PubKeyToAddr(ethID, pub)
+		u = &quota{count: n.queryTicketCount(addr, height-pt.Pos33SortBlocks)}
+		hm[string(pub)] = u
+	}
+	limit := q.limit(n, height, ty, u.count)
+	if u.used[ty]+int64(num) > limit {
+		u.drops++
+		return u.drops, pt.ErrCatSortQuota.New(u.used[ty]+int64(num), limit, u.count, height)
+	}
+	u.used[ty] += int64(num)
+	return 0, nil
+}
+
+func (q *sortQuota) evict(height int64) {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for h := range q.mp {
+		if h < height {
+			delete(q.mp, h)
+		}
+	}
+}
+
+// takeQuota 自己的消息不限制, 超过配额的消息计入 peerScore, 每个发送者每个高度只记录第一次的日志
+func (n *node) takeQuota(pub []byte, height int64, ty int, num int, myself bool) bool {
+	if myself {
+		return true
+	}
+	drops, err := n.quota.take(n, pub, height, ty, num)
+	if err == nil {
+		return true
+	}
+	if drops == 1 {
+		n.logError("sort quota exceeded", err, "addr", address.PubKeyToAddr(ethID, pub))
+	}
+	n.scoreFail(pub, err)
+	return false
+}
@@ -0,0 +1,203 @@
+package pos33
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	metrics "github.com/rcrowley/go-metrics"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const (
+	// 没有设置 maxReorgDepth 时记录最近 reorgKeep 个高度的区块 hash
+	reorgKeep = 1000
+	// 等待发送的 webhook, 满了直接丢掉
+	reorgQueueSize = 64
+)
+
+// reorgEvent 发给 reorgWebhook 的 json
+type reorgEvent struct {
+	// 新的主链和旧的主链最后一个相同的区块高度
+	Ancestor int64  `json:"ancestor"`
+	Depth    int64  `json:"depth"`
+	OldHash  string `json:"oldHash"`
+	NewHash  string `json:"newHash"`
+	Height   int64  `json:"height"`
+	Time     int64  `json:"time"`
+}
+
+// reorgWatch 记录加入主链的区块 hash, 发现回滚时记录日志和 metrics, 并通知 reorgWebhook.
+// maxReorgDepth 大于 0 时, 拒绝回滚超过 maxReorgDepth 个区块的分叉.
+// 不管有没有设置 maxReorgDepth, 都拒绝替换 finalized checkpoint 和之前的区块. finalized 的高度记在这里只增不减,
+// 因为回滚时 chain33 先断开旧的区块, 执行器状态里的 finalized checkpoint 也跟着回滚了
+type reorgWatch struct {
+	max     int64
+	webhook string
+	ch      chan *reorgEvent
+
+	mu        sync.Mutex
+	hashes    map[int64][]byte
+	tip       int64
+	finInit   bool
+	finalized int64
+	finHash   []byte
+}
+
+func newReorgWatch(conf *subConfig) *reorgWatch {
+	r := &reorgWatch{max: conf.MaxReorgDepth, webhook: conf.ReorgWebhook, hashes: make(map[int64][]byte)}
+	if r.webhook != "" {
+		r.ch = make(chan *reorgEvent, reorgQueueSize)
+		go r.sendLoop()
+	}
+	return r
+}
+
+// finalize 记录 finalized checkpoint, 高度只增不减
+func (r *reorgWatch) finalize(cp *pt.Pos33Checkpoint) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.finInit = true
+	if cp == nil || cp.Height <= r.finalized {
+		return
+	}
+	r.finalized = cp.Height
+	r.finHash = cp.Hash
+	metrics.GetOrRegisterGauge("pos33.finalized", nil).Update(cp.Height)
+}
+
+func (r *reorgWatch) keep() int64 {
+	if r.max*2 > reorgKeep {
+		return r.max * 2
+	}
+	return reorgKeep
+}
+
+// check 连接到主链之前检查, 区块 b 替换了 finalized 的区块, 或者替换了已经在主链上的区块并且回滚太深时返回错误.
+// finalized 高度和之前只能连接记录过的主链区块, 没有记录的 (超过 keep 个区块的回滚) 也拒绝
+func (r *reorgWatch) check(b *types.Block, hash []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	old, ok := r.hashes[b.Height]
+	if b.Height <= r.finalized {
+		if b.Height == r.finalized && !bytes.Equal(hash, r.finHash) || !bytes.Equal(old, hash) {
+			plog.Error("block conflict with finalized checkpoint", "height", b.Height, "finalized", r.finalized)
+			metrics.GetOrRegisterCounter("pos33.reorg.finalized", nil).Inc(1)
+			return pt.ErrCheckpoint
+		}
+		return nil
+	}
+	if r.max <= 0 || !ok || bytes.Equal(old, hash) {
+		return nil
+	}
+	if depth := r.tip - b.Height + 1; depth > r.max {
+		plog.Error("reject deep reorg", "height", b.Height, "depth", depth, "max", r.max)
+		return pt.ErrReorgTooDeep
+	}
+	return nil
+}
+
+// added 区块加入主链, main 返回现在主链上的区块 hash. 调用 main 的时候不能持有锁, 否则会和 check 死锁
+func (r *reorgWatch) added(b *types.Block, hash []byte, main func(int64) []byte) {
+	r.mu.Lock()
+	old, ok := r.hashes[b.Height-1]
+	reorged := ok && !bytes.Equal(old, b.ParentHash)
+	if old, ok := r.hashes[b.Height]; ok && !bytes.Equal(old, hash) {
+		reorged = true
+	}
+	olds := make(map[int64][]byte)
+	if reorged {
+		for h, v := range r.hashes {
+			if h < b.Height {
+				olds[h] = v
+			}
+		}
+	}
+	r.mu.Unlock()
+
+	ancestor := b.Height - 1
+	if reorged {
+		// 找新旧主链最后一个相同的区块
+		for ; ancestor > 0; ancestor-- {
+			old, ok := olds[ancestor]
+			if !ok || bytes.Equal(old, main(ancestor)) {
+				break
+			}
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if reorged {
+		e := &reorgEvent{
+			Ancestor: ancestor,
+			Depth:    r.tip - ancestor,
+			NewHash:  common.ToHex(hash),
+			Height:   b.Height,
+			Time:     time.Now().Unix(),
+		}
+		if old, ok := r.hashes[ancestor+1]; ok {
+			e.OldHash = common.ToHex(old)
+		}
+		plog.Warn("chain reorg", "ancestor", e.Ancestor, "depth", e.Depth, "height", b.Height, "old", e.OldHash, "new", e.NewHash)
+		metrics.GetOrRegisterCounter("pos33.reorg", nil).Inc(1)
+		metrics.GetOrRegisterHistogram("pos33.reorg.depth", nil, metrics.NewUniformSample(128)).Update(e.Depth)
+		for h := range r.hashes {
+			if h > ancestor {
+				delete(r.hashes, h)
+			}
+		}
+		if r.ch != nil {
+			select {
+			case r.ch <- e:
+			default:
+			}
+		}
+	}
+	r.hashes[b.Height] = hash
+	r.tip = b.Height
+	delete(r.hashes, b.Height-r.keep())
+}
+
+func (r *reorgWatch) sendLoop() {
+	for e := range r.ch {
+		data, err := json.Marshal(e)
+		if err != nil {
+			continue
+		}
+		resp, err := http.Post(r.webhook, "application/json", bytes.NewReader(data))
+		if err != nil {
+			plog.Error("reorg webhook error", "err", err)
+			continue
+		}
+		resp.Body.Close()
+	}
+}
+
+func (n *node) checkReorg(b *types.Block) error {
+	n.reorg.mu.Lock()
+	init := n.reorg.finInit
+	n.reorg.mu.Unlock()
+	if !init && n.checkpointOn(b.Height) {
+		n.reorg.finalize(n.queryCheckpoint("Pos33Finalized"))
+	}
+	return n.reorg.check(b, b.Hash(n.GetAPI().GetConfig()))
+}
+
+func (n *node) watchReorg(b *types.Block) {
+	cfg := n.GetAPI().GetConfig()
+	if n.checkpointOn(b.Height) {
+		defer func() { n.reorg.finalize(n.queryCheckpoint("Pos33Finalized")) }()
+	}
+	n.reorg.added(b, b.Hash(cfg), func(height int64) []byte {
+		mb, err := n.RequestBlock(height)
+		if err != nil {
+			return nil
+		}
+		return mb.Hash(cfg)
+	})
+}
@@ -0,0 +1,47 @@
+package pos33
+
+import (
+	"sync"
+
+	"github.com/33cn/chain33/common"
+)
+
+// seenCache gossip 收到过的消息 hash, 同一个消息从不同的 peer 收到时, 在解码和验证签名/vrf 之前丢掉.
+// 按收到时的区块高度分组, 区块上链后删除旧的分组
+type seenCache struct {
+	mu sync.Mutex
+	mp map[int64]map[string]struct{}
+}
+
+func newSeenCache() *seenCache {
+	return &seenCache{mp: make(map[int64]map[string]struct{})}
+}
+
+// add 第一次收到返回 true
+func (c *seenCache) add(height int64, data []byte) bool {
+	k := string(common.Sha256(data))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, hm := range c.mp {
+		if _, ok := hm[k]; ok {
+			return false
+		}
+	}
+	hm, ok := c.mp[height]
+	if !ok {
+		hm = make(map[string]struct{})
+		c.mp[height] = hm
+	}
+	hm[k] = struct{}{}
+	return true
+}
+
+func (c *seenCache) evict(height int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for h := range c.mp {
+		if h < height {
+			delete(c.mp, h)
+		}
+	}
+}
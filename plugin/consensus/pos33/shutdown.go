@@ -0,0 +1,37 @@
+package pos33
+
+import (
+	"time"
+)
+
+const defaultShutdownTimeout = 3000
+
+// shutdown 关闭的顺序: 主循环处理完当前的消息后停下, 不再签名新的抽签和投票,
+// 等待已经签名的消息发送出去, 记录共识状态, 最后 Close 通知主循环退出
+func (n *node) shutdown() {
+	d := time.Duration(n.conf.ShutdownTimeout) * time.Millisecond
+	if d <= 0 {
+		d = defaultShutdownTimeout * time.Millisecond
+	}
+	tt := time.Now()
+	ack := make(chan struct{})
+	select {
+	case n.pause <- ack:
+		<-ack
+	case <-time.After(d):
+		// 主循环没有运行(没有开始挖矿)或者卡住了
+		plog.Info("pos33 run loop not paused")
+	}
+	if n.gss != nil && !n.gss.flush(d) {
+		plog.Error("pos33 shutdown flush timeout, some msgs may not be sent", "timeout", d)
+	}
+	n.wal.saveShutdown(n.cs.snapshot())
+	plog.Info("pos33 shutdown", "cost", time.Since(tt))
+}
+
+// waitDone 主循环停下, 等待 Close 通知退出
+func (n *node) waitDone(ack chan struct{}) {
+	ack <- struct{}{}
+	<-n.done
+	plog.Info("pos33 consensus run loop stopped")
+}
@@ -0,0 +1,232 @@
+package pos33
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	bls33 "github.com/33cn/plugin/plugin/crypto/bls"
+	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
+	pb "github.com/libp2p/go-libp2p-core/crypto/pb"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const remoteSignerTimeout = time.Second * 3
+
+var errSignerTimeout = errors.New("remote signer timeout")
+
+// remoteSigner 通过 unix socket, tls 或者 tcp 连接远程签名服务, 挖矿私钥不在节点上
+type remoteSigner struct {
+	service string
+	laddr   string
+	token   string
+	tls     *tls.Config
+
+	mu  sync.Mutex
+	cli *rpc.Client
+
+	pub    []byte
+	blsPub []byte
+}
+
+// newRemoteSigner caFile 是 tls:// 服务证书的 ca, 为空时用系统的根证书
+func newRemoteSigner(service, laddr, token, caFile string) (*remoteSigner, error) {
+	conf, err := pt.ClientTLS(caFile)
+	if err != nil {
+		return nil, err
+	}
+	s := &remoteSigner{service: service, laddr: laddr, token: token, tls: conf}
+	var reply pt.SignerReply
+	err = s.call("PubKey", "", nil, &reply)
+	if err != nil {
+		return nil, err
+	}
+	s.pub = reply.Pubkey
+	s.blsPub = reply.BlsPubkey
+	return s, nil
+}
+
+func (s *remoteSigner) client() (*rpc.Client, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cli != nil {
+		return s.cli, nil
+	}
+	var conn net.Conn
+	var err error
+	network, addr := pt.SignerNetAddr(s.laddr)
+	if network == "tls" {
+		conn, err = tls.DialWithDialer(&net.Dialer{Timeout: remoteSignerTimeout}, "tcp", addr, s.tls)
+	} else {
+		conn, err = net.DialTimeout(network, addr, remoteSignerTimeout)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.cli = rpc.NewClientWithCodec(jsonrpc.NewClientCodec(conn))
+	return s.cli, nil
+}
+
+func (s *remoteSigner) call(method, kind string, msg []byte, reply *pt.SignerReply) error {
+	cli, err := s.client()
+	if err != nil {
+		return err
+	}
+	args := &pt.SignerArgs{Token: s.token, Kind: kind, Msg: msg}
+	call := cli.Go(s.service+"."+method, args, reply, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+		err = call.Error
+	case <-time.After(remoteSignerTimeout):
+		err = errSignerTimeout
+	}
+	if err == rpc.ErrShutdown || err == errSignerTimeout {
+		// 下次重新连接
+		s.mu.Lock()
+		if s.cli == cli {
+			s.cli.Close()
+			s.cli = nil
+		}
+		s.mu.Unlock()
+	}
+	return err
+}
+
+func (s *remoteSigner) PubKey() []byte {
+	return s.pub
+}
+
+func (s *remoteSigner) BlsPubKey() []byte {
+	return s.blsPub
+}
+
+func (s *remoteSigner) Sign(kind string, msg []byte) ([]byte, error) {
+	var reply pt.SignerReply
+	err := s.call("Sign", kind, msg, &reply)
+	return reply.Sig, err
+}
+
+func (s *remoteSigner) BlsSign(kind string, msg []byte) ([]byte, error) {
+	var reply pt.SignerReply
+	err := s.call("BlsSign", kind, msg, &reply)
+	return reply.Sig, err
+}
+
+func (s *remoteSigner) Vrf(input []byte) ([]byte, []byte, error) {
+	var reply pt.SignerReply
+	err := s.call("Vrf", "", input, &reply)
+	return reply.Hash, reply.Proof, err
+}
+
+// loadRemoteSigner 连接远程签名服务, 这时节点可以不运行钱包
+func (c *Client) loadRemoteSigner() {
+	s, err := newRemoteSigner(pt.SignerServiceName, c.conf.RemoteSigner, c.conf.RemoteSignerToken, c.conf.RemoteSignerCA)
+	if err != nil {
+		plog.Error("connect remote signer error", "err", err, "addr", c.conf.RemoteSigner)
+		return
+	}
+	c.signers = []pt.Signer{s}
+	c.myAddr = address.PubKeyToAddr(ethID, s.PubKey())
+	plog.Info("use remote signer", "addr", c.myAddr, "signer", c.conf.RemoteSigner)
+}
+
+// signTx 和 types.Transaction.Sign 一样, 签名由 signer 完成, 出块签名地址的交易由 KMS 签名
+func signTx(s pt.Signer, tx *types.Transaction) error {
+	tx.Signature = nil
+	sig, err := s.Sign(pt.SignTx, types.Encode(tx))
+	if err != nil {
+		return err
+	}
+	pub := s.PubKey()
+	if ks, ok := s.(*kmsSigner); ok {
+		pub = ks.kms.PubKey()
+	}
+	tx.Signature = &types.Signature{
+		Ty:        types.EncodeSignID(types.SECP256K1, ethID),
+		Pubkey:    pub,
+		Signature: sig,
+	}
+	return nil
+}
+
+// signSortsVote 和 Pos33SortsVote.Sign 一样, 签名由 signer 完成
+func signSortsVote(s pt.Signer, v *pt.Pos33SortsVote) error {
+	v.Sig = nil
+	sig, err := s.Sign(pt.SignSorts, types.Encode(v))
+	if err != nil {
+		return err
+	}
+	v.Sig = &types.Signature{Ty: types.SECP256K1, Pubkey: s.PubKey(), Signature: sig}
+	return nil
+}
+
+// blsSign 投票和 checkpoint 的 bls 签名
+func blsSign(s pt.Signer, kind string, msg types.Message) (*types.Signature, error) {
+	sig, err := s.BlsSign(kind, types.Encode(msg))
+	if err != nil {
+		return nil, err
+	}
+	return &types.Signature{Ty: bls33.ID, Pubkey: s.BlsPubKey(), Signature: sig}, nil
+}
+
+// p2pKey gossip 的节点身份就是挖矿私钥, 别的节点按抽签的公钥直接给我发消息
+func p2pKey(s pt.Signer) (p2pcrypto.PrivKey, error) {
+	if s == nil {
+		return nil, errors.New("miner key not set")
+	}
+	if ls, ok := s.(*pt.LocalSigner); ok {
+		return p2pcrypto.UnmarshalSecp256k1PrivateKey(ls.PrivKey().Bytes())
+	}
+	pub, err := p2pcrypto.UnmarshalSecp256k1PublicKey(s.PubKey())
+	if err != nil {
+		return nil, err
+	}
+	return &signerP2PKey{s: s, pub: pub}, nil
+}
+
+// signerP2PKey libp2p 握手的签名也交给 signer.
+// chain33 和 libp2p 的 secp256k1 签名都是对 sha256(msg) 做 DER 编码的 ecdsa 签名, 可以通用
+type signerP2PKey struct {
+	s   pt.Signer
+	pub p2pcrypto.PubKey
+}
+
+func (k *signerP2PKey) Equals(o p2pcrypto.Key) bool {
+	ok, isPriv := o.(p2pcrypto.PrivKey)
+	return isPriv && k.pub.Equals(ok.GetPublic())
+}
+
+func (k *signerP2PKey) Raw() ([]byte, error) {
+	return nil, errors.New("remote signer key can not export")
+}
+
+func (k *signerP2PKey) Type() pb.KeyType {
+	return pb.KeyType_Secp256k1
+}
+
+func (k *signerP2PKey) Sign(msg []byte) ([]byte, error) {
+	return k.s.Sign(pt.SignP2P, msg)
+}
+
+func (k *signerP2PKey) GetPublic() p2pcrypto.PubKey {
+	return k.pub
+}
+
+// loadPoolSigner 作为矿池的 worker, 挖矿私钥在矿池, 矿池选出的主 worker 才投票和出块
+func (c *Client) loadPoolSigner() {
+	s, err := newRemoteSigner(pt.PoolServiceName, c.conf.Pool, c.conf.PoolToken, c.conf.PoolCA)
+	if err != nil {
+		plog.Error("subscribe pool error", "err", err, "addr", c.conf.Pool)
+		return
+	}
+	c.signers = []pt.Signer{s}
+	c.myAddr = address.PubKeyToAddr(ethID, s.PubKey())
+	plog.Info("use pool", "addr", c.myAddr, "pool", c.conf.Pool)
+	go c.n.sb.runPool(s)
+}
@@ -0,0 +1,92 @@
+package sim
+
+import (
+	"math/rand"
+)
+
+// Msg 节点之间的消息
+type Msg struct {
+	Seq    int64  `json:"seq"`
+	Time   int64  `json:"time"` // 发送的时间
+	From   int    `json:"from"`
+	To     int    `json:"to"`
+	Ty     string `json:"ty"`
+	Height int64  `json:"height"`
+	Round  int    `json:"round"`
+	// 制作人抽签的 hash, 投票的制作人抽签 hash, 或者区块 hash
+	Hash []byte `json:"hash"`
+	// 投票的票数
+	Count int `json:"count,omitempty"`
+	// 区块的打包时间
+	BlockTime int64 `json:"blockTime,omitempty"`
+}
+
+// Network 模拟网络, 决定每个消息什么时候到达
+type Network interface {
+	// Deliver 返回消息到达的时间, 小于 0 表示丢掉
+	Deliver(m *Msg) int64
+}
+
+type randomNet struct {
+	rng      *rand.Rand
+	latency  int64
+	jitter   int64
+	dropRate float64
+}
+
+// NewRandomNet 延迟是 latency 加上 [0, jitter) 的随机抖动, 按 dropRate 随机丢包, seed 相同时结果相同
+func NewRandomNet(seed, latency, jitter int64, dropRate float64) Network {
+	return &randomNet{rng: rand.New(rand.NewSource(seed)), latency: latency, jitter: jitter, dropRate: dropRate}
+}
+
+func (r *randomNet) Deliver(m *Msg) int64 {
+	if r.dropRate > 0 && r.rng.Float64() < r.dropRate {
+		return -1
+	}
+	d := r.latency
+	if r.jitter > 0 {
+		d += r.rng.Int63n(r.jitter)
+	}
+	return m.Time + d
+}
+
+type traceKey struct {
+	from, to int
+	ty       string
+	height   int64
+	round    int
+}
+
+type replayNet struct {
+	delays   map[traceKey]int64
+	fallback Network
+}
+
+// NewReplayNet 按轨迹里记录的延迟和丢包发送消息. 参数修改以后可能有轨迹里没有的消息,
+// 这些消息由 fallback 决定, fallback 为 nil 时丢掉
+func NewReplayNet(t *Trace, fallback Network) Network {
+	r := &replayNet{delays: make(map[traceKey]int64), fallback: fallback}
+	for _, e := range t.Entries {
+		k := traceKey{e.From, e.To, e.Ty, e.Height, e.Round}
+		if e.At < 0 {
+			r.delays[k] = -1
+			continue
+		}
+		r.delays[k] = e.At - e.Time
+	}
+	return r
+}
+
+func (r *replayNet) Deliver(m *Msg) int64 {
+	d, ok := r.delays[traceKey{m.From, m.To, m.Ty, m.Height, m.Round}]
+	if !ok {
+		if r.fallback == nil {
+			return -1
+		}
+		return r.fallback.Deliver(m)
+	}
+	if d < 0 {
+		return -1
+	}
+	return m.Time + d
+}
@@ -0,0 +1,179 @@
+package sim
+
+import (
+	"bytes"
+)
+
+// 抽签的步骤, 和 pos33 的 Maker, Voter 相同
+const (
+	stepMaker = iota
+	stepVoter
+)
+
+type candidate struct {
+	hash  []byte
+	maker int
+}
+
+// node 模拟的节点, 只保留共识的流程: 抽签, 投票给最好的制作人, 票数够了出块, 超时后重新抽签
+type node struct {
+	s      *Sim
+	id     int
+	count  int64
+	online bool
+
+	height int64  // 正在出块的高度
+	round  int    // 正在出块的轮次
+	prev   []byte // 上一个区块的 hash, 也是抽签的种子
+
+	best  map[int]*candidate // 轮次 => 收到的最好的制作人抽签
+	mine  map[int][]byte     // 轮次 => 我的制作人抽签
+	votes map[int]int        // 轮次 => 我收到的投票
+	made  map[int]bool
+
+	rt         *timeout
+	blockTimes []int64
+}
+
+func newNode(s *Sim, id int, count int64, online bool) *node {
+	return &node{
+		s:      s,
+		id:     id,
+		count:  count,
+		online: online,
+		height: 1,
+		prev:   make([]byte, 32),
+		rt:     newTimeout(s.cfg.TimeoutFloor, s.cfg.TimeoutCeiling),
+	}
+}
+
+func (n *node) start() {
+	n.resetHeight()
+	n.s.after(n.s.cfg.BlockDelay, func() { n.startRound(1, 0) })
+}
+
+func (n *node) resetHeight() {
+	n.round = 0
+	n.best = make(map[int]*candidate)
+	n.mine = make(map[int][]byte)
+	n.votes = make(map[int]int)
+	n.made = make(map[int]bool)
+}
+
+// sort 返回抽中的票数和最好的 hash
+func (n *node) sort(round, step int) (int, []byte) {
+	diff := n.s.diff(round, step == stepMaker)
+	var best []byte
+	num := 0
+	for i := int64(0); i < n.count; i++ {
+		h := hashOf(n.prev, i64(n.height), i64(int64(round)), i64(int64(step)), i64(int64(n.id)), i64(i))
+		if !selected(h, diff) {
+			continue
+		}
+		num++
+		if best == nil || bytes.Compare(h, best) < 0 {
+			best = h
+		}
+	}
+	return num, best
+}
+
+// startRound 抽签, 发送制作人抽签, 过 VoteDelay 后投票, 超时后进入下一轮
+func (n *node) startRound(height int64, round int) {
+	if n.height != height || n.round > round {
+		return
+	}
+	n.round = round
+	if _, h := n.sort(round, stepMaker); h != nil {
+		n.mine[round] = h
+		n.see(round, &candidate{hash: h, maker: n.id})
+		n.s.broadcast(Msg{From: n.id, Ty: MsgMaker, Height: height, Round: round, Hash: h})
+	}
+	if num, _ := n.sort(round, stepVoter); num > 0 {
+		n.s.after(n.s.cfg.VoteDelay, func() { n.vote(height, round, num) })
+	}
+	d := n.rt.block()
+	if round > 0 {
+		d = n.rt.resort()
+	}
+	n.s.after(d, func() {
+		if n.height == height && n.round == round {
+			n.startRound(height, round+1)
+		}
+	})
+}
+
+func (n *node) see(round int, c *candidate) {
+	b, ok := n.best[round]
+	if !ok || bytes.Compare(c.hash, b.hash) < 0 {
+		n.best[round] = c
+	}
+}
+
+func (n *node) vote(height int64, round, num int) {
+	if n.height != height {
+		return
+	}
+	c, ok := n.best[round]
+	if !ok {
+		return
+	}
+	m := Msg{From: n.id, To: c.maker, Ty: MsgVote, Height: height, Round: round, Hash: c.hash, Count: num}
+	if c.maker == n.id {
+		n.receive(&m)
+		return
+	}
+	n.s.send(&m)
+}
+
+func (n *node) receive(m *Msg) {
+	if !n.online {
+		return
+	}
+	switch m.Ty {
+	case MsgMaker:
+		if m.Height == n.height {
+			n.see(m.Round, &candidate{hash: m.Hash, maker: m.From})
+		}
+	case MsgVote:
+		if m.Height != n.height || !bytes.Equal(n.mine[m.Round], m.Hash) {
+			return
+		}
+		n.votes[m.Round] += m.Count
+		if n.votes[m.Round] >= n.s.cfg.MustVotes && !n.made[m.Round] {
+			n.made[m.Round] = true
+			hash := hashOf(n.prev, i64(m.Height), i64(int64(m.Round)), i64(int64(n.id)))
+			b := Msg{From: n.id, Ty: MsgBlock, Height: m.Height, Round: m.Round, Hash: hash, BlockTime: n.s.now}
+			n.s.accept(m.Height, hash, m.Round)
+			n.s.broadcast(b)
+			n.addBlock(&b)
+		}
+	case MsgBlock:
+		// 落后的节点直接同步到这个区块
+		if m.Height < n.height {
+			return
+		}
+		n.s.accept(m.Height, m.Hash, m.Round)
+		n.addBlock(m)
+	}
+}
+
+// addBlock 和 pos33 的主循环一样, 从制作人打包的时间开始等 BlockDelay 开始下一个高度
+func (n *node) addBlock(m *Msg) {
+	blockTime := m.BlockTime
+	n.rt.observe(n.s.now, blockTime)
+	n.blockTimes = append(n.blockTimes, blockTime)
+	n.height = m.Height + 1
+	n.prev = m.Hash
+	n.resetHeight()
+
+	d := blockTime + n.s.cfg.BlockDelay - n.s.now
+	if d < 0 {
+		d = 0
+	}
+	if d > n.s.cfg.BlockDelay {
+		d = n.s.cfg.BlockDelay
+	}
+	height := n.height
+	n.s.after(d, func() { n.startRound(height, 0) })
+}
@@ -0,0 +1,303 @@
+// Package sim pos33 共识的确定性模拟: 在内存里运行 N 个节点, 网络和时钟都是模拟的,
+// 同样的配置和随机数种子得到同样的结果, 也可以重放记录下来的消息轨迹.
+// 用来在 CI 里验证超时, 委员会大小这些参数的修改, 不需要在测试网上试
+package sim
+
+import (
+	"container/heap"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math"
+	"math/big"
+
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 消息类型
+const (
+	MsgMaker = "maker"
+	MsgVote  = "vote"
+	MsgBlock = "block"
+)
+
+// Config 模拟的参数, 时间单位都是毫秒
+type Config struct {
+	// 随机数种子, 只影响模拟网络
+	Seed int64 `json:"seed"`
+	// 每个节点的票数
+	Tickets []int64 `json:"tickets"`
+	// 不在线的节点序号
+	Offline []int `json:"offline,omitempty"`
+	// 出多少个区块
+	Blocks int64 `json:"blocks"`
+	// 委员会大小, 0 使用 pos33 的默认值
+	MakerSize int `json:"makerSize,omitempty"`
+	VoterSize int `json:"voterSize,omitempty"`
+	MustVotes int `json:"mustVotes,omitempty"`
+	// 收到区块后等多久开始下一个高度(默认 900), 投票人抽签后等多久投票(默认 200)
+	BlockDelay int64 `json:"blockDelay,omitempty"`
+	VoteDelay  int64 `json:"voteDelay,omitempty"`
+	// 轮次超时的下限和上限, 和 consensus.sub.pos33 的 roundTimeoutFloor/roundTimeoutCeiling 相同
+	TimeoutFloor   int64 `json:"timeoutFloor,omitempty"`
+	TimeoutCeiling int64 `json:"timeoutCeiling,omitempty"`
+	// 模拟网络的延迟, 抖动和丢包率
+	Latency  int64   `json:"latency,omitempty"`
+	Jitter   int64   `json:"jitter,omitempty"`
+	DropRate float64 `json:"dropRate,omitempty"`
+	// 最长模拟时间, 0 表示每个区块最多 60 秒
+	MaxTime int64 `json:"maxTime,omitempty"`
+}
+
+func (c *Config) setDefaults() {
+	if c.MakerSize <= 0 {
+		c.MakerSize = pt.Pos33MakerSize
+	}
+	if c.VoterSize <= 0 {
+		c.VoterSize = pt.Pos33VoterSize
+	}
+	if c.MustVotes <= 0 {
+		c.MustVotes = pt.Pos33MustVotes
+	}
+	if c.BlockDelay <= 0 {
+		c.BlockDelay = 900
+	}
+	if c.VoteDelay <= 0 {
+		c.VoteDelay = 200
+	}
+	if c.TimeoutFloor <= 0 {
+		c.TimeoutFloor = 2000
+	}
+	if c.TimeoutCeiling <= 0 {
+		c.TimeoutCeiling = 30000
+	}
+	if c.TimeoutCeiling < c.TimeoutFloor {
+		c.TimeoutCeiling = c.TimeoutFloor
+	}
+	if c.MaxTime <= 0 {
+		c.MaxTime = c.Blocks * 60000
+	}
+}
+
+// Result 模拟的结果, 配置和网络相同时结果完全相同
+type Result struct {
+	// 所有在线节点都到达的高度
+	Height int64 `json:"height"`
+	// 模拟结束的时间
+	Time int64 `json:"time"`
+	// 平均出块间隔
+	AvgInterval float64 `json:"avgInterval"`
+	// 区块在第几轮出的: 轮次 => 区块数
+	Rounds   map[int]int `json:"rounds"`
+	MaxRound int         `json:"maxRound"`
+	// 不同节点在同一个高度接受了不同区块的次数
+	Forks   int `json:"forks"`
+	Msgs    int `json:"msgs"`
+	Dropped int `json:"dropped"`
+	// 第一个在线节点最后一个区块的 hash
+	Hash string `json:"hash"`
+}
+
+// ErrNoNodes 没有在线的节点
+var ErrNoNodes = errors.New("sim: no online nodes")
+
+type event struct {
+	at  int64
+	seq int64
+	fn  func()
+}
+
+type eventQueue []*event
+
+func (q eventQueue) Len() int { return len(q) }
+func (q eventQueue) Less(i, j int) bool {
+	if q[i].at != q[j].at {
+		return q[i].at < q[j].at
+	}
+	return q[i].seq < q[j].seq
+}
+func (q eventQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *eventQueue) Push(x interface{}) { *q = append(*q, x.(*event)) }
+func (q *eventQueue) Pop() interface{} {
+	old := *q
+	e := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return e
+}
+
+// Sim 一次模拟, 所有事件按 (时间, 序号) 顺序在一个 goroutine 里执行
+type Sim struct {
+	cfg   Config
+	net   Network
+	trace *Trace
+	nodes []*node
+	total int64
+
+	now    int64
+	seq    int64
+	msgSeq int64
+	events eventQueue
+
+	// 每个高度被接受的区块, 用来统计分叉
+	accepted map[int64][]string
+	result   Result
+}
+
+// New 创建模拟, net 为 nil 时使用 cfg 的延迟和丢包率的随机网络
+func New(cfg Config, net Network) *Sim {
+	cfg.setDefaults()
+	if net == nil {
+		net = NewRandomNet(cfg.Seed, cfg.Latency, cfg.Jitter, cfg.DropRate)
+	}
+	s := &Sim{cfg: cfg, net: net, accepted: make(map[int64][]string)}
+	s.result.Rounds = make(map[int]int)
+	offline := make(map[int]bool)
+	for _, i := range cfg.Offline {
+		offline[i] = true
+	}
+	for i, t := range cfg.Tickets {
+		s.total += t
+		s.nodes = append(s.nodes, newNode(s, i, t, !offline[i]))
+	}
+	return s
+}
+
+// Record 记录所有消息的发送和到达时间, 可以用 NewReplayNet 重放
+func (s *Sim) Record(t *Trace) {
+	s.trace = t
+}
+
+func (s *Sim) after(d int64, fn func()) {
+	s.seq++
+	heap.Push(&s.events, &event{at: s.now + d, seq: s.seq, fn: fn})
+}
+
+// send 经过模拟网络发送, 丢掉的消息不会到达
+func (s *Sim) send(m *Msg) {
+	s.msgSeq++
+	m.Seq = s.msgSeq
+	m.Time = s.now
+	at := s.net.Deliver(m)
+	s.result.Msgs++
+	if s.trace != nil {
+		s.trace.add(m, at)
+	}
+	if at < 0 {
+		s.result.Dropped++
+		return
+	}
+	if at < s.now {
+		at = s.now
+	}
+	to := s.nodes[m.To]
+	s.after(at-s.now, func() { to.receive(m) })
+}
+
+func (s *Sim) broadcast(m Msg) {
+	for _, n := range s.nodes {
+		if n.id == m.From {
+			continue
+		}
+		c := m
+		c.To = n.id
+		s.send(&c)
+	}
+}
+
+func (s *Sim) done() bool {
+	for _, n := range s.nodes {
+		if n.online && n.height <= s.cfg.Blocks {
+			return false
+		}
+	}
+	return true
+}
+
+// Run 运行到所有在线节点都有 cfg.Blocks 个区块, 或者超过 MaxTime
+func (s *Sim) Run() (*Result, error) {
+	var first *node
+	for _, n := range s.nodes {
+		if n.online {
+			if first == nil {
+				first = n
+			}
+			n.start()
+		}
+	}
+	if first == nil {
+		return nil, ErrNoNodes
+	}
+	for s.events.Len() > 0 && !s.done() {
+		e := heap.Pop(&s.events).(*event)
+		if e.at > s.cfg.MaxTime {
+			break
+		}
+		s.now = e.at
+		e.fn()
+	}
+
+	r := s.result
+	r.Time = s.now
+	r.Height = math.MaxInt64
+	for _, n := range s.nodes {
+		if n.online && n.height-1 < r.Height {
+			r.Height = n.height - 1
+		}
+	}
+	if first.height > 1 {
+		r.AvgInterval = float64(first.blockTimes[len(first.blockTimes)-1]) / float64(len(first.blockTimes))
+	}
+	r.Hash = hex.EncodeToString(first.prev)
+	return &r, nil
+}
+
+// accept 记录每个高度接受的区块, 同一个高度每多一个不同的区块算一次分叉
+func (s *Sim) accept(height int64, hash []byte, round int) {
+	hs := s.accepted[height]
+	for _, h := range hs {
+		if h == string(hash) {
+			return
+		}
+	}
+	s.accepted[height] = append(hs, string(hash))
+	if len(hs) > 0 {
+		s.result.Forks++
+		return
+	}
+	s.result.Rounds[round]++
+	if round > s.result.MaxRound {
+		s.result.MaxRound = round
+	}
+}
+
+func hashOf(data ...[]byte) []byte {
+	h := sha256.New()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+func i64(v int64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(v))
+	return b[:]
+}
+
+var fmax = new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(2), big.NewInt(256), nil))
+
+// selected 和 pos33 的 sortF 一样, hash/2^256 小于难度时抽中
+func selected(hash []byte, diff float64) bool {
+	z := new(big.Float).SetInt(new(big.Int).SetBytes(hash))
+	return new(big.Float).Quo(z, fmax).Cmp(big.NewFloat(diff)) <= 0
+}
+
+// diff 和 pos33 的 getDiff 一样, 每多一轮难度增加 10%
+func (s *Sim) diff(round int, isMaker bool) float64 {
+	size := s.cfg.MakerSize
+	if !isMaker {
+		size = s.cfg.VoterSize
+	}
+	return float64(size) / float64(s.total) * math.Pow(1.1, float64(round))
+}
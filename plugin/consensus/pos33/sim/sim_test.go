@@ -0,0 +1,72 @@
+package sim
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func testConfig() Config {
+	var tickets []int64
+	for i := 0; i < 20; i++ {
+		tickets = append(tickets, int64(50+i*10))
+	}
+	return Config{Seed: 1, Tickets: tickets, Blocks: 30, Latency: 50, Jitter: 100, DropRate: 0.01}
+}
+
+func TestSimDeterministic(t *testing.T) {
+	r1, err := New(testConfig(), nil).Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r2, err := New(testConfig(), nil).Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(r1, r2) {
+		t.Fatalf("results differ: %+v != %+v", r1, r2)
+	}
+	if r1.Height < 30 {
+		t.Fatalf("height %d < 30: %+v", r1.Height, r1)
+	}
+}
+
+func TestSimReplay(t *testing.T) {
+	tr := new(Trace)
+	s := New(testConfig(), nil)
+	s.Record(tr)
+	r1, err := s.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = tr.Write(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr2, err := ReadTrace(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := testConfig()
+	r2, err := New(cfg, NewReplayNet(tr2, nil)).Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(r1, r2) {
+		t.Fatalf("replay differs: %+v != %+v", r1, r2)
+	}
+}
+
+func TestSimOffline(t *testing.T) {
+	cfg := testConfig()
+	cfg.Offline = []int{0, 1, 2, 3}
+	r, err := New(cfg, nil).Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Height < cfg.Blocks {
+		t.Fatalf("height %d < %d: %+v", r.Height, cfg.Blocks, r)
+	}
+}
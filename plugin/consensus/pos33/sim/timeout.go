@@ -0,0 +1,51 @@
+package sim
+
+const timeoutEmaAlpha = 0.1
+
+// timeout 和 pos33 的 roundTimeout 相同的算法, 时间由模拟时钟提供
+type timeout struct {
+	interval float64
+	delay    float64
+	last     int64
+	floor    int64
+	ceiling  int64
+}
+
+func newTimeout(floor, ceiling int64) *timeout {
+	return &timeout{interval: 1000, last: -1, floor: floor, ceiling: ceiling}
+}
+
+func ema(old, v float64) float64 {
+	return old*(1-timeoutEmaAlpha) + v*timeoutEmaAlpha
+}
+
+func (t *timeout) observe(now, blockTime int64) {
+	if t.last >= 0 {
+		t.interval = ema(t.interval, float64(now-t.last))
+	}
+	t.last = now
+	d := now - blockTime
+	if d < 0 {
+		d = 0
+	}
+	t.delay = ema(t.delay, float64(d))
+}
+
+func (t *timeout) clamp(ms float64) int64 {
+	d := int64(ms)
+	if d < t.floor {
+		return t.floor
+	}
+	if d > t.ceiling {
+		return t.ceiling
+	}
+	return d
+}
+
+func (t *timeout) block() int64 {
+	return t.clamp(t.interval*5 + t.delay*2)
+}
+
+func (t *timeout) resort() int64 {
+	return t.clamp(t.interval*3 + t.delay*2)
+}
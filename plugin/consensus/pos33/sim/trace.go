@@ -0,0 +1,53 @@
+package sim
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+)
+
+// TraceEntry 一个消息和它到达的时间, At 小于 0 表示被丢掉
+type TraceEntry struct {
+	Msg
+	At int64 `json:"at"`
+}
+
+// Trace 模拟中所有消息的轨迹, 保存为每行一个 json
+type Trace struct {
+	Entries []*TraceEntry
+}
+
+func (t *Trace) add(m *Msg, at int64) {
+	t.Entries = append(t.Entries, &TraceEntry{Msg: *m, At: at})
+}
+
+// Write 写到 w, 每行一个消息
+func (t *Trace) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	for _, e := range t.Entries {
+		err := enc.Encode(e)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadTrace 读取 Write 写的轨迹
+func ReadTrace(r io.Reader) (*Trace, error) {
+	t := new(Trace)
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+	for sc.Scan() {
+		if len(sc.Bytes()) == 0 {
+			continue
+		}
+		e := new(TraceEntry)
+		err := json.Unmarshal(sc.Bytes(), e)
+		if err != nil {
+			return nil, err
+		}
+		t.Entries = append(t.Entries, e)
+	}
+	return t, sc.Err()
+}
@@ -0,0 +1,142 @@
+package pos33
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// Sorter 抽签规则，可以按名字注册，通过 consensus.sub.pos33 的 sorter 选择
+type Sorter interface {
+	// Sort 用 signer 的票在 (height, round, step) 抽签, num 是投票人的抽签组或者制作人的重试次数
+	Sort(signer pt.Signer, seed []byte, height int64, round, step, num int) []*pt.Pos33SortMsg
+	// Verify 验证别人的抽签
+	Verify(seed []byte, height int64, step int, m *pt.Pos33SortMsg) error
+}
+
+// SorterCreator create a Sorter for the node
+type SorterCreator func(n *node) Sorter
+
+const defaultSorter = "vrf"
+
+var sorters = make(map[string]SorterCreator)
+
+// RegisterSorter register a sorter by name
+func RegisterSorter(name string, create SorterCreator) {
+	if create == nil {
+		panic("pos33: register sorter is nil")
+	}
+	if _, ok := sorters[name]; ok {
+		panic("pos33: register duplicate sorter " + name)
+	}
+	sorters[name] = create
+}
+
+func newSorter(name string, n *node) Sorter {
+	if name == "" {
+		name = defaultSorter
+	}
+	create, ok := sorters[name]
+	if !ok {
+		panic("pos33: sorter NOT registered: " + name)
+	}
+	plog.Info("pos33 sorter", "name", name)
+	return create(n)
+}
+
+func init() {
+	RegisterSorter(defaultSorter, func(n *node) Sorter { return &vrfSorter{n: n} })
+}
+
+// vrfSorter 默认的抽签规则: 每张票用 vrf hash 和难度比较
+type vrfSorter struct {
+	n *node
+}
+
+func (s *vrfSorter) Sort(signer pt.Signer, seed []byte, height int64, round, step, num int) []*pt.Pos33SortMsg {
+	n := s.n
+	if signer == nil {
+		return nil
+	}
+	count := n.queryTicketCount(address.PubKeyToAddr(ethID, signer.PubKey()), height-10)
+
+	diff, err := n.getDiff(height, round, step == Maker)
+	if err != nil {
+		// 难度系数查不到, 这一轮不抽签
+		plog.Error("sort diff error", "height", height, "round", round, "err", err)
+		return nil
+	}
+	input := &pt.VrfInput{Seed: seed, Height: height, Round: int32(round), Ty: int32(step)}
+	vrfHash, vrfProof, err := signer.Vrf(types.Encode(input))
+	if err != nil {
+		plog.Error("vrf error", "height", height, "round", round, "err", err)
+		return nil
+	}
+	proof := &pt.HashProof{
+		Input:    input,
+		VrfHash:  vrfHash,
+		VrfProof: vrfProof,
+		Pubkey:   signer.PubKey(),
+	}
+
+	msgs := n.doSort(vrfHash, int(count), num, diff, proof)
+	plog.Debug("vrf sort", "height", height, "round", round, "step", step, "num", num, "mycount", count, "n", len(msgs), "diff", diff*1000000, "addr", address.PubKeyToAddr(ethID, proof.Pubkey)[:16])
+	return msgs
+}
+
+func (s *vrfSorter) Verify(seed []byte, height int64, ty int, m *pt.Pos33SortMsg) error {
+	n := s.n
+	if height <= pt.Pos33SortBlocks {
+		return nil
+	}
+	if m == nil || m.Proof == nil || m.SortHash == nil || m.Proof.Input == nil {
+		return pt.ErrCatSortMsg.New()
+	}
+
+	addr := address.PubKeyToAddr(ethID, m.Proof.Pubkey)
+	count := n.queryTicketCount(addr, height-pt.Pos33SortBlocks)
+	if count <= m.SortHash.Index {
+		return pt.ErrCatSortIndex.New(m.SortHash.Index, count, height)
+	}
+
+	if m.Proof.Input.Height != height {
+		return pt.ErrCatSortHeight.New(m.Proof.Input.Height, height)
+	}
+	if string(m.Proof.Input.Seed) != string(seed) {
+		return pt.ErrCatSortSeed.New()
+	}
+	if m.Proof.Input.Ty != int32(ty) {
+		return pt.ErrCatSortStep.New()
+	}
+
+	round := m.Proof.Input.Round
+	input := &pt.VrfInput{Seed: seed, Height: height, Round: round, Ty: int32(ty)}
+	in := types.Encode(input)
+	err := vrfVerify(m.Proof.Pubkey, in, m.Proof.VrfProof, m.Proof.VrfHash)
+	if err != nil {
+		plog.Debug("vrfVerify error", "err", err, "height", height, "round", round, "ty", ty, "who", addr[:16])
+		return err
+	}
+	data := fmt.Sprintf("%x+%d+%d", m.Proof.VrfHash, m.SortHash.Index, m.SortHash.Num)
+	hash := hash2([]byte(data))
+	if string(hash) != string(m.SortHash.Hash) {
+		return pt.ErrCatSortHash.New()
+	}
+
+	diff, err := n.getDiff(height, int(round), ty == 0)
+	if err != nil {
+		return err
+	}
+
+	y := new(big.Int).SetBytes(hash)
+	z := new(big.Float).SetInt(y)
+	if new(big.Float).Quo(z, fmax).Cmp(big.NewFloat(diff)) > 0 {
+		plog.Error("verifySort diff error", "height", height, "ty", ty, "round", round, "diff", diff*1000000, "addr", address.PubKeyToAddr(ethID, m.Proof.Pubkey))
+		return pt.ErrCatSortDiff.New()
+	}
+
+	return nil
+}
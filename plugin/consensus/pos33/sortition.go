@@ -3,16 +3,13 @@ package pos33
 import (
 	"bytes"
 	"crypto/ecdsa"
-	"errors"
 	"fmt"
 	"math/big"
 
-	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
 	vrf "github.com/33cn/chain33/common/vrf/secp256k1"
 	"github.com/33cn/chain33/types"
 	secp256k1 "github.com/btcsuite/btcd/btcec"
-	"github.com/golang/protobuf/proto"
 	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
 )
 
@@ -22,20 +19,14 @@ var fmax = big.NewFloat(0).SetInt(max) // 2^^256
 const (
 	Maker = iota
 	Voter
+	// Seed 制作人计算下一个种子
+	Seed
 )
 
 // 算法依据：
 // 1. 通过签名，然后hash，得出的Hash值是在[0，max]的范围内均匀分布并且随机的, 那么Hash/max实在[1/max, 1]之间均匀分布的
 // 2. 那么从N个选票中抽出M个选票，等价于计算N次Hash, 并且Hash/max < M/N
 
-func calcuVrfHash(input proto.Message, priv crypto.PrivKey) ([]byte, []byte) {
-	privKey, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), priv.Bytes())
-	vrfPriv := &vrf.PrivateKey{PrivateKey: (*ecdsa.PrivateKey)(privKey)}
-	in := types.Encode(input)
-	vrfHash, vrfProof := vrfPriv.Evaluate(in)
-	return vrfHash[:], vrfProof
-}
-
 func sortF(vrfHash []byte, index, num int, diff float64, proof *pt.HashProof) *pt.Pos33SortMsg {
 	data := fmt.Sprintf("%x+%d+%d", vrfHash, index, num)
 	hash := hash2([]byte(data))
@@ -94,46 +85,20 @@ func (n *node) doSort(vrfHash []byte, count, num int, diff float64, proof *pt.Ha
 	return msgs
 }
 
-func (n *node) voterSort(seed []byte, height int64, round, ty, num int) []*pt.Pos33SortMsg {
-	count := n.queryTicketCount(n.myAddr, height-10)
-	priv := n.getPriv()
-	if priv == nil {
-		return nil
-	}
-
-	diff := n.getDiff(height, round, false)
-
-	input := &pt.VrfInput{Seed: seed, Height: height, Round: int32(round), Ty: int32(ty)}
-	vrfHash, vrfProof := calcuVrfHash(input, priv)
-	proof := &pt.HashProof{
-		Input:    input,
-		VrfHash:  vrfHash,
-		VrfProof: vrfProof,
-		Pubkey:   priv.PubKey().Bytes(),
-	}
-
-	msgs := n.doSort(vrfHash, int(count), num, diff, proof)
-	plog.Debug("voter sort", "height", height, "round", round, "num", num, "mycount", count, "n", len(msgs), "diff", diff*1000000, "addr", address.PubKeyToAddr(ethID, proof.Pubkey)[:16])
-	return msgs
+func (n *node) voterSort(s pt.Signer, seed []byte, height int64, round, ty, num int) []*pt.Pos33SortMsg {
+	return n.sorter.Sort(s, seed, height, round, ty, num)
 }
 
+// makerSort 所有私钥里 hash 最小的抽签
+// makerSort 用 SortHash.Num = 0 抽签, 所有私钥都没有抽中时用下一个 Num 重试, 最多 sortRetries 次.
+// 排名先比 Num 再比 hash, 重试抽中的排在没有重试的后面
 func (n *node) makerSort(seed []byte, height int64, round int) *pt.Pos33SortMsg {
-	count := n.queryTicketCount(n.myAddr, height-10)
-	priv := n.getPriv()
-	if priv == nil {
-		return nil
-	}
-
-	diff := n.getDiff(height, round, true)
-	input := &pt.VrfInput{Seed: seed, Height: height, Round: int32(round), Ty: int32(0)}
-	vrfHash, vrfProof := calcuVrfHash(input, priv)
-	proof := &pt.HashProof{
-		Input:    input,
-		VrfHash:  vrfHash,
-		VrfProof: vrfProof,
-		Pubkey:   priv.PubKey().Bytes(),
+	var msgs []*pt.Pos33SortMsg
+	for num := 0; num < n.sortRetries(height) && len(msgs) == 0; num++ {
+		for _, s := range n.getSigners() {
+			msgs = append(msgs, n.sorter.Sort(s, seed, height, round, Maker, num)...)
+		}
 	}
-	msgs := n.doSort(vrfHash, int(count), 0, diff, proof)
 	var minSort *pt.Pos33SortMsg
 	for _, m := range msgs {
 		if minSort == nil {
@@ -144,8 +109,6 @@ func (n *node) makerSort(seed []byte, height int64, round int) *pt.Pos33SortMsg
 			minSort = m
 		}
 	}
-
-	plog.Info("maker sort", "height", height, "round", round, "mycount", count, "diff", diff*1000000, "addr", address.PubKeyToAddr(ethID, proof.Pubkey)[:16], "sortHash", minSort != nil)
 	return minSort
 }
 
@@ -168,8 +131,6 @@ func vrfVerify(pub []byte, input []byte, proof []byte, hash []byte) error {
 	return nil
 }
 
-var errDiff = errors.New("diff error")
-
 func (n *node) queryDeposit(addr string) (*pt.Pos33DepositMsg, error) {
 	resp, err := n.GetAPI().Query(pt.Pos33TicketX, "Pos33Deposit", &types.ReqAddr{Addr: addr})
 	if err != nil {
@@ -180,53 +141,7 @@ func (n *node) queryDeposit(addr string) (*pt.Pos33DepositMsg, error) {
 }
 
 func (n *node) verifySort(height int64, ty int, seed []byte, m *pt.Pos33SortMsg) error {
-	if height <= pt.Pos33SortBlocks {
-		return nil
-	}
-	if m == nil || m.Proof == nil || m.SortHash == nil || m.Proof.Input == nil {
-		return fmt.Errorf("verifySort error: sort msg is nil")
-	}
-
-	addr := address.PubKeyToAddr(ethID, m.Proof.Pubkey)
-	count := n.queryTicketCount(addr, height-pt.Pos33SortBlocks)
-	if count <= m.SortHash.Index {
-		return fmt.Errorf("sort index %d > %d your count, height %d", m.SortHash.Index, count, height)
-	}
-
-	if m.Proof.Input.Height != height {
-		return fmt.Errorf("verifySort error, height NOT match: %d!=%d", m.Proof.Input.Height, height)
-	}
-	if string(m.Proof.Input.Seed) != string(seed) {
-		return fmt.Errorf("verifySort error, seed NOT match")
-	}
-	if m.Proof.Input.Ty != int32(ty) {
-		return fmt.Errorf("verifySort error, step NOT match")
-	}
-
-	round := m.Proof.Input.Round
-	input := &pt.VrfInput{Seed: seed, Height: height, Round: round, Ty: int32(ty)}
-	in := types.Encode(input)
-	err := vrfVerify(m.Proof.Pubkey, in, m.Proof.VrfProof, m.Proof.VrfHash)
-	if err != nil {
-		plog.Debug("vrfVerify error", "err", err, "height", height, "round", round, "ty", ty, "who", addr[:16])
-		return err
-	}
-	data := fmt.Sprintf("%x+%d+%d", m.Proof.VrfHash, m.SortHash.Index, m.SortHash.Num)
-	hash := hash2([]byte(data))
-	if string(hash) != string(m.SortHash.Hash) {
-		return fmt.Errorf("sort hash error")
-	}
-
-	diff := n.getDiff(height, int(round), ty == 0)
-
-	y := new(big.Int).SetBytes(hash)
-	z := new(big.Float).SetInt(y)
-	if new(big.Float).Quo(z, fmax).Cmp(big.NewFloat(diff)) > 0 {
-		plog.Error("verifySort diff error", "height", height, "ty", ty, "round", round, "diff", diff*1000000, "addr", address.PubKeyToAddr(ethID, m.Proof.Pubkey))
-		return errDiff
-	}
-
-	return nil
+	return n.sorter.Verify(seed, height, ty, m)
 }
 
 func hash2(data []byte) []byte {
@@ -0,0 +1,248 @@
+package pos33
+
+import (
+	"fmt"
+	"sort"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	metrics "github.com/rcrowley/go-metrics"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+type sortKey struct {
+	height int64
+	round  int
+	num    int
+}
+
+func (k sortKey) less(o sortKey) bool {
+	if k.height != o.height {
+		return k.height < o.height
+	}
+	if k.round != o.round {
+		return k.round < o.round
+	}
+	return k.num < o.num
+}
+
+type sortSet struct {
+	sorts   map[string]*pt.Pos33SortMsg // key is sort hash
+	senders map[string]int              // key is pubkey, val is sort count
+	size    int
+}
+
+func newSortSet() *sortSet {
+	return &sortSet{
+		sorts:   make(map[string]*pt.Pos33SortMsg),
+		senders: make(map[string]int),
+	}
+}
+
+// sortStore 保存收到的抽签, 按 (height, round, num) 索引, 每个发送者有数量限制.
+// 内存里的抽签超过 limit 字节时, 把最低的 (height, round, num) 写到 db, 用到时再读回来;
+// 没有 db 时直接丢掉. 高度停下来或者有节点刷抽签时内存不会一直增长
+type sortStore struct {
+	sets    map[sortKey]*sortSet
+	quota   int
+	size    int
+	limit   int
+	db      dbm.DB
+	prefix  string
+	spilled map[sortKey]bool
+}
+
+func newSortStore(quota int) *sortStore {
+	return &sortStore{
+		sets:    make(map[sortKey]*sortSet),
+		quota:   quota,
+		spilled: make(map[sortKey]bool),
+	}
+}
+
+// bound 内存限制 limit 字节, db 不为 nil 时超过的抽签写到 db 里 prefix 开头的 key
+func (s *sortStore) bound(limit int, db dbm.DB, prefix string) *sortStore {
+	s.limit = limit
+	s.db = db
+	s.prefix = prefix
+	return s
+}
+
+func (s *sortStore) dbKey(k sortKey) []byte {
+	return []byte(fmt.Sprintf("%s-%012d-%05d-%03d", s.prefix, k.height, k.round, k.num))
+}
+
+// set 返回 k 的抽签, 写到 db 里的读回内存
+func (s *sortStore) set(k sortKey) (*sortSet, bool) {
+	set, ok := s.sets[k]
+	if ok || !s.spilled[k] {
+		return set, ok
+	}
+	delete(s.spilled, k)
+	val, err := s.db.Get(s.dbKey(k))
+	if err != nil {
+		return nil, false
+	}
+	s.db.Delete(s.dbKey(k))
+	var ss pt.Pos33Sorts
+	if types.Decode(val, &ss) != nil {
+		return nil, false
+	}
+	set = newSortSet()
+	for _, m := range ss.Sorts {
+		set.sorts[string(m.SortHash.Hash)] = m
+		set.senders[string(m.Proof.Pubkey)]++
+		set.size += types.Size(m)
+	}
+	s.sets[k] = set
+	s.size += set.size
+	metrics.GetOrRegisterCounter("pos33.msgstore.loaded", nil).Inc(1)
+	s.shrink(k)
+	return set, true
+}
+
+// shrink 超过内存限制时从最低的 (height, round, num) 开始移出内存, keep 不移出
+func (s *sortStore) shrink(keep sortKey) {
+	if s.limit <= 0 || s.size <= s.limit {
+		return
+	}
+	keys := make([]sortKey, 0, len(s.sets))
+	for k := range s.sets {
+		if k != keep {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].less(keys[j]) })
+	for _, k := range keys {
+		if s.size <= s.limit*3/4 {
+			break
+		}
+		set := s.sets[k]
+		delete(s.sets, k)
+		s.size -= set.size
+		if s.db == nil {
+			metrics.GetOrRegisterCounter("pos33.msgstore.dropped", nil).Inc(int64(len(set.sorts)))
+			continue
+		}
+		ss := &pt.Pos33Sorts{Sorts: make([]*pt.Pos33SortMsg, 0, len(set.sorts))}
+		for _, m := range set.sorts {
+			ss.Sorts = append(ss.Sorts, m)
+		}
+		err := s.db.Set(s.dbKey(k), types.Encode(ss))
+		if err != nil {
+			plog.Error("spill sorts error", "err", err, "height", k.height)
+			continue
+		}
+		s.spilled[k] = true
+		metrics.GetOrRegisterCounter("pos33.msgstore.spilled", nil).Inc(1)
+	}
+}
+
+// add 添加抽签, 超过发送者限额的会被丢弃, 返回添加的数量
+func (s *sortStore) add(height int64, round, num int, ss []*pt.Pos33SortMsg) int {
+	k := sortKey{height, round, num}
+	set, ok := s.set(k)
+	if !ok {
+		set = newSortSet()
+		s.sets[k] = set
+	}
+
+	added := 0
+	for _, m := range ss {
+		h := string(m.SortHash.Hash)
+		if _, ok := set.sorts[h]; ok {
+			continue
+		}
+		pub := string(m.Proof.Pubkey)
+		if set.senders[pub] >= s.quota {
+			continue
+		}
+		set.senders[pub]++
+		set.sorts[h] = m
+		size := types.Size(m)
+		set.size += size
+		s.size += size
+		added++
+	}
+	s.shrink(k)
+	return added
+}
+
+// hasSender 是否已经收到过 pub 的抽签
+func (s *sortStore) hasSender(height int64, round, num int, pub []byte) bool {
+	set, ok := s.set(sortKey{height, round, num})
+	if !ok {
+		return false
+	}
+	return set.senders[string(pub)] > 0
+}
+
+func (s *sortStore) count(height int64, round, num int) int {
+	set, ok := s.set(sortKey{height, round, num})
+	if !ok {
+		return 0
+	}
+	return len(set.sorts)
+}
+
+// Best 返回 hash 最小的 n 个抽签, n <= 0 返回全部
+func (s *sortStore) Best(height int64, round, num, n int) []*pt.Pos33SortMsg {
+	set, ok := s.set(sortKey{height, round, num})
+	if !ok {
+		return nil
+	}
+	ss := make([]*pt.Pos33SortMsg, 0, len(set.sorts))
+	for _, m := range set.sorts {
+		ss = append(ss, m)
+	}
+	sort.Sort(pt.Sorts(ss))
+	if n > 0 && len(ss) > n {
+		ss = ss[:n]
+	}
+	return ss
+}
+
+// get 返回 hash 对应的抽签
+func (s *sortStore) get(height int64, round, num int, hash []byte) *pt.Pos33SortMsg {
+	set, ok := s.set(sortKey{height, round, num})
+	if !ok {
+		return nil
+	}
+	return set.sorts[string(hash)]
+}
+
+// each 遍历 height 的所有抽签
+func (s *sortStore) each(height int64, f func(round, num int, ss map[string]*pt.Pos33SortMsg)) {
+	var keys []sortKey
+	for k := range s.sets {
+		if k.height == height {
+			keys = append(keys, k)
+		}
+	}
+	for k := range s.spilled {
+		if k.height == height {
+			keys = append(keys, k)
+		}
+	}
+	for _, k := range keys {
+		if set, ok := s.set(k); ok {
+			f(k.round, k.num, set.sorts)
+		}
+	}
+}
+
+// evict 删除 height 以下的抽签
+func (s *sortStore) evict(height int64) {
+	for k, set := range s.sets {
+		if k.height < height {
+			s.size -= set.size
+			delete(s.sets, k)
+		}
+	}
+	for k := range s.spilled {
+		if k.height < height {
+			s.db.Delete(s.dbKey(k))
+			delete(s.spilled, k)
+		}
+	}
+}
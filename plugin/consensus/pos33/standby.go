@@ -0,0 +1,176 @@
+package pos33
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const defaultStandbyTimeout = 30
+
+// standby 两台机器用同一个挖矿私钥, 同时只有一台参与抽签和投票.
+// 配置了 leaseFile 时, 用共享的 lease 文件选出活动的节点, 活动节点每秒续约, 过期后别的节点接管;
+// 没有 lease 文件时, standby 节点观察网络上自己私钥的消息, 超过 timeout 没有看到才接管.
+type standby struct {
+	mu       sync.Mutex
+	standby  bool
+	file     string
+	id       string
+	timeout  time.Duration
+	lastMine time.Time
+	active   bool
+	pool     bool
+}
+
+func newStandby(conf *subConfig) *standby {
+	if !conf.Standby && conf.LeaseFile == "" && conf.Pool == "" {
+		return nil
+	}
+	timeout := conf.StandbyTimeout
+	if timeout <= 0 {
+		timeout = defaultStandbyTimeout
+	}
+	host, _ := os.Hostname()
+	sb := &standby{
+		standby:  conf.Standby,
+		file:     conf.LeaseFile,
+		id:       fmt.Sprintf("%s-%d", host, os.Getpid()),
+		timeout:  time.Second * time.Duration(timeout),
+		lastMine: time.Now(),
+		pool:     conf.Pool != "",
+	}
+	if sb.file != "" {
+		go sb.runLease()
+	}
+	return sb
+}
+
+// isActive 这个节点现在是否可以签名
+func (sb *standby) isActive() bool {
+	if sb == nil {
+		return true
+	}
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	if sb.file != "" || sb.pool {
+		return sb.active
+	}
+	if !sb.standby {
+		return true
+	}
+	active := time.Since(sb.lastMine) > sb.timeout
+	if active != sb.active {
+		plog.Info("pos33 standby", "active", active)
+		sb.active = active
+	}
+	return active
+}
+
+// canSign 挖矿没有暂停, 并且热备模式下是活动节点
+func (n *node) canSign() bool {
+	return !n.cs.isPaused() && n.sb.isActive()
+}
+
+// setMinerPause 运行时暂停或者恢复挖矿. 暂停后不再抽签, 投票和签名 checkpoint,
+// 已经发出的抽签和投票照常完成, 节点继续同步区块和提供 rpc 服务. 重启后恢复挖矿
+func (n *node) setMinerPause(pause bool) {
+	n.cs.setPaused(pause)
+	plog.Info("pos33 miner pause", "pause", pause, "height", n.GetCurrentHeight())
+}
+
+// seeMine 从网络收到用自己私钥签名的消息, 说明主节点在工作
+func (n *node) seeMine(pub []byte, myself bool) {
+	sb := n.sb
+	if sb == nil || sb.file != "" || myself {
+		return
+	}
+	if n.signerOf(pub) == nil {
+		return
+	}
+	sb.mu.Lock()
+	sb.lastMine = time.Now()
+	sb.mu.Unlock()
+}
+
+// lease 文件的内容: id expire(unix 纳秒)
+func (sb *standby) readLease() (string, int64) {
+	data, err := ioutil.ReadFile(sb.file)
+	if err != nil {
+		return "", 0
+	}
+	fs := strings.Fields(string(data))
+	if len(fs) != 2 {
+		return "", 0
+	}
+	expire, err := strconv.ParseInt(fs[1], 10, 64)
+	if err != nil {
+		return "", 0
+	}
+	return fs[0], expire
+}
+
+func (sb *standby) renewLease() bool {
+	id, expire := sb.readLease()
+	now := time.Now()
+	if id != sb.id && now.UnixNano() < expire {
+		return false
+	}
+	// standby 节点在 lease 过期后多等一个续约周期, 主节点优先
+	if id != sb.id && sb.standby && now.UnixNano() < expire+int64(time.Second) {
+		return false
+	}
+	tmp := sb.file + "." + sb.id
+	data := fmt.Sprintf("%s %d", sb.id, now.Add(sb.timeout).UnixNano())
+	err := ioutil.WriteFile(tmp, []byte(data), 0600)
+	if err != nil {
+		plog.Error("write lease error", "err", err)
+		return false
+	}
+	err = os.Rename(tmp, sb.file)
+	if err != nil {
+		plog.Error("rename lease error", "err", err)
+		return false
+	}
+	// 别的节点可能同时写入, 再读一次确认
+	id, _ = sb.readLease()
+	return id == sb.id
+}
+
+func (sb *standby) runLease() {
+	for range time.NewTicker(time.Second).C {
+		active := sb.renewLease()
+		sb.mu.Lock()
+		if active != sb.active {
+			plog.Info("pos33 lease", "active", active, "id", sb.id)
+			sb.active = active
+		}
+		sb.mu.Unlock()
+	}
+}
+
+// 矿池 worker 订阅的间隔
+const poolSubscribeInterval = time.Second * 3
+
+// runPool 定期订阅矿池, 矿池选中的主 worker 才投票和出块, 订阅失败时停止
+func (sb *standby) runPool(s *remoteSigner) {
+	for range time.NewTicker(poolSubscribeInterval).C {
+		var reply pt.SignerReply
+		err := s.call("Subscribe", "", nil, &reply)
+		if err != nil {
+			plog.Error("pool subscribe error", "err", err)
+		}
+		active := err == nil && reply.Primary
+		sb.mu.Lock()
+		if active != sb.active {
+			plog.Info("pos33 pool", "primary", active)
+			sb.active = active
+		}
+		sb.mu.Unlock()
+	}
+}
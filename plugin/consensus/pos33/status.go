@@ -0,0 +1,87 @@
+package pos33
+
+import (
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// validatorStatus 本节点的挖矿地址最近出块和投票进区块的记录, 节点启动以后统计
+type validatorStatus struct {
+	mu    sync.Mutex
+	made  map[string]*pt.Pos33ValidatorStatus
+	voted map[string]*pt.Pos33ValidatorStatus
+}
+
+func newValidatorStatus() *validatorStatus {
+	return &validatorStatus{
+		made:  make(map[string]*pt.Pos33ValidatorStatus),
+		voted: make(map[string]*pt.Pos33ValidatorStatus),
+	}
+}
+
+// onBlock 区块加入主链, 记录我的地址出块和投票
+func (vs *validatorStatus) onBlock(n *node, b *types.Block) {
+	m, err := getMiner(b)
+	if err != nil || m.Sort.GetProof() == nil {
+		return
+	}
+	signers := n.getSigners()
+	if len(signers) == 0 {
+		return
+	}
+	now := time.Now().Unix()
+	voters := make(map[string]bool)
+	for _, pk := range m.Voters() {
+		voters[string(pk)] = true
+	}
+	maker := string(m.Sort.Proof.Pubkey)
+
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
+	for _, s := range signers {
+		addr := address.PubKeyToAddr(ethID, s.PubKey())
+		if string(s.PubKey()) == maker {
+			vs.made[addr] = &pt.Pos33ValidatorStatus{LastMadeHeight: b.Height, LastMadeTime: now}
+		}
+		if voters[string(s.BlsPubKey())] {
+			vs.voted[addr] = &pt.Pos33ValidatorStatus{LastVotedHeight: b.Height, LastVotedTime: now}
+		}
+	}
+}
+
+// getValidatorStatus 本节点是否可以正常出块和投票
+func (n *node) getValidatorStatus() *pt.Pos33ValidatorStatuses {
+	height := n.GetCurrentHeight()
+	r := &pt.Pos33ValidatorStatuses{
+		Height: height,
+		Synced: n.synced(),
+		Active: n.canSign(),
+	}
+	vs := n.vals
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
+	eligible := false
+	for _, s := range n.getSigners() {
+		addr := address.PubKeyToAddr(ethID, s.PubKey())
+		st := &pt.Pos33ValidatorStatus{
+			Addr:    addr,
+			Tickets: n.queryTicketCount(addr, height+1-pt.Pos33SortBlocks),
+		}
+		st.Eligible = st.Tickets > 0
+		if m, ok := vs.made[addr]; ok {
+			st.LastMadeHeight, st.LastMadeTime = m.LastMadeHeight, m.LastMadeTime
+		}
+		if v, ok := vs.voted[addr]; ok {
+			st.LastVotedHeight, st.LastVotedTime = v.LastVotedHeight, v.LastVotedTime
+		}
+		eligible = eligible || st.Eligible
+		r.Items = append(r.Items, st)
+	}
+	r.HasKey = len(r.Items) > 0
+	r.Healthy = r.HasKey && eligible && r.Synced && r.Active
+	return r
+}
@@ -0,0 +1,311 @@
+package pos33
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	metrics "github.com/rcrowley/go-metrics"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 默认提交区块 N 之前, N-defaultSyncVerifyLag 和之前的区块必须验证完
+const defaultSyncVerifyLag = 64
+
+// verifyJob 验证用到的委员会大小和抽签种子在提交的时候按区块高度取好, worker 不读当前的状态
+type verifyJob struct {
+	b   *types.Block
+	pb  *types.Block
+	act *pt.Pos33MinerMsg
+	gen int
+
+	voters     int              // b.Height 的投票人委员会大小
+	lateVoters int              // 迟到投票 (b.Height-1) 的投票人委员会大小
+	seed       []byte           // 制作人抽签的种子, 为空不检查 vrf 证明
+	lateSeeds  map[int64][]byte // 迟到投票的抽签种子
+}
+
+// syncVerifier 同步区块时 (没有追上, 或者本节点没有票) 共识不做完整的区块检查, 因为抽签的难度和票数依赖当时的状态.
+// 和状态无关的部分: 抽签和种子的 vrf 证明, 投票和迟到投票的 bls 聚合签名和投票承诺, 放到 worker 里并行验证, 区块照常按顺序执行.
+// 提交区块 N 之前等 N-lag 和之前的区块都验证完, 所以最多有 lag 个没有验证的区块已经提交.
+// 有区块验证失败以后拒绝最后一个验证过的高度之后的区块, 链回到这个高度 (分叉, 或者用 blockchain.rollbackBlock 回滚以后重启) 时清掉错误.
+// chain33 的主链运行中不能删除区块, 所以没有在这里直接回滚
+type syncVerifier struct {
+	n    *node
+	lag  int64
+	ch   chan *verifyJob
+	quit chan struct{}
+	wg   sync.WaitGroup
+
+	mu       sync.Mutex
+	cond     *sync.Cond
+	closed   bool
+	gen      int
+	top      int64
+	pending  map[int64]bool // 已经提交还没有验证完的高度
+	verified int64          // 这个高度和之前的区块都验证过了
+	failed   error
+}
+
+func newSyncVerifier(n *node, conf *subConfig) *syncVerifier {
+	workers := conf.SyncVerifyWorkers
+	if workers < 0 {
+		return nil
+	}
+	if workers == 0 {
+		workers = runtime.NumCPU()
+	}
+	lag := conf.SyncVerifyLag
+	if lag <= 0 {
+		lag = defaultSyncVerifyLag
+	}
+	v := &syncVerifier{n: n, lag: int64(lag), ch: make(chan *verifyJob, lag+1), quit: make(chan struct{}), pending: make(map[int64]bool)}
+	v.cond = sync.NewCond(&v.mu)
+	for i := 0; i < workers; i++ {
+		v.wg.Add(1)
+		go v.loop()
+	}
+	return v
+}
+
+func (v *syncVerifier) close() {
+	if v == nil {
+		return
+	}
+	v.mu.Lock()
+	v.closed = true
+	v.cond.Broadcast()
+	v.mu.Unlock()
+	close(v.quit)
+	v.wg.Wait()
+}
+
+// low 没有等待验证的区块时是最后提交的高度, 否则是最低的等待验证的高度的前一个
+func (v *syncVerifier) low() int64 {
+	low := v.top
+	for h := range v.pending {
+		if h-1 < low {
+			low = h - 1
+		}
+	}
+	return low
+}
+
+// submit 把区块放到验证队列. 先等 b.Height-lag 和之前的区块验证完, 有区块验证失败时返回错误
+func (v *syncVerifier) submit(b, pb *types.Block) error {
+	if v == nil {
+		return nil
+	}
+	j, err := v.n.newVerifyJob(b, pb)
+	if err != nil {
+		return err
+	}
+
+	v.mu.Lock()
+	if v.failed != nil {
+		if b.Height > v.verified+1 {
+			err = v.failed
+			v.mu.Unlock()
+			return err
+		}
+		plog.Info("sync verify recovered", "height", b.Height, "verified", v.verified)
+		v.failed = nil
+	}
+	if b.Height <= v.top {
+		// 分叉, 被替换的区块的验证结果不再有用
+		v.gen++
+		for h := range v.pending {
+			if h >= b.Height {
+				delete(v.pending, h)
+			}
+		}
+	}
+	if len(v.pending) == 0 {
+		// 父区块已经检查过了: 完整检查, 或者之前的验证
+		v.verified = b.Height - 1
+	}
+	v.top = b.Height
+	for !v.closed && v.failed == nil && v.low() < b.Height-v.lag {
+		v.cond.Wait()
+	}
+	if v.failed != nil {
+		err = v.failed
+		v.mu.Unlock()
+		return err
+	}
+	v.pending[b.Height] = true
+	j.gen = v.gen
+	metrics.GetOrRegisterGauge("pos33.syncverify.pending", nil).Update(int64(len(v.pending)))
+	v.mu.Unlock()
+
+	select {
+	case v.ch <- j:
+	case <-v.quit:
+	}
+	return nil
+}
+
+func (v *syncVerifier) loop() {
+	defer v.wg.Done()
+	for {
+		select {
+		case <-v.quit:
+			return
+		case j := <-v.ch:
+			start := time.Now()
+			err := verifyStateless(v.n, j)
+			metrics.GetOrRegisterTimer("pos33.syncverify.time", nil).UpdateSince(start)
+			v.done(j, err)
+		}
+	}
+}
+
+func (v *syncVerifier) done(j *verifyJob, err error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	defer v.cond.Broadcast()
+	if j.gen != v.gen {
+		return
+	}
+	height := j.b.Height
+	delete(v.pending, height)
+	if err == nil {
+		if v.failed == nil {
+			v.verified = v.low()
+			metrics.GetOrRegisterGauge("pos33.syncverify.verified", nil).Update(v.verified)
+		}
+		return
+	}
+	metrics.GetOrRegisterCounter("pos33.syncverify.failed", nil).Inc(1)
+	if v.failed != nil && height > v.verified {
+		return
+	}
+	if height-1 < v.verified {
+		v.verified = height - 1
+	}
+	v.failed = fmt.Errorf("block %d verify failed: %v", height, err)
+	plog.Error("sync verify error, blocks after verified height must be rolled back", "height", height, "verified", v.verified, "top", v.top, "err", err)
+}
+
+// newVerifyJob 按区块高度取委员会大小和抽签种子, 在 CheckBlock 里按区块顺序调用, 读的是父区块的状态
+func (n *node) newVerifyJob(b, pb *types.Block) (*verifyJob, error) {
+	act, err := getMiner(b)
+	if err != nil {
+		return nil, err
+	}
+	if act.Sort == nil || act.Sort.Proof == nil || act.Sort.Proof.Input == nil || act.Sort.SortHash == nil {
+		return nil, pt.ErrCatSortMsg.New()
+	}
+	if int(act.Sort.SortHash.Num) >= n.sortRetries(b.Height) {
+		return nil, pt.ErrCatSortMsg.New()
+	}
+	j := &verifyJob{b: b, pb: pb, act: act, voters: n.voterSize(b.Height)}
+	_, vrf := n.sorter.(*vrfSorter)
+	if vrf && b.Height > pt.Pos33SortBlocks {
+		j.seed, err = n.getSortSeed(b.Height - pt.Pos33SortBlocks)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if act.Late == nil {
+		return j, nil
+	}
+	j.lateVoters = n.voterSize(b.Height - 1)
+	if !vrf {
+		return j, nil
+	}
+	j.lateSeeds = make(map[int64][]byte)
+	for _, s := range act.Late.Sorts {
+		if s == nil || s.Proof == nil || s.Proof.Input == nil || s.SortHash == nil {
+			return nil, pt.ErrCatSortMsg.New()
+		}
+		h := s.Proof.Input.Height
+		if _, ok := j.lateSeeds[h]; ok {
+			continue
+		}
+		j.lateSeeds[h], err = n.getSortSeed(h - pt.Pos33SortBlocks)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return j, nil
+}
+
+// verifyStateless 检查区块里和状态无关的证明和签名, 委员会大小和种子用 job 里按高度取好的
+func verifyStateless(n *node, j *verifyJob) error {
+	cfg := n.GetAPI().GetConfig()
+	b, pb, act := j.b, j.pb, j.act
+	err := act.CheckCounts(j.voters)
+	if err != nil {
+		return err
+	}
+	if len(act.Voters()) < pt.MustVotes(j.voters) {
+		return pt.ErrCatVotesEnough.New()
+	}
+	if j.seed != nil {
+		err = verifySortProof(j.seed, b.Height, Maker, act.Sort)
+		if err != nil {
+			n.sortFailed(b.Height, 0, j.seed, act.Sort, err)
+			return err
+		}
+	}
+	if cfg.IsDappFork(b.Height, pt.Pos33TicketX, "ForkVrfSeed") {
+		err = checkMinerSeed(act, pb)
+		if err != nil {
+			return err
+		}
+	}
+	if cfg.IsDappFork(b.Height, pt.Pos33TicketX, "ForkVoteCommitment") {
+		err = act.CheckCommitment(prevVotersRoot(pb))
+		if err != nil {
+			return err
+		}
+	}
+	if act.Late != nil {
+		pm, err := getMiner(pb)
+		if err != nil {
+			return err
+		}
+		err = act.CheckLate(b.Height, pm, j.lateVoters)
+		if err != nil {
+			return err
+		}
+		for _, s := range act.Late.Sorts {
+			seed, ok := j.lateSeeds[s.Proof.Input.Height]
+			if !ok {
+				continue
+			}
+			err = verifySortProof(seed, s.Proof.Input.Height, Voter, s)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return act.Verify()
+}
+
+// verifySortProof 抽签的 vrf 证明, 不检查票数和难度
+func verifySortProof(seed []byte, height int64, ty int, m *pt.Pos33SortMsg) error {
+	in := m.Proof.Input
+	if in.Height != height {
+		return pt.ErrCatSortHeight.New(in.Height, height)
+	}
+	if string(in.Seed) != string(seed) {
+		return pt.ErrCatSortSeed.New()
+	}
+	if int(in.Ty) != ty {
+		return pt.ErrCatSortStep.New()
+	}
+	input := &pt.VrfInput{Seed: seed, Height: height, Round: in.Round, Ty: int32(ty)}
+	err := vrfVerify(m.Proof.Pubkey, types.Encode(input), m.Proof.VrfProof, m.Proof.VrfHash)
+	if err != nil {
+		return err
+	}
+	data := fmt.Sprintf("%x+%d+%d", m.Proof.VrfHash, m.SortHash.Index, m.SortHash.Num)
+	if string(hash2([]byte(data))) != string(m.SortHash.Hash) {
+		return pt.ErrCatSortHash.New()
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package pos33
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const (
+	// 超过 telemetryAlive 个周期没有收到心跳认为不在线
+	telemetryAlive = 3
+	// 超过这么久没有新的区块认为网络停止了
+	telemetryStalled = time.Minute
+	maxTelemetries   = 4096
+)
+
+type liveness struct {
+	tm   *pt.Pos33Telemetry
+	seen time.Time
+}
+
+// telemetries 验证者之间每 telemetryInterval 秒互相发送签名的心跳, 每个节点据此建立验证者的在线地图,
+// 用 pos33.GetPos33Liveness 查询, 运维可以区分是整个网络停了还是本节点被隔离了.
+// 同一个公钥在半个周期内的心跳直接丢掉, 没有票的地址的心跳也丢掉
+type telemetries struct {
+	interval time.Duration
+
+	mu sync.Mutex
+	mp map[string]*liveness
+}
+
+func newTelemetries(conf *subConfig) *telemetries {
+	if conf.TelemetryInterval <= 0 {
+		return nil
+	}
+	return &telemetries{interval: time.Duration(conf.TelemetryInterval) * time.Second, mp: make(map[string]*liveness)}
+}
+
+// add 限流, 返回 false 表示丢掉
+func (t *telemetries) add(addr string, m *pt.Pos33Telemetry, now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	l, ok := t.mp[addr]
+	if ok && now.Sub(l.seen) < t.interval/2 {
+		return false
+	}
+	if !ok && len(t.mp) >= maxTelemetries {
+		return false
+	}
+	t.mp[addr] = &liveness{tm: m, seen: now}
+	return true
+}
+
+func (t *telemetries) livenessMap(now time.Time) []*pt.Pos33Liveness {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var ls []*pt.Pos33Liveness
+	for addr, l := range t.mp {
+		// 很久没有心跳的地址删掉
+		if now.Sub(l.seen) > t.interval*telemetryAlive*10 {
+			delete(t.mp, addr)
+			continue
+		}
+		ls = append(ls, &pt.Pos33Liveness{
+			Addr:     addr,
+			Height:   l.tm.Height,
+			LastSeen: l.seen.Unix(),
+			Delay:    l.seen.UnixNano()/1000000 - l.tm.Time,
+			Peers:    l.tm.Peers,
+			Alive:    now.Sub(l.seen) <= t.interval*telemetryAlive,
+		})
+	}
+	sort.Slice(ls, func(i, j int) bool { return ls[i].Addr < ls[j].Addr })
+	return ls
+}
+
+func signTelemetry(s pt.Signer, m *pt.Pos33Telemetry) error {
+	m.Sig = nil
+	sig, err := s.Sign(pt.SignTelemetry, types.Encode(m))
+	if err != nil {
+		return err
+	}
+	m.Sig = &types.Signature{Ty: types.SECP256K1, Pubkey: s.PubKey(), Signature: sig}
+	return nil
+}
+
+// runTelemetry 每个周期给每个有票的挖矿私钥发一个心跳
+func (n *node) runTelemetry() {
+	t := n.tms
+	if t == nil {
+		return
+	}
+	tm := time.NewTicker(t.interval)
+	defer tm.Stop()
+	for range tm.C {
+		if n.guard.shed() {
+			continue
+		}
+		height := n.lastBlock().Height
+		for _, s := range n.getSigners() {
+			if n.queryTicketCount(address.PubKeyToAddr(ethID, s.PubKey()), height) <= 0 {
+				continue
+			}
+			m := &pt.Pos33Telemetry{
+				Height: height,
+				Time:   time.Now().UnixNano() / 1000000,
+				Peers:  int32(len(n.gss.h.Network().Peers())),
+			}
+			if err := signTelemetry(s, m); err != nil {
+				plog.Error("sign telemetry error", "err", err)
+				continue
+			}
+			n.handleTelemetry(m, true)
+			pm := &pt.Pos33Msg{Data: types.Encode(m), Ty: pt.Pos33Msg_TM}
+			n.gss.gossip(n.topic+"/telemetry", types.Encode(pm))
+		}
+	}
+}
+
+func (n *node) handleTelemetry(m *pt.Pos33Telemetry, myself bool) {
+	t := n.tms
+	if t == nil || m.Sig == nil {
+		return
+	}
+	if !myself {
+		if n.score.banned(m.Sig.Pubkey) {
+			return
+		}
+		if !m.Verify() {
+			n.score.fail(m.Sig.Pubkey, types.ErrSign)
+			return
+		}
+	}
+	addr := address.PubKeyToAddr(ethID, m.Sig.Pubkey)
+	if !myself && n.queryTicketCount(addr, n.lastBlock().Height) <= 0 {
+		return
+	}
+	t.add(addr, m, time.Now())
+}
+
+// liveness 本节点看到的验证者在线地图
+func (n *node) liveness() *pt.Pos33LivenessMap {
+	t := n.tms
+	if t == nil {
+		return nil
+	}
+	lb := n.lastBlock()
+	r := &pt.Pos33LivenessMap{Height: lb.Height, Items: t.livenessMap(time.Now())}
+	if n.gss != nil {
+		r.Peers = int32(len(n.gss.h.Network().Peers()))
+	}
+	for _, l := range r.Items {
+		r.Total++
+		if l.Alive {
+			r.Alive++
+		}
+	}
+	switch {
+	case r.Alive*3 < r.Total*2:
+		r.Status = "partitioned"
+	case time.Since(time.Unix(lb.BlockTime, 0)) > telemetryStalled:
+		r.Status = "stalled"
+	default:
+		r.Status = "ok"
+	}
+	return r
+}
@@ -0,0 +1,98 @@
+package pos33
+
+import (
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const (
+	defaultTimeoutFloor   = time.Second * 2
+	defaultTimeoutCeiling = time.Second * 30
+	timeoutEmaAlpha       = 0.1
+)
+
+// roundTimeout 根据最近的出块间隔和区块到达的延迟 (EMA) 计算超时时间,
+// 网络慢的时候延长, 网络快的时候缩短, 限制在 [floor, ceiling] 之间
+type roundTimeout struct {
+	interval float64 // 出块间隔 ms
+	delay    float64 // 区块从制作到收到的延迟 ms
+	last     time.Time
+	floor    time.Duration
+	ceiling  time.Duration
+}
+
+func newRoundTimeout(floor, ceiling int64) *roundTimeout {
+	t := &roundTimeout{
+		interval: 1000,
+		floor:    time.Duration(floor) * time.Millisecond,
+		ceiling:  time.Duration(ceiling) * time.Millisecond,
+	}
+	if t.floor <= 0 {
+		t.floor = defaultTimeoutFloor
+	}
+	if t.ceiling <= 0 {
+		t.ceiling = defaultTimeoutCeiling
+	}
+	if t.ceiling < t.floor {
+		t.ceiling = t.floor
+	}
+	return t
+}
+
+func ema(old, v float64) float64 {
+	return old*(1-timeoutEmaAlpha) + v*timeoutEmaAlpha
+}
+
+// observe 收到新区块, blockTime 是制作人打包的时间 ms
+func (t *roundTimeout) observe(blockTime int64) {
+	now := time.Now()
+	if !t.last.IsZero() {
+		t.interval = ema(t.interval, float64(now.Sub(t.last).Milliseconds()))
+	}
+	t.last = now
+	if blockTime > 0 {
+		d := now.UnixNano()/1000000 - blockTime
+		if d < 0 {
+			d = 0
+		}
+		t.delay = ema(t.delay, float64(d))
+	}
+}
+
+func (t *roundTimeout) clamp(ms float64) time.Duration {
+	d := time.Duration(ms) * time.Millisecond
+	if d < t.floor {
+		return t.floor
+	}
+	if d > t.ceiling {
+		return t.ceiling
+	}
+	return d
+}
+
+// block 等待区块的超时, 正常网络 (1s 出块) 约 5s
+func (t *roundTimeout) block() time.Duration {
+	return t.clamp(t.interval*5 + t.delay*2)
+}
+
+// resort 重新抽签后等待的时间, 正常网络约 3s
+func (t *roundTimeout) resort() time.Duration {
+	return t.clamp(t.interval*3 + t.delay*2)
+}
+
+// blockTime 上一个区块上链后等待多少毫秒开始出高度 height 的区块
+func (n *node) blockTime(height int64) int64 {
+	return pt.GetPos33MineParam(n.GetAPI().GetConfig(), height).BlockTime
+}
+
+// warnWriteStall 轮次超时的时候, 如果等待区块期间 store 的 leveldb 发生过写入停顿就告警
+func warnWriteStall(height int64, round int, wait time.Duration) {
+	d, ok := pt.WriteStallSince(time.Now().Add(-wait))
+	if !ok {
+		return
+	}
+	metrics.GetOrRegisterCounter("pos33.timeout.stall", nil).Inc(1)
+	plog.Warn("round timeout with db write stall", "height", height, "round", round, "stall", d)
+}
@@ -0,0 +1,23 @@
+package pos33
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// traceTxs 带追踪 ID 的交易记录共识的事件和日志, 追踪 ID 只在本节点有效
+func traceTxs(txs []*types.Transaction, height int64, event string) {
+	if !pt.TracingTx() {
+		return
+	}
+	ev := fmt.Sprintf("%s height %d", event, height)
+	for _, tx := range txs {
+		hash := tx.Hash()
+		if id := pt.TraceTx(hash, ev); id != "" {
+			plog.Info("trace tx", "traceId", id, "hash", common.ToHex(hash), "event", ev)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package pos33
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/version"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 导出文件的格式版本, 字段只增加不修改
+const validatorExportFormat = 1
+
+const defaultExportBlocks = 100
+
+type exportValidator struct {
+	Addr    string `json:"addr"`
+	Tickets int64  `json:"tickets"`
+	Name    string `json:"name,omitempty"`
+	Website string `json:"website,omitempty"`
+	// 最近 blocks 个区块里出块和投票的数量, participation 是投过票的区块的比例
+	Made          int     `json:"made"`
+	Votes         int     `json:"votes"`
+	Participation float64 `json:"participation"`
+}
+
+type validatorSet struct {
+	Format       int                `json:"format"`
+	Node         string             `json:"node"`
+	Height       int64              `json:"height"`
+	Hash         string             `json:"hash"`
+	Time         int64              `json:"time"`
+	Blocks       int64              `json:"blocks"`
+	TotalTickets int                `json:"totalTickets"`
+	Validators   []*exportValidator `json:"validators"`
+}
+
+// signedValidatorSet signature 是导出节点的挖矿私钥对 sha256(data 的原始字节) 的 secp256k1 签名
+type signedValidatorSet struct {
+	Data      json.RawMessage `json:"data"`
+	Pubkey    string          `json:"pubkey,omitempty"`
+	Signature string          `json:"signature,omitempty"`
+}
+
+// validatorExport 每 blocks 个区块导出一次验证人集合, 写到文件或者通过 http 提供, 给社区的面板使用
+type validatorExport struct {
+	n      *node
+	file   string
+	blocks int64
+
+	mu   sync.Mutex
+	last []byte
+}
+
+func newValidatorExport(n *node, conf *subConfig) *validatorExport {
+	if conf.ValidatorExportFile == "" && conf.ValidatorExportAddr == "" {
+		return nil
+	}
+	e := &validatorExport{n: n, file: conf.ValidatorExportFile, blocks: conf.ValidatorExportBlocks}
+	if e.blocks <= 0 {
+		e.blocks = defaultExportBlocks
+	}
+	if conf.ValidatorExportAddr != "" {
+		go e.serve(conf.ValidatorExportAddr)
+	}
+	return e
+}
+
+func (e *validatorExport) serve(addr string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/validators", func(w http.ResponseWriter, r *http.Request) {
+		e.mu.Lock()
+		data := e.last
+		e.mu.Unlock()
+		if data == nil {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	})
+	err := http.ListenAndServe(addr, mux)
+	plog.Error("validator export server stopped", "err", err, "addr", addr)
+}
+
+func (e *validatorExport) onBlock(b *types.Block) {
+	if e == nil || b.Height == 0 || b.Height%e.blocks != 0 {
+		return
+	}
+	go func() {
+		err := e.export(b)
+		if err != nil {
+			plog.Error("validator export error", "err", err, "height", b.Height)
+		}
+	}()
+}
+
+func (e *validatorExport) export(b *types.Block) error {
+	n := e.n
+	cfg := n.GetAPI().GetConfig()
+	vs := &validatorSet{
+		Format: validatorExportFormat,
+		Node:   version.GetVersion(),
+		Height: b.Height,
+		Hash:   common.ToHex(b.Hash(cfg)),
+		Time:   b.BlockTime,
+		Blocks: e.blocks,
+	}
+	mp := make(map[string]*exportValidator)
+	get := func(addr string) *exportValidator {
+		v, ok := mp[addr]
+		if !ok {
+			v = &exportValidator{Addr: addr}
+			mp[addr] = v
+		}
+		return v
+	}
+	blsAddrs := make(map[string]string)
+	for h := b.Height - e.blocks + 1; h <= b.Height; h++ {
+		blk, err := n.RequestBlock(h)
+		if err != nil {
+			return err
+		}
+		m, err := getMiner(blk)
+		if err != nil {
+			return err
+		}
+		get(blockMaker(blk, m)).Made++
+		voted := make(map[string]bool)
+		for _, pk := range m.Voters() {
+			addr, ok := blsAddrs[string(pk)]
+			if !ok {
+				msg, err := n.GetAPI().Query(pt.Pos33TicketX, "Pos33BlsAddr", &types.ReqAddr{Addr: address.PubKeyToAddr(ethID, pk)})
+				if err == nil {
+					addr = msg.(*types.ReplyString).Data
+				}
+				blsAddrs[string(pk)] = addr
+			}
+			if addr == "" {
+				continue
+			}
+			v := get(addr)
+			v.Votes++
+			if !voted[addr] {
+				voted[addr] = true
+				v.Participation++
+			}
+		}
+	}
+	for addr, v := range mp {
+		v.Participation /= float64(e.blocks)
+		v.Tickets = n.queryTicketCount(addr, b.Height)
+		if msg, err := n.GetAPI().Query(pt.Pos33TicketX, "Pos33MinerInfo", &types.ReqAddr{Addr: addr}); err == nil {
+			info := msg.(*pt.Pos33MinerInfo)
+			v.Name = info.Name
+			v.Website = info.Website
+		}
+		vs.Validators = append(vs.Validators, v)
+	}
+	sort.Slice(vs.Validators, func(i, j int) bool {
+		if vs.Validators[i].Tickets != vs.Validators[j].Tickets {
+			return vs.Validators[i].Tickets > vs.Validators[j].Tickets
+		}
+		return vs.Validators[i].Addr < vs.Validators[j].Addr
+	})
+	vs.TotalTickets = n.allCount(b.Height)
+
+	data, err := json.Marshal(vs)
+	if err != nil {
+		return err
+	}
+	sv := &signedValidatorSet{Data: data}
+	if ss := n.getSigners(); len(ss) > 0 {
+		s := ss[0]
+		sig, err := s.Sign(pt.SignValidators, data)
+		if err != nil {
+			return err
+		}
+		sv.Pubkey = common.ToHex(s.PubKey())
+		sv.Signature = common.ToHex(sig)
+	}
+	out, err := json.MarshalIndent(sv, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	e.last = out
+	e.mu.Unlock()
+	if e.file != "" {
+		tmp := e.file + ".tmp"
+		err = ioutil.WriteFile(tmp, out, 0644)
+		if err != nil {
+			return err
+		}
+		err = os.Rename(tmp, e.file)
+		if err != nil {
+			return err
+		}
+	}
+	plog.Info("validator export", "height", b.Height, "validators", len(vs.Validators))
+	return nil
+}
@@ -0,0 +1,163 @@
+package pos33
+
+import (
+	"fmt"
+	"strings"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// consensusWAL 记录本节点签名过的区块和投票,
+// 重启后在同一个高度和轮次重发记录下来的消息, 不会签名另一个冲突的消息
+type consensusWAL struct {
+	db dbm.DB
+}
+
+func newConsensusWAL(path string) *consensusWAL {
+	if path == "" {
+		return nil
+	}
+	w := &consensusWAL{db: dbm.NewDB("pos33wal", "leveldb", path, 16)}
+	w.repair()
+	it := w.db.Iterator([]byte("wal-"), nil, true)
+	defer it.Close()
+	if it.Rewind() && it.Valid() {
+		plog.Info("pos33 wal replay", "last", string(it.Key()))
+	}
+	if s := w.lastShutdown(); s != nil {
+		plog.Info("pos33 last shutdown", "height", s.Height, "round", s.Round, "lastHeight", s.LastHeight)
+	}
+	return w
+}
+
+// repair 删掉解不开的记录 (上次没有正常关闭时没有写完整的), 重启后不会重发坏的消息
+func (w *consensusWAL) repair() {
+	it := w.db.Iterator([]byte("wal-"), nil, false)
+	defer it.Close()
+	batch := w.db.NewBatch(true)
+	n := 0
+	for it.Rewind(); it.Valid(); it.Next() {
+		var err error
+		if strings.Contains(string(it.Key()), "-b-") {
+			err = types.Decode(it.Value(), new(types.Block))
+		} else {
+			err = types.Decode(it.Value(), new(pt.Pos33MakerVotes))
+		}
+		if err != nil {
+			plog.Error("pos33 wal drop broken record", "key", string(it.Key()), "err", err)
+			batch.Delete(append([]byte{}, it.Key()...))
+			n++
+		}
+	}
+	if n == 0 {
+		return
+	}
+	err := batch.Write()
+	if err != nil {
+		plog.Error("pos33 wal repair error", "err", err)
+	}
+}
+
+var shutdownKey = []byte("pos33-shutdown")
+
+// saveShutdown 正常关闭时记录共识状态, 启动时读取后删除, 没有这个记录说明上次没有正常关闭
+func (w *consensusWAL) saveShutdown(s *pt.Pos33ConsensusState) {
+	if w == nil {
+		return
+	}
+	err := w.db.SetSync(shutdownKey, types.Encode(s))
+	if err != nil {
+		plog.Error("wal save shutdown error", "err", err)
+	}
+}
+
+func (w *consensusWAL) lastShutdown() *pt.Pos33ConsensusState {
+	s := new(pt.Pos33ConsensusState)
+	if !w.get(shutdownKey, s) {
+		return nil
+	}
+	err := w.db.Delete(shutdownKey)
+	if err != nil {
+		plog.Error("wal delete shutdown error", "err", err)
+	}
+	return s
+}
+
+func walKey(height int64, ty string, round int) []byte {
+	return []byte(fmt.Sprintf("wal-%012d-%s-%d", height, ty, round))
+}
+
+func (w *consensusWAL) set(key []byte, msg types.Message) {
+	if w == nil {
+		return
+	}
+	err := w.db.SetSync(key, types.Encode(msg))
+	if err != nil {
+		plog.Error("wal write error", "err", err, "key", string(key))
+	}
+}
+
+func (w *consensusWAL) get(key []byte, msg types.Message) bool {
+	if w == nil {
+		return false
+	}
+	val, err := w.db.Get(key)
+	if err != nil || len(val) == 0 {
+		return false
+	}
+	return types.Decode(val, msg) == nil
+}
+
+// saveBlock 广播前记录自己制作的区块
+func (w *consensusWAL) saveBlock(b *types.Block, round int) {
+	w.set(walKey(b.Height, "b", round), b)
+}
+
+// getBlock 在这个高度和轮次已经制作过的区块
+func (w *consensusWAL) getBlock(height int64, round int) *types.Block {
+	b := new(types.Block)
+	if !w.get(walKey(height, "b", round), b) {
+		return nil
+	}
+	return b
+}
+
+// saveVotes 广播前记录自己的投票
+func (w *consensusWAL) saveVotes(height int64, round int, mvs []*pt.Pos33Votes) {
+	w.set(walKey(height, "v", round), &pt.Pos33MakerVotes{Mvs: mvs})
+}
+
+// getVotes 在这个高度和轮次已经投过的票
+func (w *consensusWAL) getVotes(height int64, round int) []*pt.Pos33Votes {
+	m := new(pt.Pos33MakerVotes)
+	if !w.get(walKey(height, "v", round), m) {
+		return nil
+	}
+	return m.Mvs
+}
+
+// prune 删除 height 以下的记录
+func (w *consensusWAL) prune(height int64) {
+	if w == nil {
+		return
+	}
+	it := w.db.Iterator([]byte("wal-"), []byte(fmt.Sprintf("wal-%012d", height)), false)
+	defer it.Close()
+	batch := w.db.NewBatch(false)
+	for it.Rewind(); it.Valid(); it.Next() {
+		batch.Delete(it.Key())
+	}
+	err := batch.Write()
+	if err != nil {
+		plog.Error("wal prune error", "err", err, "height", height)
+	}
+}
+
+func (w *consensusWAL) close() {
+	if w == nil {
+		return
+	}
+	w.db.Close()
+}
@@ -0,0 +1,75 @@
+package pos33
+
+import (
+	metrics "github.com/rcrowley/go-metrics"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const (
+	// 默认接收比当前高度低 defaultMsgWindowPast 个高度的消息
+	defaultMsgWindowPast = 20
+	// 默认接收比当前高度高 defaultMsgWindowFuture 个高度的消息
+	defaultMsgWindowFuture = pt.Pos33SortBlocks * 2
+)
+
+// msgWindow 抽签, 投票和区块消息的高度必须在 [当前高度-past, 当前高度+future] 里,
+// 太旧或者太远的消息在验证签名之前丢掉, 不会一直缓存
+type msgWindow struct {
+	past   int64
+	future int64
+}
+
+func newMsgWindow(conf *subConfig) *msgWindow {
+	w := &msgWindow{past: conf.MsgWindowPast, future: conf.MsgWindowFuture}
+	if w.past <= 0 {
+		w.past = defaultMsgWindowPast
+	}
+	if w.future <= 0 {
+		w.future = defaultMsgWindowFuture
+	}
+	return w
+}
+
+// accept height 在 cur 的窗口里
+func (w *msgWindow) accept(height, cur int64) bool {
+	if w == nil {
+		return true
+	}
+	if height >= cur-w.past && height <= cur+w.future {
+		return true
+	}
+	metrics.GetOrRegisterCounter("pos33.msg.window.dropped", nil).Inc(1)
+	return false
+}
+
+func sortsHeight(ss []*pt.Pos33SortMsg) int64 {
+	if len(ss) == 0 || ss[0].Proof == nil || ss[0].Proof.Input == nil {
+		return -1
+	}
+	return ss[0].Proof.Input.Height
+}
+
+// filterVoterSorts 去掉窗口外的投票抽签
+func (w *msgWindow) filterVoterSorts(ms []*pt.Pos33Sorts, cur int64) []*pt.Pos33Sorts {
+	r := ms[:0]
+	for _, m := range ms {
+		if m != nil && w.accept(sortsHeight(m.Sorts), cur) {
+			r = append(r, m)
+		}
+	}
+	return r
+}
+
+// filterMakerVotes 去掉窗口外的投票
+func (w *msgWindow) filterMakerVotes(mvs []*pt.Pos33Votes, cur int64) []*pt.Pos33Votes {
+	r := mvs[:0]
+	for _, vs := range mvs {
+		if vs == nil || len(vs.Vs) == 0 || vs.Vs[0].Sort == nil || vs.Vs[0].Sort.Proof == nil || vs.Vs[0].Sort.Proof.Input == nil {
+			continue
+		}
+		if w.accept(voteHeight(vs.Vs[0]), cur) {
+			r = append(r, vs)
+		}
+	}
+	return r
+}
@@ -0,0 +1,95 @@
+package executor
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common/address"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	evm "github.com/33cn/plugin/plugin/dapp/evm/executor"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/state"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	pos33 "github.com/yccproject/ycc/plugin/dapp/pos33/executor"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// evm 执行器, 在 plugin 的 evm 执行器外面设置 pos33 预编译合约需要的执行状态
+
+type subConfig struct {
+	AddressDriver string `json:"addressDriver"`
+}
+
+// Init 和 plugin 的 evm.Init 一样, 只是注册的是 ycc 的驱动
+func Init(name string, cfg *types.Chain33Config, sub []byte) {
+	enableHeight := cfg.GetDappFork(evmtypes.ExecutorName, evmtypes.EVMEnable)
+	initAddressDriver(sub, enableHeight)
+	drivers.Register(cfg, name, newEVMDriver, enableHeight)
+	evm.EvmAddress = address.ExecAddress(cfg.ExecName(name))
+	state.InitForkData()
+	evm.InitExecType()
+}
+
+func initAddressDriver(sub []byte, enableHeight int64) {
+	var subCfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subCfg)
+	}
+	addressType := address.GetDefaultAddressID()
+	if subCfg.AddressDriver != "" {
+		ty, err := address.GetDriverType(subCfg.AddressDriver)
+		if err != nil {
+			panic("GetDriverType:" + err.Error())
+		}
+		addressType = ty
+	}
+	driver, err := address.LoadDriver(addressType, enableHeight)
+	if err != nil {
+		panic(fmt.Sprintf("address driver must enable before %d", enableHeight))
+	}
+	common.InitEvmAddressTypeOnce(driver)
+}
+
+// GetName 返回本合约名称
+func GetName() string {
+	return newEVMDriver().GetName()
+}
+
+// EVMExecutor 查询和执行都在 pos33.EnterEVM 里进行.
+// child 还是 plugin 的执行器, 按方法表分发的调用不经过这里
+type EVMExecutor struct {
+	*evm.EVMExecutor
+}
+
+func newEVMDriver() drivers.Driver {
+	return &EVMExecutor{evm.NewEVMExecutor()}
+}
+
+func (e *EVMExecutor) enter() func() {
+	return pos33.EnterEVM(e.GetAPI().GetConfig(), e.GetStateDB(), e.GetHeight())
+}
+
+// Exec 执行交易
+func (e *EVMExecutor) Exec(tx *types.Transaction, index int) (*types.Receipt, error) {
+	defer e.enter()()
+	return e.EVMExecutor.Exec(tx, index)
+}
+
+// CheckTx 本节点关掉 evm 时交易池不接收.
+// evm 的 localdb 里有合约状态和 eth 交易的 nonce, Exec 要读, 所以关掉以后 ExecLocal 还是照常写
+func (e *EVMExecutor) CheckTx(tx *types.Transaction, index int) error {
+	err := pt.CheckExecEnabled(tx, index)
+	if err != nil {
+		return err
+	}
+	return e.EVMExecutor.CheckTx(tx, index)
+}
+
+// Query 查询, EstimateGas 和 Query 也会执行合约. 本节点关掉 evm 时不回答
+func (e *EVMExecutor) Query(funcname string, params []byte) (types.Message, error) {
+	if pt.ExecNameDisabled(evmtypes.ExecutorName) {
+		return nil, pt.ErrExecDisabled
+	}
+	defer e.enter()()
+	return e.EVMExecutor.Query(funcname, params)
+}
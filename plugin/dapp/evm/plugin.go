@@ -0,0 +1,20 @@
+package evm
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/plugin/plugin/dapp/evm/commands"
+	"github.com/33cn/plugin/plugin/dapp/evm/rpc"
+	"github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/yccproject/ycc/plugin/dapp/evm/executor"
+)
+
+// 替换 plugin 里的 evm 插件, 执行器换成 ycc 的, 命令行和 rpc 不变
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.ExecutorName,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.EvmCmd,
+		RPC:      rpc.Init,
+	})
+}
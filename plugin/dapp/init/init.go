@@ -2,7 +2,6 @@ package init
 
 import (
 	_ "github.com/33cn/plugin/plugin/dapp/autonomy"  //auto gen
-	_ "github.com/33cn/plugin/plugin/dapp/evm"       //auto gen
 	_ "github.com/33cn/plugin/plugin/dapp/evmxgo"    //auto gen
 	_ "github.com/33cn/plugin/plugin/dapp/hashlock"  //auto gen
 	_ "github.com/33cn/plugin/plugin/dapp/multisig"  //auto gen
@@ -11,5 +10,8 @@ import (
 	_ "github.com/33cn/plugin/plugin/dapp/token"     //auto gen
 	_ "github.com/33cn/plugin/plugin/dapp/trade"     //auto gen
 	_ "github.com/33cn/plugin/plugin/dapp/unfreeze"  //auto gen
+	_ "github.com/yccproject/ycc/plugin/dapp/evm"    //auto gen
 	_ "github.com/yccproject/ycc/plugin/dapp/pos33"  //auto gen
+	_ "github.com/yccproject/ycc/plugin/dapp/random" //auto gen
+	_ "github.com/yccproject/ycc/plugin/dapp/stycc"  //auto gen
 )
@@ -0,0 +1,293 @@
+// Package openrpc 生成节点上所有 dapp 接口的 OpenRPC 描述.
+// 执行器的交易(action)和查询(Query_)从注册的执行器反射得到, dapp 自己的 json rpc 方法由 dapp 用 Register 登记,
+// 客户端生成器和 API 浏览器用它发现 ycc 的全部接口
+package openrpc
+
+import (
+	"context"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/33cn/chain33/pluginmgr"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+)
+
+// Version OpenRPC 规范的版本
+const Version = "1.2.6"
+
+// Schema json schema
+type Schema map[string]interface{}
+
+// ContentDescriptor 参数或者返回值
+type ContentDescriptor struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Schema      Schema `json:"schema"`
+}
+
+// Tag 方法的分类, 用 dapp 的名字
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// Method 一个接口
+type Method struct {
+	Name        string               `json:"name"`
+	Summary     string               `json:"summary,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Tags        []*Tag               `json:"tags,omitempty"`
+	Params      []*ContentDescriptor `json:"params"`
+	Result      *ContentDescriptor   `json:"result"`
+}
+
+// Info 文档信息
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// Components 方法引用的类型
+type Components struct {
+	Schemas map[string]Schema `json:"schemas"`
+}
+
+// Document OpenRPC 文档
+type Document struct {
+	OpenRPC    string     `json:"openrpc"`
+	Info       Info       `json:"info"`
+	Methods    []*Method  `json:"methods"`
+	Components Components `json:"components"`
+}
+
+type service struct {
+	name   string
+	jrpc   reflect.Type
+	client reflect.Type
+}
+
+var (
+	mu       sync.Mutex
+	services []*service
+)
+
+var (
+	ctxType    = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errType    = reflect.TypeOf((*error)(nil)).Elem()
+	resultType = reflect.TypeOf((*interface{})(nil))
+	bytesType  = reflect.TypeOf([]byte(nil))
+)
+
+// Register dapp 登记自己的 json rpc 服务. jrpc 的方法是 func(in *T, result *interface{}) error,
+// client 有同名的方法 func(ctx, in *T) (*R, error) 时用 R 作为返回值的类型
+func Register(name string, jrpc, client interface{}) {
+	mu.Lock()
+	defer mu.Unlock()
+	s := &service{name: name, jrpc: reflect.TypeOf(jrpc)}
+	if client != nil {
+		s.client = reflect.TypeOf(client)
+	}
+	services = append(services, s)
+}
+
+type builder struct {
+	doc   *Document
+	names map[reflect.Type]string
+	types map[string]reflect.Type
+}
+
+// Build 生成文档, title 和 version 是节点的名字和版本
+func Build(title, version string) *Document {
+	b := &builder{
+		doc: &Document{
+			OpenRPC:    Version,
+			Info:       Info{Title: title, Version: version},
+			Components: Components{Schemas: make(map[string]Schema)},
+		},
+		names: make(map[reflect.Type]string),
+		types: make(map[string]reflect.Type),
+	}
+	execs := pluginmgr.GetExecList()
+	sort.Strings(execs)
+	for _, exec := range execs {
+		b.addExec(exec)
+	}
+	mu.Lock()
+	ss := append([]*service{}, services...)
+	mu.Unlock()
+	for _, s := range ss {
+		b.addService(s)
+	}
+	return b.doc
+}
+
+// addExec 执行器的交易和查询, 通过 Chain33.CreateTransaction 和 Chain33.Query 调用
+func (b *builder) addExec(exec string) {
+	d, err := drivers.LoadDriver(exec, -1)
+	if err != nil {
+		return
+	}
+	funcs := d.GetFuncMap()
+	tags := []*Tag{{Name: exec}}
+
+	var actions []string
+	if ety := types.LoadExecutorType(exec); ety != nil {
+		for action := range ety.GetTypeMap() {
+			actions = append(actions, action)
+		}
+	}
+	sort.Strings(actions)
+	for _, action := range actions {
+		f, ok := funcs["Exec_"+action]
+		if !ok || f.Type.NumIn() < 2 {
+			continue
+		}
+		b.doc.Methods = append(b.doc.Methods, &Method{
+			Name:        exec + ".CreateTransaction." + action,
+			Summary:     "create " + exec + " " + action + " tx",
+			Description: "call Chain33.CreateTransaction with execer = \"" + exec + "\", actionName = \"" + action + "\"",
+			Tags:        tags,
+			Params:      []*ContentDescriptor{{Name: "payload", Required: true, Schema: b.schema(f.Type.In(1))}},
+			Result:      &ContentDescriptor{Name: "txHex", Schema: Schema{"type": "string"}},
+		})
+	}
+
+	var queries []string
+	for name := range funcs {
+		if strings.HasPrefix(name, "Query_") {
+			queries = append(queries, name)
+		}
+	}
+	sort.Strings(queries)
+	for _, name := range queries {
+		f := funcs[name]
+		if f.Type.NumIn() < 2 {
+			continue
+		}
+		fn := strings.TrimPrefix(name, "Query_")
+		b.doc.Methods = append(b.doc.Methods, &Method{
+			Name:        exec + ".Query." + fn,
+			Description: "call Chain33.Query with execer = \"" + exec + "\", funcName = \"" + fn + "\"",
+			Tags:        tags,
+			Params:      []*ContentDescriptor{{Name: "payload", Required: true, Schema: b.schema(f.Type.In(1))}},
+			Result:      &ContentDescriptor{Name: "result", Schema: Schema{"type": "object"}},
+		})
+	}
+}
+
+// addService dapp 登记的 json rpc 方法
+func (b *builder) addService(s *service) {
+	tags := []*Tag{{Name: s.name}}
+	for i := 0; i < s.jrpc.NumMethod(); i++ {
+		m := s.jrpc.Method(i)
+		t := m.Type
+		if t.NumIn() != 3 || t.In(2) != resultType || t.NumOut() != 1 || t.Out(0) != errType {
+			continue
+		}
+		result := &ContentDescriptor{Name: "result", Schema: Schema{"type": "object"}}
+		if s.client != nil {
+			if cm, ok := s.client.MethodByName(m.Name); ok && cm.Type.NumOut() == 2 && cm.Type.NumIn() == 3 && cm.Type.In(1) == ctxType {
+				result.Schema = b.schema(cm.Type.Out(0))
+			}
+		}
+		b.doc.Methods = append(b.doc.Methods, &Method{
+			Name:   s.name + "." + m.Name,
+			Tags:   tags,
+			Params: []*ContentDescriptor{{Name: "in", Required: true, Schema: b.schema(t.In(1))}},
+			Result: result,
+		})
+	}
+}
+
+// schema 类型的 json schema, 结构体放到 components 里引用
+func (b *builder) schema(t reflect.Type) Schema {
+	if t == bytesType {
+		return Schema{"type": "string", "format": "hex"}
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return b.schema(t.Elem())
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int32, reflect.Uint32, reflect.Int, reflect.Uint:
+		return Schema{"type": "integer", "format": "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return Schema{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return Schema{"type": "array", "items": b.schema(t.Elem())}
+	case reflect.Map:
+		return Schema{"type": "object", "additionalProperties": b.schema(t.Elem())}
+	case reflect.Struct:
+		return b.ref(t)
+	}
+	return Schema{}
+}
+
+// ref 结构体的引用, 不同 dapp 里同名的类型加上包名
+func (b *builder) ref(t reflect.Type) Schema {
+	if name, ok := b.names[t]; ok {
+		return Schema{"$ref": "#/components/schemas/" + name}
+	}
+	name := t.Name()
+	if _, ok := b.types[name]; ok {
+		name = path.Base(t.PkgPath()) + "." + name
+	}
+	props := make(map[string]interface{})
+	s := Schema{"type": "object", "properties": props}
+	if t.Name() != "" {
+		// 先占位, 递归的类型直接引用
+		b.names[t] = name
+		b.types[name] = t
+		b.doc.Components.Schemas[name] = s
+	}
+	var oneofs []interface{}
+	if w, ok := reflect.New(t).Interface().(interface{ XXX_OneofWrappers() []interface{} }); ok {
+		oneofs = w.XXX_OneofWrappers()
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" || strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		if _, ok := f.Tag.Lookup("protobuf_oneof"); ok {
+			// oneof 的每个分支是只有一个字段的结构体
+			var any []interface{}
+			for _, o := range oneofs {
+				ot := reflect.TypeOf(o)
+				if ot.Implements(f.Type) && ot.Elem().NumField() == 1 {
+					of := ot.Elem().Field(0)
+					any = append(any, Schema{"type": "object", "properties": map[string]interface{}{jsonName(of): b.schema(of.Type)}})
+				}
+			}
+			s["oneOf"] = any
+			continue
+		}
+		props[jsonName(f)] = b.schema(f.Type)
+	}
+	if t.Name() == "" {
+		return s
+	}
+	return Schema{"$ref": "#/components/schemas/" + name}
+}
+
+// jsonName 字段在 json 里的名字, oneof 的分支没有 json tag, 用 protobuf tag 的 name
+func jsonName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name != "" && name != "-" {
+		return name
+	}
+	for _, s := range strings.Split(f.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(s, "name=") {
+			return strings.TrimPrefix(s, "name=")
+		}
+	}
+	return f.Name
+}
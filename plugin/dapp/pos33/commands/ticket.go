@@ -5,10 +5,15 @@
 package commands
 
 import (
+	"crypto/tls"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net/rpc/jsonrpc"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
@@ -37,6 +42,60 @@ func Pos33TicketCmd() *cobra.Command {
 		BlsBind(),
 		BlsAddr(),
 		GetMinerList(),
+		WaitTxCmd(),
+		SendTxCmd(),
+		SortAuditCmd(),
+		SetMinerInfoCmd(),
+		GetMinerInfoCmd(),
+		DelegateCmd(),
+		UndelegateCmd(),
+		CommissionCmd(),
+		OperatorCmd(),
+		BaseFeeCmd(),
+		SetCompoundCmd(),
+		GetCompoundCmd(),
+		MinerBindCmd(),
+		GetMinerBindCmd(),
+		BlockSignerCmd(),
+		GetBlockSignerCmd(),
+		OpenTicketsCmd(),
+		CloseTicketsCmd(),
+		ChartCmd(),
+		TopDepositsCmd(),
+		DepositsCmd(),
+		RewardHistoryCmd(),
+		MissedSlotsCmd(),
+		ParticipationCmd(),
+		LivenessCmd(),
+		DryRunCmd(),
+		DiagnosticsCmd(),
+		MinerPauseCmd(),
+		StateUsageCmd(),
+		AddrTxsCmd(),
+		OpenRPCCmd(),
+		AdvisoryCmd(),
+		BannedPeersCmd(),
+		ConsensusStateCmd(),
+		ValidatorStatusCmd(),
+		CommitteeCmd(),
+		SendAdvisoryCmd(),
+		PushCmd(),
+		FaucetCmd(),
+		TenantsCmd(),
+		TraceCmd(),
+		LocatorCmd(),
+		KeyFileCmd(),
+		NodeKeyCmd(),
+		ArchiveCmd(),
+		TransferCmd(),
+		ApproveCmd(),
+		AuditLogCmd(),
+		FinalizedCmd(),
+		NextNonceCmd(),
+		SignerCmd(),
+		PoolCmd(),
+		PoolStatsCmd(),
+		DoctorCmd(),
 	)
 
 	return cmd
@@ -96,153 +155,1686 @@ func getMinerList(cmd *cobra.Command, args []string) {
 	ctx.Run()
 }
 
+func WaitTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "waittx",
+		Short: "wait tx packed into block",
+		Run:   waitTx,
+	}
+	cmd.Flags().StringP("hash", "x", "", "tx hash")
+	cmd.MarkFlagRequired("hash")
+	cmd.Flags().Int64P("timeout", "t", 0, "wait timeout seconds (default 30)")
+	cmd.Flags().BoolP("broadcast", "b", false, "only wait until the tx is in local mempool with connected peers (not a peer acknowledgement)")
+	return cmd
+}
+
+func waitTx(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	hash, _ := cmd.Flags().GetString("hash")
+	timeout, _ := cmd.Flags().GetInt64("timeout")
+	broadcast, _ := cmd.Flags().GetBool("broadcast")
+	var res ty.ReplyPos33TxStatus
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.WaitPos33Tx", &ty.ReqPos33WaitTx{Hash: hash, Timeout: timeout, Broadcast: broadcast}, &res)
+	ctx.Run()
+}
+
+func SendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sendtx",
+		Short: "send signed tx and return after mempool admission",
+		Run:   sendTx,
+	}
+	cmd.Flags().StringP("data", "d", "", "signed tx hex")
+	cmd.MarkFlagRequired("data")
+	return cmd
+}
+
+func sendTx(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	data, _ := cmd.Flags().GetString("data")
+	var res ty.ReplyPos33SendTx
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.SendPos33Tx", &ty.ReqPos33SendTx{Tx: data}, &res)
+	ctx.Run()
+}
+
+func SortAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "get sortition result of height",
+		Run:   sortAudit,
+	}
+	cmd.Flags().Int64P("height", "t", 0, "block height")
+	cmd.MarkFlagRequired("height")
+	return cmd
+}
+
+func sortAudit(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+	var res ty.Pos33SortAudit
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33SortAudit", &types.ReqInt{Height: height}, &res)
+	ctx.Run()
+}
+
+func AdvisoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "advisory",
+		Short: "get network advisories",
+		Run:   getAdvisories,
+	}
+	return cmd
+}
+
+func getAdvisories(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res ty.Pos33Advisories
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33Advisories", &types.ReqNil{}, &res)
+	ctx.Run()
+}
+
+func CommitteeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "committee",
+		Short: "get maker and voters of a block on chain",
+		Run:   getCommittee,
+	}
+	cmd.Flags().Int64P("height", "t", 0, "block height")
+	cmd.MarkFlagRequired("height")
+	return cmd
+}
+
+func getCommittee(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+	var res ty.Pos33Committee
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33Committee", &types.ReqInt{Height: height}, &res)
+	ctx.Run()
+}
+
+func ConsensusStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "get live consensus state of this node (height, round, sortition, votes, timer, last error)",
+		Run:   getConsensusState,
+	}
+	return cmd
+}
+
+func getConsensusState(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res ty.Pos33ConsensusState
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33ConsensusState", &types.ReqNil{}, &res)
+	ctx.Run()
+}
+
+// ValidatorStatusCmd 查询本节点的验证者是否正常
+func ValidatorStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "health",
+		Short: "get whether this node's validators are eligible, synced, hold keys, and when they last made or voted a block",
+		Run:   validatorStatus,
+	}
+	return cmd
+}
+
+func validatorStatus(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res ty.Pos33ValidatorStatuses
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33ValidatorStatus", &types.ReqNil{}, &res)
+	ctx.Run()
+}
+
+func BannedPeersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "banned",
+		Short: "get consensus msg senders banned by this node",
+		Run:   getBannedPeers,
+	}
+	return cmd
+}
+
+func getBannedPeers(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res ty.Pos33BannedPeers
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33BannedPeers", &types.ReqNil{}, &res)
+	ctx.Run()
+}
+
+func SendAdvisoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "advise",
+		Short: "sign and broadcast a network advisory (super manager only)",
+		Run:   sendAdvisory,
+	}
+	cmd.Flags().StringP("msg", "m", "", "advisory message")
+	cmd.Flags().Int64P("height", "t", 0, "related block height")
+	cmd.Flags().Int64P("expire", "e", 0, "expire time (unix seconds), 0 never expire")
+	cmd.Flags().StringP("key", "k", "", "super manager private key")
+	cmd.MarkFlagRequired("msg")
+	cmd.MarkFlagRequired("key")
+	return cmd
+}
+
+func sendAdvisory(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	msg, _ := cmd.Flags().GetString("msg")
+	height, _ := cmd.Flags().GetInt64("height")
+	expire, _ := cmd.Flags().GetInt64("expire")
+	key, _ := cmd.Flags().GetString("key")
+
+	m := &ty.Pos33Advisory{Message: msg, Height: height, Time: time.Now().Unix(), Expire: expire}
+	m.Sign(HexToPrivkey(key))
+	var res types.Reply
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.SendPos33Advisory", m, &res)
+	ctx.Run()
+}
+
+func PushCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "push",
+		Short: "register or remove a device for push notifications of the address",
+		Run:   push,
+	}
+	cmd.Flags().StringP("token", "t", "", "device token")
+	cmd.Flags().StringP("platform", "p", "fcm", "fcm or apns")
+	cmd.Flags().BoolP("remove", "d", false, "remove the device")
+	cmd.Flags().StringP("key", "k", "", "private key of the address")
+	cmd.MarkFlagRequired("token")
+	cmd.MarkFlagRequired("key")
+	return cmd
+}
+
+func push(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	token, _ := cmd.Flags().GetString("token")
+	platform, _ := cmd.Flags().GetString("platform")
+	remove, _ := cmd.Flags().GetBool("remove")
+	key, _ := cmd.Flags().GetString("key")
+
+	priv := HexToPrivkey(key)
+	m := &ty.Pos33PushDevice{
+		Addr:     address.PubKeyToAddr(ty.EthAddrID, priv.PubKey().Bytes()),
+		Token:    token,
+		Platform: platform,
+		Time:     time.Now().Unix(),
+		Remove:   remove,
+	}
+	m.Sign(priv)
+	var res types.Reply
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.RegisterPos33Push", m, &res)
+	ctx.Run()
+}
+
+func FaucetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "faucet",
+		Short: "get test coins from the faucet (test network only)",
+		Run:   faucet,
+	}
+	cmd.Flags().StringP("addr", "a", "", "address to receive coins")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func faucet(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+
+	var res types.ReplyHash
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.Pos33Faucet", &types.ReqAddr{Addr: addr}, &res)
+	ctx.Run()
+}
+
+func TenantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tenants",
+		Short: "get usage of rpc tenants (admin only)",
+		Run:   tenants,
+	}
+	cmd.Flags().StringP("token", "t", "", "admin token")
+	cmd.MarkFlagRequired("token")
+	cmd.Flags().StringP("name", "n", "", "tenant name, empty for all")
+	return cmd
+}
+
+func tenants(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	token, _ := cmd.Flags().GetString("token")
+	name, _ := cmd.Flags().GetString("name")
+
+	var res ty.Pos33TenantUsages
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33TenantUsage", &ty.ReqPos33TenantUsage{AdminToken: token, Name: name}, &res)
+	ctx.Run()
+}
+
+func TraceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace",
+		Short: "send signed tx with a trace id, or query tx status by the trace id",
+		Run:   trace,
+	}
+	cmd.Flags().StringP("id", "i", "", "trace id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringP("data", "d", "", "signed tx hex, empty to query")
+	return cmd
+}
+
+func trace(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	id, _ := cmd.Flags().GetString("id")
+	data, _ := cmd.Flags().GetString("data")
+
+	if data != "" {
+		var res string
+		ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.SendPos33TracedTx", &ty.ReqPos33TracedTx{TraceId: id, Data: data}, &res)
+		ctx.Run()
+		return
+	}
+	var res ty.Pos33TxTrace
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33TxTrace", &types.ReqString{Data: id}, &res)
+	ctx.Run()
+}
+
+func LocatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locator",
+		Short: "get headers after the highest main chain block in the locator",
+		Run:   locator,
+	}
+	cmd.Flags().StringP("hashes", "l", "", "known block hashes, newest first, separated by ','")
+	cmd.Flags().Int32P("count", "c", 0, "max headers, 0 for default 500")
+	cmd.Flags().StringP("stop", "s", "", "stop hash")
+	return cmd
+}
+
+func locator(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	hashes, _ := cmd.Flags().GetString("hashes")
+	count, _ := cmd.Flags().GetInt32("count")
+	stop, _ := cmd.Flags().GetString("stop")
+
+	req := &ty.ReqPos33Locator{Count: count, Stop: stop}
+	if hashes != "" {
+		req.Hashes = strings.Split(hashes, ",")
+	}
+	var res interface{}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetHeadersLocator", req, &res)
+	ctx.Run()
+}
+
+// KeyFileCmd 生成加密的挖矿私钥文件, 配合 consensus.sub.pos33 的 keyFile 使用
+func KeyFileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keyfile",
+		Short: "create encrypted miner key file for headless mining",
+		Run:   keyFile,
+	}
+	cmd.Flags().StringP("key", "k", "", "miner private key")
+	cmd.Flags().StringP("out", "o", "pos33.key", "key file path")
+	cmd.Flags().StringP("env", "e", ty.DefaultKeyPasswordEnv, "environment variable of the password")
+	cmd.MarkFlagRequired("key")
+	return cmd
+}
+
+// NodeKeyCmd 生成或者轮换节点的网络身份私钥, 配合 consensus.sub.pos33 的 nodeKeyFile 使用, 重启节点后生效
+func NodeKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nodekey",
+		Short: "generate or rotate the node network identity key, restart the node to use it",
+		Run:   nodeKey,
+	}
+	cmd.Flags().StringP("file", "f", "pos33node.key", "node key file path, the old key is renamed to file.old")
+	return cmd
+}
+
+func nodeKey(cmd *cobra.Command, args []string) {
+	file, _ := cmd.Flags().GetString("file")
+	priv, err := ty.NewNodeKey(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(file, common.ToHex(priv.PubKey().Bytes()))
+}
+
+// ArchiveCmd 读取共识消息存档 (consensus.sub.pos33 的 archiveDir 下面的文件), 每条消息输出一行 json
+func ArchiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive",
+		Short: "print the consensus msgs in the archive file, one json per line",
+		Run:   archive,
+	}
+	cmd.Flags().StringP("file", "f", "", "archive file path")
+	cmd.Flags().StringP("type", "t", "", "only print this msg type, MS, VS, MV, B ...")
+	cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+type archivedVote struct {
+	Addr   string `json:"addr"`
+	Height int64  `json:"height"`
+	Round  int32  `json:"round"`
+	Hash   string `json:"hash,omitempty"`
+}
+
+type archivedMsg struct {
+	Time   string          `json:"time"`
+	Height int64           `json:"height"`
+	Type   string          `json:"type"`
+	Size   int             `json:"size"`
+	Msgs   []*archivedVote `json:"msgs,omitempty"`
+}
+
+func archivedSort(m *ty.Pos33SortMsg) *archivedVote {
+	in := m.GetProof().GetInput()
+	return &archivedVote{
+		Addr:   address.PubKeyToAddr(ty.EthAddrID, m.GetProof().GetPubkey()),
+		Height: in.GetHeight(),
+		Round:  in.GetRound(),
+	}
+}
+
+// archivedVotes 解出消息里每个抽签或者投票的地址, 高度和轮次
+func archivedVotes(pm *ty.Pos33Msg) []*archivedVote {
+	var vs []*archivedVote
+	switch pm.Ty {
+	case ty.Pos33Msg_MS:
+		var m ty.Pos33SortMsg
+		if types.Decode(pm.Data, &m) == nil {
+			vs = append(vs, archivedSort(&m))
+		}
+	case ty.Pos33Msg_VS:
+		var m ty.Pos33VoteSorts
+		if types.Decode(pm.Data, &m) == nil {
+			for _, ss := range m.VoteSorts {
+				for _, s := range ss.Sorts {
+					vs = append(vs, archivedSort(s))
+				}
+			}
+		}
+	case ty.Pos33Msg_MV:
+		var m ty.Pos33MakerVotes
+		if types.Decode(pm.Data, &m) == nil {
+			for _, mv := range m.Mvs {
+				for _, v := range mv.Vs {
+					vs = append(vs, &archivedVote{
+						Addr:   address.PubKeyToAddr(ty.EthAddrID, v.GetSig().GetPubkey()),
+						Height: v.Height,
+						Round:  v.Round,
+						Hash:   common.ToHex(v.Hash),
+					})
+				}
+			}
+		}
+	case ty.Pos33Msg_B:
+		var m ty.Pos33BlockMsg
+		if len(pm.Data) > 0 && types.Decode(pm.Data, &m) == nil && m.B != nil {
+			vs = append(vs, &archivedVote{Height: m.B.Height, Hash: common.ToHex(m.B.HashNew())})
+		}
+	}
+	return vs
+}
+
+func archive(cmd *cobra.Command, args []string) {
+	file, _ := cmd.Flags().GetString("file")
+	tyname, _ := cmd.Flags().GetString("type")
+	f, err := os.Open(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	defer f.Close()
+	err = ty.ReadArchivedMsgs(f, func(am *ty.Pos33ArchivedMsg) error {
+		pm := am.GetMsg()
+		if pm == nil || (tyname != "" && pm.Ty.String() != tyname) {
+			return nil
+		}
+		out := &archivedMsg{
+			Time:   time.Unix(0, am.Time*int64(time.Millisecond)).Format("2006-01-02T15:04:05.000Z07:00"),
+			Height: am.Height,
+			Type:   pm.Ty.String(),
+			Size:   len(pm.Data),
+			Msgs:   archivedVotes(pm),
+		}
+		data, err := json.Marshal(out)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+// SignerCmd 启动远程签名服务, 配合 consensus.sub.pos33 的 remoteSigner 使用
+func SignerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer",
+		Short: "run remote signer with the encrypted miner key file",
+		Run:   signer,
+	}
+	cmd.Flags().StringP("file", "f", "pos33.key", "key file path")
+	cmd.Flags().StringP("listen", "l", "unix:///tmp/pos33signer.sock", "listen address, unix:///path or tls://host:port")
+	cmd.Flags().StringP("env", "e", ty.DefaultKeyPasswordEnv, "environment variable of the password")
+	cmd.Flags().StringP("token", "t", "", "token the node must send, at least 16 chars")
+	cmd.MarkFlagRequired("token")
+	cmd.Flags().String("cert", "", "tls certificate file for tls://")
+	cmd.Flags().String("key", "", "tls key file for tls://")
+	cmd.Flags().String("hwm", "", "file of the highest signed heights, default key file path + .hwm")
+	return cmd
+}
+
+func signer(cmd *cobra.Command, args []string) {
+	file, _ := cmd.Flags().GetString("file")
+	listen, _ := cmd.Flags().GetString("listen")
+	env, _ := cmd.Flags().GetString("env")
+	token, _ := cmd.Flags().GetString("token")
+	hwm, _ := cmd.Flags().GetString("hwm")
+	if hwm == "" {
+		hwm = file + ".hwm"
+	}
+	conf, ok := listenTLS(cmd, listen)
+	if !ok {
+		return
+	}
+
+	password := os.Getenv(env)
+	if password == "" {
+		fmt.Fprintln(os.Stderr, "password NOT set, export "+env)
+		return
+	}
+	os.Unsetenv(env)
+	kf, err := ty.ReadKeyFile(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	priv, err := kf.Decrypt(password)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	ss, err := ty.NewSignerService(priv, token, hwm)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println("signer", kf.Addr, "listen on", listen)
+	err = ty.ServeSigner(ss, listen, conf)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+// PoolCmd 启动矿池服务, worker 节点配置 consensus.sub.pos33 的 pool 和 poolToken
+func PoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool",
+		Short: "run mining pool with the encrypted entrusted miner key file",
+		Run:   pool,
+	}
+	cmd.Flags().StringP("file", "f", "pos33.key", "key file path")
+	cmd.Flags().StringP("listen", "l", "tcp://0.0.0.0:9911", "listen address, unix:///path, tls://host:port or tcp://host:port")
+	cmd.Flags().StringP("env", "e", ty.DefaultKeyPasswordEnv, "environment variable of the password")
+	cmd.Flags().StringP("workers", "w", "", "workers, name:token separated by ','")
+	cmd.MarkFlagRequired("workers")
+	cmd.Flags().StringP("admin", "a", "", "admin token for pool stats, empty for none")
+	cmd.Flags().String("cert", "", "tls certificate file for tls://")
+	cmd.Flags().String("key", "", "tls key file for tls://")
+	return cmd
+}
+
+// listenTLS tls:// 监听时加载 --cert 和 --key
+func listenTLS(cmd *cobra.Command, listen string) (*tls.Config, bool) {
+	if network, _ := ty.SignerNetAddr(listen); network != "tls" {
+		return nil, true
+	}
+	cert, _ := cmd.Flags().GetString("cert")
+	key, _ := cmd.Flags().GetString("key")
+	conf, err := ty.ServerTLS(cert, key)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return nil, false
+	}
+	return conf, true
+}
+
+func pool(cmd *cobra.Command, args []string) {
+	file, _ := cmd.Flags().GetString("file")
+	listen, _ := cmd.Flags().GetString("listen")
+	env, _ := cmd.Flags().GetString("env")
+	ws, _ := cmd.Flags().GetString("workers")
+	admin, _ := cmd.Flags().GetString("admin")
+	conf, ok := listenTLS(cmd, listen)
+	if !ok {
+		return
+	}
+
+	workers := make(map[string]string)
+	for _, w := range strings.Split(ws, ",") {
+		kv := strings.SplitN(w, ":", 2)
+		if len(kv) != 2 || kv[0] == "" || len(kv[1]) < 16 {
+			fmt.Fprintln(os.Stderr, "worker must be name:token, token at least 16 chars:", w)
+			return
+		}
+		workers[kv[1]] = kv[0]
+	}
+	password := os.Getenv(env)
+	if password == "" {
+		fmt.Fprintln(os.Stderr, "password NOT set, export "+env)
+		return
+	}
+	os.Unsetenv(env)
+	kf, err := ty.ReadKeyFile(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	priv, err := kf.Decrypt(password)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println("pool", kf.Addr, "workers", len(workers), "listen on", listen)
+	err = ty.ServePool(ty.NewPoolService(priv, workers, admin), listen, conf)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+func PoolStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "poolstats",
+		Short: "get worker stats of the mining pool",
+		Run:   poolStats,
+	}
+	cmd.Flags().StringP("pool", "p", "tcp://127.0.0.1:9911", "pool address")
+	cmd.Flags().StringP("admin", "a", "", "admin token")
+	cmd.MarkFlagRequired("admin")
+	return cmd
+}
+
+func poolStats(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("pool")
+	admin, _ := cmd.Flags().GetString("admin")
+
+	network, laddr := ty.SignerNetAddr(addr)
+	cli, err := jsonrpc.Dial(network, laddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	defer cli.Close()
+	var res ty.PoolStats
+	err = cli.Call(ty.PoolServiceName+".Stats", &ty.SignerArgs{Token: admin}, &res)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	data, _ := json.MarshalIndent(&res, "", "    ")
+	fmt.Println(string(data))
+}
+
+func keyFile(cmd *cobra.Command, args []string) {
+	key, _ := cmd.Flags().GetString("key")
+	out, _ := cmd.Flags().GetString("out")
+	env, _ := cmd.Flags().GetString("env")
+
+	password := os.Getenv(env)
+	if password == "" {
+		fmt.Fprintln(os.Stderr, "password NOT set, export "+env)
+		return
+	}
+	kf, err := ty.EncryptKeyFile(HexToPrivkey(key), password)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	data, err := json.MarshalIndent(kf, "", "  ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	err = ioutil.WriteFile(out, data, 0600)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(out)
+}
+
+// TransferCmd 按照钱包的 spend policy 转账
+func TransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer",
+		Short: "transfer from wallet, checked by wallet spend policy",
+		Run:   transfer,
+	}
+	cmd.Flags().StringP("from", "f", "", "from address in wallet")
+	cmd.Flags().StringP("to", "t", "", "to address")
+	cmd.Flags().Float64P("amount", "a", 0, "amount")
+	cmd.Flags().StringP("note", "n", "", "note")
+	cmd.MarkFlagRequired("from")
+	cmd.MarkFlagRequired("to")
+	cmd.MarkFlagRequired("amount")
+	return cmd
+}
+
+func transfer(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	note, _ := cmd.Flags().GetString("note")
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
+	}
+
+	req := &ty.ReqPos33Transfer{From: from, To: to, Amount: int64(amount * float64(cfg.CoinPrecision)), Note: note}
+	var res ty.ReplyPos33Transfer
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.Pos33Transfer", req, &res)
+	ctx.Run()
+}
+
+// ApproveCmd 审批人确认大额转账
+func ApproveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve",
+		Short: "approve a pending transfer (approver only)",
+		Run:   approve,
+	}
+	cmd.Flags().StringP("id", "i", "", "pending transfer id")
+	cmd.Flags().StringP("key", "k", "", "approver private key")
+	cmd.MarkFlagRequired("id")
+	cmd.MarkFlagRequired("key")
+	return cmd
+}
+
+func approve(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	id, _ := cmd.Flags().GetString("id")
+	key, _ := cmd.Flags().GetString("key")
+
+	req := &ty.ReqPos33Approve{PendingId: id}
+	req.Sign(HexToPrivkey(key))
+	var res ty.ReplyPos33Transfer
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.Pos33ApproveTransfer", req, &res)
+	ctx.Run()
+}
+
+// AuditLogCmd 导出钱包的审计日志
+func AuditLogCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auditlog",
+		Short: "export wallet audit log (signing and admin operations)",
+		Run:   auditLog,
+	}
+	cmd.Flags().Int64P("start", "s", 0, "start index")
+	cmd.Flags().Int32P("count", "c", 100, "count")
+	return cmd
+}
+
+func auditLog(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	start, _ := cmd.Flags().GetInt64("start")
+	count, _ := cmd.Flags().GetInt32("count")
+
+	var res ty.Pos33AuditEntries
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33AuditLog", &ty.ReqPos33AuditLog{Start: start, Count: count}, &res)
+	ctx.Run()
+}
+
+// FinalizedCmd 查询最近的 finalized checkpoint
+func FinalizedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalized",
+		Short: "get the latest finalized checkpoint",
+		Run:   finalized,
+	}
+	return cmd
+}
+
+func finalized(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res ty.Pos33Checkpoint
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33Finalized", &types.ReqNil{}, &res)
+	ctx.Run()
+}
+
+// NextNonceCmd 查询账户的下一个 nonce
+func NextNonceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nonce",
+		Short: "get the next nonce of the account, 0 means the account has not used nonce yet",
+		Run:   nextNonce,
+	}
+	cmd.Flags().StringP("addr", "a", "", "account address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func nextNonce(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	var res types.Int64
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33NextNonce", &types.ReqString{Data: addr}, &res)
+	ctx.Run()
+}
+
+// DoctorCmd 解释日志里的共识错误, 不需要连接节点
+func DoctorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "explain consensus errors in the log, list all errors if no error given",
+		Run:   doctor,
+	}
+	cmd.Flags().StringP("error", "e", "", "error id (POS33-E101) or the error message in the log")
+	cmd.Flags().StringP("lang", "l", "en", "language, en or zh")
+	return cmd
+}
+
+func doctor(cmd *cobra.Command, args []string) {
+	msg, _ := cmd.Flags().GetString("error")
+	lang, _ := cmd.Flags().GetString("lang")
+
+	if msg == "" {
+		for _, e := range ty.ErrCatalog() {
+			fmt.Printf("%s  %s\n", e.ID, errText(e, lang))
+		}
+		return
+	}
+	e := ty.LookupErr(msg)
+	if e == nil {
+		fmt.Fprintln(os.Stderr, "unknown error, run 'pos33 doctor' to list all")
+		return
+	}
+	fmt.Printf("%s  %s\n\n%s\n", e.ID, errText(e, lang), e.Hint(lang))
+}
+
+// errText 不带参数的错误信息格式
+func errText(e *ty.ErrEntry, lang string) string {
+	if lang == "zh" {
+		return e.ZH
+	}
+	return e.EN
+}
+
 func GetPos33Info() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "info",
-		Short: "get pos33 info : ticket price and all ticket count",
-		Run:   getPos33Info,
+		Use:   "info",
+		Short: "get pos33 info : ticket price and all ticket count",
+		Run:   getPos33Info,
+	}
+	return cmd
+}
+
+type Pos33Info struct {
+	DappAddress    string
+	TicketPrice    string
+	AllTicketCount int64
+}
+
+func parsePos33Info(arg ...interface{}) (interface{}, error) {
+	res := arg[0].(*ty.ReplyPos33Info)
+	cfg := arg[1].(*rpctypes.ChainConfigInfo)
+	result := new(Pos33Info)
+	result.TicketPrice = types.FormatAmount2FloatDisplay(res.Price, cfg.CoinPrecision, false)
+	result.AllTicketCount = res.AllCount
+	dappAddr, err := address.GetExecAddress(ty.Pos33TicketX, 2)
+	if err != nil {
+		return nil, err
+	}
+	result.DappAddress = dappAddr
+	return result, nil
+}
+
+func getPos33Info(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+
+	var res ty.ReplyPos33Info
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33Info", &types.ReqNil{}, &res)
+	ctx.SetResultCbExt(parsePos33Info)
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	ctx.RunExt(cfg)
+}
+
+func SetFeeRateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee",
+		Short: "set miner fee",
+		Run:   setMinerFeeRate,
+	}
+	addSetMinerFeeRateFlags(cmd)
+	return cmd
+}
+
+func addSetMinerFeeRateFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("addr", "a", "", "address for miner")
+	cmd.Flags().Int32P("fee", "f", 10, "miner entrust mine fee rate persent (default 10% if nil)")
+	cmd.MarkFlagRequired("fee")
+}
+
+func setMinerFeeRate(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	fee, _ := cmd.Flags().GetInt32("fee")
+
+	fr := &ty.Pos33MinerFeeRate{FeeRatePersent: fee}
+	rpcCall(rpcLaddr, "pos33.SetMinerFeeRate", fr)
+}
+
+func BlsBind() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blsbind",
+		Short: "bls bind opration",
+		Run:   blsBind,
+	}
+	return cmd
+}
+
+func blsBind(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	rpcCall(rpcLaddr, "pos33.BlsBind", &types.ReqNil{})
+}
+
+func Migrate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate ",
+		Short: "migrate opration",
+		Run:   migrate,
+	}
+	return cmd
+}
+
+func migrate(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	rpcCall(rpcLaddr, "pos33.Pos33Migrate", &types.ReqNil{})
+}
+
+func SetEntrustCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entrust",
+		Short: "set entrust opration",
+		Run:   setEntrust,
+	}
+	addSetEntrustFlags(cmd)
+	return cmd
+}
+
+func addSetEntrustFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("consignor", "r", "", "address for consignor")
+	cmd.Flags().StringP("consignee", "e", "", "address for consignee")
+	cmd.Flags().IntP("amount", "a", 1000, "amount of entrust, if < 0, unentrust")
+	cmd.MarkFlagRequired("consignor")
+	cmd.MarkFlagRequired("consignee")
+	cmd.MarkFlagRequired("amount")
+}
+
+func setEntrust(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
+	}
+
+	consignor, _ := cmd.Flags().GetString("consignor")
+	consignee, _ := cmd.Flags().GetString("consignee")
+	amount, _ := cmd.Flags().GetInt("amount")
+	realAmount := cfg.CoinPrecision * int64(amount)
+
+	entrust := &ty.Pos33Entrust{
+		Consignor: consignor,
+		Consignee: consignee,
+		Amount:    realAmount,
+	}
+	act := &ty.Pos33TicketAction{
+		Ty:    ty.Pos33ActionEntrust,
+		Value: &ty.Pos33TicketAction_Entrust{Entrust: entrust},
+	}
+
+	rawTx := &types.Transaction{Payload: types.Encode(act)}
+	tx, err := types.FormatTxExt(cfg.ChainID, len(paraName) > 0, cfg.MinTxFeeRate, ty.Pos33TicketX, rawTx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	txHex := types.Encode(tx)
+	fmt.Println(hex.EncodeToString(txHex))
+}
+
+func SetMinerInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "setinfo",
+		Short: "set miner name, website and contact",
+		Run:   setMinerInfo,
+	}
+	cmd.Flags().StringP("name", "n", "", "miner name")
+	cmd.Flags().StringP("website", "w", "", "miner website")
+	cmd.Flags().StringP("contact", "c", "", "hash of miner contact (hex)")
+	cmd.Flags().Float64P("fee", "f", 1, "tx fee")
+	cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+func setMinerInfo(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	name, _ := cmd.Flags().GetString("name")
+	website, _ := cmd.Flags().GetString("website")
+	contact, _ := cmd.Flags().GetString("contact")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
+	}
+	contactHash, err := common.FromHex(contact)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	act := &ty.Pos33TicketAction{
+		Ty:    ty.Pos33ActionMinerInfo,
+		Value: &ty.Pos33TicketAction_MinerInfo{MinerInfo: &ty.Pos33MinerInfo{Name: name, Website: website, ContactHash: contactHash}},
+	}
+	rawTx := &types.Transaction{Payload: types.Encode(act), Fee: int64(fee * float64(cfg.CoinPrecision))}
+	tx, err := types.FormatTxExt(cfg.ChainID, len(paraName) > 0, cfg.MinTxFeeRate, ty.Pos33TicketX, rawTx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	txHex := types.Encode(tx)
+	fmt.Println(hex.EncodeToString(txHex))
+}
+
+func GetMinerInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "get miner name, website and contact",
+		Run:   getMinerInfo,
+	}
+	cmd.Flags().StringP("addr", "a", "", "miner address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func getMinerInfo(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	var res ty.Pos33MinerInfo
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33MinerInfo", &types.ReqAddr{Addr: addr}, &res)
+	ctx.Run()
+}
+
+// DelegateCmd 委托给运营者
+func DelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate",
+		Short: "delegate coins to an operator",
+		Run:   delegate,
+	}
+	addDelegateFlags(cmd)
+	return cmd
+}
+
+// UndelegateCmd 取消委托给运营者的币
+func UndelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate",
+		Short: "undelegate coins from an operator",
+		Run:   undelegate,
+	}
+	addDelegateFlags(cmd)
+	return cmd
+}
+
+func addDelegateFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("operator", "o", "", "operator address")
+	cmd.Flags().IntP("amount", "a", 1000, "amount of coins")
+	cmd.Flags().Float64P("fee", "f", 0.01, "tx fee")
+	cmd.MarkFlagRequired("operator")
+	cmd.MarkFlagRequired("amount")
+}
+
+func delegate(cmd *cobra.Command, args []string) {
+	delegateTx(cmd, false)
+}
+
+func undelegate(cmd *cobra.Command, args []string) {
+	delegateTx(cmd, true)
+}
+
+func delegateTx(cmd *cobra.Command, undelegate bool) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	operator, _ := cmd.Flags().GetString("operator")
+	amount, _ := cmd.Flags().GetInt("amount")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
+	}
+	realAmount := cfg.CoinPrecision * int64(amount)
+	act := &ty.Pos33TicketAction{
+		Ty:    ty.Pos33ActionDelegate,
+		Value: &ty.Pos33TicketAction_Delegate{Delegate: &ty.Pos33Delegate{Operator: operator, Amount: realAmount}},
+	}
+	if undelegate {
+		act = &ty.Pos33TicketAction{
+			Ty:    ty.Pos33ActionUndelegate,
+			Value: &ty.Pos33TicketAction_Undelegate{Undelegate: &ty.Pos33Undelegate{Operator: operator, Amount: realAmount}},
+		}
+	}
+	createPos33Tx(cmd, cfg, act, fee)
+}
+
+// CommissionCmd 登记成为运营者并设置佣金
+func CommissionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commission",
+		Short: "register as operator and set commission percent",
+		Run:   setCommission,
+	}
+	cmd.Flags().Int64P("commission", "c", 5, "commission percent of rewards, 0 - 99")
+	cmd.Flags().Float64P("fee", "f", 0.01, "tx fee")
+	cmd.MarkFlagRequired("commission")
+	return cmd
+}
+
+func setCommission(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	commission, _ := cmd.Flags().GetInt64("commission")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
+	}
+	act := &ty.Pos33TicketAction{
+		Ty:    ty.Pos33ActionSetCommission,
+		Value: &ty.Pos33TicketAction_SetCommission{SetCommission: &ty.Pos33SetCommission{Commission: commission}},
+	}
+	createPos33Tx(cmd, cfg, act, fee)
+}
+
+func createPos33Tx(cmd *cobra.Command, cfg *rpctypes.ChainConfigInfo, act *ty.Pos33TicketAction, fee float64) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	rawTx := &types.Transaction{Payload: types.Encode(act), Fee: int64(fee * float64(cfg.CoinPrecision))}
+	tx, err := types.FormatTxExt(cfg.ChainID, len(paraName) > 0, cfg.MinTxFeeRate, ty.Pos33TicketX, rawTx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(hex.EncodeToString(types.Encode(tx)))
+}
+
+// OperatorCmd 查询运营者的佣金
+func OperatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operator",
+		Short: "get delegation operator and its commission",
+		Run:   getOperator,
+	}
+	cmd.Flags().StringP("addr", "a", "", "operator address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func getOperator(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	var res ty.Pos33Operator
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33Operator", &types.ReqAddr{Addr: addr}, &res)
+	ctx.Run()
+}
+
+// SetCompoundCmd 打开或者关闭奖励自动复投
+func SetCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compound",
+		Short: "turn on or off auto compounding of rewards into tickets",
+		Run:   setCompound,
+	}
+	cmd.Flags().BoolP("off", "o", false, "turn off auto compounding")
+	cmd.Flags().Float64P("fee", "f", 0.01, "tx fee")
+	return cmd
+}
+
+func setCompound(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	off, _ := cmd.Flags().GetBool("off")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
+	}
+	act := &ty.Pos33TicketAction{
+		Ty:    ty.Pos33ActionSetCompound,
+		Value: &ty.Pos33TicketAction_SetCompound{SetCompound: &ty.Pos33SetCompound{On: !off}},
+	}
+	createPos33Tx(cmd, cfg, act, fee)
+}
+
+// OpenTicketsCmd 一个交易打开多张票
+func OpenTicketsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open",
+		Short: "open tickets in one tx",
+		Run:   openTickets,
 	}
+	cmd.Flags().Int64P("count", "n", 0, "count of tickets")
+	cmd.Flags().StringP("consignee", "e", "", "address of the miner, empty for yourself")
+	cmd.Flags().Float64P("fee", "f", 0.01, "tx fee")
+	cmd.MarkFlagRequired("count")
 	return cmd
 }
 
-type Pos33Info struct {
-	DappAddress    string
-	TicketPrice    string
-	AllTicketCount int64
+func openTickets(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	count, _ := cmd.Flags().GetInt64("count")
+	consignee, _ := cmd.Flags().GetString("consignee")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
+	}
+	act := &ty.Pos33TicketAction{
+		Ty:    ty.Pos33ActionOpenTickets,
+		Value: &ty.Pos33TicketAction_OpenTickets{OpenTickets: &ty.Pos33OpenTickets{Consignee: consignee, Count: count}},
+	}
+	createPos33Tx(cmd, cfg, act, fee)
 }
 
-func parsePos33Info(arg ...interface{}) (interface{}, error) {
-	res := arg[0].(*ty.ReplyPos33Info)
-	cfg := arg[1].(*rpctypes.ChainConfigInfo)
-	result := new(Pos33Info)
-	result.TicketPrice = types.FormatAmount2FloatDisplay(res.Price, cfg.CoinPrecision, false)
-	result.AllTicketCount = res.AllCount
-	dappAddr, err := address.GetExecAddress(ty.Pos33TicketX, 2)
+// CloseTicketsCmd 一个交易关闭多个矿工的委托
+func CloseTicketsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close",
+		Short: "close tickets of some or all miners in one tx",
+		Run:   closeTickets,
+	}
+	cmd.Flags().Int64P("count", "n", 0, "count of tickets to close, 0 for all")
+	cmd.Flags().StringP("consignees", "e", "", "addresses of the miners separated by ',', empty for all")
+	cmd.Flags().Float64P("fee", "f", 0.01, "tx fee")
+	return cmd
+}
+
+func closeTickets(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	count, _ := cmd.Flags().GetInt64("count")
+	consignees, _ := cmd.Flags().GetString("consignees")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
 	if err != nil {
-		return nil, err
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
 	}
-	result.DappAddress = dappAddr
-	return result, nil
+	ct := &ty.Pos33CloseTickets{Count: count}
+	if consignees != "" {
+		ct.Consignees = strings.Split(consignees, ",")
+	}
+	act := &ty.Pos33TicketAction{
+		Ty:    ty.Pos33ActionCloseTickets,
+		Value: &ty.Pos33TicketAction_CloseTickets{CloseTickets: ct},
+	}
+	createPos33Tx(cmd, cfg, act, fee)
 }
 
-func getPos33Info(cmd *cobra.Command, args []string) {
+// GetCompoundCmd 查询自动复投的设置
+func GetCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compoundinfo",
+		Short: "get auto compounding setting of the address",
+		Run:   getCompound,
+	}
+	cmd.Flags().StringP("addr", "a", "", "address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func getCompound(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	var res ty.Pos33Compound
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33Compound", &types.ReqAddr{Addr: addr}, &res)
+	ctx.Run()
+}
+
+// MinerBindCmd 冷地址绑定热的挖矿地址, 交易由冷地址签名
+func MinerBindCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bind",
+		Short: "bind a hot miner address to the cold address holding the tickets",
+		Run:   minerBind,
+	}
+	cmd.Flags().StringP("return", "r", "", "cold address holding the tickets, signs the tx")
+	cmd.MarkFlagRequired("return")
+	cmd.Flags().StringP("miner", "m", "", "hot miner address, empty to unbind")
+	cmd.Flags().Float64P("fee", "f", 0.01, "tx fee")
+	return cmd
+}
+
+func minerBind(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	raddr, _ := cmd.Flags().GetString("return")
+	maddr, _ := cmd.Flags().GetString("miner")
+	fee, _ := cmd.Flags().GetFloat64("fee")
 
-	var res ty.ReplyPos33Info
-	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33Info", &types.ReqNil{}, &res)
-	ctx.SetResultCbExt(parsePos33Info)
 	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
 	if err != nil {
-		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
 		return
 	}
-	ctx.RunExt(cfg)
+	act := &ty.Pos33TicketAction{
+		Ty:    ty.Pos33TicketActionBind,
+		Value: &ty.Pos33TicketAction_MinerBind{MinerBind: &ty.Pos33TicketBind{MinerAddress: maddr, ReturnAddress: raddr}},
+	}
+	createPos33Tx(cmd, cfg, act, fee)
 }
 
-func SetFeeRateCmd() *cobra.Command {
+// GetMinerBindCmd 查询地址所在的冷热绑定
+func GetMinerBindCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "fee",
-		Short: "set miner fee",
-		Run:   setMinerFeeRate,
+		Use:   "bindinfo",
+		Short: "get the miner bind which the address is in",
+		Run:   getMinerBind,
 	}
-	addSetMinerFeeRateFlags(cmd)
+	cmd.Flags().StringP("addr", "a", "", "miner or return address")
+	cmd.MarkFlagRequired("addr")
 	return cmd
 }
 
-func addSetMinerFeeRateFlags(cmd *cobra.Command) {
-	cmd.Flags().StringP("addr", "a", "", "address for miner")
-	cmd.Flags().Int32P("fee", "f", 10, "miner entrust mine fee rate persent (default 10% if nil)")
-	cmd.MarkFlagRequired("fee")
+func getMinerBind(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	var res ty.Pos33MinerBind
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33MinerBind", &types.ReqAddr{Addr: addr}, &res)
+	ctx.Run()
 }
 
-func setMinerFeeRate(cmd *cobra.Command, args []string) {
+// BlockSignerCmd 挖矿地址登记出块签名地址, miner 交易由它签名, 私钥可以放在 KMS 里. 交易由挖矿地址签名
+func BlockSignerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocksigner",
+		Short: "register an address (e.g. a KMS key) to sign the miner txs of the miner address which signs this tx",
+		Run:   blockSigner,
+	}
+	cmd.Flags().StringP("signer", "s", "", "block signer address")
+	cmd.MarkFlagRequired("signer")
+	cmd.Flags().BoolP("revoke", "r", false, "revoke the block signer")
+	cmd.Flags().Float64P("fee", "f", 0.01, "tx fee")
+	return cmd
+}
+
+func blockSigner(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
-	fee, _ := cmd.Flags().GetInt32("fee")
+	signer, _ := cmd.Flags().GetString("signer")
+	revoke, _ := cmd.Flags().GetBool("revoke")
+	fee, _ := cmd.Flags().GetFloat64("fee")
 
-	fr := &ty.Pos33MinerFeeRate{FeeRatePersent: fee}
-	rpcCall(rpcLaddr, "pos33.SetMinerFeeRate", fr)
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
+	}
+	act := &ty.Pos33TicketAction{
+		Ty:    ty.Pos33ActionBlockSigner,
+		Value: &ty.Pos33TicketAction_BlockSigner{BlockSigner: &ty.Pos33BlockSignerBind{Signer: signer, Revoke: revoke}},
+	}
+	createPos33Tx(cmd, cfg, act, fee)
 }
 
-func BlsBind() *cobra.Command {
+// GetBlockSignerCmd 查询出块签名地址的登记
+func GetBlockSignerCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "blsbind",
-		Short: "bls bind opration",
-		Run:   blsBind,
+		Use:   "blocksignerinfo",
+		Short: "get the registration of a block signer address",
+		Run:   getBlockSigner,
 	}
+	cmd.Flags().StringP("signer", "s", "", "block signer address")
+	cmd.MarkFlagRequired("signer")
 	return cmd
 }
 
-func blsBind(cmd *cobra.Command, args []string) {
+func getBlockSigner(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
-	rpcCall(rpcLaddr, "pos33.BlsBind", &types.ReqNil{})
+	signer, _ := cmd.Flags().GetString("signer")
+	var res ty.Pos33BlockSigner
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33BlockSigner", &types.ReqAddr{Addr: signer}, &res)
+	ctx.Run()
 }
 
-func Migrate() *cobra.Command {
+// ChartCmd 查询一段区块的难度和抵押, 按点数取样
+func ChartCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "migrate ",
-		Short: "migrate opration",
-		Run:   migrate,
+		Use:   "chart",
+		Short: "get downsampled difficulty and stake of blocks",
+		Run:   chart,
 	}
+	cmd.Flags().Int64P("start", "s", 0, "start height")
+	cmd.Flags().Int64P("end", "e", 0, "end height")
+	cmd.Flags().Int32P("points", "n", 100, "max count of points")
+	cmd.MarkFlagRequired("end")
 	return cmd
 }
 
-func migrate(cmd *cobra.Command, args []string) {
+func chart(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
-	rpcCall(rpcLaddr, "pos33.Pos33Migrate", &types.ReqNil{})
+	start, _ := cmd.Flags().GetInt64("start")
+	end, _ := cmd.Flags().GetInt64("end")
+	points, _ := cmd.Flags().GetInt32("points")
+	var res ty.Pos33ChartPoints
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33Chart", &ty.ReqPos33Chart{Start: start, End: end, Points: points}, &res)
+	ctx.Run()
 }
 
-func SetEntrustCmd() *cobra.Command {
+// TopDepositsCmd 按票数从大到小查询矿工, 用上一页返回的 next 作为 cursor 翻页
+func TopDepositsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "entrust",
-		Short: "set entrust opration",
-		Run:   setEntrust,
+		Use:   "top",
+		Short: "get miners ordered by ticket count",
+		Run:   topDeposits,
 	}
-	addSetEntrustFlags(cmd)
+	cmd.Flags().StringP("cursor", "c", "", "next of the previous page, empty for the first page")
+	cmd.Flags().Int32P("count", "n", 20, "count of miners, max 100")
 	return cmd
 }
 
-func addSetEntrustFlags(cmd *cobra.Command) {
-	cmd.Flags().StringP("consignor", "r", "", "address for consignor")
-	cmd.Flags().StringP("consignee", "e", "", "address for consignee")
-	cmd.Flags().IntP("amount", "a", 1000, "amount of entrust, if < 0, unentrust")
-	cmd.MarkFlagRequired("consignor")
-	cmd.MarkFlagRequired("consignee")
-	cmd.MarkFlagRequired("amount")
+func topDeposits(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	cursor, _ := cmd.Flags().GetString("cursor")
+	count, _ := cmd.Flags().GetInt32("count")
+	var res ty.Pos33TopDeposits
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33TopDeposits", &ty.ReqPos33TopDeposits{Cursor: cursor, Count: count}, &res)
+	ctx.Run()
 }
 
-func setEntrust(cmd *cobra.Command, args []string) {
+// DepositsCmd 按抵押 (amount), 票数 (count) 或者抵押减少的高度 (close) 查询矿工
+func DepositsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposits",
+		Short: "get miners by the amount, count or close height index",
+		Run:   deposits,
+	}
+	cmd.Flags().StringP("index", "i", "count", "index: amount, count or close")
+	cmd.Flags().Int64P("start", "s", 0, "min value of the index")
+	cmd.Flags().Int64P("end", "e", 0, "max value of the index, 0 for no limit")
+	cmd.Flags().BoolP("asc", "a", false, "from small to large, default from large to small")
+	cmd.Flags().StringP("cursor", "c", "", "next of the previous page, empty for the first page")
+	cmd.Flags().Int32P("count", "n", 20, "count of miners, max 100")
+	return cmd
+}
+
+func deposits(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
-	paraName, _ := cmd.Flags().GetString("paraName")
+	index, _ := cmd.Flags().GetString("index")
+	start, _ := cmd.Flags().GetInt64("start")
+	end, _ := cmd.Flags().GetInt64("end")
+	asc, _ := cmd.Flags().GetBool("asc")
+	cursor, _ := cmd.Flags().GetString("cursor")
+	count, _ := cmd.Flags().GetInt32("count")
+	req := &ty.ReqPos33Deposits{Index: index, Start: start, End: end, Cursor: cursor, Count: count}
+	if asc {
+		req.Direction = 1
+	}
+	var res ty.Pos33Deposits
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33Deposits", req, &res)
+	ctx.Run()
+}
 
-	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
-	if err != nil {
-		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
-		return
+// RewardHistoryCmd 查询地址的奖励记录, 从新到旧, 用上一页返回的 next 作为 cursor 翻页
+func RewardHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards",
+		Short: "get reward events of the address, newest first",
+		Run:   rewardHistory,
 	}
+	cmd.Flags().StringP("addr", "a", "", "address")
+	cmd.Flags().StringP("cursor", "c", "", "next of the previous page, empty for the first page")
+	cmd.Flags().Int32P("count", "n", 20, "count of events, max 100")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
 
-	consignor, _ := cmd.Flags().GetString("consignor")
-	consignee, _ := cmd.Flags().GetString("consignee")
-	amount, _ := cmd.Flags().GetInt("amount")
-	realAmount := cfg.CoinPrecision * int64(amount)
+func rewardHistory(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	cursor, _ := cmd.Flags().GetString("cursor")
+	count, _ := cmd.Flags().GetInt32("count")
+	var res ty.Pos33RewardEvents
+	req := &ty.ReqPos33RewardHistory{Addr: addr, Cursor: cursor, Count: count}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33RewardHistory", req, &res)
+	ctx.Run()
+}
 
-	entrust := &ty.Pos33Entrust{
-		Consignor: consignor,
-		Consignee: consignee,
-		Amount:    realAmount,
+// BaseFeeCmd 查询最近区块的基础交易费, 钱包可以用 next 设置交易费
+func BaseFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "basefee",
+		Short: "get base fee of the recent blocks",
+		Run:   baseFee,
 	}
-	act := &ty.Pos33TicketAction{
-		Ty:    ty.Pos33ActionEntrust,
-		Value: &ty.Pos33TicketAction_Entrust{Entrust: entrust},
+	cmd.Flags().Int64P("count", "n", 10, "count of recent blocks")
+	return cmd
+}
+
+func baseFee(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	count, _ := cmd.Flags().GetInt64("count")
+	var res ty.Pos33BaseFees
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33BaseFeeHistory", &types.ReqInt{Height: count}, &res)
+	ctx.Run()
+}
+
+// MissedSlotsCmd 查询制作人抽中了但是没有出块的记录
+func MissedSlotsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "missed",
+		Short: "get missed maker slots of the miner",
+		Run:   missedSlots,
 	}
+	cmd.Flags().StringP("addr", "a", "", "miner address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
 
-	rawTx := &types.Transaction{Payload: types.Encode(act)}
-	tx, err := types.FormatTxExt(cfg.ChainID, len(paraName) > 0, cfg.MinTxFeeRate, ty.Pos33TicketX, rawTx)
+func missedSlots(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	var res ty.Pos33MissedSlots
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33MissedSlots", &types.ReqAddr{Addr: addr}, &res)
+	ctx.Run()
+}
+
+// ParticipationCmd 查询矿工最近的出块和投票
+func ParticipationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "participation",
+		Short: "get blocks made vs expected and votes cast vs elected of the miner in recent blocks",
+		Run:   participation,
+	}
+	cmd.Flags().StringP("addr", "a", "", "miner address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().Int64P("window", "w", 0, "recent blocks, 0 is the node config")
+	return cmd
+}
+
+func participation(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	window, _ := cmd.Flags().GetInt64("window")
+	var res ty.Pos33Participation
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33Participation", &ty.ReqPos33Participation{Addr: addr, Window: window}, &res)
+	ctx.Run()
+}
+
+// LivenessCmd 查询本节点看到的验证者在线地图
+func LivenessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liveness",
+		Short: "get validators liveness map from telemetry heartbeats seen by this node",
+		Run:   liveness,
+	}
+	return cmd
+}
+
+func liveness(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res ty.Pos33LivenessMap
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33Liveness", &types.ReqNil{}, &res)
+	ctx.Run()
+}
+
+// StateUsageCmd 按执行器查询状态数据占用
+func StateUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "get state bytes owned per execer and growth rates (need store name = \"usage\")",
+		Run:   stateUsage,
+	}
+	return cmd
+}
+
+func stateUsage(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res ty.Pos33StateUsages
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33StateUsage", &types.ReqNil{}, &res)
+	ctx.Run()
+}
+
+// AddrTxsCmd 从异步索引查询地址相关的交易
+func AddrTxsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "addrtxs",
+		Short: "get txs of the address from the async tx index (need indexDBPath)",
+		Run:   addrTxs,
+	}
+	cmd.Flags().StringP("addr", "a", "", "address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().Int64P("height", "", 0, "start before this height, 0 is the latest")
+	cmd.Flags().Int32P("index", "i", 0, "start before this tx index in the height")
+	cmd.Flags().Int32P("count", "c", 0, "max txs, 0 is 20")
+	return cmd
+}
+
+func addrTxs(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	height, _ := cmd.Flags().GetInt64("height")
+	index, _ := cmd.Flags().GetInt32("index")
+	count, _ := cmd.Flags().GetInt32("count")
+	var res ty.Pos33IndexedTxs
+	req := &ty.ReqPos33AddrTxs{Addr: addr, Height: height, Index: index, Count: count}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33AddrTxs", req, &res)
+	ctx.Run()
+}
+
+// OpenRPCCmd 导出节点所有 dapp 接口的 OpenRPC 描述
+func OpenRPCCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "openrpc",
+		Short: "get the OpenRPC document of all dapp txs, queries and rpc methods on the node",
+		Run:   openRPC,
+	}
+	return cmd
+}
+
+func openRPC(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res interface{}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetOpenRPC", &types.ReqNil{}, &res)
+	ctx.Run()
+}
+
+// DryRunCmd 用交易池的交易试打包一个区块
+func DryRunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dryrun",
+		Short: "assemble a block from mempool without broadcasting it, show why txs are not packed",
+		Run:   dryRun,
+	}
+	cmd.Flags().StringP("token", "t", "", "admin token")
+	cmd.MarkFlagRequired("token")
+	return cmd
+}
+
+func dryRun(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	token, _ := cmd.Flags().GetString("token")
+	var res ty.Pos33DryRunBlock
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.DryRunPos33Block", &ty.ReqPos33DryRun{AdminToken: token}, &res)
+	ctx.Run()
+}
+
+// DiagnosticsCmd 共识内部的诊断信息
+func DiagnosticsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diag",
+		Short: "dump pos33 internal diagnostics: map sizes, channel backlogs, timers and goroutine stacks",
+		Run:   diagnostics,
+	}
+	cmd.Flags().StringP("token", "t", "", "admin token")
+	cmd.MarkFlagRequired("token")
+	cmd.Flags().BoolP("stacks", "s", false, "only print goroutine stacks")
+	return cmd
+}
+
+func diagnostics(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	token, _ := cmd.Flags().GetString("token")
+	stacks, _ := cmd.Flags().GetBool("stacks")
+	var res ty.Pos33Diagnostics
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPos33Diagnostics", &ty.ReqPos33Diagnostics{AdminToken: token}, &res)
+	if !stacks {
+		ctx.Run()
+		return
+	}
+	_, err := ctx.RunResult()
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		return
 	}
-	txHex := types.Encode(tx)
-	fmt.Println(hex.EncodeToString(txHex))
+	fmt.Println(res.Goroutines)
+}
+
+// MinerPauseCmd 运行时暂停或者恢复挖矿
+func MinerPauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "pause the miner at runtime (stop sortition and vote signing), or resume it with -r",
+		Run:   minerPause,
+	}
+	cmd.Flags().StringP("token", "t", "", "admin token")
+	cmd.MarkFlagRequired("token")
+	cmd.Flags().BoolP("resume", "r", false, "resume the miner")
+	return cmd
+}
+
+func minerPause(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	token, _ := cmd.Flags().GetString("token")
+	resume, _ := cmd.Flags().GetBool("resume")
+	var res types.Reply
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.SetPos33MinerPause", &ty.ReqPos33MinerPause{AdminToken: token, Pause: !resume}, &res)
+	ctx.Run()
 }
 
 func addGetConsignorFlags(cmd *cobra.Command) {
@@ -0,0 +1,77 @@
+package executor
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// BaseFeeKey height 的基础交易费, 按 Pos33BaseFeeRing 循环使用
+func BaseFeeKey(height int64) []byte {
+	return []byte(fmt.Sprintf("mavl-pos33-basefee-%d", height%ty.Pos33BaseFeeRing))
+}
+
+func getBaseFee(db dbm.KV, height int64) (*ty.Pos33BaseFee, error) {
+	val, err := db.Get(BaseFeeKey(height))
+	if err != nil || len(val) == 0 {
+		return nil, types.ErrNotFound
+	}
+	var bf ty.Pos33BaseFee
+	err = types.Decode(val, &bf)
+	if err != nil {
+		return nil, err
+	}
+	if bf.Height != height {
+		return nil, types.ErrNotFound
+	}
+	return &bf, nil
+}
+
+// calcBaseFee 区块 height 的基础交易费, 由 height-1 的记录算出来, 没有记录时用最低交易费
+func calcBaseFee(db dbm.KV, cfg *types.Chain33Config, height int64) int64 {
+	prev, err := getBaseFee(db, height-1)
+	if err != nil {
+		prev = nil
+	}
+	target := ty.GetPos33MineParam(cfg, height).BaseFeeTarget
+	return ty.NextBaseFee(prev, target, cfg.GetMinTxFeeRate())
+}
+
+// blockBaseFee ForkBaseFee 之后本区块的基础交易费记录, 基础交易费由父区块的状态算出来.
+// miner 交易最先执行, action.db 读到的就是父区块的状态
+func (action *Action) blockBaseFee() (*ty.Pos33BaseFee, int) {
+	cfg := action.api.GetConfig()
+	bf := &ty.Pos33BaseFee{Height: action.height, BaseFee: calcBaseFee(action.db, cfg, action.height)}
+	var under int
+	if len(action.txs) > 1 {
+		bf.Txs = int64(len(action.txs) - 1)
+		bf.Burned, bf.Tips, bf.Size, under = ty.SplitBaseFee(action.txs[1:], bf.BaseFee, action.txs[0].From())
+	}
+	return bf, under
+}
+
+// checkBaseFee miner 交易之外的交易, 交易费都不能少于基础交易费, 制作人签名的免除基础交易费的交易除外
+func (action *Action) checkBaseFee() error {
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkBaseFee") {
+		return nil
+	}
+	bf, under := action.blockBaseFee()
+	if under > 0 {
+		tlog.Error("checkBaseFee error", "height", action.height, "baseFee", bf.BaseFee, "under", under)
+		return types.ErrTxFeeTooLow
+	}
+	return nil
+}
+
+// baseFee ForkBaseFee 之后计算本区块的基础交易费和小费. 交易费在执行交易时已经扣掉了,
+// 这里只把小费重新发给制作人, 基础费就销毁了. 交易费不够基础费的区块在 checkBaseFee 里已经拒绝
+func (action *Action) baseFee() ([]*types.KeyValue, int64) {
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkBaseFee") {
+		return nil, 0
+	}
+	bf, _ := action.blockBaseFee()
+	tlog.Debug("base fee", "height", action.height, "baseFee", bf.BaseFee, "txs", bf.Txs, "size", bf.Size, "burned", bf.Burned, "tips", bf.Tips)
+	return []*types.KeyValue{{Key: BaseFeeKey(action.height), Value: types.Encode(bf)}}, bf.Tips
+}
@@ -0,0 +1,143 @@
+package executor
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 冷热地址分离: 持有资金的冷地址 (returnAddr) 用 MinerBind 交易绑定一个热的挖矿地址 (miner),
+// 挖矿的机器上只有热地址的私钥, 用它抽签, 投票和出块. 抽签的票数, 奖励和处罚都算在冷地址上.
+// 热地址不能有自己的抵押, 一个地址只能在一个绑定里. 抽签用 Pos33SortBlocks 之前的票,
+// 所以绑定也按抽签的高度读, 每个地址的绑定修改以后 Pos33BindCooldown 个区块内不能再改
+
+// MinerBindKey addr 所在的绑定, 冷热两个地址指向同一个绑定
+func MinerBindKey(addr string) []byte {
+	return []byte(fmt.Sprintf("mavl-pos33-minerbind-%s", address.FormatAddrKey(addr)))
+}
+
+// readMinerBind addr 的绑定记录, 包括已经解除的和上一次的绑定
+func readMinerBind(db dbm.KV, addr string) (*ty.Pos33MinerBind, error) {
+	val, err := db.Get(MinerBindKey(addr))
+	if err != nil || len(val) == 0 {
+		return nil, types.ErrNotFound
+	}
+	var b ty.Pos33MinerBind
+	err = types.Decode(val, &b)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// getMinerBind addr 当前的绑定
+func getMinerBind(db dbm.KV, addr string) (*ty.Pos33MinerBind, error) {
+	b, err := readMinerBind(db, addr)
+	if err != nil {
+		return nil, err
+	}
+	if b.Miner == "" {
+		return nil, types.ErrNotFound
+	}
+	return b, nil
+}
+
+// bindReturn ForkMinerBind 之后, 绑定的热地址 addr 在 sortHeight 抽签, 投票和奖励都算在冷地址上
+func bindReturn(db dbm.KV, cfg *types.Chain33Config, height, sortHeight int64, addr string) string {
+	if !cfg.IsDappFork(height, ty.Pos33TicketX, "ForkMinerBind") {
+		return addr
+	}
+	b, err := readMinerBind(db, addr)
+	if err != nil {
+		return addr
+	}
+	if r := b.StakeAddr(addr, sortHeight); r != "" {
+		return r
+	}
+	return addr
+}
+
+// stakeAddrAt addr 在 sortHeight 抽签用的是哪个地址的票
+func (action *Action) stakeAddrAt(addr string, sortHeight int64) string {
+	return bindReturn(action.db, action.api.GetConfig(), action.height, sortHeight, addr)
+}
+
+// stakeAddr 本区块的抽签在 Pos33SortBlocks 之前, 用那时的绑定
+func (action *Action) stakeAddr(addr string) string {
+	return action.stakeAddrAt(addr, action.height-ty.Pos33SortBlocks)
+}
+
+// bindWrite 修改 addr 的绑定, 当前的绑定留作 prev. 上一次修改不到 Pos33BindCooldown 个区块不能改
+func (action *Action) bindWrite(addr string, b *ty.Pos33MinerBind) (*types.KeyValue, error) {
+	old, err := readMinerBind(action.db, addr)
+	if err == nil {
+		if old.Height > 0 && action.height-old.Height < ty.Pos33BindCooldown {
+			return nil, ty.ErrBindCooldown
+		}
+		old.Prev = nil
+		b.Prev = old
+	}
+	return &types.KeyValue{Key: MinerBindKey(addr), Value: types.Encode(b)}, nil
+}
+
+// Pos33Bind 冷地址绑定热的挖矿地址, MinerAddress 为空时解除绑定
+func (action *Action) Pos33Bind(tb *ty.Pos33TicketBind) (*types.Receipt, error) {
+	if action.fromaddr != tb.ReturnAddress {
+		return nil, types.ErrFromAddr
+	}
+	miner := tb.MinerAddress
+	old := ""
+	if b, err := getMinerBind(action.db, tb.ReturnAddress); err == nil {
+		if b.ReturnAddr != tb.ReturnAddress {
+			// 自己是别人的热地址
+			return nil, ty.ErrMinerBound
+		}
+		old = b.Miner
+	}
+	if old == miner {
+		return nil, types.ErrInvalidParam
+	}
+	if miner != "" {
+		if err := address.CheckAddress(miner, action.height); err != nil {
+			return nil, err
+		}
+		if miner == tb.ReturnAddress {
+			return nil, types.ErrInvalidParam
+		}
+		if _, err := getMinerBind(action.db, miner); err == nil {
+			return nil, ty.ErrMinerBound
+		}
+		if c, err := getConsignee(action.db, miner); err == nil && c.Amount > 0 {
+			return nil, ty.ErrMinerBound
+		}
+	}
+
+	b := &ty.Pos33MinerBind{Height: action.height}
+	if miner != "" {
+		b.Miner = miner
+		b.ReturnAddr = tb.ReturnAddress
+	}
+	var kvs []*types.KeyValue
+	addrs := []string{tb.ReturnAddress}
+	if old != "" {
+		addrs = append(addrs, old)
+	}
+	if miner != "" {
+		addrs = append(addrs, miner)
+	}
+	for _, addr := range addrs {
+		kv, err := action.bindWrite(addr, &ty.Pos33MinerBind{Miner: b.Miner, ReturnAddr: b.ReturnAddr, Height: b.Height})
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, kv)
+	}
+
+	r := &ty.ReceiptPos33TicketBind{OldMinerAddress: old, NewMinerAddress: miner, ReturnAddress: tb.ReturnAddress}
+	logs := []*types.ReceiptLog{{Ty: ty.TyLogPos33TicketBind, Log: types.Encode(r)}}
+	tlog.Info("pos33 miner bind", "miner", miner, "return", tb.ReturnAddress, "old", old, "height", action.height)
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
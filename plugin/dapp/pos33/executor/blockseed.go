@@ -0,0 +1,22 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// blockSeed ForkVrfSeed 之后记录本区块的种子和制作人, 种子的 vrf 证明由共识检查.
+// miner 交易最先执行, 同一个区块里后面的 random 交易已经可以读到
+func (action *Action) blockSeed(miner *ty.Pos33MinerMsg) []*types.KeyValue {
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkVrfSeed") || len(miner.SeedHash) == 0 {
+		return nil
+	}
+	s := &ty.Pos33BlockSeed{
+		Height: action.height,
+		Seed:   miner.SeedHash,
+		Proof:  miner.SeedProof,
+		Pubkey: miner.GetSort().GetProof().GetPubkey(),
+		Maker:  action.fromaddr,
+	}
+	return []*types.KeyValue{{Key: ty.BlockSeedKey(action.height), Value: types.Encode(s)}}
+}
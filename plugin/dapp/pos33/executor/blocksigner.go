@@ -0,0 +1,92 @@
+package executor
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 出块签名地址: ForkBlockSigner 之后挖矿地址 (抽签的 vrf 公钥的地址) 可以用 BlockSigner 交易登记一个签名地址,
+// miner 交易由这个地址签名, 它的私钥可以放在 KMS 里. 抽签, 投票和 bls 的私钥仍然在节点上,
+// 奖励和处罚仍然算在挖矿地址上. 一个签名地址只能登记一次, 撤销以后它签过的 miner 交易仍然可以作为作恶的证据
+
+// BlockSignerKey 签名地址的登记
+func BlockSignerKey(signer string) []byte {
+	return []byte(fmt.Sprintf("mavl-pos33-blocksigner-%s", address.FormatAddrKey(signer)))
+}
+
+func getBlockSigner(db dbm.KV, signer string) (*ty.Pos33BlockSigner, error) {
+	val, err := db.Get(BlockSignerKey(signer))
+	if err != nil || len(val) == 0 {
+		return nil, types.ErrNotFound
+	}
+	var s ty.Pos33BlockSigner
+	err = types.Decode(val, &s)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// blockMaker height 的 miner 交易的出块人. ForkBlockSigner 之前是交易的签名地址,
+// 之后是抽签的地址, 交易由它自己或者它在 height 有效的签名地址签名
+func blockMaker(db dbm.KV, cfg *types.Chain33Config, tx *types.Transaction, miner *ty.Pos33MinerMsg, height int64) (string, error) {
+	from := tx.From()
+	if !cfg.IsDappFork(height, ty.Pos33TicketX, "ForkBlockSigner") {
+		return from, nil
+	}
+	maker := ty.MinerTxMaker(miner)
+	if maker == "" {
+		return "", ty.ErrBlockSigner
+	}
+	if from == maker {
+		return maker, nil
+	}
+	s, err := getBlockSigner(db, from)
+	if err == types.ErrNotFound {
+		return "", ty.ErrBlockSigner
+	}
+	if err != nil {
+		return "", err
+	}
+	if !s.ValidAt(maker, height) {
+		return "", ty.ErrBlockSigner
+	}
+	return maker, nil
+}
+
+// Pos33BlockSignerBind 挖矿地址登记或者撤销出块签名地址
+func (action *Action) Pos33BlockSignerBind(b *ty.Pos33BlockSignerBind) (*types.Receipt, error) {
+	if err := address.CheckAddress(b.Signer, action.height); err != nil {
+		return nil, err
+	}
+	if b.Signer == action.fromaddr {
+		return nil, types.ErrInvalidParam
+	}
+	s, err := getBlockSigner(action.db, b.Signer)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	if b.Revoke {
+		if s == nil || s.Miner != action.fromaddr {
+			return nil, ty.ErrBlockSigner
+		}
+		if s.Revoked != 0 {
+			return nil, types.ErrInvalidParam
+		}
+		s.Revoked = action.height
+	} else {
+		if s != nil {
+			return nil, ty.ErrBlockSigner
+		}
+		s = &ty.Pos33BlockSigner{Signer: b.Signer, Miner: action.fromaddr, Height: action.height}
+	}
+
+	kvs := []*types.KeyValue{{Key: BlockSignerKey(b.Signer), Value: types.Encode(s)}}
+	logs := []*types.ReceiptLog{{Ty: ty.TyLogPos33BlockSigner, Log: types.Encode(s)}}
+	tlog.Info("pos33 block signer", "miner", action.fromaddr, "signer", b.Signer, "revoke", b.Revoke, "height", action.height)
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
@@ -0,0 +1,75 @@
+package executor
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const (
+	// 一次查询最多返回的点数
+	maxChartPoints = 1000
+	// 每段最多找多少个区块, 没有记录的高度(例如升级以前)不会一直找下去
+	maxChartScan = 16
+)
+
+// ChartKey height 的难度和抵押, 保存在 localdb
+func ChartKey(height int64) []byte {
+	return []byte(fmt.Sprintf("LODB-pos33-chart-%012d", height))
+}
+
+// execLocalChart 在 miner 交易里记录本区块的难度, 全网票数和投票人数, 不用重放区块就能画图
+func (t *Pos33Ticket) execLocalChart(miner *ty.Pos33MinerMsg) []*types.KeyValue {
+	height := t.GetHeight()
+	cp := &ty.Pos33ChartPoint{
+		Height:     height,
+		Time:       t.GetBlockTime(),
+		Difficulty: t.GetDifficulty(),
+		Voters:     int32(len(miner.Voters())),
+		Round:      miner.GetSort().GetProof().GetInput().GetRound(),
+	}
+	db := t.GetStateDB()
+	if dr, err := getDiffRetarget(db, height); err == nil {
+		cp.DiffRatio = dr.Ratio
+	}
+	amount, err := getAllAmount(db)
+	if err == nil {
+		cp.Tickets = amount / ticketPrice(db, t.GetAPI().GetConfig(), height)
+	}
+	return []*types.KeyValue{{Key: ChartKey(height), Value: types.Encode(cp)}}
+}
+
+func (t *Pos33Ticket) execDelLocalChart() []*types.KeyValue {
+	return []*types.KeyValue{{Key: ChartKey(t.GetHeight()), Value: nil}}
+}
+
+// queryChart [start, end] 分成 points 段, 每段取第一个有记录的区块
+func queryChart(db dbm.KV, req *ty.ReqPos33Chart) (*ty.Pos33ChartPoints, error) {
+	if req.Start < 0 || req.End < req.Start {
+		return nil, types.ErrInvalidParam
+	}
+	points := int64(req.Points)
+	if points <= 0 || points > maxChartPoints {
+		points = maxChartPoints
+	}
+	step := (req.End - req.Start + points) / points
+	cps := &ty.Pos33ChartPoints{}
+	for h := req.Start; h <= req.End; h += step {
+		for i := h; i < h+step && i < h+maxChartScan && i <= req.End; i++ {
+			val, err := db.Get(ChartKey(i))
+			if err != nil || len(val) == 0 {
+				continue
+			}
+			cp := new(ty.Pos33ChartPoint)
+			err = types.Decode(val, cp)
+			if err != nil {
+				return nil, err
+			}
+			cps.Points = append(cps.Points, cp)
+			break
+		}
+	}
+	return cps, nil
+}
@@ -0,0 +1,61 @@
+package executor
+
+import (
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// CheckpointPendingKey 等待委员会签名的 checkpoint, 保存了可以签名的投票人和他们的票数
+func CheckpointPendingKey() []byte {
+	return []byte("mavl-pos33-checkpoint-pending")
+}
+
+// CheckpointFinalizedKey 最近的不可回滚的 checkpoint
+func CheckpointFinalizedKey() []byte {
+	return []byte("mavl-pos33-checkpoint-finalized")
+}
+
+func getCheckpoint(db dbm.KV, key []byte) (*ty.Pos33Checkpoint, error) {
+	val, err := db.Get(key)
+	if err != nil || len(val) == 0 {
+		return nil, types.ErrNotFound
+	}
+	cp := new(ty.Pos33Checkpoint)
+	err = types.Decode(val, cp)
+	if err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+// checkpoint 记录委员会签名的 checkpoint, 每 checkpointBlocks 个区块生成新的 pending checkpoint
+// miner 交易出错会 panic, 无效的 checkpoint 签名直接忽略, 由共识拒绝这样的区块
+func (action *Action) checkpoint(miner *ty.Pos33MinerMsg) []*types.KeyValue {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkCheckpoint") {
+		return nil
+	}
+	var kvs []*types.KeyValue
+	pending, err := getCheckpoint(action.db, CheckpointPendingKey())
+	if cp := miner.Checkpoint; cp != nil && err == nil && pending.Height == cp.Height && string(pending.Hash) == string(cp.Hash) {
+		if cp.Verify(pending) == nil {
+			tlog.Info("checkpoint finalized", "height", cp.Height, "nvs", len(cp.BlsPkList), "at", action.height)
+			kvs = append(kvs, &types.KeyValue{Key: CheckpointFinalizedKey(), Value: types.Encode(&ty.Pos33Checkpoint{Height: cp.Height, Hash: cp.Hash})})
+			kvs = append(kvs, &types.KeyValue{Key: CheckpointPendingKey(), Value: types.Encode(&ty.Pos33Checkpoint{})})
+		}
+	}
+
+	k := ty.GetPos33MineParam(cfg, action.height).CheckpointBlocks
+	if action.height > 0 && action.height%k == 0 {
+		pks, weights := ty.CheckpointWeights(miner.Voters())
+		next := &ty.Pos33Checkpoint{
+			Height:    action.height - 1,
+			Hash:      action.parentHash,
+			BlsPkList: pks,
+			Weights:   weights,
+		}
+		kvs = append(kvs, &types.KeyValue{Key: CheckpointPendingKey(), Value: types.Encode(next)})
+	}
+	return kvs
+}
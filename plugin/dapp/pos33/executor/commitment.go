@@ -0,0 +1,30 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// VotersRootKey 上一个区块的投票人 merkle root
+func VotersRootKey() []byte {
+	return []byte("mavl-pos33-voters-root")
+}
+
+// voteCommitment ForkVoteCommitment 之后 miner 交易必须带投票的承诺, 并且接在上一个区块的 votersRoot 后面
+func (action *Action) voteCommitment(miner *ty.Pos33MinerMsg) ([]*types.KeyValue, error) {
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkVoteCommitment") {
+		if miner.Commitment != nil {
+			return nil, ty.ErrVoteCommitment
+		}
+		return nil, nil
+	}
+	prev, err := action.db.Get(VotersRootKey())
+	if err != nil {
+		prev = nil
+	}
+	err = miner.CheckCommitment(prev)
+	if err != nil {
+		return nil, err
+	}
+	return []*types.KeyValue{{Key: VotersRootKey(), Value: miner.Commitment.VotersRoot}}, nil
+}
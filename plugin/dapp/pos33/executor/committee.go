@@ -0,0 +1,26 @@
+package executor
+
+import (
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// voterSize ForkDynamicCommittee 之后, height 的投票人委员会大小随抽签用的 epoch 快照里的全网票数变化,
+// 和共识里 getDiff 用的票数一样. 没有快照时使用 Pos33VoterSize
+func voterSize(db dbm.KV, cfg *types.Chain33Config, height int64) int {
+	if !cfg.IsDappFork(height, ty.Pos33TicketX, "ForkDynamicCommittee") {
+		return ty.Pos33VoterSize
+	}
+	h := height - ty.Pos33SortBlocks
+	if h < 0 || !cfg.IsDappFork(h, ty.Pos33TicketX, "ForkStakeEpoch") {
+		return ty.Pos33VoterSize
+	}
+	k := ty.GetPos33MineParam(cfg, h).StakeEpochBlocks
+	amount, err := getEpochStake(db, h/k)
+	if err != nil {
+		return ty.Pos33VoterSize
+	}
+	count := amount / ticketPrice(db, cfg, h/k*k)
+	return ty.GetPos33MineParam(cfg, height).VoterSize(count)
+}
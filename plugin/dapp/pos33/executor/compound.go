@@ -0,0 +1,200 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 自动复投: 打开以后奖励不转到 coins 的余额, 而是存到 pos33 执行器下面的账户 (币还在 pos33 的执行器地址上),
+// 只复投成熟以后存进来的奖励 (Pending), 账户里的其他余额不动. 够一张票的价格时冻结起来,
+// 在下一次发奖励时委托给产生这笔奖励的矿工, 钱包不用轮询
+
+// CompoundKey 自动复投的设置
+func CompoundKey(addr string) []byte {
+	return []byte("mavl-pos33-compound-" + string(address.FormatAddrKey(addr)))
+}
+
+func getCompound(db dbm.KV, addr string) (*ty.Pos33Compound, error) {
+	val, err := db.Get(CompoundKey(addr))
+	if err == nil && len(val) == 0 {
+		err = types.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	c := new(ty.Pos33Compound)
+	err = types.Decode(val, c)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// loadCompound 没有设置过自动复投时返回关闭的记录
+func loadCompound(db dbm.KV, addr string) (*ty.Pos33Compound, error) {
+	c, err := getCompound(db, addr)
+	if err == types.ErrNotFound {
+		return &ty.Pos33Compound{Address: addr}, nil
+	}
+	return c, err
+}
+
+// Pos33SetCompound 打开或者关闭自动复投
+func (action *Action) Pos33SetCompound(sc *ty.Pos33SetCompound) (*types.Receipt, error) {
+	c, err := loadCompound(action.db, action.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	c.On = sc.On
+	tlog.Info("pos33 set compound", "addr", action.fromaddr, "on", sc.On, "height", action.height)
+	return &types.Receipt{KV: []*types.KeyValue{{Key: CompoundKey(c.Address), Value: types.Encode(c)}}, Ty: types.ExecOk}, nil
+}
+
+func (act *Action) isCompound(addr string) (bool, error) {
+	if !act.api.GetConfig().IsDappFork(act.height, ty.Pos33TicketX, "ForkCompound") {
+		return false, nil
+	}
+	c, err := act.compoundRecord(addr)
+	if err != nil {
+		return false, err
+	}
+	return c.On, nil
+}
+
+// compoundRecord 本交易里自动复投的记录, 修改以后由 compoundKVs 写回
+func (act *Action) compoundRecord(addr string) (*ty.Pos33Compound, error) {
+	if c, ok := act.compounds[addr]; ok {
+		return c, nil
+	}
+	c, err := loadCompound(act.db, addr)
+	if err != nil {
+		return nil, err
+	}
+	if act.compounds == nil {
+		act.compounds = make(map[string]*ty.Pos33Compound)
+	}
+	act.compounds[addr] = c
+	return c, nil
+}
+
+// depositCompound 成熟的奖励存到 pos33 执行器下面的账户, 等着复投
+func (act *Action) depositCompound(to string, amount int64) (*types.Receipt, error) {
+	c, err := act.compoundRecord(to)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := act.coinsAccount.ExecDeposit(to, act.execaddr, amount)
+	if err != nil {
+		return nil, err
+	}
+	c.Pending += amount
+	act.compoundChanged(to)
+	return receipt, nil
+}
+
+func (act *Action) compoundChanged(addr string) {
+	for _, a := range act.compoundAddrs {
+		if a == addr {
+			return
+		}
+	}
+	act.compoundAddrs = append(act.compoundAddrs, addr)
+}
+
+type compounded struct {
+	cr        *ty.Consignor
+	consignee string
+	amount    int64
+}
+
+// compound 打开自动复投的委托人, 等着复投的奖励够整张票时冻结起来, 增加在 consignee 的委托,
+// 委托人的记录和全网抵押最后由 compoundKVs 一起更新
+func (act *Action) compound(consignee *ty.Pos33Consignee, cr *ty.Consignor) (*types.Receipt, error) {
+	on, err := act.isCompound(cr.Address)
+	if err != nil || !on {
+		return nil, err
+	}
+	c, err := act.compoundRecord(cr.Address)
+	if err != nil {
+		return nil, err
+	}
+	// 奖励存进来以后可能被取走了
+	acc := act.coinsAccount.LoadExecAccount(cr.Address, act.execaddr)
+	if c.Pending > acc.Balance {
+		c.Pending = acc.Balance
+		act.compoundChanged(cr.Address)
+	}
+	tprice := ticketPrice(act.db, act.api.GetConfig(), act.height)
+	amount := c.Pending / tprice * tprice
+	if amount == 0 {
+		return nil, nil
+	}
+	receipt, err := act.coinsAccount.ExecFrozen(cr.Address, act.execaddr, amount)
+	if err != nil {
+		tlog.Error("compound freeze error", "err", err, "height", act.height, "addr", cr.Address)
+		return nil, err
+	}
+	kvs, err := act.recordStake(consignee.Address)
+	if err != nil {
+		return nil, err
+	}
+	receipt.KV = append(receipt.KV, kvs...)
+	cr.Amount += amount
+	consignee.Amount += amount
+	c.Pending -= amount
+	c.Amount += amount
+	act.compoundChanged(cr.Address)
+	act.compounded = append(act.compounded, &compounded{cr: cr, consignee: consignee.Address, amount: amount})
+	tlog.Info("pos33 compound", "consignor", cr.Address, "consignee", consignee.Address, "amount", amount, "height", act.height)
+	return receipt, nil
+}
+
+// compoundKVs 更新复投的委托人, 全网抵押和改过的自动复投记录, 要在所有奖励发放以后调用
+func (act *Action) compoundKVs() ([]*types.KeyValue, error) {
+	var kvs []*types.KeyValue
+	var addrs []string
+	consignors := make(map[string]*ty.Pos33Consignor)
+	var total int64
+	for _, c := range act.compounded {
+		addr := c.cr.Address
+		pc, ok := consignors[addr]
+		if !ok {
+			var err error
+			pc, err = getConsignor(act.db, addr)
+			if err == types.ErrNotFound {
+				pc, err = &ty.Pos33Consignor{Address: addr}, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			consignors[addr] = pc
+			addrs = append(addrs, addr)
+		}
+		found := false
+		for _, e := range pc.Consignees {
+			if e.Address == c.consignee {
+				e.Amount = c.cr.Amount
+				found = true
+				break
+			}
+		}
+		if !found {
+			pc.Consignees = append(pc.Consignees, &ty.Consignee{Address: c.consignee, Amount: c.cr.Amount})
+		}
+		total += c.amount
+	}
+	for _, addr := range addrs {
+		kvs = append(kvs, &types.KeyValue{Key: ConsignorKey(addr), Value: types.Encode(consignors[addr])})
+	}
+	for _, addr := range act.compoundAddrs {
+		kvs = append(kvs, &types.KeyValue{Key: CompoundKey(addr), Value: types.Encode(act.compounds[addr])})
+	}
+	if total > 0 {
+		kvs = append(kvs, act.updateAllAmount(total))
+	}
+	act.compounded = nil
+	act.compoundAddrs = nil
+	return kvs, nil
+}
@@ -0,0 +1,114 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 委托: 矿工 (operator) 先用 SetCommission 登记并设置佣金, 持币人用 Delegate 把币委托给登记过的矿工,
+// 矿工用自己和委托人的总数抽签. 委托记在 consignee/consignor 表里, 奖励按佣金在矿工和委托人之间分配 (见 minerReward)
+
+// OperatorKey operator key
+func OperatorKey(addr string) []byte {
+	return []byte("mavl-pos33-operator-" + string(address.FormatAddrKey(addr)))
+}
+
+func getOperator(db dbm.KV, addr string) (*ty.Pos33Operator, error) {
+	val, err := db.Get(OperatorKey(addr))
+	if err == types.ErrNotFound {
+		return nil, ty.ErrNotOperator
+	}
+	if err != nil {
+		return nil, err
+	}
+	op := new(ty.Pos33Operator)
+	err = types.Decode(val, op)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// activeCommission 提高的佣金到了生效高度, 改成矿工分配奖励用的佣金
+func (action *Action) activeCommission(consignee *ty.Pos33Consignee) {
+	if consignee.FeeActiveHeight > 0 && action.height >= consignee.FeeActiveHeight {
+		consignee.FeePersent = consignee.PendingFeePersent
+		consignee.PendingFeePersent = 0
+		consignee.FeeActiveHeight = 0
+	}
+}
+
+// setCommission 降低佣金马上生效. 提高佣金每次最多提高 CommissionStep 个百分点, 在 CommissionDelay 个区块以后生效,
+// 委托人有时间取消委托. 生效以前再次设置会替换等待生效的佣金
+func (action *Action) setCommission(consignee *ty.Pos33Consignee, fee int64) error {
+	mp := ty.GetPos33MineParam(action.api.GetConfig(), action.height)
+	action.activeCommission(consignee)
+	if fee <= consignee.FeePersent {
+		consignee.FeePersent = fee
+		consignee.PendingFeePersent = 0
+		consignee.FeeActiveHeight = 0
+		return nil
+	}
+	if fee > consignee.FeePersent+mp.CommissionStep {
+		return ty.ErrCommission
+	}
+	consignee.PendingFeePersent = fee
+	consignee.FeeActiveHeight = action.height + mp.CommissionDelay
+	return nil
+}
+
+// Pos33SetCommission 矿工登记或者修改佣金, 同时改矿工分配奖励用的佣金 (提高的佣金延迟生效, 见 setCommission)
+func (action *Action) Pos33SetCommission(sc *ty.Pos33SetCommission) (*types.Receipt, error) {
+	if sc.Commission < 0 || sc.Commission > ty.MaxCommission {
+		return nil, ty.ErrCommission
+	}
+	op, err := getOperator(action.db, action.fromaddr)
+	if err == ty.ErrNotOperator {
+		op = &ty.Pos33Operator{Address: action.fromaddr, Height: action.height}
+	} else if err != nil {
+		return nil, err
+	}
+	op.Commission = sc.Commission
+
+	consignee, err := action.getConsignee(action.fromaddr)
+	if err == types.ErrNotFound {
+		// 还没有委托人, 佣金马上生效
+		consignee = &ty.Pos33Consignee{Address: action.fromaddr, FeePersent: sc.Commission}
+	} else if err != nil {
+		return nil, err
+	} else if err = action.setCommission(consignee, sc.Commission); err != nil {
+		return nil, err
+	}
+	kvs := []*types.KeyValue{{Key: OperatorKey(op.Address), Value: types.Encode(op)}}
+	kvs = append(kvs, action.updateConsignee(consignee)...)
+	tlog.Info("pos33 set commission", "operator", action.fromaddr, "commission", sc.Commission, "active", consignee.FeeActiveHeight, "height", action.height)
+	return &types.Receipt{KV: kvs, Ty: types.ExecOk}, nil
+}
+
+// Pos33Delegate 委托给登记过的矿工
+func (action *Action) Pos33Delegate(d *ty.Pos33Delegate) (*types.Receipt, error) {
+	if d.Amount <= 0 {
+		return nil, types.ErrAmount
+	}
+	if _, err := getOperator(action.db, d.Operator); err != nil {
+		return nil, err
+	}
+	return action.Pos33Entrust(&ty.Pos33Entrust{Consignee: d.Operator, Consignor: action.fromaddr, Amount: d.Amount})
+}
+
+// Pos33Undelegate 取消委托, 不能超过委托给这个矿工的数量
+func (action *Action) Pos33Undelegate(u *ty.Pos33Undelegate) (*types.Receipt, error) {
+	if u.Amount <= 0 {
+		return nil, types.ErrAmount
+	}
+	amount, err := EntrustAmount(action.db, u.Operator, action.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	if amount < u.Amount {
+		return nil, types.ErrAmount
+	}
+	return action.Pos33Entrust(&ty.Pos33Entrust{Consignee: u.Operator, Consignor: action.fromaddr, Amount: -u.Amount})
+}
@@ -0,0 +1,91 @@
+package executor
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+/*
+table  struct
+data:  矿工的抵押 (Pos33DepositMsg)
+index: amount, count, close
+*/
+
+var depositOpt = &table.Option{
+	Prefix:  "LODB-pos33",
+	Name:    "deposit",
+	Primary: "addr",
+	Index:   []string{"amount", "count", "close"},
+}
+
+// NewDepositTable 新建表
+func NewDepositTable(kvdb db.KV) *table.Table {
+	rowmeta := NewDepositRow()
+	newTable, err := table.NewTable(rowmeta, kvdb, depositOpt)
+	if err != nil {
+		panic(err)
+	}
+	return newTable
+}
+
+// DepositRow table meta 结构
+type DepositRow struct {
+	*ty.Pos33DepositMsg
+}
+
+// NewDepositRow 新建一个meta 结构
+func NewDepositRow() *DepositRow {
+	return &DepositRow{Pos33DepositMsg: &ty.Pos33DepositMsg{}}
+}
+
+// CreateRow 新建数据行
+func (r *DepositRow) CreateRow() *table.Row {
+	return &table.Row{Data: &ty.Pos33DepositMsg{}}
+}
+
+// SetPayload 设置数据
+func (r *DepositRow) SetPayload(data types.Message) error {
+	if d, ok := data.(*ty.Pos33DepositMsg); ok {
+		r.Pos33DepositMsg = d
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+// Get 按照indexName 查询 indexValue
+func (r *DepositRow) Get(key string) ([]byte, error) {
+	switch key {
+	case "addr":
+		return address.FormatAddrKey(r.Maddr), nil
+	case "amount", "count", "close":
+		return depositIndexValue(key, depositIndexOf(key, r.Pos33DepositMsg)), nil
+	}
+	return nil, types.ErrNotFound
+}
+
+func depositIndexOf(index string, d *ty.Pos33DepositMsg) int64 {
+	switch index {
+	case "amount":
+		return d.Amount
+	case "count":
+		return d.Count
+	}
+	return d.CloseHeight
+}
+
+func depositIndexValue(index string, v int64) []byte {
+	if index == "close" {
+		return []byte(fmt.Sprintf("%012d", v))
+	}
+	return []byte(fmt.Sprintf("%019d", v))
+}
+
+// depositIndexPrefix 索引在 localdb 里的前缀, 和 table 的 key 格式一致, 用于按范围查询
+func depositIndexPrefix(index string) []byte {
+	return []byte(depositOpt.Prefix + "-" + depositOpt.Name + "-m-" + index + "-")
+}
@@ -0,0 +1,84 @@
+package executor
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const (
+	// 难度系数只保存最近的 diffRing 个高度
+	diffRing = 64
+	diffUnit = int64(1000000)
+	// 全网在线时委员会的位置平均能填满的比例, 作为调整的目标
+	diffTargetFill = diffUnit * 92 / 100
+	diffMinRatio   = diffUnit / 2
+	diffMaxRatio   = diffUnit * 4
+	// 超过 diffMaxRound 的轮次按 diffMaxRound 折算
+	diffMaxRound = 20
+)
+
+// DiffRetargetKey height 的难度系数, 按 diffRing 循环使用
+func DiffRetargetKey(height int64) []byte {
+	return []byte(fmt.Sprintf("mavl-pos33-diff-%d", height%diffRing))
+}
+
+func getDiffRetarget(db dbm.KV, height int64) (*ty.Pos33DiffRetarget, error) {
+	val, err := db.Get(DiffRetargetKey(height))
+	if err != nil || len(val) == 0 {
+		return nil, types.ErrNotFound
+	}
+	var dr ty.Pos33DiffRetarget
+	err = types.Decode(val, &dr)
+	if err != nil {
+		return nil, err
+	}
+	if dr.Height != height {
+		return nil, types.ErrNotFound
+	}
+	return &dr, nil
+}
+
+// diffFill 区块的投票人数占委员会 (size 个位置) 的比例, 每多一轮难度增加 10%, 折算回第 0 轮
+func diffFill(miner *ty.Pos33MinerMsg, size int) int64 {
+	fill := int64(len(miner.Voters())) * diffUnit / int64(size)
+	if fill > diffUnit {
+		fill = diffUnit
+	}
+	round := miner.GetSort().GetProof().GetInput().GetRound()
+	for i := int32(0); i < round && i < diffMaxRound; i++ {
+		fill = fill * 10 / 11
+	}
+	return fill
+}
+
+// diffRetarget ForkSmoothDiff 之后每个区块更新抽签难度系数. 抵押离线或者加入时委员会填不满或者溢出,
+// 系数按照 (目标比例 - 本区块比例) / diffWindow 小步调整, 相当于对最近 diffWindow 个区块的偏差做移动平均,
+// 抽签用的是 Pos33SortBlocks 之前的系数, 窗口远大于这个延迟, 系数平滑收敛不会来回震荡
+func (action *Action) diffRetarget(miner *ty.Pos33MinerMsg, size int) []*types.KeyValue {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkSmoothDiff") {
+		return nil
+	}
+	w := ty.GetPos33MineParam(cfg, action.height).DiffWindow
+	prev, err := getDiffRetarget(action.db, action.height-1)
+	if err != nil {
+		prev = &ty.Pos33DiffRetarget{Ratio: diffUnit, Fill: diffTargetFill}
+	}
+	fill := diffFill(miner, size)
+	dr := &ty.Pos33DiffRetarget{
+		Height: action.height,
+		Ratio:  prev.Ratio + prev.Ratio*(diffTargetFill-fill)/diffUnit/w,
+		Fill:   prev.Fill + (fill-prev.Fill)/w,
+	}
+	if dr.Ratio < diffMinRatio {
+		dr.Ratio = diffMinRatio
+	}
+	if dr.Ratio > diffMaxRatio {
+		dr.Ratio = diffMaxRatio
+	}
+	tlog.Debug("diff retarget", "height", action.height, "fill", fill, "avg", dr.Fill, "ratio", dr.Ratio)
+	return []*types.KeyValue{{Key: DiffRetargetKey(action.height), Value: types.Encode(dr)}}
+}
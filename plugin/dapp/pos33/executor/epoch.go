@@ -0,0 +1,46 @@
+package executor
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// EpochStakeKey epoch 开始时全网抵押的快照
+func EpochStakeKey(epoch int64) []byte {
+	return []byte(fmt.Sprintf("mavl-pos33-epoch-stake-%d", epoch))
+}
+
+func getEpochStake(db dbm.KV, epoch int64) (int64, error) {
+	val, err := db.Get(EpochStakeKey(epoch))
+	if err != nil || len(val) == 0 {
+		return 0, types.ErrNotFound
+	}
+	var n types.Int64
+	err = types.Decode(val, &n)
+	if err != nil {
+		return 0, err
+	}
+	return n.Data, nil
+}
+
+// epochStake epoch 的第一个区块记录全网抵押, miner 交易是区块的第一个交易, 记录的是上一个区块结束时的抵押
+func (action *Action) epochStake() []*types.KeyValue {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkStakeEpoch") {
+		return nil
+	}
+	k := ty.GetPos33MineParam(cfg, action.height).StakeEpochBlocks
+	if action.height%k != 0 {
+		return nil
+	}
+	amount, err := getAllAmount(action.db)
+	if err != nil {
+		tlog.Error("epochStake getAllAmount error", "height", action.height, "err", err)
+		return nil
+	}
+	tlog.Info("epoch stake", "epoch", action.height/k, "amount", amount)
+	return []*types.KeyValue{{Key: EpochStakeKey(action.height / k), Value: types.Encode(&types.Int64{Data: amount})}}
+}
@@ -53,18 +53,35 @@ import (
 //Exec_Miner exec miner
 func (t *Pos33Ticket) Exec_Miner(payload *ty.Pos33MinerMsg, tx *types.Transaction, index int) (*types.Receipt, error) {
 	actiondb := NewAction(t, tx)
+	// nonce 和基础交易费错误是区块内容的问题, miner 交易执行失败, 拒绝这个区块
+	nkvs, err := actiondb.execNonce(t.GetTxs())
+	if err != nil {
+		return nil, err
+	}
+	err = actiondb.checkBaseFee()
+	if err != nil {
+		return nil, err
+	}
+	actiondb.fromaddr, err = blockMaker(actiondb.db, actiondb.api.GetConfig(), tx, payload, actiondb.height)
+	if err != nil {
+		return nil, err
+	}
 	r, err := actiondb.Pos33MinerNew(payload, index)
 	if err != nil {
 		panic(err)
 	}
+	r.KV = append(r.KV, nkvs...)
 	return r, nil
 }
 
-// // Exec_Tbind exec bind
-// func (t *Pos33Ticket) Exec_Tbind(payload *ty.Pos33TicketBind, tx *types.Transaction, index int) (*types.Receipt, error) {
-// 	actiondb := NewAction(t, tx)
-// 	return actiondb.Pos33TicketBind(payload)
-// }
+// Exec_MinerBind exec bind
+func (t *Pos33Ticket) Exec_MinerBind(payload *ty.Pos33TicketBind, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkMinerBind") {
+		return nil, types.ErrActionNotSupport
+	}
+	return action.Pos33Bind(payload)
+}
 
 // Exec_Entrust exec entrust
 func (t *Pos33Ticket) Exec_Entrust(payload *ty.Pos33Entrust, tx *types.Transaction, index int) (*types.Receipt, error) {
@@ -99,3 +116,90 @@ func (t *Pos33Ticket) Exec_BlsBind(payload *ty.Pos33BlsBind, tx *types.Transacti
 // 	action := NewAction(t, tx)
 // 	return action.Pos33SetMinerFeeRate(payload)
 // }
+
+// Exec_MinerInfo exec set miner info
+func (t *Pos33Ticket) Exec_MinerInfo(payload *ty.Pos33MinerInfo, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	chain33Cfg := action.api.GetConfig()
+	if !chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkMinerInfo") {
+		return nil, types.ErrActionNotSupport
+	}
+	mp := ty.GetPos33MineParam(chain33Cfg, action.height)
+	if tx.Fee < mp.MinerInfoFee {
+		return nil, types.ErrTxFeeTooLow
+	}
+	return action.Pos33SetMinerInfo(payload)
+}
+
+// Exec_Delegate exec delegate
+func (t *Pos33Ticket) Exec_Delegate(payload *ty.Pos33Delegate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkDelegation") {
+		return nil, types.ErrActionNotSupport
+	}
+	return action.Pos33Delegate(payload)
+}
+
+// Exec_Undelegate exec undelegate
+func (t *Pos33Ticket) Exec_Undelegate(payload *ty.Pos33Undelegate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkDelegation") {
+		return nil, types.ErrActionNotSupport
+	}
+	return action.Pos33Undelegate(payload)
+}
+
+// Exec_SetCommission exec set commission
+func (t *Pos33Ticket) Exec_SetCommission(payload *ty.Pos33SetCommission, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkDelegation") {
+		return nil, types.ErrActionNotSupport
+	}
+	return action.Pos33SetCommission(payload)
+}
+
+// Exec_SetCompound exec set compound
+func (t *Pos33Ticket) Exec_SetCompound(payload *ty.Pos33SetCompound, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkCompound") {
+		return nil, types.ErrActionNotSupport
+	}
+	return action.Pos33SetCompound(payload)
+}
+
+// Exec_OpenTickets exec open tickets
+func (t *Pos33Ticket) Exec_OpenTickets(payload *ty.Pos33OpenTickets, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkBatchTickets") {
+		return nil, types.ErrActionNotSupport
+	}
+	return action.Pos33OpenTickets(payload)
+}
+
+// Exec_CloseTickets exec close tickets
+func (t *Pos33Ticket) Exec_CloseTickets(payload *ty.Pos33CloseTickets, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkBatchTickets") {
+		return nil, types.ErrActionNotSupport
+	}
+	return action.Pos33CloseTickets(payload)
+}
+
+// Exec_BlockSigner exec bind block signer
+func (t *Pos33Ticket) Exec_BlockSigner(payload *ty.Pos33BlockSignerBind, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkBlockSigner") {
+		return nil, types.ErrActionNotSupport
+	}
+	return action.Pos33BlockSignerBind(payload)
+}
+
+// Exec_Slash exec slash
+func (t *Pos33Ticket) Exec_Slash(payload *ty.Pos33Evidence, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	chain33Cfg := action.api.GetConfig()
+	if !chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkSlash") {
+		return nil, types.ErrActionNotSupport
+	}
+	return action.Pos33Slash(payload)
+}
@@ -35,11 +35,53 @@ func (t *Pos33Ticket) ExecDelLocal_Tclose(payload *ty.Pos33TicketClose, tx *type
 // ExecDelLocal_Miner exec del local miner
 func (t *Pos33Ticket) ExecDelLocal_Miner(payload *ty.Pos33MinerMsg, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	tlog.Info("ExecDelLocal_Miner", "height", t.GetHeight())
-	return t.execDelLocal(receiptData)
+	dbSet, err := t.execDelLocal(receiptData)
+	if err != nil {
+		return nil, err
+	}
+	// 抵押索引的回滚
+	kvs, err := t.DelRollbackKV(tx, tx.Execer)
+	if err != nil {
+		return nil, err
+	}
+	dbSet.KV = append(dbSet.KV, kvs...)
+	dbSet.KV = append(dbSet.KV, t.execDelLocalChart()...)
+	dbSet.KV = append(dbSet.KV, execLocalRewards(receiptData, true)...)
+	return dbSet, nil
 }
 
-// ExecDelLocal_Bind exec del local miner
-func (t *Pos33Ticket) ExecDelLocal_Bind(payload *ty.Pos33TicketBind, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
-	tlog.Info("ExecDelLocal_Miner", "height", t.GetHeight())
+// ExecDelLocal_Entrust exec del local entrust
+func (t *Pos33Ticket) ExecDelLocal_Entrust(payload *ty.Pos33Entrust, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocalStake(tx)
+}
+
+// ExecDelLocal_Delegate exec del local delegate
+func (t *Pos33Ticket) ExecDelLocal_Delegate(payload *ty.Pos33Delegate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocalStake(tx)
+}
+
+// ExecDelLocal_Undelegate exec del local undelegate
+func (t *Pos33Ticket) ExecDelLocal_Undelegate(payload *ty.Pos33Undelegate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocalStake(tx)
+}
+
+// ExecDelLocal_OpenTickets exec del local open tickets
+func (t *Pos33Ticket) ExecDelLocal_OpenTickets(payload *ty.Pos33OpenTickets, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocalStake(tx)
+}
+
+// ExecDelLocal_CloseTickets exec del local close tickets
+func (t *Pos33Ticket) ExecDelLocal_CloseTickets(payload *ty.Pos33CloseTickets, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocalStake(tx)
+}
+
+// ExecDelLocal_Slash exec del local slash
+func (t *Pos33Ticket) ExecDelLocal_Slash(payload *ty.Pos33Evidence, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocalStake(tx)
+}
+
+// ExecDelLocal_MinerBind exec del local bind
+func (t *Pos33Ticket) ExecDelLocal_MinerBind(payload *ty.Pos33TicketBind, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	tlog.Info("ExecDelLocal_MinerBind", "height", t.GetHeight())
 	return t.execDelLocal(receiptData)
 }
@@ -39,12 +39,63 @@ func (t *Pos33Ticket) ExecLocal_Miner(payload *ty.Pos33MinerMsg, tx *types.Trans
 	if err != nil {
 		return nil, err
 	}
+	kvs, err := t.stakeIndex(t.minerStakeAddrs(tx, payload))
+	if err != nil {
+		return nil, err
+	}
+	if len(kvs) > 0 {
+		dbSet.KV = append(dbSet.KV, t.AddRollbackKV(tx, tx.Execer, kvs)...)
+	}
+	dbSet.KV = append(dbSet.KV, t.execLocalChart(payload)...)
+	if !asyncIndex {
+		dbSet.KV = append(dbSet.KV, execLocalRewards(receiptData, false)...)
+	}
 	return dbSet, nil
 }
 
-// ExecLocal_Miner exec local miner
-func (t *Pos33Ticket) ExecLocal_Bind(payload *ty.Pos33TicketBind, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
-	tlog.Debug("ExecLocal_Bind", "height", t.GetHeight())
+// ExecLocal_Entrust exec local entrust
+func (t *Pos33Ticket) ExecLocal_Entrust(payload *ty.Pos33Entrust, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalStake(tx, payload.Consignee)
+}
+
+// ExecLocal_Delegate exec local delegate
+func (t *Pos33Ticket) ExecLocal_Delegate(payload *ty.Pos33Delegate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalStake(tx, payload.Operator)
+}
+
+// ExecLocal_Undelegate exec local undelegate
+func (t *Pos33Ticket) ExecLocal_Undelegate(payload *ty.Pos33Undelegate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalStake(tx, payload.Operator)
+}
+
+// ExecLocal_OpenTickets exec local open tickets
+func (t *Pos33Ticket) ExecLocal_OpenTickets(payload *ty.Pos33OpenTickets, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalStake(tx, ticketsConsignees(receiptData)...)
+}
+
+// ExecLocal_CloseTickets exec local close tickets
+func (t *Pos33Ticket) ExecLocal_CloseTickets(payload *ty.Pos33CloseTickets, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalStake(tx, ticketsConsignees(receiptData)...)
+}
+
+// ExecLocal_Slash exec local slash
+func (t *Pos33Ticket) ExecLocal_Slash(payload *ty.Pos33Evidence, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	var addrs []string
+	for _, l := range receiptData.Logs {
+		if l.Ty != ty.TyLogPos33Slash {
+			continue
+		}
+		var r ty.ReceiptPos33Slash
+		if types.Decode(l.Log, &r) == nil {
+			addrs = append(addrs, r.Addr)
+		}
+	}
+	return t.execLocalStake(tx, addrs...)
+}
+
+// ExecLocal_MinerBind exec local bind
+func (t *Pos33Ticket) ExecLocal_MinerBind(payload *ty.Pos33TicketBind, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	tlog.Debug("ExecLocal_MinerBind", "height", t.GetHeight())
 	dbSet, err := t.execLocal(receiptData)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,122 @@
+package executor
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// ImmatureKey 地址未成熟的奖励
+func ImmatureKey(addr string) []byte {
+	return []byte("mavl-pos33-immature-" + string(address.FormatAddrKey(addr)))
+}
+
+// MatureKey 在 height 成熟的奖励列表
+func MatureKey(height int64) []byte {
+	return []byte(fmt.Sprintf("mavl-pos33-mature-%d", height))
+}
+
+func getImmature(db dbm.KV, addr string) (*ty.Pos33Immature, error) {
+	val, err := db.Get(ImmatureKey(addr))
+	if err == types.ErrNotFound {
+		return &ty.Pos33Immature{Addr: addr}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	im := new(ty.Pos33Immature)
+	err = types.Decode(val, im)
+	if err != nil {
+		return nil, err
+	}
+	return im, nil
+}
+
+func getMatureList(db dbm.KV, height int64) (*ty.Pos33ImmatureList, error) {
+	val, err := db.Get(MatureKey(height))
+	if err == types.ErrNotFound || (err == nil && len(val) == 0) {
+		return &ty.Pos33ImmatureList{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	list := new(ty.Pos33ImmatureList)
+	err = types.Decode(val, list)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// rewardTransfer 转账奖励, 需要锁定的奖励先记下来, 由 matureReward 统一处理
+func (act *Action) rewardTransfer(to string, amount int64) (*types.Receipt, error) {
+	if act.maturity <= 0 {
+		return act.payReward(to, amount)
+	}
+	if act.immature == nil {
+		act.immature = make(map[string]int64)
+	}
+	act.immature[to] += amount
+	return &types.Receipt{Ty: types.ExecOk}, nil
+}
+
+// matureReward 发放在本高度成熟的奖励, 并把本区块的奖励锁定 maturity 个区块
+func (act *Action) matureReward() (*types.Receipt, error) {
+	var kvs []*types.KeyValue
+	var logs []*types.ReceiptLog
+
+	delta := make(map[string]int64)
+	list, err := getMatureList(act.db, act.height)
+	if err != nil {
+		return nil, err
+	}
+	for _, it := range list.Items {
+		receipt, err := act.payReward(it.Addr, it.Amount)
+		if err != nil {
+			tlog.Error("mature reward transfer error", "to", it.Addr, "amount", it.Amount, "height", act.height)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kvs = append(kvs, receipt.KV...)
+		delta[it.Addr] -= it.Amount
+	}
+	if len(list.Items) > 0 {
+		kvs = append(kvs, &types.KeyValue{Key: MatureKey(act.height), Value: types.Encode(&ty.Pos33ImmatureList{})})
+	}
+
+	if len(act.immature) > 0 {
+		mh := act.height + act.maturity
+		nl, err := getMatureList(act.db, mh)
+		if err != nil {
+			return nil, err
+		}
+		var items []*ty.Pos33Immature
+		for addr, amount := range act.immature {
+			items = append(items, &ty.Pos33Immature{Addr: addr, Amount: amount})
+			delta[addr] += amount
+		}
+		sort.Slice(items, func(i, j int) bool { return items[i].Addr < items[j].Addr })
+		nl.Items = append(nl.Items, items...)
+		kvs = append(kvs, &types.KeyValue{Key: MatureKey(mh), Value: types.Encode(nl)})
+		act.immature = nil
+	}
+
+	addrs := make([]string, 0, len(delta))
+	for addr := range delta {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	for _, addr := range addrs {
+		im, err := getImmature(act.db, addr)
+		if err != nil {
+			return nil, err
+		}
+		im.Amount += delta[addr]
+		kvs = append(kvs, &types.KeyValue{Key: ImmatureKey(addr), Value: types.Encode(im)})
+	}
+	return &types.Receipt{KV: kvs, Logs: logs, Ty: types.ExecOk}, nil
+}
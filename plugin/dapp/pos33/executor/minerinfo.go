@@ -0,0 +1,42 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// MinerInfoKey miner info key
+func MinerInfoKey(addr string) []byte {
+	return []byte("mavl-pos33-minerinfo-" + string(address.FormatAddrKey(addr)))
+}
+
+func getMinerInfo(db dbm.KV, addr string) (*ty.Pos33MinerInfo, error) {
+	val, err := db.Get(MinerInfoKey(addr))
+	if err != nil {
+		return nil, err
+	}
+	info := new(ty.Pos33MinerInfo)
+	err = types.Decode(val, info)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// Pos33SetMinerInfo 矿工设置自己的名字, 网址和联系方式
+func (action *Action) Pos33SetMinerInfo(info *ty.Pos33MinerInfo) (*types.Receipt, error) {
+	if len(info.Name) > ty.MinerInfoNameSize || len(info.Website) > ty.MinerInfoWebsiteSize || len(info.ContactHash) > ty.MinerInfoContactSize {
+		return nil, ty.ErrMinerInfoSize
+	}
+	_, err := action.getConsignee(action.fromaddr)
+	if err != nil {
+		tlog.Error("set miner info error", "err", err, "height", action.height, "addr", action.fromaddr)
+		return nil, ty.ErrMinerAddr
+	}
+	info.Addr = action.fromaddr
+	info.Height = action.height
+	tlog.Info("set miner info", "addr", info.Addr, "name", info.Name, "height", action.height)
+	return &types.Receipt{KV: []*types.KeyValue{{Key: MinerInfoKey(info.Addr), Value: types.Encode(info)}}, Ty: types.ExecOk}, nil
+}
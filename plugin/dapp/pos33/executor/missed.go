@@ -0,0 +1,62 @@
+package executor
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// MissedKey 地址没有出块的统计
+func MissedKey(addr string) []byte {
+	return []byte(fmt.Sprintf("mavl-pos33-missed-%s", address.FormatAddrKey(addr)))
+}
+
+func getMissedSlots(db dbm.KV, addr string) (*ty.Pos33MissedSlots, error) {
+	val, err := db.Get(MissedKey(addr))
+	if err != nil || len(val) == 0 {
+		return nil, types.ErrNotFound
+	}
+	var ms ty.Pos33MissedSlots
+	err = types.Decode(val, &ms)
+	if err != nil {
+		return nil, err
+	}
+	return &ms, nil
+}
+
+// missedMakers ForkMissedMaker 之后记录抽中了但是没有出块的制作人. 抽签已经由共识验证过,
+// miner 交易里保存了抽签的证明, 质押服务可以根据链上的记录做补偿
+func (action *Action) missedMakers(miner *ty.Pos33MinerMsg) ([]*types.KeyValue, []*types.ReceiptLog, error) {
+	if len(miner.Missed) == 0 {
+		return nil, nil, nil
+	}
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkMissedMaker") {
+		return nil, nil, ty.ErrMissedMaker
+	}
+	err := miner.CheckMissed(action.height)
+	if err != nil {
+		return nil, nil, err
+	}
+	var kvs []*types.KeyValue
+	var logs []*types.ReceiptLog
+	for _, s := range miner.Missed {
+		addr := action.stakeAddr(address.PubKeyToAddr(ethID, s.Proof.Pubkey))
+		ms, err := getMissedSlots(action.db, addr)
+		if err != nil {
+			ms = &ty.Pos33MissedSlots{Addr: addr}
+		}
+		r := &ty.ReceiptPos33Missed{Addr: addr, Height: action.height, Round: s.Proof.Input.Round, SortHash: s.SortHash.Hash}
+		ms.Count++
+		ms.Recent = append(ms.Recent, r)
+		if len(ms.Recent) > ty.Pos33MissedRecent {
+			ms.Recent = ms.Recent[len(ms.Recent)-ty.Pos33MissedRecent:]
+		}
+		kvs = append(kvs, &types.KeyValue{Key: MissedKey(addr), Value: types.Encode(ms)})
+		logs = append(logs, &types.ReceiptLog{Ty: ty.TyLogPos33Missed, Log: types.Encode(r)})
+		tlog.Info("pos33 missed maker", "addr", addr, "height", action.height, "round", r.Round)
+	}
+	return kvs, logs, nil
+}
@@ -0,0 +1,55 @@
+package executor
+
+import (
+	"sort"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// NonceKey 账户的下一个 nonce, 保存在状态里
+func NonceKey(addr string) []byte {
+	return []byte("mavl-pos33-nonce-" + string(address.FormatAddrKey(addr)))
+}
+
+func getNextNonce(db dbm.KV, addr string) (int64, error) {
+	val, err := db.Get(NonceKey(addr))
+	if err == types.ErrNotFound || len(val) == 0 {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	var n types.Int64
+	err = types.Decode(val, &n)
+	if err != nil {
+		return 0, err
+	}
+	return n.Data, nil
+}
+
+// execNonce 在 miner 交易里检查并更新整个区块交易的账户 nonce.
+// miner 交易最先执行, 读到的是上一个区块的状态, nonce 不对的区块执行失败
+func (action *Action) execNonce(txs []*types.Transaction) ([]*types.KeyValue, error) {
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkAccountNonce") {
+		return nil, nil
+	}
+	ns := ty.NewNonces(func(addr string) (int64, error) {
+		return getNextNonce(action.db, addr)
+	})
+	for _, tx := range txs {
+		err := ns.Check(tx)
+		if err != nil {
+			tlog.Error("execNonce error", "height", action.height, "tx", tx.Hash(), "nonce", tx.Nonce, "err", err)
+			return nil, err
+		}
+	}
+	var kvs []*types.KeyValue
+	for addr, next := range ns.Changed() {
+		kvs = append(kvs, &types.KeyValue{Key: NonceKey(addr), Value: types.Encode(&types.Int64{Data: next})})
+	}
+	sort.Slice(kvs, func(i, j int) bool { return string(kvs[i].Key) < string(kvs[j].Key) })
+	return kvs, nil
+}
@@ -0,0 +1,108 @@
+package executor
+
+import (
+	"sync"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"github.com/yccproject/ycc/plugin/dapp/xcall"
+)
+
+// 委托池: 别的执行器 (例如 stycc) 用一个没有私钥的地址做委托人, 币冻结在它自己执行器下面的账户里,
+// 通过 xcall 的 pos33.Entrust 登记委托. 给委托池的奖励转到它执行器下面的账户, 由执行器自己分配
+var (
+	execPoolsMu sync.RWMutex
+	execPools   = make(map[string]string) // key 是委托池地址, val 是执行器地址
+)
+
+func init() {
+	xcall.Register(driverName, "Entrust", xcallEntrust, false)
+}
+
+// RegisterExecPool 登记执行器 exec 的委托池地址 pool
+func RegisterExecPool(exec, pool string) {
+	execPoolsMu.Lock()
+	defer execPoolsMu.Unlock()
+	execPools[pool] = dapp.ExecAddress(exec)
+}
+
+func execPoolAddr(pool string) (string, bool) {
+	execPoolsMu.RLock()
+	defer execPoolsMu.RUnlock()
+	execaddr, ok := execPools[pool]
+	return execaddr, ok
+}
+
+// BurnAddr 罚没的币转到这个地址
+func BurnAddr() string {
+	return burnAddr
+}
+
+// EntrustAmount consignor 委托给 consignee 的数量, 罚没以后会减少
+func EntrustAmount(db dbm.KV, consignee, consignor string) (int64, error) {
+	c, err := getConsignee(db, consignee)
+	if err == types.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	for _, cr := range c.Consignors {
+		if cr.Address == consignor {
+			return cr.Amount, nil
+		}
+	}
+	return 0, nil
+}
+
+// payReward 从 pos33 转奖励给 to, 委托池的奖励转到它执行器下面的账户, 自动复投的奖励存到 pos33 执行器下面的账户
+func (act *Action) payReward(to string, amount int64) (*types.Receipt, error) {
+	on, err := act.isCompound(to)
+	if err != nil {
+		return nil, err
+	}
+	if on {
+		return act.depositCompound(to, amount)
+	}
+	execaddr, ok := execPoolAddr(to)
+	if !ok {
+		return act.coinsAccount.Transfer(act.execaddr, to, amount)
+	}
+	receipt, err := act.coinsAccount.Transfer(act.execaddr, execaddr, amount)
+	if err != nil {
+		return nil, err
+	}
+	receipt1, err := act.coinsAccount.ExecDeposit(to, execaddr, amount)
+	if err != nil {
+		return nil, err
+	}
+	receipt.KV = append(receipt.KV, receipt1.KV...)
+	receipt.Logs = append(receipt.Logs, receipt1.Logs...)
+	return receipt, nil
+}
+
+// xcallEntrust 委托池登记或者取消委托, payload 是 ty.Pos33Entrust, Consignor 必须是调用者登记的委托池.
+// 币由调用的执行器自己冻结和解冻, 这里只改委托的数量
+func xcallEntrust(ctx *xcall.Context, payload []byte) ([]byte, *types.Receipt, error) {
+	var pe ty.Pos33Entrust
+	err := types.Decode(payload, &pe)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := execPoolAddr(ctx.Caller); !ok || pe.Consignor != ctx.Caller {
+		return nil, nil, types.ErrFromAddr
+	}
+	cfg := ctx.GetAPI().GetConfig()
+	if !cfg.IsDappFork(ctx.GetHeight(), ty.Pos33TicketX, "UseEntrust") {
+		return nil, nil, types.ErrActionNotSupport
+	}
+	act := &Action{db: ctx.GetStateDB(), fromaddr: ctx.Caller, blocktime: ctx.GetBlockTime(), height: ctx.GetHeight(),
+		execaddr: dapp.ExecAddress(driverName), api: ctx.GetAPI()}
+	receipt, err := act.setEntrust(&pe)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, receipt, nil
+}
@@ -63,14 +63,30 @@ type Action struct {
 	height       int64
 	execaddr     string
 	api          client.QueueProtocolAPI
+	parentHash   []byte
+	// 区块里所有的交易
+	txs []*types.Transaction
+	// 本区块自动复投的委托, 读过的自动复投记录和改过的地址
+	compounded    []*compounded
+	compounds     map[string]*ty.Pos33Compound
+	compoundAddrs []string
+	// 本区块每个地址的奖励
+	rewards    map[rewardKey]*ty.Pos33RewardEvent
+	rewardList []*ty.Pos33RewardEvent
+
+	// 奖励锁定的区块数, 和锁定中的奖励
+	maturity int64
+	immature map[string]int64
+	// 本交易已经记过抵押变化的委托
+	stakeRecorded map[string]bool
 }
 
 // NewAction new action type
 func NewAction(t *Pos33Ticket, tx *types.Transaction) *Action {
 	hash := tx.Hash()
 	fromaddr := tx.From()
-	return &Action{t.GetCoinsAccount(), t.GetStateDB(), hash, fromaddr,
-		t.GetBlockTime(), t.GetHeight(), dapp.ExecAddress(string(tx.Execer)), t.GetAPI()}
+	return &Action{coinsAccount: t.GetCoinsAccount(), db: t.GetStateDB(), txhash: hash, fromaddr: fromaddr,
+		blocktime: t.GetBlockTime(), height: t.GetHeight(), execaddr: dapp.ExecAddress(string(tx.Execer)), api: t.GetAPI(), parentHash: t.GetParentHash(), txs: t.GetTxs()}
 }
 
 func (act *Action) updateConsignee(consignee *ty.Pos33Consignee) []*types.KeyValue {
@@ -132,10 +148,11 @@ func (act *Action) minerReward(consignee *ty.Pos33Consignee, mineReward int64) (
 	if consignee.Amount == 0 {
 		return nil, nil
 	}
+	act.activeCommission(consignee)
 	chain33Cfg := act.api.GetConfig()
 	mp := ty.GetPos33MineParam(chain33Cfg, act.height)
 	needTransfer := mp.RewardTransfer
-	tprice := mp.GetTicketPrice()
+	tprice := ticketPrice(act.db, chain33Cfg, act.height)
 
 	var kvs []*types.KeyValue
 	var logs []*types.ReceiptLog
@@ -147,14 +164,16 @@ func (act *Action) minerReward(consignee *ty.Pos33Consignee, mineReward int64) (
 		}
 		crr := int64(r1 * float64(cr.Amount/tprice))
 		cr.Reward += crr
+		act.addReward(cr.Address, ty.Pos33RewardMaker, crr)
 		tlog.Debug("mine reward add", "addr", cr.Address, "reward", cr.Reward, "height", act.height)
 		cr.RemainReward += crr
 		if cr.RemainReward >= needTransfer {
 			fee := cr.RemainReward * consignee.FeePersent / 100
 			consignee.FeeReward += fee
+			act.addReward(consignee.Address, ty.Pos33RewardCommission, fee)
 			consignee.RemainFeeReward += fee
 			transferAmount := cr.RemainReward - fee
-			receipt, err := act.coinsAccount.Transfer(act.execaddr, cr.Address, transferAmount)
+			receipt, err := act.rewardTransfer(cr.Address, transferAmount)
 			if err != nil {
 				tlog.Error("reward transfer error", "to", cr.Address, "execaddr", act.execaddr, "amount", transferAmount)
 				return nil, err
@@ -163,9 +182,17 @@ func (act *Action) minerReward(consignee *ty.Pos33Consignee, mineReward int64) (
 			logs = append(logs, receipt.Logs...)
 			kvs = append(kvs, receipt.KV...)
 			tlog.Debug("reward transfer to", "addr", cr.Address, "height", act.height, "amount", transferAmount, "fee", fee)
+			receipt, err = act.compound(consignee, cr)
+			if err != nil {
+				return nil, err
+			}
+			if receipt != nil {
+				logs = append(logs, receipt.Logs...)
+				kvs = append(kvs, receipt.KV...)
+			}
 
 			if consignee.RemainFeeReward >= needTransfer*10 {
-				receipt, err := act.coinsAccount.Transfer(act.execaddr, consignee.Address, consignee.RemainFeeReward)
+				receipt, err := act.rewardTransfer(consignee.Address, consignee.RemainFeeReward)
 				if err != nil {
 					tlog.Error("fee reward transfer error", "to", consignee.Address, "execaddr", act.execaddr, "amount", consignee.RemainFeeReward)
 					return nil, err
@@ -184,7 +211,7 @@ func (act *Action) voteReward(mis []*minerInfo, voteReward int64) (*types.Receip
 	chain33Cfg := act.api.GetConfig()
 	mp := ty.GetPos33MineParam(chain33Cfg, act.height)
 	needTransfer := mp.RewardTransfer
-	tprice := mp.GetTicketPrice()
+	tprice := ticketPrice(act.db, chain33Cfg, act.height)
 
 	var kvs []*types.KeyValue
 	var logs []*types.ReceiptLog
@@ -197,6 +224,7 @@ func (act *Action) voteReward(mis []*minerInfo, voteReward int64) (*types.Receip
 		if consignee.Amount == 0 {
 			continue
 		}
+		act.activeCommission(consignee)
 		vr := voteReward * int64(mi.nv)
 		r1 := float64(vr) / float64(consignee.Amount/tprice)
 		for _, cr := range consignee.Consignors {
@@ -205,14 +233,16 @@ func (act *Action) voteReward(mis []*minerInfo, voteReward int64) (*types.Receip
 			}
 			crr := int64(r1 * float64(cr.Amount/tprice))
 			cr.Reward += crr
+			act.addReward(cr.Address, ty.Pos33RewardVoter, crr)
 			tlog.Debug("vote reward add", "addr", cr.Address, "reward", cr.Reward, "height", act.height)
 			cr.RemainReward += crr
 			if cr.RemainReward >= needTransfer {
 				fee := cr.RemainReward * consignee.FeePersent / 100
 				consignee.FeeReward += fee
+				act.addReward(consignee.Address, ty.Pos33RewardCommission, fee)
 				consignee.RemainFeeReward += fee
 				transferAmount := cr.RemainReward - fee
-				receipt, err := act.coinsAccount.Transfer(act.execaddr, cr.Address, transferAmount)
+				receipt, err := act.rewardTransfer(cr.Address, transferAmount)
 				if err != nil {
 					tlog.Error("transfer reward error", "height", act.height, "to", cr.Address, "amount", transferAmount)
 					return nil, err
@@ -221,9 +251,17 @@ func (act *Action) voteReward(mis []*minerInfo, voteReward int64) (*types.Receip
 				logs = append(logs, receipt.Logs...)
 				kvs = append(kvs, receipt.KV...)
 				tlog.Debug("reward transfer to", "addr", cr.Address, "height", act.height, "transfer", transferAmount, "fee", fee)
+				receipt, err = act.compound(consignee, cr)
+				if err != nil {
+					return nil, err
+				}
+				if receipt != nil {
+					logs = append(logs, receipt.Logs...)
+					kvs = append(kvs, receipt.KV...)
+				}
 
 				if consignee.RemainFeeReward >= needTransfer*10 {
-					receipt, err := act.coinsAccount.Transfer(act.execaddr, consignee.Address, consignee.RemainFeeReward)
+					receipt, err := act.rewardTransfer(consignee.Address, consignee.RemainFeeReward)
 					if err != nil {
 						tlog.Error("fee reward transfer error", "to", consignee.Address, "execaddr", act.execaddr, "amount", consignee.RemainFeeReward)
 						return nil, err
@@ -240,12 +278,34 @@ func (act *Action) voteReward(mis []*minerInfo, voteReward int64) (*types.Receip
 }
 
 func (act *Action) getFromBls(blspk []byte) (string, error) {
+	return act.blsAddrAt(blspk, act.height-ty.Pos33SortBlocks)
+}
+
+// blsAddrAt bls 公钥绑定的地址在 sortHeight 抽签用的抵押地址
+func (act *Action) blsAddrAt(blspk []byte, sortHeight int64) (string, error) {
 	val, err := act.db.Get(BlsKey(address.PubKeyToAddr(ethID, blspk)))
 	if err != nil {
 		tlog.Error("getFromBls error", "err", err, "height", act.height)
 		return "", err
 	}
-	return string(val), nil
+	return act.stakeAddrAt(string(val), sortHeight), nil
+}
+
+// splitTips 每一票分到的小费: 投票人一共分到 tips*voteReward/(voteReward+makerReward), 按票数 nv 平分
+func splitTips(tips, voteReward, makerReward int64, nv int) int64 {
+	if tips <= 0 || nv <= 0 || voteReward+makerReward <= 0 {
+		return 0
+	}
+	return mulDiv(tips, voteReward, voteReward+makerReward) / int64(nv)
+}
+
+// calcFundReward 出块奖励分给投票人和制作人以后剩下给基金的部分, 迟到的投票只有投票奖励, 不能超过区块奖励
+func calcFundReward(blockReward, voteReward, makerReward int64, votes, late int) (int64, error) {
+	r := blockReward - (voteReward+makerReward)*int64(votes) - voteReward*int64(late)
+	if r < 0 {
+		return 0, ty.ErrLateVotes
+	}
+	return r, nil
 }
 
 type minerInfo struct {
@@ -282,6 +342,17 @@ func (action *Action) Pos33MinerNew(miner *ty.Pos33MinerMsg, index int) (*types.
 		Pos33VoteReward = mp.VoteReward
 		Pos33MakerReward = mp.MineReward
 	}
+	Pos33VoteReward, Pos33MakerReward = rewardSplit(action.db, chain33Cfg, action.height, Pos33BlockReward, Pos33VoteReward, Pos33MakerReward)
+	// 委员会变大时每一票的奖励按比例变小, 总的增发不变
+	size := voterSize(action.db, chain33Cfg, action.height)
+	if size != ty.Pos33VoterSize {
+		Pos33VoteReward = Pos33VoteReward * ty.Pos33VoterSize / int64(size)
+		Pos33MakerReward = Pos33MakerReward * ty.Pos33VoterSize / int64(size)
+	}
+	useMature := chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkRewardMature")
+	if useMature {
+		action.maturity = ty.GetPos33MineParam(chain33Cfg, action.height).RewardMaturity
+	}
 
 	var kvs []*types.KeyValue
 	var logs []*types.ReceiptLog
@@ -300,16 +371,36 @@ func (action *Action) Pos33MinerNew(miner *ty.Pos33MinerMsg, index int) (*types.
 
 	// voters reward
 	mp := make(map[string]int)
-	for _, pk := range miner.BlsPkList {
+	for _, pk := range miner.Voters() {
 		addr, err := action.getFromBls(pk)
 		if err != nil {
 			return nil, err
 		}
 		mp[addr]++
 	}
-	_, ok := mp[action.fromaddr]
+	// 上一个区块没有包含的投票, 只有投票的奖励
+	var lateVoters [][]byte
+	if miner.Late != nil && chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkLateVotes") {
+		lateVoters = miner.Late.BlsPkList
+	}
+	if len(lateVoters) > 0 && len(miner.Late.Sorts) != len(lateVoters) {
+		return nil, ty.ErrLateVotes
+	}
+	for i, pk := range lateVoters {
+		// bls 公钥绑定的必须是抽签的地址, 不能拿别人的抽签领奖励
+		val, err := action.db.Get(BlsKey(address.PubKeyToAddr(ethID, pk)))
+		if err != nil {
+			return nil, err
+		}
+		if string(val) != address.PubKeyToAddr(ethID, miner.Late.Sorts[i].GetProof().GetPubkey()) {
+			return nil, ty.ErrLateVotes
+		}
+		mp[action.stakeAddr(string(val))]++
+	}
+	maker := action.stakeAddr(action.fromaddr)
+	_, ok := mp[maker]
 	if !ok {
-		mp[action.fromaddr] = 0
+		mp[maker] = 0
 	}
 
 	var bm *ty.Pos33Consignee
@@ -319,13 +410,28 @@ func (action *Action) Pos33MinerNew(miner *ty.Pos33MinerMsg, index int) (*types.
 		if err != nil {
 			return nil, err
 		}
-		if k == action.fromaddr {
+		if k == maker {
 			bm = consignee
 		}
 		mis = append(mis, &minerInfo{addr: k, nv: v, miner: consignee})
 	}
 	sort.Slice(mis, func(i, j int) bool { return mis[i].addr < mis[j].addr })
-	receipt, err := action.voteReward(mis, Pos33VoteReward)
+
+	// 小费增发以后按出块奖励的比例分给投票人和制作人, 投票人按票平分, 除不尽的给制作人
+	bkvs, tips := action.baseFee()
+	kvs = append(kvs, bkvs...)
+	var voteTip int64
+	if tips > 0 {
+		receipt, err := action.coinsAccount.ExecIssueCoins(action.execaddr, tips)
+		if err != nil {
+			tlog.Error("Pos33MinerNew issue tips error", "err", err, "height", action.height)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kvs = append(kvs, receipt.KV...)
+		voteTip = splitTips(tips, Pos33VoteReward, Pos33MakerReward, len(miner.Voters())+len(lateVoters))
+	}
+	receipt, err := action.voteReward(mis, Pos33VoteReward+voteTip)
 	if err != nil {
 		tlog.Error("Pos33MinerNew error", "err", err, "height", action.height)
 		return nil, err
@@ -335,7 +441,7 @@ func (action *Action) Pos33MinerNew(miner *ty.Pos33MinerMsg, index int) (*types.
 	}
 
 	// bp reward
-	bpReward := Pos33MakerReward * int64(len(miner.BlsPkList))
+	bpReward := Pos33MakerReward*int64(len(miner.Voters())) + tips - voteTip*int64(len(miner.Voters())+len(lateVoters))
 	receipt, err = action.minerReward(bm, bpReward)
 	if err != nil {
 		tlog.Error("Pos33MinerNew error", "err", err, "height", action.height)
@@ -350,8 +456,28 @@ func (action *Action) Pos33MinerNew(miner *ty.Pos33MinerMsg, index int) (*types.
 		kvs = append(kvs, action.updateConsignee(mi.miner)...)
 	}
 
+	// 锁定的奖励
+	if useMature {
+		receipt, err = action.matureReward()
+		if err != nil {
+			tlog.Error("Pos33MinerNew error", "err", err, "height", action.height)
+			return nil, err
+		}
+		kvs = append(kvs, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
+	}
+	// 成熟的奖励也要记到自动复投的记录里
+	compoundKVs, err := action.compoundKVs()
+	if err != nil {
+		return nil, err
+	}
+	kvs = append(kvs, compoundKVs...)
+
 	// fund reward
-	fundReward := Pos33BlockReward - (Pos33VoteReward+Pos33MakerReward)*int64(len(miner.BlsPkList))
+	fundReward, err := calcFundReward(Pos33BlockReward, Pos33VoteReward, Pos33MakerReward, len(miner.Voters()), len(lateVoters))
+	if err != nil {
+		return nil, err
+	}
 	fundaddr := chain33Cfg.MGStr("mver.consensus.fundKeyAddr", action.height)
 	tlog.Debug("fund rerward", "fundaddr", fundaddr, "height", action.height, "reward", fundReward)
 
@@ -362,6 +488,42 @@ func (action *Action) Pos33MinerNew(miner *ty.Pos33MinerMsg, index int) (*types.
 	}
 	logs = append(logs, receipt.Logs...)
 	kvs = append(kvs, receipt.KV...)
+	if chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkRewardSplit") || chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkDynamicCommittee") {
+		r := &ty.ReceiptPos33Reward{
+			Height:      action.height,
+			BlockReward: Pos33BlockReward,
+			Maker:       maker,
+			MakerReward: bpReward,
+			Tips:        tips,
+			VoteReward:  Pos33VoteReward,
+			Votes:       int32(len(miner.Voters())),
+			LateVotes:   int32(len(lateVoters)),
+			Fund:        fundaddr,
+			FundReward:  fundReward,
+			VoterSize:   int32(size),
+			VoteTip:     voteTip,
+		}
+		logs = append(logs, &types.ReceiptLog{Ty: ty.TyLogPos33Reward, Log: types.Encode(r)})
+	}
+	logs = append(logs, action.rewardsLog()...)
+	kvs = append(kvs, action.checkpoint(miner)...)
+	kvs = append(kvs, action.epochStake()...)
+	kvs = append(kvs, action.diffRetarget(miner, size)...)
+	kvs = append(kvs, action.blockSeed(miner)...)
+	kvs = append(kvs, action.managePrice()...)
+	mkvs, mlogs, err := action.missedMakers(miner)
+	if err != nil {
+		tlog.Error("Pos33MinerNew missed makers error", "err", err, "height", action.height)
+		return nil, err
+	}
+	kvs = append(kvs, mkvs...)
+	logs = append(logs, mlogs...)
+	ckvs, err := action.voteCommitment(miner)
+	if err != nil {
+		tlog.Error("Pos33MinerNew vote commitment error", "err", err, "height", action.height)
+		return nil, err
+	}
+	kvs = append(kvs, ckvs...)
 
 	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
 }
@@ -452,14 +614,20 @@ func (action *Action) setEntrust(pe *ty.Pos33Entrust) (*types.Receipt, error) {
 	if pe.Amount == 0 {
 		return nil, types.ErrAmount
 	}
+	if pe.Amount > 0 && action.stakeAddrAt(pe.Consignee, action.height) != pe.Consignee {
+		// 热地址不能有自己的抵押
+		return nil, ty.ErrMinerBound
+	}
 
 	chain33Cfg := action.api.GetConfig()
 	mp := ty.GetPos33MineParam(chain33Cfg, action.height)
 
 	consignee, err := action.getConsignee(pe.Consignee)
-	if err != nil {
-		tlog.Error("setEntrust error", "err", err, "height", action.height, "consignee", pe.Consignee)
+	if err == types.ErrNotFound {
 		consignee = &ty.Pos33Consignee{Address: pe.Consignee, FeePersent: mp.MinerFeePersent}
+	} else if err != nil {
+		tlog.Error("setEntrust error", "err", err, "height", action.height, "consignee", pe.Consignee)
+		return nil, err
 	}
 	var consignor *ty.Consignor
 	for _, cr := range consignee.Consignors {
@@ -477,9 +645,13 @@ func (action *Action) setEntrust(pe *ty.Pos33Entrust) (*types.Receipt, error) {
 		consignee.Consignors = append(consignee.Consignors, consignor)
 	}
 
+	kvs, err := action.recordStake(pe.Consignee)
+	if err != nil {
+		return nil, err
+	}
 	consignee.Amount += pe.Amount
 	consignor.Amount += pe.Amount
-	kvs := action.updateConsignor(consignor, pe.Consignee)
+	kvs = append(kvs, action.updateConsignor(consignor, pe.Consignee)...)
 	kvs = append(kvs, action.updateConsignee(consignee)...)
 	kvs = append(kvs, action.updateAllAmount(pe.Amount))
 
@@ -567,7 +739,14 @@ func (action *Action) Pos33SetMinerFeeRate(fr *ty.Pos33MinerFeeRate) (*types.Rec
 	if fr.FeeRatePersent <= 0 || fr.FeeRatePersent >= 100 {
 		return nil, errors.New("minerFeeRate is error")
 	}
-	consignee.FeePersent = int64(fr.FeeRatePersent)
+	if action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkDelegation") {
+		// 和 SetCommission 一样, 提高的佣金延迟生效
+		if err = action.setCommission(consignee, int64(fr.FeeRatePersent)); err != nil {
+			return nil, err
+		}
+	} else {
+		consignee.FeePersent = int64(fr.FeeRatePersent)
+	}
 	kv := action.updateConsignee(consignee)
 	tlog.Info("set miner fee", "height", action.height, "fee rate %", fr.FeeRatePersent)
 	return &types.Receipt{KV: kv, Ty: types.ExecOk}, nil
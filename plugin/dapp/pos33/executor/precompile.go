@@ -0,0 +1,120 @@
+package executor
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strconv"
+	"sync"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// evm 合约读取抵押的预编译合约, 参数和返回值按 abi 编码 (每个参数 32 字节, 没有函数选择器):
+//
+//	0x...0200 ticketCount(address addr) returns (uint256 tickets, uint256 stake)
+//	0x...0201 totalStake() returns (uint256 tickets, uint256 stake)
+//
+// 读的是正在执行的状态, 包括本区块前面交易的修改.
+// ForkEVMStake 之前这两个地址不是预编译合约, 和以前一样是空账户
+var (
+	ticketCountAddr = common.BytesToHash160Address([]byte{2, 0})
+	totalStakeAddr  = common.BytesToHash160Address([]byte{2, 1})
+
+	errStakeInput = errors.New("pos33 stake precompile: bad input")
+	errStakeState = errors.New("pos33 stake precompile: no state")
+
+	stakePrecompiles = map[common.Hash160Address]runtime.PrecompiledContract{
+		ticketCountAddr: &stakePrecompile{},
+		totalStakeAddr:  &stakePrecompile{total: true},
+	}
+)
+
+const stakePrecompileGas = 5000
+
+// 正在执行的 evm 的状态, 由 EnterEVM 设置.
+// 预编译合约表是全局的, 所以 evm 的执行要串行
+var stakeState struct {
+	sync.Mutex
+	cfg    *types.Chain33Config
+	db     dbm.KV
+	height int64
+}
+
+// EnterEVM evm 执行前调用, 按 height 打开或关闭预编译合约, 合约读 db 的状态.
+// 在返回的函数被调用以前, 其他 evm 的执行会等待
+func EnterEVM(cfg *types.Chain33Config, db dbm.KV, height int64) func() {
+	stakeState.Lock()
+	stakeState.cfg, stakeState.db, stakeState.height = cfg, db, height
+	on := cfg.IsDappFork(height, ty.Pos33TicketX, "ForkEVMStake")
+	for _, m := range []map[common.Hash160Address]runtime.PrecompiledContract{
+		runtime.PrecompiledContractsByzantium,
+		runtime.PrecompiledContractsIstanbul,
+		runtime.PrecompiledContractsYoloV1,
+		runtime.PrecompiledContractsBerlin,
+	} {
+		for addr, p := range stakePrecompiles {
+			if on {
+				m[addr] = p
+			} else {
+				delete(m, addr)
+			}
+		}
+	}
+	return func() {
+		stakeState.cfg, stakeState.db = nil, nil
+		stakeState.Unlock()
+	}
+}
+
+type stakePrecompile struct {
+	total bool
+}
+
+func (p *stakePrecompile) RequiredGas(input []byte) uint64 {
+	return stakePrecompileGas
+}
+
+// Run 调用者持有 stakeState 的锁
+func (p *stakePrecompile) Run(input []byte) ([]byte, error) {
+	cfg, db, height := stakeState.cfg, stakeState.db, stakeState.height
+	if db == nil {
+		return nil, errStakeState
+	}
+	size := 32
+	if p.total {
+		size = 0
+	}
+	if len(input) != size {
+		return nil, errStakeInput
+	}
+	var stake int64
+	if p.total {
+		val, err := db.Get(AllFrozenAmount())
+		if err != nil && err != types.ErrNotFound {
+			return nil, err
+		}
+		if len(val) > 0 {
+			stake, err = strconv.ParseInt(string(val), 10, 64)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		consignee, err := getConsignee(db, "0x"+hex.EncodeToString(input[12:32]))
+		if err != nil && err != types.ErrNotFound {
+			return nil, err
+		}
+		if consignee != nil {
+			stake = consignee.Amount
+		}
+	}
+	ret := make([]byte, 64)
+	big.NewInt(stake / ticketPrice(db, cfg, height)).FillBytes(ret[:32])
+	big.NewInt(stake).FillBytes(ret[32:])
+	return ret, nil
+}
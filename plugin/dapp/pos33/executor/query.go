@@ -6,6 +6,7 @@ package executor
 
 import (
 	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
 )
 
 // // Query_AllPos33TicketCount query all ticket count
@@ -73,3 +74,152 @@ func (ticket *Pos33Ticket) Query_Pos33ConsigneeEntrust(param *types.ReqAddr) (ty
 	}
 	return entrust, nil
 }
+
+// Query_Pos33Operator query registered operator
+func (ticket *Pos33Ticket) Query_Pos33Operator(param *types.ReqAddr) (types.Message, error) {
+	return getOperator(ticket.GetStateDB(), param.Addr)
+}
+
+// Query_Pos33MinerBind query the miner bind which addr is in, as miner or return address, with the previous bind
+func (ticket *Pos33Ticket) Query_Pos33MinerBind(param *types.ReqAddr) (types.Message, error) {
+	return readMinerBind(ticket.GetStateDB(), param.Addr)
+}
+
+// Query_Pos33BlockSigner query the block signer registration of addr
+func (ticket *Pos33Ticket) Query_Pos33BlockSigner(param *types.ReqAddr) (types.Message, error) {
+	return getBlockSigner(ticket.GetStateDB(), param.Addr)
+}
+
+// Query_Pos33Compound query auto compound setting of addr
+func (ticket *Pos33Ticket) Query_Pos33Compound(param *types.ReqAddr) (types.Message, error) {
+	c, err := getCompound(ticket.GetStateDB(), param.Addr)
+	if err == types.ErrNotFound {
+		return &ty.Pos33Compound{Address: param.Addr}, nil
+	}
+	return c, err
+}
+
+// Query_Pos33ImmatureReward query immature reward of addr
+func (ticket *Pos33Ticket) Query_Pos33ImmatureReward(param *types.ReqAddr) (types.Message, error) {
+	return getImmature(ticket.GetStateDB(), param.Addr)
+}
+
+// Query_Pos33MinerInfo query miner info
+func (ticket *Pos33Ticket) Query_Pos33MinerInfo(param *types.ReqAddr) (types.Message, error) {
+	return getMinerInfo(ticket.GetStateDB(), param.Addr)
+}
+
+// Query_Pos33Slashed query if the evidence has been slashed
+func (ticket *Pos33Ticket) Query_Pos33Slashed(param *ty.Pos33Evidence) (types.Message, error) {
+	height, round, err := param.Check()
+	if err != nil {
+		return nil, err
+	}
+	action := &Action{db: ticket.GetStateDB(), api: ticket.GetAPI(), height: ticket.GetHeight()}
+	offender, err := action.evidenceOffender(param, height)
+	if err != nil {
+		return nil, err
+	}
+	_, err = ticket.GetStateDB().Get(SlashedKey(offender, height, round, param.Ty))
+	return &types.Reply{IsOk: err == nil}, nil
+}
+
+// Query_Pos33Finalized query the latest finalized checkpoint
+func (ticket *Pos33Ticket) Query_Pos33Finalized(param *types.ReqNil) (types.Message, error) {
+	return getCheckpoint(ticket.GetStateDB(), CheckpointFinalizedKey())
+}
+
+// Query_Pos33NextNonce query the next nonce of the account, 0 means the account not use nonce
+func (ticket *Pos33Ticket) Query_Pos33NextNonce(param *types.ReqString) (types.Message, error) {
+	next, err := getNextNonce(ticket.GetStateDB(), param.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Int64{Data: next}, nil
+}
+
+// Query_Pos33PendingCheckpoint query the checkpoint waiting for committee signatures
+func (ticket *Pos33Ticket) Query_Pos33PendingCheckpoint(param *types.ReqNil) (types.Message, error) {
+	return getCheckpoint(ticket.GetStateDB(), CheckpointPendingKey())
+}
+
+// Query_Pos33EpochStake query total stake snapshot of the epoch containing height
+func (ticket *Pos33Ticket) Query_Pos33EpochStake(param *types.ReqInt) (types.Message, error) {
+	cfg := ticket.GetAPI().GetConfig()
+	k := ty.GetPos33MineParam(cfg, param.Height).StakeEpochBlocks
+	amount, err := getEpochStake(ticket.GetStateDB(), param.Height/k)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Int64{Data: amount}, nil
+}
+
+// Query_Pos33DiffRetarget query sortition difficulty ratio of height, only recent heights are kept
+func (ticket *Pos33Ticket) Query_Pos33DiffRetarget(param *types.ReqInt) (types.Message, error) {
+	dr, err := getDiffRetarget(ticket.GetStateDB(), param.Height)
+	if err != nil {
+		return nil, err
+	}
+	return dr, nil
+}
+
+// Query_Pos33BaseFee query base fee of height, heights without record return the base fee calculated from height-1
+func (ticket *Pos33Ticket) Query_Pos33BaseFee(param *types.ReqInt) (types.Message, error) {
+	bf, err := getBaseFee(ticket.GetStateDB(), param.Height)
+	if err == nil {
+		return bf, nil
+	}
+	return &ty.Pos33BaseFee{Height: param.Height, BaseFee: calcBaseFee(ticket.GetStateDB(), ticket.GetAPI().GetConfig(), param.Height)}, nil
+}
+
+// Query_Pos33BaseFeeHistory query base fee of heights [start, end], only recent heights are kept
+func (ticket *Pos33Ticket) Query_Pos33BaseFeeHistory(param *types.ReqBlocks) (types.Message, error) {
+	if param.End < param.Start || param.End-param.Start >= ty.Pos33BaseFeeRing {
+		return nil, types.ErrInvalidParam
+	}
+	db := ticket.GetStateDB()
+	bfs := &ty.Pos33BaseFees{}
+	for h := param.Start; h <= param.End; h++ {
+		bf, err := getBaseFee(db, h)
+		if err != nil {
+			continue
+		}
+		bfs.Items = append(bfs.Items, bf)
+	}
+	bfs.Next = calcBaseFee(db, ticket.GetAPI().GetConfig(), param.End+1)
+	return bfs, nil
+}
+
+// Query_Pos33Chart query downsampled difficulty and stake of blocks in [start, end]
+func (ticket *Pos33Ticket) Query_Pos33Chart(param *ty.ReqPos33Chart) (types.Message, error) {
+	return queryChart(ticket.GetLocalDB(), param)
+}
+
+// Query_Pos33TicketPrice query ticket price active at height
+func (ticket *Pos33Ticket) Query_Pos33TicketPrice(param *types.ReqInt) (types.Message, error) {
+	return &types.Int64{Data: ticketPrice(ticket.GetStateDB(), ticket.GetAPI().GetConfig(), param.Height)}, nil
+}
+
+// Query_Pos33MissedSlots query missed maker slots of the address
+func (ticket *Pos33Ticket) Query_Pos33MissedSlots(param *types.ReqAddr) (types.Message, error) {
+	ms, err := getMissedSlots(ticket.GetStateDB(), param.Addr)
+	if err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+// Query_Pos33TopDeposits query miners ordered by ticket count, paged by cursor
+func (ticket *Pos33Ticket) Query_Pos33TopDeposits(param *ty.ReqPos33TopDeposits) (types.Message, error) {
+	return queryTopDeposits(ticket.GetLocalDB(), ticket.GetAPI().GetConfig(), ticket.GetStateDB(), ticket.GetHeight(), param)
+}
+
+// Query_Pos33Deposits query miners by the amount, count or close height index
+func (ticket *Pos33Ticket) Query_Pos33Deposits(param *ty.ReqPos33Deposits) (types.Message, error) {
+	return queryDeposits(ticket.GetLocalDB(), param)
+}
+
+// Query_Pos33RewardHistory query reward events of the address, newest first
+func (ticket *Pos33Ticket) Query_Pos33RewardHistory(param *ty.ReqPos33RewardHistory) (types.Message, error) {
+	return queryRewardHistory(ticket.GetLocalDB(), param)
+}
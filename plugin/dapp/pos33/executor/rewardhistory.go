@@ -0,0 +1,118 @@
+package executor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 每个地址的奖励记录: miner 交易把本区块每个地址得到的奖励写到 TyLogPos33Rewards, ExecLocal 按地址保存在 localdb,
+// 委托人不用重放区块就能对账. asyncIndex 时不写 localdb, 由共识的异步索引在区块提交以后写. 回滚时照样删, 删不存在的 key 没有影响
+
+const (
+	defaultRewardEvents = 20
+	maxRewardEvents     = 100
+)
+
+func rewardPrefix(addr string) string {
+	return "LODB-pos33-reward-" + string(address.FormatAddrKey(addr)) + "-"
+}
+
+// RewardKey 地址在 height 的一种奖励
+func RewardKey(addr string, height int64, rty int32) []byte {
+	return []byte(fmt.Sprintf("%s%012d-%d", rewardPrefix(addr), height, rty))
+}
+
+type rewardKey struct {
+	addr string
+	ty   int32
+}
+
+// addReward 记录本区块 addr 得到的奖励
+func (act *Action) addReward(addr string, rty int32, amount int64) {
+	if amount <= 0 {
+		return
+	}
+	k := rewardKey{addr, rty}
+	if act.rewards == nil {
+		act.rewards = make(map[rewardKey]*ty.Pos33RewardEvent)
+	}
+	e, ok := act.rewards[k]
+	if !ok {
+		e = &ty.Pos33RewardEvent{Address: addr, Height: act.height, Ty: rty}
+		act.rewards[k] = e
+		act.rewardList = append(act.rewardList, e)
+	}
+	e.Amount += amount
+}
+
+func (act *Action) rewardsLog() []*types.ReceiptLog {
+	if len(act.rewardList) == 0 {
+		return nil
+	}
+	r := &ty.ReceiptPos33Rewards{Height: act.height, Items: act.rewardList}
+	return []*types.ReceiptLog{{Ty: ty.TyLogPos33Rewards, Log: types.Encode(r)}}
+}
+
+// execLocalRewards 按 TyLogPos33Rewards 保存或者删除奖励记录
+func execLocalRewards(receiptData *types.ReceiptData, del bool) []*types.KeyValue {
+	var kvs []*types.KeyValue
+	for _, l := range receiptData.GetLogs() {
+		if l.Ty != ty.TyLogPos33Rewards {
+			continue
+		}
+		var r ty.ReceiptPos33Rewards
+		if types.Decode(l.Log, &r) != nil {
+			continue
+		}
+		for _, e := range r.Items {
+			kv := &types.KeyValue{Key: RewardKey(e.Address, e.Height, e.Ty)}
+			if !del {
+				kv.Value = types.Encode(e)
+			}
+			kvs = append(kvs, kv)
+		}
+	}
+	return kvs
+}
+
+// queryRewardHistory 地址的奖励记录, 从新到旧
+func queryRewardHistory(db dbm.KVDB, req *ty.ReqPos33RewardHistory) (*ty.Pos33RewardEvents, error) {
+	if req.Addr == "" {
+		return nil, types.ErrInvalidParam
+	}
+	count := req.Count
+	if count <= 0 {
+		count = defaultRewardEvents
+	}
+	if count > maxRewardEvents {
+		count = maxRewardEvents
+	}
+	prefix := rewardPrefix(req.Addr)
+	var key []byte
+	if req.Cursor != "" {
+		key = []byte(prefix + req.Cursor)
+	}
+	vals, err := db.List([]byte(prefix), key, count, dbm.ListDESC)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &ty.Pos33RewardEvents{}
+	for _, val := range vals {
+		e := new(ty.Pos33RewardEvent)
+		err = types.Decode(val, e)
+		if err != nil {
+			return nil, err
+		}
+		reply.Items = append(reply.Items, e)
+	}
+	if n := len(reply.Items); n == int(count) {
+		last := reply.Items[n-1]
+		reply.Next = strings.TrimPrefix(string(RewardKey(last.Address, last.Height, last.Ty)), prefix)
+	}
+	return reply, nil
+}
@@ -0,0 +1,65 @@
+package executor
+
+import (
+	"strconv"
+	"strings"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// rewardSplit ForkRewardSplit 之后, manage 执行器的 pos33-rewardSplit 可以修改每一票给投票人和制作人的奖励,
+// 没有设置或者设置错了用 mver 里的 voteRewardPersent 和 mineRewardPersent.
+// 满票的时候投票人和制作人的奖励不能超过区块奖励, 剩下的给基金
+func rewardSplit(db dbm.KV, cfg *types.Chain33Config, height, blockReward, vote, mine int64) (int64, int64) {
+	if !cfg.IsDappFork(height, ty.Pos33TicketX, "ForkRewardSplit") {
+		return vote, mine
+	}
+	val, err := db.Get([]byte(types.ManageKey(ty.ManageRewardSplitKey)))
+	if err != nil || len(val) == 0 {
+		return vote, mine
+	}
+	var item types.ConfigItem
+	err = types.Decode(val, &item)
+	if err != nil {
+		return vote, mine
+	}
+	vals := item.GetArr().GetValue()
+	if len(vals) == 0 {
+		return vote, mine
+	}
+	v, m, err := parseRewardSplit(vals[len(vals)-1])
+	if err != nil {
+		tlog.Error("manage reward split error", "value", vals[len(vals)-1], "height", height)
+		return vote, mine
+	}
+	coin := cfg.GetCoinPrecision()
+	v = v * coin / 100
+	m = m * coin / 100
+	if (v+m)*ty.Pos33VoterSize > blockReward {
+		tlog.Error("manage reward split too large", "value", vals[len(vals)-1], "height", height)
+		return vote, mine
+	}
+	return v, m
+}
+
+// parseRewardSplit 解析 "voteRewardPersent:mineRewardPersent"
+func parseRewardSplit(s string) (int64, int64, error) {
+	ss := strings.Split(s, ":")
+	if len(ss) != 2 {
+		return 0, 0, types.ErrInvalidParam
+	}
+	v, err := strconv.ParseInt(strings.TrimSpace(ss[0]), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	m, err := strconv.ParseInt(strings.TrimSpace(ss[1]), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	if v < 0 || m < 0 {
+		return 0, 0, types.ErrInvalidParam
+	}
+	return v, m, nil
+}
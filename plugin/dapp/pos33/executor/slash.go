@@ -0,0 +1,259 @@
+package executor
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// SlashedKey 已经处罚过的作恶
+func SlashedKey(addr string, height int64, round int32, evty int32) []byte {
+	return []byte(fmt.Sprintf("mavl-pos33-slashed-%s-%d-%d-%d", address.FormatAddrKey(addr), height, round, evty))
+}
+
+// 罚没的币转到这个没有私钥的地址
+var burnAddr = address.ExecAddress("pos33-burn")
+
+// evidenceOffender 作恶的抵押地址, 按作恶的区块抽签时的绑定找, 作恶以后换绑定躲不掉处罚
+func (action *Action) evidenceOffender(e *ty.Pos33Evidence, height int64) (string, error) {
+	sortHeight := height - ty.Pos33SortBlocks
+	switch e.Ty {
+	case ty.EvidenceMaker:
+		var act ty.Pos33TicketAction
+		err := types.Decode(e.Tx1.Payload, &act)
+		if err != nil {
+			return "", err
+		}
+		// 出块签名地址签的 miner 交易, 作恶的是登记它的挖矿地址
+		maker, err := blockMaker(action.db, action.api.GetConfig(), e.Tx1, act.GetMiner(), height)
+		if err != nil {
+			return "", err
+		}
+		return action.stakeAddrAt(maker, sortHeight), nil
+	case ty.EvidenceCheckpoint:
+		return action.blsAddrAt(e.Cp1.Sig.Pubkey, sortHeight)
+	}
+	return action.blsAddrAt(e.Vote1.Sig.Pubkey, sortHeight)
+}
+
+// StakeHistoryKey 最近 EvidenceWindow 个区块里委托的变化
+func StakeHistoryKey(addr string) []byte {
+	return []byte("mavl-pos33-stakehist-" + string(address.FormatAddrKey(addr)))
+}
+
+func getStakeHistory(db dbm.KV, addr string) (*ty.Pos33StakeHistory, error) {
+	h := new(ty.Pos33StakeHistory)
+	val, err := db.Get(StakeHistoryKey(addr))
+	if err == types.ErrNotFound {
+		return h, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = types.Decode(val, h)
+	if err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// recordStake 在 addr 的委托第一次改变以前记下这个区块开始时的抵押, 只留证据窗口里的记录.
+// 读到的是本交易执行前的状态, 同一个区块前面的交易改过委托的时候已经记过了
+func (action *Action) recordStake(addr string) ([]*types.KeyValue, error) {
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkSlash") || action.stakeRecorded[addr] {
+		return nil, nil
+	}
+	h, err := getStakeHistory(action.db, addr)
+	if err != nil {
+		return nil, err
+	}
+	if n := len(h.Snapshots); n > 0 && h.Snapshots[n-1].Height == action.height {
+		return nil, nil
+	}
+	c, err := getConsignee(action.db, addr)
+	if err == types.ErrNotFound {
+		c = &ty.Pos33Consignee{Address: addr}
+	} else if err != nil {
+		return nil, err
+	}
+	stake := &ty.Pos33Consignee{Address: addr, Amount: c.Amount}
+	for _, cr := range c.Consignors {
+		stake.Consignors = append(stake.Consignors, &ty.Consignor{Address: cr.Address, Amount: cr.Amount})
+	}
+	var snaps []*ty.Pos33StakeSnapshot
+	for _, snap := range h.Snapshots {
+		// 证据最早是 action.height-EvidenceWindow 高度的, 要的是它之后第一次改变以前的抵押
+		if snap.Height > action.height-ty.EvidenceWindow-1 {
+			snaps = append(snaps, snap)
+		}
+	}
+	h.Snapshots = append(snaps, &ty.Pos33StakeSnapshot{Height: action.height, Consignee: stake})
+	if action.stakeRecorded == nil {
+		action.stakeRecorded = make(map[string]bool)
+	}
+	action.stakeRecorded[addr] = true
+	return []*types.KeyValue{{Key: StakeHistoryKey(addr), Value: types.Encode(h)}}, nil
+}
+
+// consigneeAt 高度 height 的区块提交以后 addr 的抵押: height 之后第一次改变以前记下的, 没有改过就是现在的
+func (action *Action) consigneeAt(addr string, height int64) (*ty.Pos33Consignee, error) {
+	h, err := getStakeHistory(action.db, addr)
+	if err != nil {
+		return nil, err
+	}
+	for _, snap := range h.Snapshots {
+		if snap.Height > height {
+			return snap.Consignee, nil
+		}
+	}
+	return action.getConsignee(addr)
+}
+
+// slashShares 按作恶时的委托 past 计算 cur 里每个委托人罚没的数量, 不超过现在的委托.
+// byTicket (ForkSlashTickets 之后) 时作恶矿工必须有票, 罚没 percent 比例的票 (向上取整),
+// 按委托的比例分到每个委托人, 返回罚没的票数
+func slashShares(past, cur *ty.Pos33Consignee, percent, price int64, byTicket bool) ([]int64, int64, error) {
+	var burn, count, acc int64
+	if byTicket {
+		n := past.Amount / price
+		if n <= 0 {
+			return nil, 0, ty.ErrMinerAddr
+		}
+		count = (n*percent + 99) / 100
+		if count > n {
+			count = n
+		}
+		burn = count * price
+	}
+	shares := make(map[string]int64)
+	for _, cr := range past.Consignors {
+		amount := cr.Amount * percent / 100
+		if byTicket {
+			amount = mulDiv(burn, acc+cr.Amount, past.Amount) - mulDiv(burn, acc, past.Amount)
+			acc += cr.Amount
+		}
+		shares[cr.Address] = amount
+	}
+	amounts := make([]int64, len(cur.Consignors))
+	for i, cr := range cur.Consignors {
+		amount := shares[cr.Address]
+		if amount > cr.Amount {
+			// 作恶以后取回了委托
+			amount = cr.Amount
+		}
+		amounts[i] = amount
+	}
+	return amounts, count, nil
+}
+
+// checkEvidence 检查证据本身, 作恶的高度要在 cur 之前的 EvidenceWindow 个区块以内
+func checkEvidence(e *ty.Pos33Evidence, cur int64) (int64, int32, error) {
+	height, round, err := e.Check()
+	if err != nil {
+		return 0, 0, err
+	}
+	if height <= 0 || height >= cur || cur-height > ty.EvidenceWindow {
+		return 0, 0, ty.ErrEvidence
+	}
+	return height, round, nil
+}
+
+type slashBurn struct {
+	addr   string
+	amount int64
+}
+
+// applySlash 从 cur 的委托人里扣掉 amounts, 返回扣过的委托人, 需要转到 burnAddr 的冻结币和总数.
+// 委托池的币在它自己的执行器下面, 由那个执行器按减少的委托销毁, 这里不转
+func applySlash(cur *ty.Pos33Consignee, amounts []int64) ([]*ty.Consignor, []slashBurn, int64) {
+	var crs []*ty.Consignor
+	var burns []slashBurn
+	var total int64
+	for i, cr := range cur.Consignors {
+		amount := amounts[i]
+		if amount <= 0 {
+			continue
+		}
+		if _, ok := execPoolAddr(cr.Address); !ok {
+			burns = append(burns, slashBurn{cr.Address, amount})
+		}
+		cr.Amount -= amount
+		total += amount
+		crs = append(crs, cr)
+	}
+	cur.Amount -= total
+	return crs, burns, total
+}
+
+// Pos33Slash 根据作恶证据罚没作恶矿工的抵押. 证据要在作恶以后 EvidenceWindow 个区块以内提交,
+// 罚没的数量按作恶时的抵押计算
+func (action *Action) Pos33Slash(e *ty.Pos33Evidence) (*types.Receipt, error) {
+	height, round, err := checkEvidence(e, action.height)
+	if err != nil {
+		tlog.Error("slash evidence error", "err", err, "height", action.height)
+		return nil, err
+	}
+	offender, err := action.evidenceOffender(e, height)
+	if err != nil {
+		return nil, err
+	}
+	key := SlashedKey(offender, height, round, e.Ty)
+	_, err = action.db.Get(key)
+	if err == nil {
+		return nil, ty.ErrSlashed
+	}
+
+	past, err := action.consigneeAt(offender, height-1)
+	if err != nil {
+		tlog.Error("slash consignee at offence error", "err", err, "height", height, "offender", offender)
+		return nil, ty.ErrMinerAddr
+	}
+	consignee, err := action.getConsignee(offender)
+	if err != nil {
+		return nil, ty.ErrMinerAddr
+	}
+
+	cfg := action.api.GetConfig()
+	mp := ty.GetPos33MineParam(cfg, action.height)
+	byTicket := cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkSlashTickets")
+	amounts, count, err := slashShares(past, consignee, mp.SlashPersent, ticketPrice(action.db, cfg, height), byTicket)
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := action.recordStake(offender)
+	if err != nil {
+		return nil, err
+	}
+	var logs []*types.ReceiptLog
+	crs, burns, total := applySlash(consignee, amounts)
+	for _, b := range burns {
+		receipt, err := action.coinsAccount.ExecTransferFrozen(b.addr, burnAddr, action.execaddr, b.amount)
+		if err != nil {
+			tlog.Error("slash error", "err", err, "height", action.height, "consignor", b.addr, "amount", b.amount)
+			return nil, err
+		}
+		kvs = append(kvs, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
+	}
+	for _, cr := range crs {
+		kvs = append(kvs, action.updateConsignor(cr, consignee.Address)...)
+	}
+	kvs = append(kvs, action.updateConsignee(consignee)...)
+	kvs = append(kvs, action.updateAllAmount(-total))
+	kvs = append(kvs, &types.KeyValue{Key: key, Value: []byte{1}})
+
+	r := &ty.ReceiptPos33Slash{Addr: offender, Height: height, Round: round, Amount: total, Count: count}
+	logs = append(logs, &types.ReceiptLog{Ty: ty.TyLogPos33Slash, Log: types.Encode(r)})
+	tlog.Info("pos33 slash", "offender", offender, "height", height, "round", round, "amount", total, "ty", e.Ty)
+	return &types.Receipt{KV: kvs, Logs: logs, Ty: types.ExecOk}, nil
+}
+
+// mulDiv a*b/c, 中间结果可能超过 int64
+func mulDiv(a, b, c int64) int64 {
+	x := new(big.Int).Mul(big.NewInt(a), big.NewInt(b))
+	return x.Quo(x, big.NewInt(c)).Int64()
+}
@@ -0,0 +1,200 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func consignee(addr string, amounts map[string]int64, order ...string) *ty.Pos33Consignee {
+	c := &ty.Pos33Consignee{Address: addr}
+	for _, a := range order {
+		c.Consignors = append(c.Consignors, &ty.Consignor{Address: a, Amount: amounts[a]})
+		c.Amount += amounts[a]
+	}
+	return c
+}
+
+func TestMulDiv(t *testing.T) {
+	cases := []struct {
+		a, b, c, want int64
+	}{
+		{7, 3, 2, 10},
+		{1 << 62, 4, 8, 1 << 61},
+		{3000 * types.DefaultCoinPrecision, 3000 * types.DefaultCoinPrecision * 7, 3000 * types.DefaultCoinPrecision * 10, 2100 * types.DefaultCoinPrecision},
+		{1, 1, 3, 0},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, mulDiv(c.a, c.b, c.c), "%d*%d/%d", c.a, c.b, c.c)
+	}
+}
+
+func TestSlashShares(t *testing.T) {
+	const price = 3
+	cases := []struct {
+		name     string
+		past     *ty.Pos33Consignee
+		cur      *ty.Pos33Consignee
+		percent  int64
+		byTicket bool
+		want     []int64
+		count    int64
+		err      error
+	}{
+		{
+			// 10 张票罚 15%, 向上取整 2 张票, 按委托比例 3:7 分, 除不尽的留给后面的委托人
+			name:     "ticket round up",
+			past:     consignee("m", map[string]int64{"a": 9, "b": 21}, "a", "b"),
+			cur:      consignee("m", map[string]int64{"a": 9, "b": 21}, "a", "b"),
+			percent:  15,
+			byTicket: true,
+			want:     []int64{1, 5},
+			count:    2,
+		},
+		{
+			name:     "ticket all",
+			past:     consignee("m", map[string]int64{"a": 4, "b": 5}, "a", "b"),
+			cur:      consignee("m", map[string]int64{"a": 4, "b": 5}, "a", "b"),
+			percent:  100,
+			byTicket: true,
+			want:     []int64{4, 5},
+			count:    3,
+		},
+		{
+			name:     "no ticket",
+			past:     consignee("m", map[string]int64{"a": 2}, "a"),
+			cur:      consignee("m", map[string]int64{"a": 2}, "a"),
+			percent:  50,
+			byTicket: true,
+			err:      ty.ErrMinerAddr,
+		},
+		{
+			// 作恶以后 b 取回了一部分委托, c 是新的委托人
+			name:     "withdrawn after offence",
+			past:     consignee("m", map[string]int64{"a": 9, "b": 21}, "a", "b"),
+			cur:      consignee("m", map[string]int64{"a": 9, "b": 2, "c": 30}, "a", "b", "c"),
+			percent:  15,
+			byTicket: true,
+			want:     []int64{1, 2, 0},
+			count:    2,
+		},
+		{
+			name:    "by amount",
+			past:    consignee("m", map[string]int64{"a": 90, "b": 210}, "a", "b"),
+			cur:     consignee("m", map[string]int64{"a": 90, "b": 210}, "a", "b"),
+			percent: 15,
+			want:    []int64{13, 31},
+		},
+	}
+	for _, c := range cases {
+		amounts, count, err := slashShares(c.past, c.cur, c.percent, price, c.byTicket)
+		assert.Equal(t, c.err, err, c.name)
+		assert.Equal(t, c.want, amounts, c.name)
+		assert.Equal(t, c.count, count, c.name)
+	}
+}
+
+func TestApplySlashPool(t *testing.T) {
+	pool := "1PoolSlashTestAddr"
+	RegisterExecPool("pos33", pool)
+	cases := []struct {
+		name    string
+		amounts []int64
+		burns   []slashBurn
+		total   int64
+		left    int64
+	}{
+		{"pool not burned", []int64{3, 6}, []slashBurn{{"a", 3}}, 9, 21},
+		{"skip zero", []int64{0, 6}, nil, 6, 24},
+	}
+	for _, c := range cases {
+		cur := consignee("m", map[string]int64{"a": 9, pool: 21}, "a", pool)
+		crs, burns, total := applySlash(cur, c.amounts)
+		assert.Equal(t, c.burns, burns, c.name)
+		assert.Equal(t, c.total, total, c.name)
+		assert.Equal(t, c.left, cur.Amount, c.name)
+		for i, cr := range cur.Consignors {
+			assert.Equal(t, []int64{9, 21}[i]-c.amounts[i], cr.Amount, c.name)
+		}
+		assert.Equal(t, len(c.burns)+1, len(crs), c.name)
+	}
+}
+
+func TestCalcFundReward(t *testing.T) {
+	cases := []struct {
+		name        string
+		votes, late int
+		want        int64
+		err         error
+	}{
+		{"no late", 20, 0, 3000 - 20*(50+22), nil},
+		{"late votes", 20, 5, 3000 - 20*(50+22) - 5*50, nil},
+		{"exact", 25, 24, 3000 - 25*(50+22) - 24*50, nil},
+		{"too many late votes", 25, 25, 0, ty.ErrLateVotes},
+	}
+	for _, c := range cases {
+		r, err := calcFundReward(3000, 50, 22, c.votes, c.late)
+		assert.Equal(t, c.err, err, c.name)
+		assert.Equal(t, c.want, r, c.name)
+	}
+}
+
+func makerTx(priv crypto.PrivKey, height int64, blockTime int64) *types.Transaction {
+	act := &ty.Pos33TicketAction{
+		Ty: ty.Pos33TicketActionMiner,
+		Value: &ty.Pos33TicketAction_Miner{Miner: &ty.Pos33MinerMsg{
+			BlockTime: blockTime,
+			Sort:      &ty.Pos33SortMsg{SortHash: &ty.SortHash{}, Proof: &ty.HashProof{Input: &ty.VrfInput{Height: height, Round: 1}}},
+		}},
+	}
+	tx := &types.Transaction{Execer: []byte(ty.Pos33TicketX), Payload: types.Encode(act)}
+	tx.Sign(types.SECP256K1, priv)
+	return tx
+}
+
+func TestCheckEvidence(t *testing.T) {
+	cr, err := crypto.Load("secp256k1", -1)
+	assert.Nil(t, err)
+	priv, err := cr.GenKey()
+	assert.Nil(t, err)
+	other, err := cr.GenKey()
+	assert.Nil(t, err)
+
+	const height = 100
+	maker := &ty.Pos33Evidence{Ty: ty.EvidenceMaker, Tx1: makerTx(priv, height, 1), Tx2: makerTx(priv, height, 2)}
+	sort := &ty.Pos33SortMsg{SortHash: &ty.SortHash{Hash: crypto.Sha256([]byte("sort"))}, Proof: &ty.HashProof{Input: &ty.VrfInput{Height: height, Round: 1, Ty: 1}}}
+	v1 := &ty.Pos33VoteMsg{Hash: crypto.Sha256([]byte("maker1")), Sort: sort}
+	v2 := &ty.Pos33VoteMsg{Hash: crypto.Sha256([]byte("maker2")), Sort: sort}
+	v1.Sign(priv)
+	v2.Sign(priv)
+
+	cases := []struct {
+		name string
+		e    *ty.Pos33Evidence
+		cur  int64
+		err  error
+	}{
+		{"maker", maker, height + 1, nil},
+		{"maker at window", maker, height + ty.EvidenceWindow, nil},
+		{"too old", maker, height + ty.EvidenceWindow + 1, ty.ErrEvidence},
+		{"not committed", maker, height, ty.ErrEvidence},
+		{"same tx", &ty.Pos33Evidence{Ty: ty.EvidenceMaker, Tx1: maker.Tx1, Tx2: maker.Tx1}, height + 1, ty.ErrEvidence},
+		{"two makers", &ty.Pos33Evidence{Ty: ty.EvidenceMaker, Tx1: maker.Tx1, Tx2: makerTx(other, height, 2)}, height + 1, ty.ErrEvidence},
+		{"other height", &ty.Pos33Evidence{Ty: ty.EvidenceMaker, Tx1: maker.Tx1, Tx2: makerTx(priv, height+1, 2)}, height + 2, ty.ErrEvidence},
+		// 投票人的证据没有制作人的抽签, 不能证明是同一个高度和轮次
+		{"voter without makers", &ty.Pos33Evidence{Ty: ty.EvidenceVoter, Vote1: v1, Vote2: v2}, height + 1, ty.ErrEvidence},
+		{"voter forged makers", &ty.Pos33Evidence{Ty: ty.EvidenceVoter, Vote1: v1, Vote2: v2, Maker1: &ty.Pos33SortMsg{SortHash: &ty.SortHash{Hash: v1.Hash}, Proof: &ty.HashProof{Input: &ty.VrfInput{Height: height, Round: 1}}}, Maker2: &ty.Pos33SortMsg{SortHash: &ty.SortHash{Hash: v2.Hash}, Proof: &ty.HashProof{Input: &ty.VrfInput{Height: height, Round: 1}}}}, height + 1, ty.ErrEvidence},
+		{"unknown type", &ty.Pos33Evidence{Ty: 9}, height + 1, ty.ErrEvidence},
+	}
+	for _, c := range cases {
+		h, r, err := checkEvidence(c.e, c.cur)
+		assert.Equal(t, c.err, err, c.name)
+		if c.err == nil {
+			assert.Equal(t, int64(height), h, c.name)
+			assert.Equal(t, int32(1), r, c.name)
+		}
+	}
+}
@@ -26,8 +26,27 @@ import (
 var clog = log.New("module", "execs.pos33")
 var driverName = "pos33"
 
+type subConfig struct {
+	// 奖励记录只写到共识的异步索引 ([consensus.sub.pos33] indexDBPath), 提交区块时不写 localdb
+	AsyncIndex bool `json:"asyncIndex,omitempty"`
+	// 本节点关掉的执行器, 见 ty.RegisterDisabledExecs
+	DisabledExecs []string `json:"disabledExecs,omitempty"`
+}
+
+var asyncIndex bool
+
 // Init initial
 func Init(name string, cfg *types.Chain33Config, sub []byte) {
+	if sub != nil {
+		var subcfg subConfig
+		types.MustDecode(sub, &subcfg)
+		asyncIndex = subcfg.AsyncIndex
+		if execs := ty.RegisterDisabledExecs(subcfg.DisabledExecs); len(execs) != len(subcfg.DisabledExecs) {
+			clog.Error("only ycc execs can be disabled", "config", subcfg.DisabledExecs, "disabled", execs)
+		} else if len(execs) > 0 {
+			clog.Info("disabled execs", "execs", execs)
+		}
+	}
 	drivers.Register(cfg, GetName(), newPos33Ticket, cfg.GetDappFork(driverName, "Enable"))
 	InitExecType()
 }
@@ -12,8 +12,18 @@ import (
 	"github.com/stretchr/testify/assert"
 	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
 
-	_ "github.com/33cn/chain33/system"
-	_ "github.com/33cn/plugin/plugin"
+	// 不引入 system/p2p (依赖的 quic-go 在新版本的 go 上编译不过) 和 evm, 单节点测试不需要它们
+	_ "github.com/33cn/chain33/system/address"
+	_ "github.com/33cn/chain33/system/consensus/init"
+	_ "github.com/33cn/chain33/system/crypto/init"
+	_ "github.com/33cn/chain33/system/dapp/init"
+	_ "github.com/33cn/chain33/system/mempool/init"
+	_ "github.com/33cn/chain33/system/store/init"
+	_ "github.com/yccproject/ycc/plugin/consensus/pos33"
+	_ "github.com/yccproject/ycc/plugin/crypto/init"
+	_ "github.com/yccproject/ycc/plugin/dapp/pos33"
+	_ "github.com/yccproject/ycc/plugin/mempool/init"
+	_ "github.com/yccproject/ycc/plugin/store/init"
 )
 
 var mock33 *testnode.Chain33Mock
@@ -29,7 +39,7 @@ func TestMain(m *testing.M) {
 // 	cfg := mock33.GetAPI().GetConfig()
 // 	//test price
 // 	ti := &executor.DB{}
-// 	assert.Equal(t, ti.GetRealPrice(cfg), 10000*types.Coin)
+// 	assert.Equal(t, ti.GetRealPrice(cfg), 10000*types.DefaultCoinPrecision)
 
 // 	ti = &executor.DB{}
 // 	ti.Price = 10
@@ -40,13 +50,13 @@ func TestCheckFork(t *testing.T) {
 	cfg := mock33.GetAPI().GetConfig()
 	assert.Equal(t, int64(1), cfg.GetFork("ForkChainParamV2"))
 	p1 := ty.GetPos33MineParam(cfg, 0)
-	assert.Equal(t, 10000*types.Coin, p1.Pos33TicketPrice)
+	assert.Equal(t, 10000*types.DefaultCoinPrecision, p1.GetTicketPrice())
 	p1 = ty.GetPos33MineParam(cfg, 1)
-	assert.Equal(t, 3000*types.Coin, p1.Pos33TicketPrice)
+	assert.Equal(t, 3000*types.DefaultCoinPrecision, p1.GetTicketPrice())
 	p1 = ty.GetPos33MineParam(cfg, 2)
-	assert.Equal(t, 3000*types.Coin, p1.Pos33TicketPrice)
+	assert.Equal(t, 3000*types.DefaultCoinPrecision, p1.GetTicketPrice())
 	p1 = ty.GetPos33MineParam(cfg, 3)
-	assert.Equal(t, 3000*types.Coin, p1.Pos33TicketPrice)
+	assert.Equal(t, 3000*types.DefaultCoinPrecision, p1.GetTicketPrice())
 }
 
 func TestPos33Ticket(t *testing.T) {
@@ -64,7 +74,7 @@ func TestPos33Ticket(t *testing.T) {
 	assert.Nil(t, err)
 	//assert.Equal(t, accounts[0].Balance, int64(1000000000000))
 	//send to address
-	tx := util.CreateCoinsTx(cfg, mock33.GetHotKey(), mock33.GetGenesisAddress(), types.Coin/100)
+	tx := util.CreateCoinsTx(cfg, mock33.GetHotKey(), mock33.GetGenesisAddress(), types.DefaultCoinPrecision/100)
 	mock33.SendTx(tx)
 	mock33.Wait()
 	//bind miner
@@ -110,7 +120,7 @@ func TestPos33Ticket(t *testing.T) {
 				// list := ticketList(t, mock33, &ty.Pos33TicketList{Addr: tx.Fromaddr, Status: 1})
 				// for _, ti := range list.GetTickets() {
 				// 	if strings.Contains(ti.TicketId, hex.EncodeToString(tx.Txhash)) {
-				// 		assert.Equal(t, 3000*types.Coin, ti.Price)
+				// 		assert.Equal(t, 3000*types.DefaultCoinPrecision, ti.Price)
 				// 	}
 				// }
 			}
@@ -124,7 +134,7 @@ func TestPos33Ticket(t *testing.T) {
 
 func createBindMiner(t *testing.T, cfg *types.Chain33Config, m, r string, priv crypto.PrivKey) *types.Transaction {
 	ety := types.LoadExecutorType("pos33")
-	tx, err := ety.Create("Tbind", &ty.Pos33TicketBind{MinerAddress: m, ReturnAddress: r})
+	tx, err := ety.Create("MinerBind", &ty.Pos33TicketBind{MinerAddress: m, ReturnAddress: r})
 	assert.Nil(t, err)
 	tx, err = types.FormatTx(cfg, "pos33", tx)
 	assert.Nil(t, err)
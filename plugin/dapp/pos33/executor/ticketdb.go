@@ -229,7 +229,7 @@ func (action *Action) Pos33Miner(miner *ty.Pos33MinerMsg, index int) (*types.Rec
 	}
 
 	// reward voters
-	for _, pk := range miner.BlsPkList {
+	for _, pk := range miner.Voters() {
 		val, err := action.db.Get(BlsKey(address.PubKeyToAddr(ethID, pk)))
 		if err != nil {
 			return nil, err
@@ -255,7 +255,7 @@ func (action *Action) Pos33Miner(miner *ty.Pos33MinerMsg, index int) (*types.Rec
 	}
 
 	// bp reward
-	bpReward := Pos33MakerReward * int64(len(miner.BlsPkList))
+	bpReward := Pos33MakerReward * int64(len(miner.Voters()))
 	if bpReward > 0 {
 		d, err := getDeposit(action.db, action.fromaddr)
 		if err != nil {
@@ -278,11 +278,11 @@ func (action *Action) Pos33Miner(miner *ty.Pos33MinerMsg, index int) (*types.Rec
 		} else {
 			kvs = append(kvs, setDeposit(action.db, action.fromaddr, "", 0, Pos33VoteReward, action.height, false))
 		}
-		tlog.Info("block reward", "height", action.height, "reward", bpReward, "from", action.fromaddr[:16], "nv", len(miner.BlsPkList))
+		tlog.Info("block reward", "height", action.height, "reward", bpReward, "from", action.fromaddr[:16], "nv", len(miner.Voters()))
 	}
 
 	// fund reward
-	fundReward := Pos33BlockReward - (Pos33VoteReward+Pos33MakerReward)*int64(len(miner.BlsPkList))
+	fundReward := Pos33BlockReward - (Pos33VoteReward+Pos33MakerReward)*int64(len(miner.Voters()))
 	fundaddr := chain33Cfg.MGStr("mver.consensus.fundKeyAddr", action.height)
 	tlog.Info("fund rerward", "fundaddr", fundaddr, "height", action.height, "reward", fundReward)
 
@@ -0,0 +1,86 @@
+package executor
+
+import (
+	"strconv"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// TicketPriceKey manage 执行器修改过的票价
+func TicketPriceKey() []byte {
+	return []byte("mavl-pos33-ticketprice")
+}
+
+func getTicketPrices(db dbm.KV) (*ty.Pos33TicketPrices, error) {
+	val, err := db.Get(TicketPriceKey())
+	if err != nil || len(val) == 0 {
+		return &ty.Pos33TicketPrices{}, nil
+	}
+	ps := new(ty.Pos33TicketPrices)
+	err = types.Decode(val, ps)
+	if err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+// ticketPrice height 生效的票价, ForkManagePrice 之前或者没有修改过使用配置的票价
+func ticketPrice(db dbm.KV, cfg *types.Chain33Config, height int64) int64 {
+	price := ty.GetPos33MineParam(cfg, height).GetTicketPrice()
+	if !cfg.IsDappFork(height, ty.Pos33TicketX, "ForkManagePrice") {
+		return price
+	}
+	ps, err := getTicketPrices(db)
+	if err != nil {
+		tlog.Error("get ticket prices error", "err", err, "height", height)
+		return price
+	}
+	return ps.PriceAt(height, price)
+}
+
+// managePrice 在 miner 交易里读 manage 执行器的 pos33-ticketPrice, 和最后安排的票价不一样时,
+// 安排在 ticketPriceDelay 个区块以后生效, 所有人都有时间调整委托
+func (action *Action) managePrice() []*types.KeyValue {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkManagePrice") {
+		return nil
+	}
+	val, err := action.db.Get([]byte(types.ManageKey(ty.ManageTicketPriceKey)))
+	if err != nil || len(val) == 0 {
+		return nil
+	}
+	var item types.ConfigItem
+	err = types.Decode(val, &item)
+	if err != nil {
+		return nil
+	}
+	vals := item.GetArr().GetValue()
+	if len(vals) == 0 {
+		return nil
+	}
+	coins, err := strconv.ParseInt(vals[len(vals)-1], 10, 64)
+	if err != nil || coins <= 0 {
+		tlog.Error("manage ticket price error", "value", vals[len(vals)-1], "height", action.height)
+		return nil
+	}
+	price := coins * cfg.GetCoinPrecision()
+
+	ps, err := getTicketPrices(action.db)
+	if err != nil {
+		return nil
+	}
+	mp := ty.GetPos33MineParam(cfg, action.height)
+	last := mp.GetTicketPrice()
+	if n := len(ps.Items); n > 0 {
+		last = ps.Items[n-1].Price
+	}
+	if price == last {
+		return nil
+	}
+	p := &ty.Pos33TicketPrice{Price: price, Height: action.height + mp.TicketPriceDelay}
+	ps.Items = append(ps.Items, p)
+	tlog.Info("ticket price change", "price", price, "activeHeight", p.Height, "height", action.height)
+	return []*types.KeyValue{{Key: TicketPriceKey(), Value: types.Encode(ps)}}
+}
@@ -0,0 +1,127 @@
+package executor
+
+import (
+	"math"
+
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 批量打开和关闭票: 大的抵押者一个交易打开或者关闭很多张票, 可以一次关闭多个矿工的委托.
+// 一个交易里的每一步都写到 statedb, 后面的步骤读到前面的结果, 任何一步出错整个交易回滚
+
+// setKVs 把已经生成的 kvs 写到 statedb, 同一个交易里后面读到的是更新以后的值
+func (action *Action) setKVs(kvs []*types.KeyValue) {
+	for _, kv := range kvs {
+		action.db.Set(kv.Key, kv.Value)
+	}
+}
+
+// batchEntrust 按顺序执行 pes, 最后加上汇总的 log
+func (action *Action) batchEntrust(pes []*ty.Pos33Entrust, price int64) (*types.Receipt, error) {
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	r := &ty.ReceiptPos33Tickets{Consignor: action.fromaddr, Price: price}
+	for _, pe := range pes {
+		re, err := action.Pos33Entrust(pe)
+		if err != nil {
+			return nil, err
+		}
+		action.setKVs(re.KV)
+		receipt.KV = append(receipt.KV, re.KV...)
+		receipt.Logs = append(receipt.Logs, re.Logs...)
+		r.Amount += pe.Amount
+		r.Items = append(r.Items, pe)
+	}
+	r.Count = r.Amount / price
+	receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: ty.TyLogPos33Tickets, Log: types.Encode(r)})
+	tlog.Info("pos33 batch tickets", "consignor", action.fromaddr, "count", r.Count, "consignees", len(pes), "height", action.height)
+	return receipt, nil
+}
+
+// Pos33OpenTickets 一次打开 count 张票
+func (action *Action) Pos33OpenTickets(ot *ty.Pos33OpenTickets) (*types.Receipt, error) {
+	if ot.Count <= 0 {
+		return nil, ty.ErrPos33TicketCount
+	}
+	consignee := ot.Consignee
+	if consignee == "" {
+		consignee = action.fromaddr
+	}
+	price := ticketPrice(action.db, action.api.GetConfig(), action.height)
+	if ot.Count > math.MaxInt64/price {
+		return nil, types.ErrAmount
+	}
+	pe := &ty.Pos33Entrust{Consignee: consignee, Consignor: action.fromaddr, Amount: ot.Count * price}
+	return action.batchEntrust([]*ty.Pos33Entrust{pe}, price)
+}
+
+// Pos33CloseTickets 关闭 consignees 里的委托, 一共关闭 count 张票, count 为 0 时全部关闭
+func (action *Action) Pos33CloseTickets(ct *ty.Pos33CloseTickets) (*types.Receipt, error) {
+	if ct.Count < 0 {
+		return nil, ty.ErrPos33TicketCount
+	}
+	cr, err := getConsignor(action.db, action.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	entrusted := make(map[string]int64)
+	var consignees []string
+	for _, e := range cr.Consignees {
+		entrusted[e.Address] = e.Amount
+		consignees = append(consignees, e.Address)
+	}
+	if len(ct.Consignees) > 0 {
+		consignees = ct.Consignees
+	}
+	price := ticketPrice(action.db, action.api.GetConfig(), action.height)
+	left := ct.Count * price
+	var pes []*ty.Pos33Entrust
+	seen := make(map[string]bool)
+	for _, addr := range consignees {
+		if seen[addr] {
+			return nil, types.ErrInvalidParam
+		}
+		seen[addr] = true
+		amount := entrusted[addr]
+		if ct.Count > 0 {
+			if left == 0 {
+				break
+			}
+			amount = amount / price * price
+			if amount > left {
+				amount = left
+			}
+			left -= amount
+		}
+		if amount == 0 {
+			if len(ct.Consignees) > 0 {
+				// 指定的矿工没有可以关闭的票
+				return nil, types.ErrAmount
+			}
+			continue
+		}
+		pes = append(pes, &ty.Pos33Entrust{Consignee: addr, Consignor: action.fromaddr, Amount: -amount})
+	}
+	if left > 0 || len(pes) == 0 {
+		return nil, ty.ErrPos33TicketCount
+	}
+	return action.batchEntrust(pes, price)
+}
+
+// ticketsConsignees 批量交易改变了抵押的矿工
+func ticketsConsignees(receiptData *types.ReceiptData) []string {
+	var addrs []string
+	for _, l := range receiptData.Logs {
+		if l.Ty != ty.TyLogPos33Tickets {
+			continue
+		}
+		var r ty.ReceiptPos33Tickets
+		if types.Decode(l.Log, &r) != nil {
+			continue
+		}
+		for _, pe := range r.Items {
+			addrs = append(addrs, pe.Consignee)
+		}
+	}
+	return addrs
+}
@@ -0,0 +1,212 @@
+package executor
+
+import (
+	"math"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 矿工的抵押保存在 localdb 的 deposit 表里 (见 deposittable.go), 按抵押, 票数和抵押减少的高度建索引:
+// 抵押变化的交易在 ExecLocal 里从 statedb 读出矿工最新的抵押, 和表里的不一样时更新.
+// 抵押变化的地方: 委托 (Entrust, Delegate, Undelegate), 罚没,
+// miner 交易里的自动复投, 以及委托池执行器通过 xcall 的委托 (在 miner 交易里更新)
+
+const (
+	// 一页默认和最多的个数
+	defaultTopDeposits = 20
+	maxTopDeposits     = 100
+)
+
+func getIndexedDeposit(tab *table.Table, addr string) *ty.Pos33DepositMsg {
+	row, err := tab.GetData(address.FormatAddrKey(addr))
+	if err != nil {
+		return nil
+	}
+	return row.Data.(*ty.Pos33DepositMsg)
+}
+
+// stakeIndex 更新 addrs 在表里的抵押, 返回的 kvs 需要用 AddRollbackKV 记录回滚
+func (t *Pos33Ticket) stakeIndex(addrs []string) ([]*types.KeyValue, error) {
+	tab := NewDepositTable(t.GetLocalDB())
+	sdb := t.GetStateDB()
+	height := t.GetHeight()
+	price := ticketPrice(sdb, t.GetAPI().GetConfig(), height)
+	seen := make(map[string]bool)
+	for _, addr := range addrs {
+		if addr == "" || seen[addr] {
+			continue
+		}
+		seen[addr] = true
+		var amount int64
+		if c, err := getConsignee(sdb, addr); err == nil {
+			amount = c.Amount
+		}
+		old := getIndexedDeposit(tab, addr)
+		d := &ty.Pos33DepositMsg{Maddr: addr, Amount: amount, Count: amount / price}
+		if old == nil {
+			if amount == 0 {
+				continue
+			}
+		} else {
+			if old.Amount == amount && old.Count == d.Count {
+				continue
+			}
+			// 抵押减少时记下减少前的票数和高度, 全部取出的矿工也留在表里
+			d.PreCount, d.CloseHeight = old.PreCount, old.CloseHeight
+			if amount < old.Amount {
+				d.PreCount, d.CloseHeight = old.Count, height
+			}
+		}
+		err := tab.Replace(d)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tab.Save()
+}
+
+// execLocalStake 交易改变了 addrs 的抵押
+func (t *Pos33Ticket) execLocalStake(tx *types.Transaction, addrs ...string) (*types.LocalDBSet, error) {
+	kvs, err := t.stakeIndex(addrs)
+	if err != nil {
+		return nil, err
+	}
+	if len(kvs) == 0 {
+		return &types.LocalDBSet{}, nil
+	}
+	return &types.LocalDBSet{KV: t.AddRollbackKV(tx, tx.Execer, kvs)}, nil
+}
+
+func (t *Pos33Ticket) execDelLocalStake(tx *types.Transaction) (*types.LocalDBSet, error) {
+	kvs, err := t.DelRollbackKV(tx, tx.Execer)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
+
+// minerStakeAddrs miner 交易里抵押可能变化的矿工: 拿到奖励的矿工 (自动复投),
+// 和本区块有交易的委托池委托的矿工
+func (t *Pos33Ticket) minerStakeAddrs(tx *types.Transaction, miner *ty.Pos33MinerMsg) []string {
+	sdb := t.GetStateDB()
+	cfg := t.GetAPI().GetConfig()
+	sh := t.GetHeight() - ty.Pos33SortBlocks
+	maker, err := blockMaker(sdb, cfg, tx, miner, t.GetHeight())
+	if err != nil {
+		maker = tx.From()
+	}
+	addrs := []string{bindReturn(sdb, cfg, t.GetHeight(), sh, maker)}
+	pks := miner.Voters()
+	if miner.Late != nil {
+		pks = append(pks, miner.Late.BlsPkList...)
+	}
+	for _, pk := range pks {
+		val, err := sdb.Get(BlsKey(address.PubKeyToAddr(ethID, pk)))
+		if err == nil {
+			addrs = append(addrs, bindReturn(sdb, cfg, t.GetHeight(), sh, string(val)))
+		}
+	}
+	execaddrs := make(map[string]bool)
+	for _, btx := range t.GetTxs() {
+		execaddrs[dapp.ExecAddress(string(btx.Execer))] = true
+	}
+	execPoolsMu.RLock()
+	var pools []string
+	for pool, execaddr := range execPools {
+		if execaddrs[execaddr] {
+			pools = append(pools, pool)
+		}
+	}
+	execPoolsMu.RUnlock()
+	for _, pool := range pools {
+		cr, err := getConsignor(sdb, pool)
+		if err != nil {
+			continue
+		}
+		for _, c := range cr.Consignees {
+			addrs = append(addrs, c.Address)
+		}
+	}
+	return addrs
+}
+
+func pageCount(count int32) int32 {
+	if count <= 0 {
+		return defaultTopDeposits
+	}
+	if count > maxTopDeposits {
+		return maxTopDeposits
+	}
+	return count
+}
+
+// queryTopDeposits 从 cursor (上一页最后的地址) 开始按抵押从大到小返回矿工
+func queryTopDeposits(db dbm.KVDB, cfg *types.Chain33Config, sdb dbm.KV, height int64, req *ty.ReqPos33TopDeposits) (*ty.Pos33TopDeposits, error) {
+	ds, err := queryDeposits(db, &ty.ReqPos33Deposits{Index: "amount", Start: 1, Cursor: req.Cursor, Count: req.Count, Direction: dbm.ListDESC})
+	if err != nil {
+		return nil, err
+	}
+	price := ticketPrice(sdb, cfg, height)
+	reply := &ty.Pos33TopDeposits{Next: ds.Next}
+	for _, d := range ds.Items {
+		reply.Items = append(reply.Items, &ty.Pos33TopDeposit{Address: d.Maddr, Amount: d.Amount, Count: d.Amount / price})
+	}
+	return reply, nil
+}
+
+// queryDeposits 按索引返回索引值在 [start, end] 里的矿工, cursor 是上一页最后的地址
+func queryDeposits(db dbm.KVDB, req *ty.ReqPos33Deposits) (*ty.Pos33Deposits, error) {
+	index := req.Index
+	if index != "amount" && index != "count" && index != "close" {
+		return nil, types.ErrInvalidParam
+	}
+	if req.Direction != dbm.ListASC && req.Direction != dbm.ListDESC {
+		return nil, types.ErrInvalidParam
+	}
+	end := req.End
+	if end <= 0 {
+		end = math.MaxInt64 - 1
+	}
+	count := pageCount(req.Count)
+	tab := NewDepositTable(db)
+	prefix := depositIndexPrefix(index)
+	// List 不包含开始的 key, 索引的 key 是 <前缀><索引值>-<地址>
+	var key []byte
+	if req.Cursor != "" {
+		d := getIndexedDeposit(tab, req.Cursor)
+		if d == nil {
+			return nil, types.ErrNotFound
+		}
+		key = append(append(prefix, depositIndexValue(index, depositIndexOf(index, d))...), '-')
+		key = append(key, address.FormatAddrKey(req.Cursor)...)
+	} else if req.Direction == dbm.ListASC {
+		key = append(prefix, depositIndexValue(index, req.Start)...)
+	} else {
+		key = append(prefix, depositIndexValue(index, end+1)...)
+	}
+	vals, err := db.List(prefix, key, count, req.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &ty.Pos33Deposits{}
+	for _, val := range vals {
+		d := getIndexedDeposit(tab, string(val))
+		if d == nil {
+			return nil, types.ErrNotFound
+		}
+		v := depositIndexOf(index, d)
+		if v < req.Start || v > end {
+			return reply, nil
+		}
+		reply.Items = append(reply.Items, d)
+	}
+	if n := len(reply.Items); n == int(count) {
+		reply.Next = string(address.FormatAddrKey(reply.Items[n-1].Maddr))
+	}
+	return reply, nil
+}
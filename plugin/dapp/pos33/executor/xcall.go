@@ -0,0 +1,61 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	"github.com/yccproject/ycc/plugin/dapp/xcall"
+)
+
+// 给别的执行器调用的只读方法, 例如合约读取地址的抵押
+func init() {
+	xcall.Register(driverName, "Stake", xcallStake, true)
+	xcall.Register(driverName, "TicketCount", xcallTicketCount, true)
+	xcall.Register(driverName, "TotalStake", xcallTotalStake, true)
+}
+
+// stakeOf 地址(矿工)的抵押, 没有抵押返回 0
+func stakeOf(ctx *xcall.Context, payload []byte) (int64, error) {
+	var req types.ReqAddr
+	err := types.Decode(payload, &req)
+	if err != nil {
+		return 0, err
+	}
+	consignee, err := getConsignee(ctx.GetStateDB(), req.Addr)
+	if err == types.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return consignee.Amount, nil
+}
+
+// xcallStake 地址的抵押, payload 是 types.ReqAddr, 返回 types.Int64
+func xcallStake(ctx *xcall.Context, payload []byte) ([]byte, *types.Receipt, error) {
+	amount, err := stakeOf(ctx, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	return types.Encode(&types.Int64{Data: amount}), nil, nil
+}
+
+// xcallTicketCount 地址的票数, payload 是 types.ReqAddr, 返回 types.Int64
+func xcallTicketCount(ctx *xcall.Context, payload []byte) ([]byte, *types.Receipt, error) {
+	amount, err := stakeOf(ctx, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	price := ticketPrice(ctx.GetStateDB(), ctx.GetAPI().GetConfig(), ctx.GetHeight())
+	return types.Encode(&types.Int64{Data: amount / price}), nil, nil
+}
+
+// xcallTotalStake 全网抵押的总数, 返回 types.Int64
+func xcallTotalStake(ctx *xcall.Context, payload []byte) ([]byte, *types.Receipt, error) {
+	amount, err := getAllAmount(ctx.GetStateDB())
+	if err == types.ErrNotFound {
+		amount, err = 0, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return types.Encode(&types.Int64{Data: amount}), nil, nil
+}
@@ -0,0 +1,194 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/json"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"golang.org/x/net/context"
+)
+
+const (
+	defaultFaucetCoins    = 100
+	defaultFaucetInterval = 86400
+)
+
+// faucet 测试网的水龙头, 只在 TestNet=true 并且配置了 faucetKey 时开启.
+// 领取记录只保存在内存中, 重启后清空
+type faucet struct {
+	cli      *channelClient
+	priv     crypto.PrivKey
+	amount   int64
+	interval int64
+
+	mu   sync.Mutex
+	last map[string]int64 // 地址或者 IP => 上次领取的时间
+}
+
+func newFaucet(cli *channelClient, subcfg *subConfig) *faucet {
+	cfg := cli.GetConfig()
+	if subcfg.FaucetKey == "" || !cfg.IsTestNet() {
+		return nil
+	}
+	c, err := crypto.Load(types.GetSignName("", types.SECP256K1), -1)
+	if err != nil {
+		panic(err)
+	}
+	key, err := common.FromHex(subcfg.FaucetKey)
+	if err != nil {
+		panic("pos33 faucetKey error: " + err.Error())
+	}
+	priv, err := c.PrivKeyFromBytes(key)
+	if err != nil {
+		panic("pos33 faucetKey error: " + err.Error())
+	}
+	f := &faucet{
+		cli:      cli,
+		priv:     priv,
+		amount:   subcfg.FaucetAmount,
+		interval: subcfg.FaucetInterval,
+		last:     make(map[string]int64),
+	}
+	if f.amount <= 0 {
+		f.amount = defaultFaucetCoins * cfg.GetCoinPrecision()
+	}
+	if f.interval <= 0 {
+		f.interval = defaultFaucetInterval
+	}
+	if subcfg.FaucetAddr != "" {
+		go f.serve(subcfg.FaucetAddr)
+	}
+	return f
+}
+
+// take 记录地址和 IP 的领取时间, 间隔内已经领过的返回错误
+func (f *faucet) take(keys ...string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now := time.Now().Unix()
+	for k, t := range f.last {
+		if now-t >= f.interval {
+			delete(f.last, k)
+		}
+	}
+	for _, k := range keys {
+		if _, ok := f.last[k]; ok && k != "" {
+			return ty.ErrFaucetLimit
+		}
+	}
+	for _, k := range keys {
+		if k != "" {
+			f.last[k] = now
+		}
+	}
+	return nil
+}
+
+func (f *faucet) untake(keys ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, k := range keys {
+		delete(f.last, k)
+	}
+}
+
+// send 从水龙头账户转 amount 到 to
+func (f *faucet) send(to, ip string) (*types.ReplyHash, error) {
+	if f == nil {
+		return nil, types.ErrActionNotSupport
+	}
+	if err := address.CheckAddress(to, -1); err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+	err := f.take(to, ip)
+	if err != nil {
+		return nil, err
+	}
+	r, err := f.transfer(to)
+	if err != nil {
+		f.untake(to, ip)
+		return nil, err
+	}
+	return r, nil
+}
+
+func (f *faucet) transfer(to string) (*types.ReplyHash, error) {
+	cfg := f.cli.GetConfig()
+	act := &cty.CoinsAction{
+		Ty:    cty.CoinsActionTransfer,
+		Value: &cty.CoinsAction_Transfer{Transfer: &types.AssetsTransfer{Cointoken: cfg.GetCoinSymbol(), Amount: f.amount, To: to, Note: []byte("faucet")}},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(cfg.ExecName(cty.CoinsX)),
+		Payload: types.Encode(act),
+		To:      to,
+		Nonce:   rand.Int63(),
+		ChainID: cfg.GetChainID(),
+	}
+	tx.SetExpire(cfg, time.Minute*2)
+	fee, err := tx.GetRealFee(cfg.GetMinTxFeeRate())
+	if err != nil {
+		return nil, err
+	}
+	tx.Fee = fee
+	tx.Sign(types.EncodeSignID(types.SECP256K1, ty.EthAddrID), f.priv)
+	r, err := f.cli.SendTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ReplyHash{Hash: r.Msg}, nil
+}
+
+// serve http 接口: GET /faucet?addr=xxx, 按地址和客户端 IP 限制
+func (f *faucet) serve(addr string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/faucet", func(w http.ResponseWriter, r *http.Request) {
+		ip, _, _ := net.SplitHostPort(r.RemoteAddr)
+		w.Header().Set("Content-Type", "application/json")
+		reply, err := f.send(r.FormValue("addr"), ip)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ty.NewRPCError(err))
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"hash": common.ToHex(reply.Hash)})
+	})
+	err := http.ListenAndServe(addr, mux)
+	if err != nil {
+		panic("pos33 faucet http error: " + err.Error())
+	}
+}
+
+// Pos33Faucet send test coins to the address, only on test network
+func (g *channelClient) Pos33Faucet(ctx context.Context, in *types.ReqAddr) (*types.ReplyHash, error) {
+	msg, err := g.limit.do(ctx, in, func(ctx context.Context) (types.Message, error) {
+		return g.faucet.send(in.Addr, "")
+	})
+	if err != nil {
+		return nil, ty.NewRPCError(err)
+	}
+	return msg.(*types.ReplyHash), nil
+}
+
+// Pos33Faucet send test coins to the address, only on test network
+func (c *Jrpc) Pos33Faucet(in *types.ReqAddr, result *interface{}) error {
+	r, err := c.cli.Pos33Faucet(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/peer"
+)
+
+// subConfig [rpc.sub.pos33] 配置
+type subConfig struct {
+	// 单个请求的最大字节数, 0 表示不限制
+	MaxRequestSize int `json:"maxRequestSize,omitempty"`
+	// 每个客户端地址同时处理的最大请求数, 0 表示不限制
+	MaxConcurrent int `json:"maxConcurrent,omitempty"`
+	// 单个请求的超时时间(秒), 0 表示不限制
+	Timeout int64 `json:"timeout,omitempty"`
+	// 创建 session token 的管理员口令, 空表示不能创建 session
+	AdminToken string `json:"adminToken,omitempty"`
+	// session token 的最长有效时间(秒), 0 表示默认 3600
+	SessionMaxTTL int64 `json:"sessionMaxTTL,omitempty"`
+	// 测试网(TestNet=true)的水龙头: 用 faucetKey 每次转 faucetAmount(默认 100 个币),
+	// 每个地址和 IP 每 faucetInterval 秒(默认 86400)只能领一次, faucetAddr 设置时提供 http 接口
+	FaucetKey      string `json:"faucetKey,omitempty"`
+	FaucetAmount   int64  `json:"faucetAmount,omitempty"`
+	FaucetInterval int64  `json:"faucetInterval,omitempty"`
+	FaucetAddr     string `json:"faucetAddr,omitempty"`
+	// 多租户的 rpc 代理地址, 每个租户用自己的 api key 访问, 有独立的速率限制, 配额和方法范围
+	TenantAddr string          `json:"tenantAddr,omitempty"`
+	Tenants    []*tenantConfig `json:"tenants,omitempty"`
+}
+
+// limiter 按客户端地址限制 pos33 rpc 请求的大小, 并发数量和处理时间.
+// grpc 请求的客户端地址从连接取得. chain33 的 json rpc 不把客户端地址传给插件, 所有 json rpc 请求
+// 共用 jrpcPeer 的名额, 对外开放 json rpc 时用 tenantAddr 的代理按租户限流
+type limiter struct {
+	maxSize       int
+	maxConcurrent int
+	timeout       time.Duration
+
+	mu     sync.Mutex
+	active map[string]int
+}
+
+const jrpcPeer = "jsonrpc"
+
+func getSubConfig(cfg *types.Chain33Config) *subConfig {
+	var subcfg subConfig
+	if sub, ok := cfg.GetSubConfig().RPC[ty.Pos33TicketX]; ok {
+		types.MustDecode(sub, &subcfg)
+	}
+	return &subcfg
+}
+
+func newLimiter(subcfg *subConfig) *limiter {
+	return &limiter{
+		maxSize:       subcfg.MaxRequestSize,
+		maxConcurrent: subcfg.MaxConcurrent,
+		timeout:       time.Duration(subcfg.Timeout) * time.Second,
+		active:        make(map[string]int),
+	}
+}
+
+// peerAddr grpc 客户端的 ip, json rpc 的请求没有客户端地址
+func peerAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return jrpcPeer
+	}
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+func (l *limiter) acquire(addr string) bool {
+	if l.maxConcurrent <= 0 {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.active[addr] >= l.maxConcurrent {
+		return false
+	}
+	l.active[addr]++
+	return true
+}
+
+func (l *limiter) release(addr string) {
+	if l.maxConcurrent <= 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.active[addr]--
+	if l.active[addr] <= 0 {
+		delete(l.active, addr)
+	}
+}
+
+// do 在限制内执行 f. f 在当前 goroutine 里执行, 拿到带超时的 ctx, 需要多次查询的方法在每一步之前检查 ctx,
+// 超时以后不再继续, 结果作废返回 ErrTimeout
+func (l *limiter) do(ctx context.Context, in types.Message, f func(ctx context.Context) (types.Message, error)) (types.Message, error) {
+	if l == nil {
+		return f(ctx)
+	}
+	if l.maxSize > 0 && in != nil && types.Size(in) > l.maxSize {
+		return nil, ty.ErrRequestTooLarge
+	}
+	addr := peerAddr(ctx)
+	if !l.acquire(addr) {
+		return nil, ty.ErrTooManyRequests
+	}
+	defer l.release(addr)
+	if l.timeout == 0 {
+		return f(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, l.timeout)
+	defer cancel()
+	msg, err := f(ctx)
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, types.ErrTimeout
+	}
+	return msg, err
+}
+
+func (g *channelClient) query(ctx context.Context, funcName string, in types.Message) (types.Message, error) {
+	msg, err := g.limit.do(ctx, in, func(ctx context.Context) (types.Message, error) {
+		return g.Query(ty.Pos33TicketX, funcName, in)
+	})
+	return msg, ty.NewRPCError(err)
+}
+
+func (g *channelClient) queryConsensus(ctx context.Context, funcName string, in types.Message) (types.Message, error) {
+	msg, err := g.limit.do(ctx, in, func(ctx context.Context) (types.Message, error) {
+		return g.QueryConsensusFunc(ty.Pos33TicketX, funcName, in)
+	})
+	return msg, ty.NewRPCError(err)
+}
+
+func (g *channelClient) execWallet(ctx context.Context, funcName string, in types.Message) (types.Message, error) {
+	msg, err := g.limit.do(ctx, in, func(ctx context.Context) (types.Message, error) {
+		return g.ExecWalletFunc(ty.Pos33TicketX, funcName, in)
+	})
+	return msg, ty.NewRPCError(err)
+}
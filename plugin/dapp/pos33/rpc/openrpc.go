@@ -0,0 +1,23 @@
+package rpc
+
+import (
+	"sync"
+
+	"github.com/33cn/chain33/common/version"
+	"github.com/33cn/chain33/types"
+	"github.com/yccproject/ycc/plugin/dapp/openrpc"
+)
+
+var (
+	openrpcOnce sync.Once
+	openrpcDoc  *openrpc.Document
+)
+
+// GetOpenRPC get the OpenRPC document of all dapps on this node
+func (c *Jrpc) GetOpenRPC(in *types.ReqNil, result *interface{}) error {
+	openrpcOnce.Do(func() {
+		openrpcDoc = openrpc.Build(c.cli.GetConfig().GetTitle(), version.GetVersion())
+	})
+	*result = openrpcDoc
+	return nil
+}
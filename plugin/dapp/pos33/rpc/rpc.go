@@ -5,7 +5,10 @@
 package rpc
 
 import (
+	"time"
+
 	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
 	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
@@ -14,7 +17,7 @@ import (
 
 // GetAllPos33TicketAmount get count
 func (g *channelClient) getAllPos33TicketAmount(ctx context.Context, in *types.ReqNil) (*types.Int64, error) {
-	msg, err := g.Query(ty.Pos33TicketX, "AllPos33TicketAmount", in)
+	msg, err := g.query(ctx, "AllPos33TicketAmount", in)
 	if err != nil {
 		return nil, err
 	}
@@ -34,7 +37,7 @@ func (g *channelClient) getAllPos33TicketAmount(ctx context.Context, in *types.R
 func (c *Jrpc) GetPos33Info(in *types.ReqNil, result *interface{}) error {
 	resp, err := c.cli.GetPos33Info(context.Background(), in)
 	if err != nil {
-		return err
+		return ty.NewRPCError(err)
 	}
 	*result = resp
 	return nil
@@ -44,12 +47,19 @@ func (c *Jrpc) GetPos33Info(in *types.ReqNil, result *interface{}) error {
 func (g *channelClient) GetPos33Info(ctx context.Context, in *types.ReqNil) (*ty.ReplyPos33Info, error) {
 	header, err := g.GetLastHeader()
 	if err != nil {
-		return nil, err
+		return nil, ty.NewRPCError(err)
 	}
 	height := header.Height
 	cfg33 := g.GetConfig()
 	mp := ty.GetPos33MineParam(cfg33, height)
 	tprice := mp.GetTicketPrice()
+	if cfg33.IsDappFork(height, ty.Pos33TicketX, "ForkManagePrice") {
+		msg, err := g.query(ctx, "Pos33TicketPrice", &types.ReqInt{Height: height})
+		if err != nil {
+			return nil, err
+		}
+		tprice = msg.(*types.Int64).Data
+	}
 
 	useEntrust := cfg33.IsDappFork(height, ty.Pos33TicketX, "UseEntrust")
 	if useEntrust {
@@ -61,7 +71,7 @@ func (g *channelClient) GetPos33Info(ctx context.Context, in *types.ReqNil) (*ty
 		return &ty.ReplyPos33Info{Price: tprice, AllCount: count}, nil
 	}
 
-	msg, err := g.Query(ty.Pos33TicketX, "AllPos33TicketCount", in)
+	msg, err := g.query(ctx, "AllPos33TicketCount", in)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +82,7 @@ func (g *channelClient) GetPos33Info(ctx context.Context, in *types.ReqNil) (*ty
 func (c *Jrpc) GetPos33TicketCount(in *types.ReqAddr, result *int64) error {
 	resp, err := c.cli.GetPos33TicketCount(context.Background(), in)
 	if err != nil {
-		return err
+		return ty.NewRPCError(err)
 	}
 	*result = resp.GetData()
 	return nil
@@ -81,7 +91,7 @@ func (c *Jrpc) GetPos33TicketCount(in *types.ReqAddr, result *int64) error {
 
 // GetPos33TicketCount get count
 func (g *channelClient) GetPos33TicketCount(ctx context.Context, in *types.ReqAddr) (*types.Int64, error) {
-	msg, err := g.Query(ty.Pos33TicketX, "Pos33TicketCount", in)
+	msg, err := g.query(ctx, "Pos33TicketCount", in)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +102,7 @@ func (g *channelClient) GetPos33TicketCount(ctx context.Context, in *types.ReqAd
 func (c *Jrpc) Pos33Migrate(in *types.ReqNil, result *interface{}) error {
 	resp, err := c.cli.Migrate(context.Background(), in)
 	if err != nil {
-		return err
+		return ty.NewRPCError(err)
 	}
 	*result = &rpctypes.ReplyHash{Hash: common.ToHex(resp.Hash)}
 	return nil
@@ -100,7 +110,7 @@ func (c *Jrpc) Pos33Migrate(in *types.ReqNil, result *interface{}) error {
 
 // BlsBind
 func (g *channelClient) Migrate(ctx context.Context, in *types.ReqNil) (*types.ReplyHash, error) {
-	data, err := g.ExecWalletFunc(ty.Pos33TicketX, "Migrate", in)
+	data, err := g.execWallet(ctx, "Migrate", in)
 	if err != nil {
 		return nil, err
 	}
@@ -111,7 +121,7 @@ func (g *channelClient) Migrate(ctx context.Context, in *types.ReqNil) (*types.R
 func (c *Jrpc) BlsBind(in *types.ReqNil, result *interface{}) error {
 	resp, err := c.cli.BlsBind(context.Background(), in)
 	if err != nil {
-		return err
+		return ty.NewRPCError(err)
 	}
 	*result = &rpctypes.ReplyHash{Hash: common.ToHex(resp.Hash)}
 	return nil
@@ -119,7 +129,7 @@ func (c *Jrpc) BlsBind(in *types.ReqNil, result *interface{}) error {
 
 // BlsBind
 func (g *channelClient) BlsBind(ctx context.Context, in *types.ReqNil) (*types.ReplyHash, error) {
-	data, err := g.ExecWalletFunc(ty.Pos33TicketX, "BlsBind", in)
+	data, err := g.execWallet(ctx, "BlsBind", in)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +140,7 @@ func (g *channelClient) BlsBind(ctx context.Context, in *types.ReqNil) (*types.R
 func (c *Jrpc) SetMinerFeeRate(in *ty.Pos33MinerFeeRate, result *interface{}) error {
 	resp, err := c.cli.SetMinerFeeRate(context.Background(), in)
 	if err != nil {
-		return err
+		return ty.NewRPCError(err)
 	}
 	*result = &rpctypes.ReplyHash{Hash: common.ToHex(resp.Hash)}
 	return nil
@@ -138,7 +148,7 @@ func (c *Jrpc) SetMinerFeeRate(in *ty.Pos33MinerFeeRate, result *interface{}) er
 
 // SetMinerFeeRate
 func (g *channelClient) SetMinerFeeRate(ctx context.Context, in *ty.Pos33MinerFeeRate) (*types.ReplyHash, error) {
-	data, err := g.ExecWalletFunc(ty.Pos33TicketX, "SetMinerFeeRate", in)
+	data, err := g.execWallet(ctx, "SetMinerFeeRate", in)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +157,7 @@ func (g *channelClient) SetMinerFeeRate(ctx context.Context, in *ty.Pos33MinerFe
 
 // query consignor entrust info
 func (g *channelClient) GetPos33ConsignorEntrust(ctx context.Context, in *types.ReqAddr) (*ty.Pos33Consignor, error) {
-	msg, err := g.Query(ty.Pos33TicketX, "Pos33ConsignorEntrust", in)
+	msg, err := g.query(ctx, "Pos33ConsignorEntrust", in)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +168,7 @@ func (g *channelClient) GetPos33ConsignorEntrust(ctx context.Context, in *types.
 func (c *Jrpc) GetPos33ConsignorEntrust(in *types.ReqAddr, result *interface{}) error {
 	resp, err := c.cli.GetPos33ConsignorEntrust(context.Background(), in)
 	if err != nil {
-		return err
+		return ty.NewRPCError(err)
 	}
 	*result = resp
 	return nil
@@ -168,7 +178,7 @@ func (c *Jrpc) GetPos33ConsignorEntrust(in *types.ReqAddr, result *interface{})
 func (c *Jrpc) GetPos33ConsigneeEntrust(in *types.ReqAddr, result *interface{}) error {
 	resp, err := c.cli.GetPos33ConsigneeEntrust(context.Background(), in)
 	if err != nil {
-		return err
+		return ty.NewRPCError(err)
 	}
 	*result = resp
 	return nil
@@ -176,37 +186,288 @@ func (c *Jrpc) GetPos33ConsigneeEntrust(in *types.ReqAddr, result *interface{})
 
 // query consignee entrust info
 func (g *channelClient) GetPos33ConsigneeEntrust(ctx context.Context, in *types.ReqAddr) (*ty.Pos33Consignee, error) {
-	msg, err := g.Query(ty.Pos33TicketX, "Pos33ConsigneeEntrust", in)
+	msg, err := g.query(ctx, "Pos33ConsigneeEntrust", in)
 	if err != nil {
 		return nil, err
 	}
 	return msg.(*ty.Pos33Consignee), nil
 }
 
-// SetEntrust create entrust
-func (g *channelClient) SetPos33Entrust(ctx context.Context, in *ty.Pos33Entrust) (*ty.ReplyTxHex, error) {
-	cfg := g.GetConfig()
-	data, err := types.CallCreateTx(cfg, cfg.ExecName(ty.Pos33TicketX), "Entrust", in)
+// GetPos33ImmatureReward get immature reward of addr
+func (g *channelClient) GetPos33ImmatureReward(ctx context.Context, in *types.ReqAddr) (*ty.Pos33Immature, error) {
+	msg, err := g.query(ctx, "Pos33ImmatureReward", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33Immature), nil
+}
+
+// GetPos33ImmatureReward get immature reward of addr
+func (c *Jrpc) GetPos33ImmatureReward(in *types.ReqAddr, result *interface{}) error {
+	resp, err := c.cli.GetPos33ImmatureReward(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = resp
+	return nil
+}
+
+// GetPos33MinerInfo get miner name, website and contact
+func (g *channelClient) GetPos33MinerInfo(ctx context.Context, in *types.ReqAddr) (*ty.Pos33MinerInfo, error) {
+	msg, err := g.query(ctx, "Pos33MinerInfo", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33MinerInfo), nil
+}
+
+// GetPos33MinerInfo get miner name, website and contact
+func (c *Jrpc) GetPos33MinerInfo(in *types.ReqAddr, result *interface{}) error {
+	resp, err := c.cli.GetPos33MinerInfo(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = resp
+	return nil
+}
+
+// GetPos33Operator get delegation operator and its commission
+func (g *channelClient) GetPos33Operator(ctx context.Context, in *types.ReqAddr) (*ty.Pos33Operator, error) {
+	msg, err := g.query(ctx, "Pos33Operator", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33Operator), nil
+}
+
+// GetPos33Operator get delegation operator and its commission
+func (c *Jrpc) GetPos33Operator(in *types.ReqAddr, result *interface{}) error {
+	resp, err := c.cli.GetPos33Operator(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = resp
+	return nil
+}
+
+// GetPos33BaseFeeHistory get base fee of the recent in.Height blocks and the base fee of the next block
+func (g *channelClient) GetPos33BaseFeeHistory(ctx context.Context, in *types.ReqInt) (*ty.Pos33BaseFees, error) {
+	if in.Height <= 0 || in.Height > ty.Pos33BaseFeeRing {
+		return nil, types.ErrInvalidParam
+	}
+	header, err := g.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	start := header.Height - in.Height + 1
+	if start < 0 {
+		start = 0
+	}
+	msg, err := g.query(ctx, "Pos33BaseFeeHistory", &types.ReqBlocks{Start: start, End: header.Height})
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33BaseFees), nil
+}
+
+// GetPos33BaseFeeHistory get base fee of the recent in.Height blocks and the base fee of the next block
+func (c *Jrpc) GetPos33BaseFeeHistory(in *types.ReqInt, result *interface{}) error {
+	resp, err := c.cli.GetPos33BaseFeeHistory(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = resp
+	return nil
+}
+
+// GetPos33Compound get auto compound setting of the address
+func (g *channelClient) GetPos33Compound(ctx context.Context, in *types.ReqAddr) (*ty.Pos33Compound, error) {
+	msg, err := g.query(ctx, "Pos33Compound", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33Compound), nil
+}
+
+// GetPos33Compound get auto compound setting of the address
+func (c *Jrpc) GetPos33Compound(in *types.ReqAddr, result *interface{}) error {
+	resp, err := c.cli.GetPos33Compound(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = resp
+	return nil
+}
+
+// GetPos33MinerBind get the miner bind which the address is in
+func (g *channelClient) GetPos33MinerBind(ctx context.Context, in *types.ReqAddr) (*ty.Pos33MinerBind, error) {
+	msg, err := g.query(ctx, "Pos33MinerBind", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33MinerBind), nil
+}
+
+// GetPos33MinerBind get the miner bind which the address is in
+func (c *Jrpc) GetPos33MinerBind(in *types.ReqAddr, result *interface{}) error {
+	resp, err := c.cli.GetPos33MinerBind(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = resp
+	return nil
+}
+
+// GetPos33BlockSigner get the block signer registration of the signer address
+func (g *channelClient) GetPos33BlockSigner(ctx context.Context, in *types.ReqAddr) (*ty.Pos33BlockSigner, error) {
+	msg, err := g.query(ctx, "Pos33BlockSigner", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33BlockSigner), nil
+}
+
+// GetPos33BlockSigner get the block signer registration of the signer address
+func (c *Jrpc) GetPos33BlockSigner(in *types.ReqAddr, result *interface{}) error {
+	resp, err := c.cli.GetPos33BlockSigner(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = resp
+	return nil
+}
+
+// GetPos33Chart get downsampled difficulty and stake of blocks for charts
+func (g *channelClient) GetPos33Chart(ctx context.Context, in *ty.ReqPos33Chart) (*ty.Pos33ChartPoints, error) {
+	msg, err := g.query(ctx, "Pos33Chart", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33ChartPoints), nil
+}
+
+// GetPos33Chart get downsampled difficulty and stake of blocks for charts
+func (c *Jrpc) GetPos33Chart(in *ty.ReqPos33Chart, result *interface{}) error {
+	resp, err := c.cli.GetPos33Chart(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = resp
+	return nil
+}
+
+// GetPos33TopDeposits get miners ordered by ticket count
+func (g *channelClient) GetPos33TopDeposits(ctx context.Context, in *ty.ReqPos33TopDeposits) (*ty.Pos33TopDeposits, error) {
+	msg, err := g.query(ctx, "Pos33TopDeposits", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33TopDeposits), nil
+}
+
+// GetPos33TopDeposits get miners ordered by ticket count
+func (c *Jrpc) GetPos33TopDeposits(in *ty.ReqPos33TopDeposits, result *interface{}) error {
+	resp, err := c.cli.GetPos33TopDeposits(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = resp
+	return nil
+}
+
+// GetPos33Deposits get miners by the amount, count or close height index
+func (g *channelClient) GetPos33Deposits(ctx context.Context, in *ty.ReqPos33Deposits) (*ty.Pos33Deposits, error) {
+	msg, err := g.query(ctx, "Pos33Deposits", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33Deposits), nil
+}
+
+// GetPos33Deposits get miners by the amount, count or close height index
+func (c *Jrpc) GetPos33Deposits(in *ty.ReqPos33Deposits, result *interface{}) error {
+	resp, err := c.cli.GetPos33Deposits(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = resp
+	return nil
+}
+
+// GetPos33RewardHistory get reward events of the address, newest first.
+// 共识开了异步索引时从索引读, 否则读执行器的 localdb
+func (g *channelClient) GetPos33RewardHistory(ctx context.Context, in *ty.ReqPos33RewardHistory) (*ty.Pos33RewardEvents, error) {
+	msg, err := g.queryConsensus(ctx, "GetIndexedRewards", in)
+	if err == nil {
+		return msg.(*ty.Pos33RewardEvents), nil
+	}
+	if err.Error() != types.ErrActionNotSupport.Error() {
+		return nil, err
+	}
+	msg, err = g.query(ctx, "Pos33RewardHistory", in)
 	if err != nil {
 		return nil, err
 	}
+	return msg.(*ty.Pos33RewardEvents), nil
+}
+
+// GetPos33RewardHistory get reward events of the address, newest first
+func (c *Jrpc) GetPos33RewardHistory(in *ty.ReqPos33RewardHistory, result *interface{}) error {
+	resp, err := c.cli.GetPos33RewardHistory(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = resp
+	return nil
+}
 
-	hex := common.ToHex(data)
-	return &ty.ReplyTxHex{TxHex: hex}, nil
+// GetPos33MissedSlots get missed maker slots of the address
+func (g *channelClient) GetPos33MissedSlots(ctx context.Context, in *types.ReqAddr) (*ty.Pos33MissedSlots, error) {
+	msg, err := g.query(ctx, "Pos33MissedSlots", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33MissedSlots), nil
+}
+
+// GetPos33MissedSlots get missed maker slots of the address
+func (c *Jrpc) GetPos33MissedSlots(in *types.ReqAddr, result *interface{}) error {
+	resp, err := c.cli.GetPos33MissedSlots(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = resp
+	return nil
+}
+
+// SetEntrust create entrust
+func (g *channelClient) SetPos33Entrust(ctx context.Context, in *ty.Pos33Entrust) (*ty.ReplyTxHex, error) {
+	msg, err := g.limit.do(ctx, in, func(ctx context.Context) (types.Message, error) {
+		cfg := g.GetConfig()
+		data, err := types.CallCreateTx(cfg, cfg.ExecName(ty.Pos33TicketX), "Entrust", in)
+		if err != nil {
+			return nil, err
+		}
+		return &ty.ReplyTxHex{TxHex: common.ToHex(data)}, nil
+	})
+	if err != nil {
+		return nil, ty.NewRPCError(err)
+	}
+	return msg.(*ty.ReplyTxHex), nil
 }
 
 // SetEntrust create entrust
 func (c *Jrpc) SetPos33Entrust(in *ty.Pos33Entrust, result *interface{}) error {
 	r, err := c.cli.SetPos33Entrust(context.Background(), in)
 	if err != nil {
-		return err
+		return ty.NewRPCError(err)
 	}
 	*result = r
 	return nil
 }
 
 func (g *channelClient) GetMinerList(ctx context.Context, in *types.ReqNil) (*types.ReplyStrings, error) {
-	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetMinerList", in)
+	data, err := g.queryConsensus(ctx, "GetMinerList", in)
 	if err != nil {
 		return nil, err
 	}
@@ -216,8 +477,738 @@ func (g *channelClient) GetMinerList(ctx context.Context, in *types.ReqNil) (*ty
 func (c *Jrpc) GetMinerList(in *types.ReqNil, result *interface{}) error {
 	r, err := c.cli.GetMinerList(context.Background(), in)
 	if err != nil {
-		return err
+		return ty.NewRPCError(err)
 	}
 	*result = r.GetDatas()
 	return nil
 }
+
+const (
+	defaultWaitTxTimeout = 30
+	maxWaitTxTimeout     = 120
+	waitTxInterval       = time.Millisecond * 500
+)
+
+// SendPos33Tx 异步发送交易, 进入 mempool 以后立即返回 hash, 发送方可以连续发送不必等待.
+// 之后用 WaitPos33Tx 等待上链. p2p 广播没有对方的回执, WaitPos33Tx 的 Broadcast 只说明交易还在本节点的
+// mempool 并且本节点有连接的 peer, 不能说明其他节点收到了交易
+func (g *channelClient) SendPos33Tx(ctx context.Context, in *ty.ReqPos33SendTx) (*ty.ReplyPos33SendTx, error) {
+	data, err := common.FromHex(in.Tx)
+	if err != nil || len(data) == 0 {
+		return nil, ty.NewRPCError(types.ErrInvalidParam)
+	}
+	var tx types.Transaction
+	err = types.Decode(data, &tx)
+	if err != nil {
+		return nil, ty.NewRPCError(types.ErrDecode)
+	}
+	_, err = g.limit.do(ctx, in, func(ctx context.Context) (types.Message, error) {
+		return g.SendTx(&tx)
+	})
+	if err != nil {
+		return nil, ty.NewRPCError(err)
+	}
+	r := &ty.ReplyPos33SendTx{Hash: common.ToHex(tx.Hash())}
+	if h, err := g.GetLastHeader(); err == nil {
+		r.Height = h.Height
+	}
+	return r, nil
+}
+
+// SendPos33Tx send tx and return after mempool admission
+func (c *Jrpc) SendPos33Tx(in *ty.ReqPos33SendTx, result *interface{}) error {
+	r, err := c.cli.SendPos33Tx(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// WaitPos33Tx 等待交易打包进区块, Broadcast 为 true 时只等待到交易在本节点的 mempool 并且有连接的 peer.
+// 每次查询状态占用一个 rpc 并发名额, 两次查询之间的等待不占用, 等待时间不超过 maxWaitTxTimeout
+func (g *channelClient) WaitPos33Tx(ctx context.Context, in *ty.ReqPos33WaitTx) (*ty.ReplyPos33TxStatus, error) {
+	hash, err := common.FromHex(in.Hash)
+	if err != nil || len(hash) == 0 {
+		return nil, ty.NewRPCError(types.ErrInvalidParam)
+	}
+	timeout := in.Timeout
+	if timeout <= 0 {
+		timeout = defaultWaitTxTimeout
+	}
+	if timeout > maxWaitTxTimeout {
+		timeout = maxWaitTxTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	ticker := time.NewTicker(waitTxInterval)
+	defer ticker.Stop()
+	var st *ty.ReplyPos33TxStatus
+	for {
+		msg, err := g.limit.do(ctx, in, func(ctx context.Context) (types.Message, error) {
+			return g.txStatus(in.Hash, hash, in.Broadcast), nil
+		})
+		if err == nil {
+			st = msg.(*ty.ReplyPos33TxStatus)
+			if st.Packed || (in.Broadcast && st.Broadcast) {
+				return st, nil
+			}
+		} else if st == nil || (err != ty.ErrTooManyRequests && ctx.Err() == nil) {
+			// 名额满或者等待到期时返回上一次的状态, 第一次查询失败直接返回错误
+			return nil, ty.NewRPCError(err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return st, nil
+		}
+	}
+}
+
+// txStatus 交易是否上链; broadcast 为 true 时还检查交易在 mempool 或者已经上链, 并且本节点有连接的 peer.
+// 这只是本节点的状态, 不是其他节点的回执
+func (g *channelClient) txStatus(hexHash string, hash []byte, broadcast bool) *ty.ReplyPos33TxStatus {
+	st := &ty.ReplyPos33TxStatus{Hash: hexHash}
+	detail, err := g.QueryTx(&types.ReqHash{Hash: hash})
+	if err == nil && detail != nil && detail.Receipt != nil {
+		st.Packed, st.Height, st.Index = true, detail.Height, detail.Index
+	}
+	if !broadcast {
+		return st
+	}
+	if !st.Packed && !g.inMempool(hash) {
+		return st
+	}
+	info, err := g.GetNetInfo(&types.P2PGetNetInfoReq{})
+	if err != nil {
+		return st
+	}
+	st.Peers = info.Outbounds + info.Inbounds
+	st.Broadcast = st.Peers > 0
+	return st
+}
+
+func (g *channelClient) inMempool(hash []byte) bool {
+	txs, err := g.GetMempool(&types.ReqGetMempool{})
+	if err != nil {
+		return false
+	}
+	for _, tx := range txs.GetTxs() {
+		if string(tx.Hash()) == string(hash) {
+			return true
+		}
+	}
+	return false
+}
+
+// WaitPos33Tx wait tx packed, or only in mempool with connected peers
+func (c *Jrpc) WaitPos33Tx(in *ty.ReqPos33WaitTx, result *interface{}) error {
+	r, err := c.cli.WaitPos33Tx(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// GetPos33Participation get blocks made and votes cast of an address seen by this node
+func (g *channelClient) GetPos33Participation(ctx context.Context, in *ty.ReqPos33Participation) (*ty.Pos33Participation, error) {
+	data, err := g.queryConsensus(ctx, "GetParticipation", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33Participation), nil
+}
+
+// GetPos33Participation get blocks made and votes cast of an address seen by this node
+func (c *Jrpc) GetPos33Participation(in *ty.ReqPos33Participation, result *interface{}) error {
+	r, err := c.cli.GetPos33Participation(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// GetPos33IndexedTx get tx from the async tx index
+func (g *channelClient) GetPos33IndexedTx(ctx context.Context, in *types.ReqHash) (*ty.Pos33IndexedTx, error) {
+	data, err := g.queryConsensus(ctx, "GetIndexedTx", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33IndexedTx), nil
+}
+
+// GetPos33IndexedTx get tx from the async tx index
+func (c *Jrpc) GetPos33IndexedTx(in *types.ReqHash, result *interface{}) error {
+	r, err := c.cli.GetPos33IndexedTx(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// GetPos33AddrTxs get txs of an address from the async tx index
+func (g *channelClient) GetPos33AddrTxs(ctx context.Context, in *ty.ReqPos33AddrTxs) (*ty.Pos33IndexedTxs, error) {
+	data, err := g.queryConsensus(ctx, "GetIndexedAddrTxs", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33IndexedTxs), nil
+}
+
+// GetPos33AddrTxs get txs of an address from the async tx index
+func (c *Jrpc) GetPos33AddrTxs(in *ty.ReqPos33AddrTxs, result *interface{}) error {
+	r, err := c.cli.GetPos33AddrTxs(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// GetPos33Liveness get the validator liveness map seen by this node
+func (g *channelClient) GetPos33Liveness(ctx context.Context, in *types.ReqNil) (*ty.Pos33LivenessMap, error) {
+	data, err := g.queryConsensus(ctx, "GetLiveness", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33LivenessMap), nil
+}
+
+// GetPos33Liveness get the validator liveness map seen by this node
+func (c *Jrpc) GetPos33Liveness(in *types.ReqNil, result *interface{}) error {
+	r, err := c.cli.GetPos33Liveness(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// GetPos33StateUsage get state bytes owned per execer since accounting started
+func (g *channelClient) GetPos33StateUsage(ctx context.Context, in *types.ReqNil) (*ty.Pos33StateUsages, error) {
+	msg, err := g.limit.do(ctx, in, func(ctx context.Context) (types.Message, error) {
+		r, ok := ty.GetStateUsage()
+		if !ok {
+			return nil, types.ErrActionNotSupport
+		}
+		return r, nil
+	})
+	if err != nil {
+		return nil, ty.NewRPCError(err)
+	}
+	return msg.(*ty.Pos33StateUsages), nil
+}
+
+// GetPos33StateUsage get state bytes owned per execer since accounting started
+func (c *Jrpc) GetPos33StateUsage(in *types.ReqNil, result *interface{}) error {
+	r, err := c.cli.GetPos33StateUsage(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// GetPos33SortAudit get sortition result of height seen by this node
+func (g *channelClient) GetPos33SortAudit(ctx context.Context, in *types.ReqInt) (*ty.Pos33SortAudit, error) {
+	data, err := g.queryConsensus(ctx, "GetSortAudit", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33SortAudit), nil
+}
+
+// GetPos33SortAudit get sortition result of height seen by this node
+func (c *Jrpc) GetPos33SortAudit(in *types.ReqInt, result *interface{}) error {
+	r, err := c.cli.GetPos33SortAudit(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// GetPos33Committee get maker and voters of height from the block on chain
+func (g *channelClient) GetPos33Committee(ctx context.Context, in *types.ReqInt) (*ty.Pos33Committee, error) {
+	msg, err := g.limit.do(ctx, in, func(ctx context.Context) (types.Message, error) {
+		return g.committee(ctx, in.Height)
+	})
+	if err != nil {
+		return nil, ty.NewRPCError(err)
+	}
+	return msg.(*ty.Pos33Committee), nil
+}
+
+func (g *channelClient) committee(ctx context.Context, height int64) (*ty.Pos33Committee, error) {
+	details, err := g.GetBlocks(&types.ReqBlocks{Start: height, End: height})
+	if err != nil {
+		return nil, err
+	}
+	items := details.Items
+	if len(items) == 0 || items[0].Block == nil || len(items[0].Block.Txs) == 0 {
+		return nil, types.ErrNotFound
+	}
+	var act ty.Pos33TicketAction
+	err = types.Decode(items[0].Block.Txs[0].Payload, &act)
+	if err != nil {
+		return nil, err
+	}
+	m := act.GetMiner()
+	if m == nil || m.Sort == nil || m.Sort.Proof == nil || m.Sort.SortHash == nil {
+		return nil, ty.ErrMinerTx
+	}
+
+	c := &ty.Pos33Committee{
+		Height: height,
+		Maker: &ty.Pos33CommitteeMember{
+			Addr:     address.PubKeyToAddr(ty.EthAddrID, m.Sort.Proof.Pubkey),
+			Pubkey:   m.Sort.Proof.Pubkey,
+			Index:    m.Sort.SortHash.Index,
+			SortHash: m.Sort.SortHash.Hash,
+		},
+	}
+	if m.Sort.Proof.Input != nil {
+		c.Round = m.Sort.Proof.Input.Round
+	}
+	mp := make(map[string]*ty.Pos33CommitteeMember)
+	for _, pk := range m.Voters() {
+		v, ok := mp[string(pk)]
+		if !ok {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			// 投票人的地址是现在 bls 公钥绑定的地址
+			v = &ty.Pos33CommitteeMember{Pubkey: pk}
+			r, err := g.Query(ty.Pos33TicketX, "Pos33BlsAddr", &types.ReqAddr{Addr: address.PubKeyToAddr(ty.EthAddrID, pk)})
+			if err == nil {
+				v.Addr = r.(*types.ReplyString).Data
+			}
+			mp[string(pk)] = v
+			c.Voters = append(c.Voters, v)
+		}
+		v.Votes++
+	}
+	return c, nil
+}
+
+// GetPos33Committee get maker and voters of height from the block on chain
+func (c *Jrpc) GetPos33Committee(in *types.ReqInt, result *interface{}) error {
+	r, err := c.cli.GetPos33Committee(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// GetPos33Advisories get network advisories
+func (g *channelClient) GetPos33Advisories(ctx context.Context, in *types.ReqNil) (*ty.Pos33Advisories, error) {
+	data, err := g.queryConsensus(ctx, "GetAdvisories", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33Advisories), nil
+}
+
+// GetPos33Advisories get network advisories
+func (c *Jrpc) GetPos33Advisories(in *types.ReqNil, result *interface{}) error {
+	r, err := c.cli.GetPos33Advisories(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// GetPos33ConsensusState get live consensus state of this node
+func (g *channelClient) GetPos33ConsensusState(ctx context.Context, in *types.ReqNil) (*ty.Pos33ConsensusState, error) {
+	data, err := g.queryConsensus(ctx, "GetConsensusState", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33ConsensusState), nil
+}
+
+// GetPos33ConsensusState get live consensus state of this node
+func (c *Jrpc) GetPos33ConsensusState(in *types.ReqNil, result *interface{}) error {
+	r, err := c.cli.GetPos33ConsensusState(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// GetPos33ValidatorStatus get whether the validators of this node are healthy
+func (g *channelClient) GetPos33ValidatorStatus(ctx context.Context, in *types.ReqNil) (*ty.Pos33ValidatorStatuses, error) {
+	data, err := g.queryConsensus(ctx, "GetValidatorStatus", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33ValidatorStatuses), nil
+}
+
+// GetPos33ValidatorStatus get whether the validators of this node are healthy
+func (c *Jrpc) GetPos33ValidatorStatus(in *types.ReqNil, result *interface{}) error {
+	r, err := c.cli.GetPos33ValidatorStatus(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// GetPos33BannedPeers get consensus msg senders banned by this node
+func (g *channelClient) GetPos33BannedPeers(ctx context.Context, in *types.ReqNil) (*ty.Pos33BannedPeers, error) {
+	data, err := g.queryConsensus(ctx, "GetBannedPeers", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33BannedPeers), nil
+}
+
+// GetPos33BannedPeers get consensus msg senders banned by this node
+func (c *Jrpc) GetPos33BannedPeers(in *types.ReqNil, result *interface{}) error {
+	r, err := c.cli.GetPos33BannedPeers(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// DryRunPos33Block assemble a block from mempool without broadcasting it, admin only
+func (g *channelClient) DryRunPos33Block(ctx context.Context, in *ty.ReqPos33DryRun) (*ty.Pos33DryRunBlock, error) {
+	if !g.sessions.isAdmin(in.AdminToken) {
+		return nil, ty.ErrSessionToken
+	}
+	data, err := g.queryConsensus(ctx, "DryRunBlock", &types.ReqNil{})
+	if err != nil {
+		return nil, err
+	}
+	g.auditAdmin("admin", "DryRunPos33Block")
+	return data.(*ty.Pos33DryRunBlock), nil
+}
+
+// DryRunPos33Block assemble a block from mempool without broadcasting it, admin only
+func (c *Jrpc) DryRunPos33Block(in *ty.ReqPos33DryRun, result *interface{}) error {
+	r, err := c.cli.DryRunPos33Block(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// SetPos33MinerPause pause or resume the miner at runtime, admin only
+func (g *channelClient) SetPos33MinerPause(ctx context.Context, in *ty.ReqPos33MinerPause) (*types.Reply, error) {
+	if !g.sessions.isAdmin(in.AdminToken) {
+		return nil, ty.ErrSessionToken
+	}
+	data, err := g.queryConsensus(ctx, "SetMinerPause", &ty.ReqPos33MinerPause{Pause: in.Pause})
+	if err != nil {
+		return nil, err
+	}
+	detail := "SetPos33MinerPause resume"
+	if in.Pause {
+		detail = "SetPos33MinerPause pause"
+	}
+	g.auditAdmin("admin", detail)
+	return data.(*types.Reply), nil
+}
+
+// SetPos33MinerPause pause or resume the miner at runtime, admin only
+func (c *Jrpc) SetPos33MinerPause(in *ty.ReqPos33MinerPause, result *interface{}) error {
+	r, err := c.cli.SetPos33MinerPause(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// GetPos33Diagnostics dump pos33 internal diagnostics, admin only
+func (g *channelClient) GetPos33Diagnostics(ctx context.Context, in *ty.ReqPos33Diagnostics) (*ty.Pos33Diagnostics, error) {
+	if !g.sessions.isAdmin(in.AdminToken) {
+		return nil, ty.ErrSessionToken
+	}
+	data, err := g.queryConsensus(ctx, "GetDiagnostics", &types.ReqNil{})
+	if err != nil {
+		return nil, err
+	}
+	g.auditAdmin("admin", "GetPos33Diagnostics")
+	return data.(*ty.Pos33Diagnostics), nil
+}
+
+// GetPos33Diagnostics dump pos33 internal diagnostics, admin only
+func (c *Jrpc) GetPos33Diagnostics(in *ty.ReqPos33Diagnostics, result *interface{}) error {
+	r, err := c.cli.GetPos33Diagnostics(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// SendPos33Advisory broadcast a network advisory signed by super manager
+func (g *channelClient) SendPos33Advisory(ctx context.Context, in *ty.Pos33Advisory) (*types.Reply, error) {
+	data, err := g.queryConsensus(ctx, "SendAdvisory", in)
+	if err != nil {
+		return nil, err
+	}
+	if in.Sig != nil {
+		g.auditAdmin(address.PubKeyToAddr(ty.EthAddrID, in.Sig.Pubkey), "SendPos33Advisory "+in.Message)
+	}
+	return data.(*types.Reply), nil
+}
+
+// SendPos33Advisory broadcast a network advisory signed by super manager
+func (c *Jrpc) SendPos33Advisory(in *ty.Pos33Advisory, result *interface{}) error {
+	r, err := c.cli.SendPos33Advisory(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// RegisterPos33Push register or remove a device for push notifications, signed by the address
+func (g *channelClient) RegisterPos33Push(ctx context.Context, in *ty.Pos33PushDevice) (*types.Reply, error) {
+	data, err := g.queryConsensus(ctx, "RegisterPush", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*types.Reply), nil
+}
+
+// RegisterPos33Push register or remove a device for push notifications, signed by the address
+func (c *Jrpc) RegisterPos33Push(in *ty.Pos33PushDevice, result *interface{}) error {
+	r, err := c.cli.RegisterPos33Push(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// Pos33Transfer transfer from wallet, checked by wallet spend policy
+func (g *channelClient) Pos33Transfer(ctx context.Context, in *ty.ReqPos33Transfer) (*ty.ReplyPos33Transfer, error) {
+	data, err := g.execWallet(ctx, "Pos33Transfer", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.ReplyPos33Transfer), nil
+}
+
+// Pos33Transfer transfer from wallet, checked by wallet spend policy
+func (c *Jrpc) Pos33Transfer(in *ty.ReqPos33Transfer, result *interface{}) error {
+	r, err := c.cli.Pos33Transfer(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// Pos33ApproveTransfer approve a pending transfer
+func (g *channelClient) Pos33ApproveTransfer(ctx context.Context, in *ty.ReqPos33Approve) (*ty.ReplyPos33Transfer, error) {
+	data, err := g.execWallet(ctx, "Pos33ApproveTransfer", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.ReplyPos33Transfer), nil
+}
+
+// Pos33ApproveTransfer approve a pending transfer
+func (c *Jrpc) Pos33ApproveTransfer(in *ty.ReqPos33Approve, result *interface{}) error {
+	r, err := c.cli.Pos33ApproveTransfer(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// auditAdmin 在钱包的审计日志里记录管理操作, 没有运行钱包时忽略
+func (g *channelClient) auditAdmin(caller, detail string) {
+	g.ExecWalletFunc(ty.Pos33TicketX, "Pos33AuditRecord", &ty.Pos33AuditEntry{Caller: caller, Detail: detail})
+}
+
+// GetPos33AuditLog export wallet audit log
+func (g *channelClient) GetPos33AuditLog(ctx context.Context, in *ty.ReqPos33AuditLog) (*ty.Pos33AuditEntries, error) {
+	data, err := g.execWallet(ctx, "Pos33AuditLog", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33AuditEntries), nil
+}
+
+// GetPos33AuditLog export wallet audit log
+func (c *Jrpc) GetPos33AuditLog(in *ty.ReqPos33AuditLog, result *interface{}) error {
+	r, err := c.cli.GetPos33AuditLog(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// GetPos33Finalized get the latest finalized checkpoint, blocks at or below its height can not be reverted
+func (g *channelClient) GetPos33Finalized(ctx context.Context, in *types.ReqNil) (*ty.Pos33Checkpoint, error) {
+	data, err := g.query(ctx, "Pos33Finalized", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33Checkpoint), nil
+}
+
+// GetPos33Finalized get the latest finalized checkpoint
+func (c *Jrpc) GetPos33Finalized(in *types.ReqNil, result *interface{}) error {
+	r, err := c.cli.GetPos33Finalized(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// GetPos33NextNonce get the next nonce of the account, 0 means the account has not used nonce yet
+func (g *channelClient) GetPos33NextNonce(ctx context.Context, in *types.ReqString) (*types.Int64, error) {
+	data, err := g.query(ctx, "Pos33NextNonce", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*types.Int64), nil
+}
+
+// GetPos33NextNonce get the next nonce of the account
+func (c *Jrpc) GetPos33NextNonce(in *types.ReqString, result *interface{}) error {
+	r, err := c.cli.GetPos33NextNonce(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+const (
+	defaultLocatorHeaders = 500
+	maxLocatorHeaders     = 2000
+	maxLocatorHashes      = 101
+)
+
+// GetHeadersLocator 轻节点用 block locator 同步区块头: 找到 locator 里在主链上的最高区块,
+// 返回它之后的区块头. 离线一段时间或者在分叉上的轻节点也只需要一次请求就能找到分叉点
+func (g *channelClient) GetHeadersLocator(ctx context.Context, in *ty.ReqPos33Locator) (*ty.ReplyPos33Locator, error) {
+	msg, err := g.limit.do(ctx, in, func(ctx context.Context) (types.Message, error) {
+		return g.locate(ctx, in)
+	})
+	if err != nil {
+		return nil, ty.NewRPCError(err)
+	}
+	return msg.(*ty.ReplyPos33Locator), nil
+}
+
+func (g *channelClient) locate(ctx context.Context, in *ty.ReqPos33Locator) (*ty.ReplyPos33Locator, error) {
+	if len(in.Hashes) > maxLocatorHashes {
+		return nil, types.ErrInvalidParam
+	}
+	count := int64(in.Count)
+	if count <= 0 {
+		count = defaultLocatorHeaders
+	}
+	if count > maxLocatorHeaders {
+		count = maxLocatorHeaders
+	}
+	last, err := g.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ty.ReplyPos33Locator{ForkHeight: -1}
+	for _, h := range in.Hashes {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		hash, err := common.FromHex(h)
+		if err != nil || len(hash) == 0 {
+			return nil, types.ErrInvalidParam
+		}
+		bo, err := g.GetBlockOverview(&types.ReqHash{Hash: hash})
+		if err != nil {
+			continue
+		}
+		height := bo.GetHead().GetHeight()
+		mh, err := g.GetBlockHash(&types.ReqInt{Height: height})
+		if err != nil || string(mh.Hash) != string(hash) {
+			// 不在主链上
+			continue
+		}
+		r.ForkHeight = height
+		r.ForkHash = hash
+		break
+	}
+
+	start := r.ForkHeight + 1
+	end := start + count - 1
+	if end > last.Height {
+		end = last.Height
+	}
+	if start > end {
+		r.Headers = &types.Headers{}
+		return r, nil
+	}
+	hs, err := g.GetHeaders(&types.ReqBlocks{Start: start, End: end})
+	if err != nil {
+		return nil, err
+	}
+	if in.Stop != "" {
+		stop, err := common.FromHex(in.Stop)
+		if err != nil {
+			return nil, types.ErrInvalidParam
+		}
+		for i, h := range hs.Items {
+			if string(h.Hash) == string(stop) {
+				hs.Items = hs.Items[:i+1]
+				break
+			}
+		}
+	}
+	r.Headers = hs
+	return r, nil
+}
+
+// GetHeadersLocator get headers after the highest main chain block in the locator
+func (c *Jrpc) GetHeadersLocator(in *ty.ReqPos33Locator, result *interface{}) error {
+	r, err := c.cli.GetHeadersLocator(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	var headers []*rpctypes.Header
+	for _, h := range r.Headers.GetItems() {
+		headers = append(headers, &rpctypes.Header{
+			Version:    h.GetVersion(),
+			ParentHash: common.ToHex(h.GetParentHash()),
+			TxHash:     common.ToHex(h.GetTxHash()),
+			StateHash:  common.ToHex(h.GetStateHash()),
+			Height:     h.GetHeight(),
+			BlockTime:  h.GetBlockTime(),
+			TxCount:    h.GetTxCount(),
+			Hash:       common.ToHex(h.GetHash()),
+			Difficulty: h.GetDifficulty(),
+		})
+	}
+	*result = &headersLocator{ForkHeight: r.ForkHeight, ForkHash: common.ToHex(r.ForkHash), Headers: headers}
+	return nil
+}
+
+// headersLocator GetHeadersLocator 的 json 结果, hash 用 hex
+type headersLocator struct {
+	ForkHeight int64              `json:"forkHeight"`
+	ForkHash   string             `json:"forkHash"`
+	Headers    []*rpctypes.Header `json:"headers"`
+}
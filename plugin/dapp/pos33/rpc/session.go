@@ -0,0 +1,213 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"golang.org/x/net/context"
+)
+
+const (
+	defaultSessionTTL = 3600
+
+	// SessionTransfer session 可以调用 SessionTransfer
+	SessionTransfer = "Transfer"
+	// SessionSetMinerFeeRate session 可以调用 SessionSetMinerFeeRate
+	SessionSetMinerFeeRate = "SetMinerFeeRate"
+)
+
+var sessionMethods = map[string]bool{
+	SessionTransfer:        true,
+	SessionSetMinerFeeRate: true,
+}
+
+type session struct {
+	methods map[string]bool
+	limit   int64
+	spent   int64
+	expire  int64
+}
+
+// sessionStore 管理员创建的有时效的 session token, 只保存在内存中
+type sessionStore struct {
+	mu       sync.Mutex
+	admin    string
+	maxTTL   int64
+	sessions map[string]*session
+}
+
+func newSessionStore(subcfg *subConfig) *sessionStore {
+	maxTTL := subcfg.SessionMaxTTL
+	if maxTTL <= 0 {
+		maxTTL = defaultSessionTTL
+	}
+	return &sessionStore{
+		admin:    subcfg.AdminToken,
+		maxTTL:   maxTTL,
+		sessions: make(map[string]*session),
+	}
+}
+
+// isAdmin token 是不是管理员口令
+func (s *sessionStore) isAdmin(token string) bool {
+	return s != nil && s.admin != "" && subtle.ConstantTimeCompare([]byte(s.admin), []byte(token)) == 1
+}
+
+func (s *sessionStore) create(in *ty.ReqPos33Session) (*ty.ReplyPos33Session, error) {
+	if !s.isAdmin(in.AdminToken) {
+		return nil, ty.ErrSessionToken
+	}
+	if len(in.Methods) == 0 || in.AmountLimit < 0 {
+		return nil, types.ErrInvalidParam
+	}
+	ss := &session{methods: make(map[string]bool), limit: in.AmountLimit}
+	for _, m := range in.Methods {
+		if !sessionMethods[m] {
+			return nil, ty.ErrSessionScope
+		}
+		ss.methods[m] = true
+	}
+	ttl := in.Ttl
+	if ttl <= 0 || ttl > s.maxTTL {
+		ttl = s.maxTTL
+	}
+	ss.expire = time.Now().Unix() + ttl
+
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return nil, err
+	}
+	token := hex.EncodeToString(b)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.evict()
+	s.sessions[token] = ss
+	return &ty.ReplyPos33Session{Token: token, Expire: ss.expire}, nil
+}
+
+// use 检查 token 是否可以调用 method, 并且记录转账的金额
+func (s *sessionStore) use(token, method string, amount int64) error {
+	if s == nil {
+		return ty.ErrSessionToken
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.evict()
+	ss, ok := s.sessions[token]
+	if !ok {
+		return ty.ErrSessionToken
+	}
+	if !ss.methods[method] {
+		return ty.ErrSessionScope
+	}
+	if amount < 0 || ss.spent+amount > ss.limit {
+		return ty.ErrSessionLimit
+	}
+	ss.spent += amount
+	return nil
+}
+
+// refund 调用失败时退回金额
+func (s *sessionStore) refund(token string, amount int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ss, ok := s.sessions[token]; ok {
+		ss.spent -= amount
+	}
+}
+
+func (s *sessionStore) evict() {
+	now := time.Now().Unix()
+	for k, ss := range s.sessions {
+		if ss.expire < now {
+			delete(s.sessions, k)
+		}
+	}
+}
+
+// CreatePos33Session create a session token for wallet rpc
+func (g *channelClient) CreatePos33Session(ctx context.Context, in *ty.ReqPos33Session) (*ty.ReplyPos33Session, error) {
+	msg, err := g.limit.do(ctx, in, func(ctx context.Context) (types.Message, error) {
+		r, err := g.sessions.create(in)
+		if err != nil {
+			return nil, err
+		}
+		g.auditAdmin("session:"+r.Token[:8], fmt.Sprintf("CreatePos33Session methods=%v limit=%d expire=%d", in.Methods, in.AmountLimit, r.Expire))
+		return r, nil
+	})
+	if err != nil {
+		return nil, ty.NewRPCError(err)
+	}
+	return msg.(*ty.ReplyPos33Session), nil
+}
+
+// CreatePos33Session create a session token for wallet rpc
+func (c *Jrpc) CreatePos33Session(in *ty.ReqPos33Session, result *interface{}) error {
+	r, err := c.cli.CreatePos33Session(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// SessionTransfer transfer from wallet with session token, 转账经过 Pos33Transfer 的 rpc 限制
+func (g *channelClient) SessionTransfer(ctx context.Context, in *ty.ReqPos33SessionTransfer) (*ty.ReplyPos33Transfer, error) {
+	if in.Amount <= 0 {
+		return nil, ty.NewRPCError(types.ErrAmount)
+	}
+	err := g.sessions.use(in.Token, SessionTransfer, in.Amount)
+	if err != nil {
+		return nil, ty.NewRPCError(err)
+	}
+	r, err := g.Pos33Transfer(ctx, &ty.ReqPos33Transfer{From: in.From, To: in.To, Amount: in.Amount, Note: in.Note})
+	if err != nil {
+		g.sessions.refund(in.Token, in.Amount)
+		return nil, err
+	}
+	return r, nil
+}
+
+// SessionTransfer transfer from wallet with session token
+func (c *Jrpc) SessionTransfer(in *ty.ReqPos33SessionTransfer, result *interface{}) error {
+	r, err := c.cli.SessionTransfer(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
+
+// SessionSetMinerFeeRate set miner fee rate with session token, 经过 SetMinerFeeRate 的 rpc 限制
+func (g *channelClient) SessionSetMinerFeeRate(ctx context.Context, in *ty.ReqPos33SessionFeeRate) (*types.ReplyHash, error) {
+	if in.Rate == nil {
+		return nil, ty.NewRPCError(types.ErrInvalidParam)
+	}
+	err := g.sessions.use(in.Token, SessionSetMinerFeeRate, 0)
+	if err != nil {
+		return nil, ty.NewRPCError(err)
+	}
+	return g.SetMinerFeeRate(ctx, in.Rate)
+}
+
+// SessionSetMinerFeeRate set miner fee rate with session token
+func (c *Jrpc) SessionSetMinerFeeRate(in *ty.ReqPos33SessionFeeRate, result *interface{}) error {
+	r, err := c.cli.SessionSetMinerFeeRate(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
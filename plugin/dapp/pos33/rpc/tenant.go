@@ -0,0 +1,332 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"golang.org/x/net/context"
+)
+
+// tenantConfig [[rpc.sub.pos33.tenants]] 一个租户
+type tenantConfig struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+	// 每秒请求数和突发请求数, 0 表示不限制
+	Rate  float64 `json:"rate,omitempty"`
+	Burst float64 `json:"burst,omitempty"`
+	// 每天(UTC)的请求数, 0 表示不限制
+	Daily int64 `json:"daily,omitempty"`
+	// 可以调用的方法, 支持 "Chain33.*" 这样的前缀, 空表示所有方法
+	Methods []string `json:"methods,omitempty"`
+}
+
+// 单个 rpc 请求的最大字节数
+const maxTenantRequest = 1 << 20
+
+type tenant struct {
+	cfg    *tenantConfig
+	tokens float64
+	last   time.Time
+	day    int64
+	usage  *ty.Pos33TenantUsage
+}
+
+// allow 令牌桶和每天的配额
+func (t *tenant) allow(n int64, now time.Time) bool {
+	day := now.Unix() / 86400
+	if day != t.day {
+		t.day = day
+		t.usage.Today = 0
+	}
+	if t.cfg.Daily > 0 && t.usage.Today+n > t.cfg.Daily {
+		return false
+	}
+	if t.cfg.Rate > 0 {
+		burst := t.cfg.Burst
+		if burst < t.cfg.Rate {
+			burst = t.cfg.Rate
+		}
+		if !t.last.IsZero() {
+			t.tokens += now.Sub(t.last).Seconds() * t.cfg.Rate
+		} else {
+			t.tokens = burst
+		}
+		if t.tokens > burst {
+			t.tokens = burst
+		}
+		t.last = now
+		if t.tokens < float64(n) {
+			return false
+		}
+		t.tokens -= float64(n)
+	}
+	t.usage.Today += n
+	return true
+}
+
+func (t *tenant) snapshot() *ty.Pos33TenantUsage {
+	u := t.usage
+	return &ty.Pos33TenantUsage{
+		Name:     u.Name,
+		Requests: u.Requests,
+		Today:    u.Today,
+		Daily:    u.Daily,
+		Limited:  u.Limited,
+		Denied:   u.Denied,
+		BytesIn:  u.BytesIn,
+		BytesOut: u.BytesOut,
+	}
+}
+
+func (t *tenant) scope(method string) bool {
+	if len(t.cfg.Methods) == 0 {
+		return true
+	}
+	for _, m := range t.cfg.Methods {
+		if m == "*" || m == method {
+			return true
+		}
+		if strings.HasSuffix(m, ".*") && strings.HasPrefix(method, m[:len(m)-1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// tenantProxy 多租户的 rpc 代理: 每个 api key 是一个虚拟的命名空间, 有自己的速率限制,
+// 每天的配额, 可以调用的方法和用量统计, 请求转发到本节点的 jrpc.
+// api key 放在 X-API-Key 头里, 或者作为路径: http://tenantAddr/<key>, GET 请求返回自己的用量
+type tenantProxy struct {
+	admin   string
+	target  string
+	user    string
+	passwd  string
+	client  *http.Client
+	mu      sync.Mutex
+	tenants map[string]*tenant // key => tenant
+}
+
+func newTenantProxy(cli *channelClient, subcfg *subConfig) *tenantProxy {
+	if subcfg.TenantAddr == "" || len(subcfg.Tenants) == 0 {
+		return nil
+	}
+	rpccfg := cli.GetConfig().GetModuleConfig().RPC
+	host := rpccfg.JrpcBindAddr
+	if strings.HasPrefix(host, ":") {
+		host = "localhost" + host
+	}
+	scheme := "http://"
+	tr := &http.Transport{}
+	if rpccfg.EnableTLS {
+		// 转发到本机, 不验证证书
+		scheme = "https://"
+		tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	p := &tenantProxy{
+		admin:   subcfg.AdminToken,
+		target:  scheme + host,
+		user:    rpccfg.JrpcUserName,
+		passwd:  rpccfg.JrpcUserPasswd,
+		client:  &http.Client{Transport: tr, Timeout: time.Minute},
+		tenants: make(map[string]*tenant),
+	}
+	for _, tc := range subcfg.Tenants {
+		if tc.Name == "" || len(tc.Key) < 16 {
+			panic("pos33 tenant name is empty or key too short: " + tc.Name)
+		}
+		if _, ok := p.tenants[tc.Key]; ok {
+			panic("pos33 tenant key duplicated: " + tc.Name)
+		}
+		p.tenants[tc.Key] = &tenant{cfg: tc, usage: &ty.Pos33TenantUsage{Name: tc.Name, Daily: tc.Daily}}
+	}
+	go func() {
+		err := http.ListenAndServe(subcfg.TenantAddr, p)
+		if err != nil {
+			panic("pos33 tenant rpc error: " + err.Error())
+		}
+	}()
+	return p
+}
+
+type jsonReq struct {
+	Method string `json:"method"`
+}
+
+func tenantError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]interface{}{"id": nil, "result": nil, "error": ty.NewRPCError(err)})
+}
+
+// methods jsonrpc 请求里的方法, 支持批量请求
+func methods(body []byte) ([]string, error) {
+	body = bytes.TrimSpace(body)
+	var reqs []*jsonReq
+	if len(body) > 0 && body[0] == '[' {
+		err := json.Unmarshal(body, &reqs)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		req := new(jsonReq)
+		err := json.Unmarshal(body, req)
+		if err != nil {
+			return nil, err
+		}
+		reqs = append(reqs, req)
+	}
+	var ms []string
+	for _, r := range reqs {
+		ms = append(ms, r.Method)
+	}
+	return ms, nil
+}
+
+func (p *tenantProxy) find(r *http.Request) *tenant {
+	key := r.Header.Get("X-API-Key")
+	if key == "" {
+		key = strings.Trim(r.URL.Path, "/")
+	}
+	if key == "" {
+		return nil
+	}
+	for k, t := range p.tenants {
+		if subtle.ConstantTimeCompare([]byte(k), []byte(key)) == 1 {
+			return t
+		}
+	}
+	return nil
+}
+
+func (p *tenantProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	t := p.find(r)
+	if t == nil {
+		tenantError(w, http.StatusUnauthorized, ty.ErrSessionToken)
+		return
+	}
+	if r.Method == http.MethodGet {
+		// GET 返回租户自己的用量
+		p.mu.Lock()
+		u := t.snapshot()
+		p.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(u)
+		return
+	}
+	if r.Method != http.MethodPost {
+		tenantError(w, http.StatusMethodNotAllowed, types.ErrActionNotSupport)
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxTenantRequest))
+	if err != nil {
+		tenantError(w, http.StatusRequestEntityTooLarge, ty.ErrRequestTooLarge)
+		return
+	}
+	ms, err := methods(body)
+	if err != nil || len(ms) == 0 {
+		tenantError(w, http.StatusBadRequest, types.ErrInvalidParam)
+		return
+	}
+
+	p.mu.Lock()
+	t.usage.Requests += int64(len(ms))
+	t.usage.BytesIn += int64(len(body))
+	for _, m := range ms {
+		if !t.scope(m) {
+			t.usage.Denied += int64(len(ms))
+			p.mu.Unlock()
+			tenantError(w, http.StatusForbidden, ty.ErrSessionScope)
+			return
+		}
+	}
+	if !t.allow(int64(len(ms)), time.Now()) {
+		t.usage.Limited += int64(len(ms))
+		p.mu.Unlock()
+		tenantError(w, http.StatusTooManyRequests, ty.ErrTooManyRequests)
+		return
+	}
+	p.mu.Unlock()
+
+	req, err := http.NewRequest(http.MethodPost, p.target, bytes.NewReader(body))
+	if err != nil {
+		tenantError(w, http.StatusInternalServerError, err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.user != "" {
+		req.SetBasicAuth(p.user, p.passwd)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		tenantError(w, http.StatusBadGateway, err)
+		return
+	}
+	defer resp.Body.Close()
+	out, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		tenantError(w, http.StatusBadGateway, err)
+		return
+	}
+	p.mu.Lock()
+	t.usage.BytesOut += int64(len(out))
+	p.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(resp.StatusCode)
+	w.Write(out)
+}
+
+// usage 管理员查询租户的用量
+func (p *tenantProxy) usage(in *ty.ReqPos33TenantUsage) (*ty.Pos33TenantUsages, error) {
+	if p == nil {
+		return nil, types.ErrActionNotSupport
+	}
+	if p.admin == "" || subtle.ConstantTimeCompare([]byte(p.admin), []byte(in.AdminToken)) != 1 {
+		return nil, ty.ErrSessionToken
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var us []*ty.Pos33TenantUsage
+	for _, t := range p.tenants {
+		if in.Name != "" && in.Name != t.cfg.Name {
+			continue
+		}
+		us = append(us, t.snapshot())
+	}
+	sort.Slice(us, func(i, j int) bool { return us[i].Name < us[j].Name })
+	return &ty.Pos33TenantUsages{Items: us}, nil
+}
+
+// GetPos33TenantUsage get usage of rpc tenants, admin only
+func (g *channelClient) GetPos33TenantUsage(ctx context.Context, in *ty.ReqPos33TenantUsage) (*ty.Pos33TenantUsages, error) {
+	msg, err := g.limit.do(ctx, in, func(ctx context.Context) (types.Message, error) {
+		return g.tenants.usage(in)
+	})
+	if err != nil {
+		return nil, ty.NewRPCError(err)
+	}
+	return msg.(*ty.Pos33TenantUsages), nil
+}
+
+// GetPos33TenantUsage get usage of rpc tenants, admin only
+func (c *Jrpc) GetPos33TenantUsage(in *ty.ReqPos33TenantUsage, result *interface{}) error {
+	r, err := c.cli.GetPos33TenantUsage(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"golang.org/x/net/context"
+)
+
+// SendPos33TracedTx 发送交易并登记客户端的追踪 ID, 追踪 ID 不上链, 只在本节点的日志和查询里出现
+func (g *channelClient) SendPos33TracedTx(ctx context.Context, in *ty.ReqPos33TracedTx) (*types.ReplyHash, error) {
+	msg, err := g.limit.do(ctx, in, func(ctx context.Context) (types.Message, error) {
+		return g.sendTracedTx(in)
+	})
+	if err != nil {
+		return nil, ty.NewRPCError(err)
+	}
+	return msg.(*types.ReplyHash), nil
+}
+
+func (g *channelClient) sendTracedTx(in *ty.ReqPos33TracedTx) (*types.ReplyHash, error) {
+	data, err := common.FromHex(in.Data)
+	if err != nil {
+		return nil, types.ErrInvalidParam
+	}
+	var tx types.Transaction
+	err = types.Decode(data, &tx)
+	if err != nil {
+		return nil, types.ErrDecode
+	}
+	hash := tx.Hash()
+	// 在发送之前登记, mempool 才能记录准入的事件
+	err = ty.AddTxTrace(in.TraceId, hash)
+	if err != nil {
+		return nil, err
+	}
+	ty.TraceTx(hash, "rpc received")
+	_, err = g.SendTx(&tx)
+	if err != nil {
+		ty.TraceTx(hash, "rpc rejected: "+err.Error())
+		return nil, err
+	}
+	return &types.ReplyHash{Hash: hash}, nil
+}
+
+// GetPos33TxTrace 用追踪 ID 查询交易的事件和当前状态: 在交易池里, 已经打包或者都不是(丢失)
+func (g *channelClient) GetPos33TxTrace(ctx context.Context, in *types.ReqString) (*ty.Pos33TxTrace, error) {
+	msg, err := g.limit.do(ctx, in, func(ctx context.Context) (types.Message, error) {
+		return g.txTrace(in.Data)
+	})
+	if err != nil {
+		return nil, ty.NewRPCError(err)
+	}
+	return msg.(*ty.Pos33TxTrace), nil
+}
+
+func (g *channelClient) txTrace(id string) (*ty.Pos33TxTrace, error) {
+	tr, ok := ty.GetTxTrace(id)
+	if !ok {
+		return nil, types.ErrNotFound
+	}
+	hash, err := common.FromHex(tr.Hash)
+	if err != nil {
+		return nil, err
+	}
+	detail, err := g.QueryTx(&types.ReqHash{Hash: hash})
+	if err == nil && detail != nil && detail.Receipt != nil {
+		tr.Packed = true
+		tr.Height = detail.Height
+		tr.Index = detail.Index
+		tr.ReceiptTy = detail.Receipt.Ty
+		return tr, nil
+	}
+	// mempool 的最后一个事件是准入时交易还在交易池里
+	for i := len(tr.Events) - 1; i >= 0; i-- {
+		if strings.HasPrefix(tr.Events[i].Event, "mempool ") {
+			tr.InMempool = tr.Events[i].Event == "mempool admitted"
+			break
+		}
+	}
+	return tr, nil
+}
+
+// SendPos33TracedTx send tx with a client trace id, the id is not on chain
+func (c *Jrpc) SendPos33TracedTx(in *ty.ReqPos33TracedTx, result *interface{}) error {
+	r, err := c.cli.SendPos33TracedTx(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = common.ToHex(r.Hash)
+	return nil
+}
+
+// GetPos33TxTrace get tx events and status by client trace id
+func (c *Jrpc) GetPos33TxTrace(in *types.ReqString, result *interface{}) error {
+	r, err := c.cli.GetPos33TxTrace(context.Background(), in)
+	if err != nil {
+		return ty.NewRPCError(err)
+	}
+	*result = r
+	return nil
+}
@@ -6,6 +6,7 @@ package rpc
 
 import (
 	"github.com/33cn/chain33/rpc/types"
+	"github.com/yccproject/ycc/plugin/dapp/openrpc"
 	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
 )
 
@@ -21,6 +22,10 @@ type Grpc struct {
 
 type channelClient struct {
 	types.ChannelClient
+	limit    *limiter
+	sessions *sessionStore
+	faucet   *faucet
+	tenants  *tenantProxy
 }
 
 // Init initial
@@ -28,5 +33,11 @@ func Init(name string, s types.RPCServer) {
 	cli := &channelClient{}
 	grpc := &Grpc{channelClient: cli}
 	cli.Init(name, s, &Jrpc{cli: cli}, grpc)
+	subcfg := getSubConfig(cli.GetConfig())
+	cli.limit = newLimiter(subcfg)
+	cli.sessions = newSessionStore(subcfg)
+	cli.faucet = newFaucet(cli, subcfg)
+	cli.tenants = newTenantProxy(cli, subcfg)
 	ty.RegisterPos33Server(s.GRPC(), grpc)
+	openrpc.Register(name, &Jrpc{}, cli)
 }
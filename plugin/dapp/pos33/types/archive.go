@@ -0,0 +1,65 @@
+package types
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/33cn/chain33/types"
+)
+
+// 共识消息存档的格式: 每条记录是 uvarint 长度加上编码的 Pos33ArchivedMsg, 一个高度一个文件
+
+// 一条记录最大的长度, 超过认为文件坏了
+const maxArchivedMsgSize = 64 << 20
+
+// ErrArchivedMsgSize 存档记录的长度不对
+var ErrArchivedMsgSize = errors.New("ErrArchivedMsgSize")
+
+// WriteArchivedMsg 写一条存档记录
+func WriteArchivedMsg(w io.Writer, m *Pos33ArchivedMsg) error {
+	data := types.Encode(m)
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], uint64(len(data)))
+	_, err := w.Write(buf[:n])
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// ReadArchivedMsgs 按顺序读出所有的存档记录, 最后一条没有写完整(节点退出时)的记录忽略
+func ReadArchivedMsgs(r io.Reader, fn func(*Pos33ArchivedMsg) error) error {
+	br := bufio.NewReader(r)
+	for {
+		size, err := binary.ReadUvarint(br)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if size > maxArchivedMsgSize {
+			return ErrArchivedMsgSize
+		}
+		data := make([]byte, size)
+		_, err = io.ReadFull(br, data)
+		if err == io.ErrUnexpectedEOF || err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		m := new(Pos33ArchivedMsg)
+		err = types.Decode(data, m)
+		if err != nil {
+			return err
+		}
+		err = fn(m)
+		if err != nil {
+			return err
+		}
+	}
+}
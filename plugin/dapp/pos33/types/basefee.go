@@ -0,0 +1,78 @@
+package types
+
+import (
+	"github.com/33cn/chain33/types"
+	rt "github.com/yccproject/ycc/plugin/dapp/random/types"
+)
+
+// isRandomFulfill tx 的内容是不是 random 的 fulfill, 不检查执行器名字
+func isRandomFulfill(tx *types.Transaction) bool {
+	var ra rt.RandomAction
+	return types.Decode(tx.Payload, &ra) == nil && ra.Ty == rt.RandomActionFulfill && ra.GetFulfill() != nil
+}
+
+// BaseFeeExempt tx 是不是制作人 maker 签名的免除基础交易费的交易: 完成随机数请求的交易由请求的 fee 付费
+func BaseFeeExempt(tx *types.Transaction, maker string) bool {
+	return maker != "" && string(tx.Execer) == rt.RandomX && isRandomFulfill(tx) && tx.From() == maker
+}
+
+// NextBaseFee 根据上一个区块的基础交易费和交易大小计算下一个区块的基础交易费,
+// 交易的总字节数超过 target 时上涨, 少于 target 时下降, 每个区块最多变化 1/Pos33BaseFeeDenominator, 不低于 minFee
+func NextBaseFee(prev *Pos33BaseFee, target, minFee int64) int64 {
+	if prev == nil || prev.BaseFee < minFee {
+		return minFee
+	}
+	if target <= 0 {
+		return prev.BaseFee
+	}
+	size := prev.Size
+	if size > 2*target {
+		size = 2 * target
+	}
+	delta := prev.BaseFee * (size - target) / target / Pos33BaseFeeDenominator
+	if delta == 0 && size > target {
+		delta = 1
+	}
+	bf := prev.BaseFee + delta
+	if bf < minFee {
+		bf = minFee
+	}
+	return bf
+}
+
+// SplitBaseFee 按 baseFee 把交易费分成销毁的基础费和给制作人和投票人的小费, 交易组的交易费在第一个交易上, 按整个组计算,
+// under 是交易费不够 baseFee 的交易(组)数, 这些交易的交易费全部销毁. size 是付基础交易费的交易的总字节数,
+// maker 签名的免除基础交易费的交易不算在里面, 交易费都是小费
+func SplitBaseFee(txs []*types.Transaction, baseFee int64, maker string) (burned, tips, size int64, under int) {
+	for i := 0; i < len(txs); {
+		n := 1
+		if c := int(txs[i].GroupCount); c > 1 && i+c <= len(txs) {
+			n = c
+		}
+		if n == 1 && BaseFeeExempt(txs[i], maker) {
+			tips += txs[i].Fee
+			i++
+			continue
+		}
+		var fee, real int64
+		ok := true
+		for _, tx := range txs[i : i+n] {
+			fee += tx.Fee
+			size += int64(types.Size(tx))
+			r, err := tx.GetRealFee(baseFee)
+			if err != nil {
+				ok = false
+			}
+			real += r
+		}
+		if !ok || fee < real {
+			under++
+			burned += fee
+		} else {
+			burned += real
+			tips += fee - real
+		}
+		i += n
+	}
+	return burned, tips, size, under
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+// Pos33BlockSeedRing 区块种子只保存最近的 Pos33BlockSeedRing 个高度, 要大于随机数请求最长的超时
+const Pos33BlockSeedRing = 2048
+
+// BlockSeedKey height 的区块种子, 按 Pos33BlockSeedRing 循环使用. 别的执行器 (random) 也会读这个 key
+func BlockSeedKey(height int64) []byte {
+	return []byte(fmt.Sprintf("mavl-pos33-blockseed-%d", height%Pos33BlockSeedRing))
+}
+
+// GetBlockSeed 读区块 height 的种子, 没有记录或者已经被覆盖返回 types.ErrNotFound
+func GetBlockSeed(db dbm.KV, height int64) (*Pos33BlockSeed, error) {
+	val, err := db.Get(BlockSeedKey(height))
+	if err != nil || len(val) == 0 {
+		return nil, types.ErrNotFound
+	}
+	var s Pos33BlockSeed
+	err = types.Decode(val, &s)
+	if err != nil {
+		return nil, err
+	}
+	if s.Height != height {
+		return nil, types.ErrNotFound
+	}
+	return &s, nil
+}
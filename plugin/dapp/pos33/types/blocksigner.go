@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/33cn/chain33/common/address"
+)
+
+// MinerTxMaker miner 交易的出块人, 也就是抽签的 vrf 公钥的地址.
+// ForkBlockSigner 之后 miner 交易可以由出块人登记的签名地址签名, 不能直接用 tx.From()
+func MinerTxMaker(miner *Pos33MinerMsg) string {
+	if miner == nil || miner.Sort == nil || miner.Sort.Proof == nil || len(miner.Sort.Proof.Pubkey) == 0 {
+		return ""
+	}
+	return address.PubKeyToAddr(EthAddrID, miner.Sort.Proof.Pubkey)
+}
+
+// ValidAt 签名地址能不能给 miner 在 height 的 miner 交易签名: 登记的区块之后生效, 撤销的区块之后失效
+func (s *Pos33BlockSigner) ValidAt(miner string, height int64) bool {
+	if s == nil || s.Miner != miner || height <= s.Height {
+		return false
+	}
+	return s.Revoked == 0 || height <= s.Revoked
+}
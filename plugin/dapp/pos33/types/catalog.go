@@ -0,0 +1,173 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrEntry 错误目录的一项, ID 发布后不再改变, 运维可以按 ID 搜索日志和文档
+type ErrEntry struct {
+	ID     string `json:"id"`
+	EN     string `json:"en"`
+	ZH     string `json:"zh"`
+	HintEN string `json:"hintEn"`
+	HintZH string `json:"hintZh"`
+}
+
+// Message 按语言返回错误信息, lang 为 zh 时返回中文, 其它返回英文
+func (e *ErrEntry) Message(lang string, args ...interface{}) string {
+	if lang == "zh" {
+		return fmt.Sprintf(e.ZH, args...)
+	}
+	return fmt.Sprintf(e.EN, args...)
+}
+
+// Hint 按语言返回处理建议
+func (e *ErrEntry) Hint(lang string) string {
+	if lang == "zh" {
+		return e.HintZH
+	}
+	return e.HintEN
+}
+
+// New 生成这一项的错误
+func (e *ErrEntry) New(args ...interface{}) error {
+	return &CatalogError{Entry: e, Args: args}
+}
+
+// CatalogError 错误目录里的错误, Error() 带上 ID, 英文信息保证日志可以 grep
+type CatalogError struct {
+	Entry *ErrEntry
+	Args  []interface{}
+}
+
+func (e *CatalogError) Error() string {
+	return e.Entry.ID + ": " + e.Entry.Message("en", e.Args...)
+}
+
+var errCatalog = make(map[string]*ErrEntry)
+
+func regErr(id, en, zh, hintEN, hintZH string) *ErrEntry {
+	e := &ErrEntry{ID: id, EN: en, ZH: zh, HintEN: hintEN, HintZH: hintZH}
+	if _, ok := errCatalog[id]; ok {
+		panic("error id registered: " + id)
+	}
+	errCatalog[id] = e
+	return e
+}
+
+// ErrCatalog 所有的错误, 按 ID 排序
+func ErrCatalog() []*ErrEntry {
+	var es []*ErrEntry
+	for _, e := range errCatalog {
+		es = append(es, e)
+	}
+	sort.Slice(es, func(i, j int) bool { return es[i].ID < es[j].ID })
+	return es
+}
+
+// LookupErr 按 ID 或者日志里的错误信息查找
+func LookupErr(s string) *ErrEntry {
+	s = strings.TrimSpace(s)
+	if e, ok := errCatalog[s]; ok {
+		return e
+	}
+	for id, e := range errCatalog {
+		if strings.Contains(s, id+":") {
+			return e
+		}
+	}
+	// 没有 ID 的旧日志, 按英文信息格式化参数之前的部分匹配
+	for _, e := range ErrCatalog() {
+		prefix := strings.SplitN(e.EN, "%", 2)[0]
+		if len(prefix) > 8 && strings.Contains(s, prefix) {
+			return e
+		}
+	}
+	return nil
+}
+
+// 共识的错误
+var (
+	ErrCatSortIndex = regErr("POS33-E101",
+		"sort index %d > %d your count, height %d",
+		"抽签序号 %d 超过了票数 %d, 高度 %d",
+		"The sender's ticket count at height-10 is not more than the index it used. Usually the sender has a different view of tickets (not synced, or just closed tickets). If it is your node, wait for sync and check 'ycc-cli pos33 consignee -a <addr>'.",
+		"发送者在 高度-10 时的票数不大于它使用的抽签序号, 一般是发送者的票数视图不同(没有同步或者刚刚取回了票). 如果是自己的节点, 等待同步后用 'ycc-cli pos33 consignee -a <addr>' 检查票数.")
+	ErrCatSortHeight = regErr("POS33-E102",
+		"verifySort error, height NOT match: %d!=%d",
+		"抽签验证失败, 高度不一致: %d!=%d",
+		"The sort was made for another height. Check the node is synced and its clock is right.",
+		"抽签的高度不对. 检查节点是否同步, 系统时间是否正确.")
+	ErrCatSortSeed = regErr("POS33-E103",
+		"verifySort error, seed NOT match",
+		"抽签验证失败, 种子不一致",
+		"The sort used a different seed block; the sender is probably on a fork. Check 'ycc-cli block last_header' against peers.",
+		"抽签使用的种子区块不同, 发送者可能在分叉上. 用 'ycc-cli block last_header' 和其它节点比较.")
+	ErrCatSortStep = regErr("POS33-E104",
+		"verifySort error, step NOT match",
+		"抽签验证失败, 步骤不一致",
+		"A maker sort was used as a voter sort or the reverse. The sender runs an incompatible version; upgrade it.",
+		"制作人和投票人的抽签用错了, 发送者的版本不兼容, 请升级.")
+	ErrCatSortHash = regErr("POS33-E105",
+		"sort hash error",
+		"抽签 hash 错误",
+		"The sort hash does not match the VRF output. The message was corrupted or forged.",
+		"抽签 hash 和 vrf 结果不一致, 消息被篡改或者伪造.")
+	ErrCatSortDiff = regErr("POS33-E106",
+		"diff error",
+		"抽签难度错误",
+		"The sort hash is above the difficulty for its ticket count. The sender's ticket count view differs from ours; make sure both are synced.",
+		"抽签 hash 超过了难度, 发送者和本节点的票数视图不同, 确认双方都已经同步.")
+	ErrCatSortMsg = regErr("POS33-E107",
+		"sortMsg error",
+		"抽签消息错误",
+		"The sort message misses its proof or hash. The sender runs an incompatible version.",
+		"抽签消息缺少证明或者 hash, 发送者的版本不兼容.")
+	ErrCatSortLate = regErr("POS33-E108",
+		"sort msg too late, lbHeight=%d, sortHeight=%d",
+		"抽签消息太晚, 最新高度=%d, 抽签高度=%d",
+		"The sort arrived after its block. Occasional ones are normal; many of them mean slow network to peers, check 'ycc-cli net peer'.",
+		"抽签在区块之后才收到. 偶尔出现是正常的, 经常出现说明到其它节点的网络慢, 用 'ycc-cli net peer' 检查.")
+	ErrCatSortQuota = regErr("POS33-E109",
+		"sort msg quota exceeded: %d > %d, tickets %d, height %d",
+		"抽签消息超过配额: %d > %d, 票数 %d, 高度 %d",
+		"The sender sent more sorts or votes for one height than its ticket count allows, usually sorts for far future rounds. The messages are dropped and count towards banning the sender. If it is your node, check that it runs a released version.",
+		"发送者在一个高度发送的抽签或者投票超过了票数允许的数量, 一般是很多以后轮次的抽签. 这些消息被丢掉并计入禁止发送者的失败数. 如果是自己的节点, 检查是否运行的是发布的版本.")
+	ErrCatVotesEnough = regErr("POS33-E201",
+		"checkVotes error: NOT enough votes",
+		"投票不够",
+		"A block needs at least the minimum number of committee votes. Too few voters are online, or this node misses vote messages; check p2p connectivity of the pos33 port.",
+		"区块需要足够的委员会投票. 在线的投票人太少, 或者本节点收不到投票消息, 检查 pos33 端口的连通性.")
+	ErrCatVotesVerify = regErr("POS33-E202",
+		"verifyVotes error",
+		"投票签名验证失败",
+		"A BLS vote signature is invalid. The voter's BLS key may not match its binding; the voter should run 'ycc-cli pos33 blsbind'.",
+		"投票的 bls 签名无效, 投票人的 bls 私钥可能和绑定的不一致, 投票人需要执行 'ycc-cli pos33 blsbind'.")
+	ErrCatVotesRound = regErr("POS33-E203",
+		"checkVotes error: height, round or num NOT same",
+		"投票的高度, 轮次或者组号不一致",
+		"Votes of different rounds were mixed in one block. The block maker runs an incompatible version.",
+		"一个区块里混入了不同轮次的投票, 制作人的版本不兼容.")
+	ErrCatVoteHash = regErr("POS33-E204",
+		"vote hash NOT right",
+		"投票的 hash 不对",
+		"A vote is for another maker's sort. The block maker packed wrong votes.",
+		"投票给了其它制作人, 区块制作人打包了错误的投票.")
+	ErrCatBindAddr = regErr("POS33-E205",
+		"Pos33BindAddr NOT match",
+		"bls 绑定的地址不一致",
+		"The vote's BLS key is bound to another address. The voter should bind its BLS key again with 'ycc-cli pos33 blsbind'.",
+		"投票的 bls 公钥绑定在其它地址上, 投票人需要用 'ycc-cli pos33 blsbind' 重新绑定.")
+	ErrCatMinerSeed = regErr("POS33-E301",
+		"miner seed is nil",
+		"制作人种子为空",
+		"After ForkVrfSeed the miner tx must carry the seed VRF. The block maker runs an old version.",
+		"ForkVrfSeed 之后 miner 交易必须带种子 vrf, 区块制作人的版本太旧.")
+	ErrCatLastBlock = regErr("POS33-E302",
+		"the last block too low",
+		"最新区块高度太低",
+		"The node tried to make a block before receiving the previous one. It will retry in the next round; if it repeats, the node is behind its peers.",
+		"节点还没有收到上一个区块就开始出块, 会在下一轮重试. 如果一直出现, 说明节点落后于其它节点.")
+)
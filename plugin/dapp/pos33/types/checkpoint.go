@@ -0,0 +1,77 @@
+package types
+
+import (
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	bls33 "github.com/33cn/plugin/plugin/crypto/bls"
+)
+
+// CheckpointMsg checkpoint 签名的内容
+func CheckpointMsg(height int64, hash []byte) []byte {
+	return crypto.Sha256(types.Encode(&Pos33Checkpoint{Height: height, Hash: hash}))
+}
+
+// Sign 用 bls 私钥签名, 和投票用同一个 bls 私钥
+func (v *Pos33CheckpointVote) Sign(priv crypto.PrivKey) {
+	blsSk := Hash2BlsSk(crypto.Sha256(priv.Bytes()))
+	sig := blsSk.Sign(CheckpointMsg(v.Height, v.Hash))
+	v.Sig = &types.Signature{Ty: bls33.ID, Pubkey: blsSk.PubKey().Bytes(), Signature: sig.Bytes()}
+}
+
+// Verify 验证 checkpoint 投票
+func (v *Pos33CheckpointVote) Verify() bool {
+	if v.Sig == nil {
+		return false
+	}
+	return types.CheckSign(CheckpointMsg(v.Height, v.Hash), Pos33TicketX, v.Sig, v.Height)
+}
+
+// Verify 验证 checkpoint 的聚合签名, 公钥不能重复, 并且都在 pending 的投票人里, 签名的票数超过 pending 总票数的 2/3
+func (c *Pos33Checkpoint) Verify(pending *Pos33Checkpoint) error {
+	if len(pending.Weights) != len(pending.BlsPkList) {
+		return ErrCheckpoint
+	}
+	mp := make(map[string]int64)
+	var total int64
+	for i, pk := range pending.BlsPkList {
+		mp[string(pk)] = pending.Weights[i]
+		total += pending.Weights[i]
+	}
+	var signed int64
+	var pks []crypto.PubKey
+	for _, b := range c.BlsPkList {
+		w, ok := mp[string(b)]
+		if !ok {
+			return ErrCheckpoint
+		}
+		delete(mp, string(b))
+		signed += w
+		pk := bls33.PubKeyBLS{}
+		copy(pk[:], b)
+		pks = append(pks, pk)
+	}
+	if total == 0 || signed*3 <= total*2 {
+		return ErrCheckpoint
+	}
+	var sig bls33.SignatureBLS
+	copy(sig[:], c.BlsSig)
+	return new(bls33.Driver).VerifyAggregatedOne(pks, CheckpointMsg(c.Height, c.Hash), sig)
+}
+
+// CheckpointWeights 区块投票人的公钥去重, 每个公钥的票数是它在 voters 里出现的次数
+func CheckpointWeights(voters [][]byte) ([][]byte, []int64) {
+	idx := make(map[string]int)
+	var pks [][]byte
+	var weights []int64
+	for _, pk := range voters {
+		i, ok := idx[string(pk)]
+		if !ok {
+			i = len(pks)
+			idx[string(pk)] = i
+			pks = append(pks, pk)
+			weights = append(weights, 0)
+		}
+		weights[i]++
+	}
+	return pks, weights
+}
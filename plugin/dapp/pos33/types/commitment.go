@@ -0,0 +1,68 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/merkle"
+)
+
+// voterLeaves 投票人的叶子, 按公钥排序, 返回排序后的公钥
+func voterLeaves(m *Pos33MinerMsg) ([][]byte, [][]byte) {
+	counts := make(map[string]int32)
+	var pks [][]byte
+	for _, pk := range m.Voters() {
+		if counts[string(pk)] == 0 {
+			pks = append(pks, pk)
+		}
+		counts[string(pk)]++
+	}
+	sort.Slice(pks, func(i, j int) bool { return bytes.Compare(pks[i], pks[j]) < 0 })
+	leaves := make([][]byte, len(pks))
+	for i, pk := range pks {
+		var c [4]byte
+		binary.BigEndian.PutUint32(c[:], uint32(counts[string(pk)]))
+		leaves[i] = crypto.Sha256(append(append([]byte{}, pk...), c[:]...))
+	}
+	return pks, leaves
+}
+
+// VoteCommitment miner 交易的投票的承诺, prev 是上一个区块的 votersRoot
+func VoteCommitment(m *Pos33MinerMsg, prev []byte) *Pos33VoteCommitment {
+	_, leaves := voterLeaves(m)
+	c := &Pos33VoteCommitment{
+		Votes:          int32(len(m.Voters())),
+		SigHash:        crypto.Sha256(m.BlsSig),
+		PrevVotersRoot: prev,
+	}
+	if len(leaves) > 0 {
+		c.VotersRoot = merkle.GetMerkleRoot(leaves)
+	}
+	return c
+}
+
+// CheckCommitment miner 交易里的承诺必须和投票一致
+func (m *Pos33MinerMsg) CheckCommitment(prev []byte) error {
+	c := m.Commitment
+	if c == nil {
+		return ErrVoteCommitment
+	}
+	e := VoteCommitment(m, prev)
+	if c.Votes != e.Votes || !bytes.Equal(c.SigHash, e.SigHash) || !bytes.Equal(c.VotersRoot, e.VotersRoot) || !bytes.Equal(c.PrevVotersRoot, e.PrevVotersRoot) {
+		return ErrVoteCommitment
+	}
+	return nil
+}
+
+// VoterBranch 投票人 pk 的叶子和 merkle 证明, 轻客户端用 merkle.GetMerkleRootFromBranch 验证
+func VoterBranch(m *Pos33MinerMsg, pk []byte) (leaf []byte, branch [][]byte, index uint32, err error) {
+	pks, leaves := voterLeaves(m)
+	for i, p := range pks {
+		if bytes.Equal(p, pk) {
+			return leaves[i], merkle.GetMerkleBranch(leaves, uint32(i)), uint32(i), nil
+		}
+	}
+	return nil, nil, 0, ErrVoteCommitment
+}
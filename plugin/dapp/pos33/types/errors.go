@@ -29,4 +29,70 @@ var (
 	ErrNoVrf = errors.New("ErrNoVrf")
 	// ErrVrfVerify err type
 	ErrVrfVerify = errors.New("ErrVrfVerify")
+	// ErrRequestTooLarge err type
+	ErrRequestTooLarge = errors.New("ErrRequestTooLarge")
+	// ErrTooManyRequests err type
+	ErrTooManyRequests = errors.New("ErrTooManyRequests")
+	// ErrMinerInfoSize err type
+	ErrMinerInfoSize = errors.New("ErrMinerInfoSize")
+	// ErrEvidence err type
+	ErrEvidence = errors.New("ErrEvidence")
+	// ErrSlashed err type
+	ErrSlashed = errors.New("ErrSlashed")
+	// ErrKeyFile err type
+	ErrKeyFile = errors.New("ErrKeyFile")
+	// ErrBlsCounts err type
+	ErrBlsCounts = errors.New("ErrBlsCounts")
+	// ErrSessionToken err type
+	ErrSessionToken = errors.New("ErrSessionToken")
+	// ErrSessionScope err type
+	ErrSessionScope = errors.New("ErrSessionScope")
+	// ErrSessionLimit err type
+	ErrSessionLimit = errors.New("ErrSessionLimit")
+	// ErrSpendNotAllowed err type
+	ErrSpendNotAllowed = errors.New("ErrSpendNotAllowed")
+	// ErrSpendDailyLimit err type
+	ErrSpendDailyLimit = errors.New("ErrSpendDailyLimit")
+	// ErrSpendApprove err type
+	ErrSpendApprove = errors.New("ErrSpendApprove")
+	// ErrCheckpoint err type
+	ErrCheckpoint = errors.New("ErrCheckpoint")
+	// ErrTxNonce err type
+	ErrTxNonce = errors.New("ErrTxNonce")
+	// ErrSignerToken err type
+	ErrSignerToken = errors.New("ErrSignerToken")
+	// ErrSignerKind err type
+	ErrSignerKind = errors.New("ErrSignerKind")
+	// ErrSignerConflict err type
+	ErrSignerConflict = errors.New("ErrSignerConflict")
+	// ErrFaucetLimit err type
+	ErrFaucetLimit = errors.New("ErrFaucetLimit")
+	// ErrTraceID err type
+	ErrTraceID = errors.New("ErrTraceID")
+	// ErrPoolStandby err type
+	ErrPoolStandby = errors.New("ErrPoolStandby")
+	// ErrPoolTx err type
+	ErrPoolTx = errors.New("ErrPoolTx")
+	// ErrPoolConflict err type
+	ErrPoolConflict = errors.New("ErrPoolConflict")
+	// ErrMissedMaker err type
+	ErrMissedMaker = errors.New("ErrMissedMaker")
+	// ErrReorgTooDeep err type
+	ErrReorgTooDeep = errors.New("ErrReorgTooDeep")
+	// ErrVoteCommitment err type
+	ErrVoteCommitment = errors.New("ErrVoteCommitment")
+	// ErrExecDisabled err type
+	ErrExecDisabled = errors.New("ErrExecDisabled")
+	// ErrLateVotes err type
+	ErrLateVotes = errors.New("ErrLateVotes")
+	// ErrNotOperator err type
+	ErrNotOperator = errors.New("ErrNotOperator")
+	// ErrCommission err type
+	ErrCommission = errors.New("ErrCommission")
+	// ErrMinerBound err type
+	ErrMinerBound = errors.New("ErrMinerBound")
+	// ErrBindCooldown err type
+	ErrBindCooldown = errors.New("ErrBindCooldown")
+	// ErrBlockSigner err type
+	ErrBlockSigner = errors.New("ErrBlockSigner")
 )
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/33cn/chain33/common/crypto"
+	vrf "github.com/33cn/chain33/common/vrf/secp256k1"
+	"github.com/33cn/chain33/types"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+)
+
+const (
+	// EvidenceMaker 制作人在同一个高度和轮次做了两个区块
+	EvidenceMaker = 0
+	// EvidenceVoter 投票人用同一个抽签投了两个制作人
+	EvidenceVoter = 1
+	// EvidenceCheckpoint 投票人在同一个高度签了两个不同的 checkpoint
+	EvidenceCheckpoint = 2
+	// EvidenceWindow 作恶以后多少个区块以内可以提交证据
+	EvidenceWindow = 1000
+)
+
+func minerOfTx(tx *types.Transaction) (*Pos33MinerMsg, error) {
+	var act Pos33TicketAction
+	err := types.Decode(tx.Payload, &act)
+	if err != nil {
+		return nil, err
+	}
+	m := act.GetMiner()
+	if m == nil || m.Sort == nil || m.Sort.Proof == nil || m.Sort.Proof.Input == nil {
+		return nil, ErrEvidence
+	}
+	return m, nil
+}
+
+func voteInput(v *Pos33VoteMsg) *VrfInput {
+	if v == nil || v.Sig == nil || v.Sort == nil || v.Sort.SortHash == nil || v.Sort.Proof == nil {
+		return nil
+	}
+	return v.Sort.Proof.Input
+}
+
+// Check 检查证据, 返回作恶的高度和轮次
+func (e *Pos33Evidence) Check() (int64, int32, error) {
+	switch e.Ty {
+	case EvidenceMaker:
+		if e.Tx1 == nil || e.Tx2 == nil || string(e.Tx1.Hash()) == string(e.Tx2.Hash()) {
+			return 0, 0, ErrEvidence
+		}
+		m1, err := minerOfTx(e.Tx1)
+		if err != nil {
+			return 0, 0, err
+		}
+		m2, err := minerOfTx(e.Tx2)
+		if err != nil {
+			return 0, 0, err
+		}
+		in1, in2 := m1.Sort.Proof.Input, m2.Sort.Proof.Input
+		if in1.Height != in2.Height || in1.Round != in2.Round || e.Tx1.From() != e.Tx2.From() {
+			return 0, 0, ErrEvidence
+		}
+		if !e.Tx1.CheckSign(in1.Height) || !e.Tx2.CheckSign(in2.Height) {
+			return 0, 0, types.ErrSign
+		}
+		return in1.Height, in1.Round, nil
+	case EvidenceVoter:
+		in1, in2 := voteInput(e.Vote1), voteInput(e.Vote2)
+		if in1 == nil || in2 == nil {
+			return 0, 0, ErrEvidence
+		}
+		v1, v2 := e.Vote1, e.Vote2
+		if in1.Height != in2.Height || in1.Round != in2.Round || string(v1.Sig.Pubkey) != string(v2.Sig.Pubkey) {
+			return 0, 0, ErrEvidence
+		}
+		if string(v1.Sort.SortHash.Hash) != string(v2.Sort.SortHash.Hash) || string(v1.Hash) == string(v2.Hash) {
+			return 0, 0, ErrEvidence
+		}
+		if !v1.Verify() || !v2.Verify() {
+			return 0, 0, types.ErrSign
+		}
+		// bls 签名只签了制作人的抽签 hash, 没有签投票人的抽签, 投票人的抽签可以从别的轮次拿过来.
+		// 必须带上两个制作人的抽签, 证明同一个 bls 公钥在同一个高度和轮次签了两个制作人
+		mi1, err := checkVoteMaker(v1, e.Maker1)
+		if err != nil {
+			return 0, 0, err
+		}
+		mi2, err := checkVoteMaker(v2, e.Maker2)
+		if err != nil {
+			return 0, 0, err
+		}
+		if mi1.Height != mi2.Height || mi1.Round != mi2.Round {
+			return 0, 0, ErrEvidence
+		}
+		return mi1.Height, mi1.Round, nil
+	case EvidenceCheckpoint:
+		c1, c2 := e.Cp1, e.Cp2
+		if c1 == nil || c2 == nil || c1.Sig == nil || c2.Sig == nil {
+			return 0, 0, ErrEvidence
+		}
+		if c1.Height != c2.Height || bytes.Equal(c1.Hash, c2.Hash) || !bytes.Equal(c1.Sig.Pubkey, c2.Sig.Pubkey) {
+			return 0, 0, ErrEvidence
+		}
+		if !c1.Verify() || !c2.Verify() {
+			return 0, 0, types.ErrSign
+		}
+		return c1.Height, 0, nil
+	}
+	return 0, 0, ErrEvidence
+}
+
+// checkVoteMaker m 是 v 投票的制作人抽签, 验证 vrf 和抽签 hash
+func checkVoteMaker(v *Pos33VoteMsg, m *Pos33SortMsg) (*VrfInput, error) {
+	if m == nil || m.SortHash == nil || m.Proof == nil || m.Proof.Input == nil || m.Proof.Input.Ty != 0 {
+		return nil, ErrEvidence
+	}
+	if !bytes.Equal(m.SortHash.Hash, v.Hash) {
+		return nil, ErrEvidence
+	}
+	if v.Height != 0 && v.Height != m.Proof.Input.Height {
+		return nil, ErrEvidence
+	}
+	data := fmt.Sprintf("%x+%d+%d", m.Proof.VrfHash, m.SortHash.Index, m.SortHash.Num)
+	if !bytes.Equal(crypto.Sha256(crypto.Sha256([]byte(data))), m.SortHash.Hash) {
+		return nil, ErrEvidence
+	}
+	pub, err := secp256k1.ParsePubKey(m.Proof.Pubkey, secp256k1.S256())
+	if err != nil {
+		return nil, ErrEvidence
+	}
+	vrfPub := &vrf.PublicKey{PublicKey: (*ecdsa.PublicKey)(pub)}
+	hash, err := vrfPub.ProofToHash(types.Encode(m.Proof.Input), m.Proof.VrfProof)
+	if err != nil || !bytes.Equal(hash[:], m.Proof.VrfHash) {
+		return nil, ErrEvidence
+	}
+	return m.Proof.Input, nil
+}
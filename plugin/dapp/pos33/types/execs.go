@@ -0,0 +1,61 @@
+package types
+
+import (
+	"sync"
+
+	"github.com/33cn/chain33/types"
+)
+
+// 只提供 rpc 的节点可以在 [exec.sub.pos33] disabledExecs 关掉不需要的执行器: 执行器的 CheckTx 拒绝交易池里的交易
+// (不管用哪个交易池), 不回答查询, 不写本地索引, 异步索引也跳过这些交易. 区块里的这些交易照常执行, 状态和别的节点一致.
+// 只有 ycc 自己的执行器能关掉, plugin 里的执行器没有地方加这些检查
+var (
+	execsMu       sync.RWMutex
+	disabledExecs map[string]bool
+)
+
+var disableableExecs = map[string]bool{
+	"evm":    true,
+	"random": true,
+	"stycc":  true,
+}
+
+// RegisterDisabledExecs 登记关掉的执行器, 返回真正关掉的
+func RegisterDisabledExecs(execs []string) []string {
+	mp := make(map[string]bool)
+	var list []string
+	for _, exec := range execs {
+		if !disableableExecs[exec] || mp[exec] {
+			continue
+		}
+		mp[exec] = true
+		list = append(list, exec)
+	}
+	execsMu.Lock()
+	defer execsMu.Unlock()
+	disabledExecs = mp
+	return list
+}
+
+// ExecNameDisabled 执行器是否在本节点关掉了
+func ExecNameDisabled(name string) bool {
+	execsMu.RLock()
+	defer execsMu.RUnlock()
+	if len(disabledExecs) == 0 {
+		return false
+	}
+	return disabledExecs[name]
+}
+
+// ExecDisabled 交易的执行器是否在本节点关掉了
+func ExecDisabled(tx *types.Transaction) bool {
+	return ExecNameDisabled(string(types.GetRealExecName(tx.Execer)))
+}
+
+// CheckExecEnabled 在执行器的 CheckTx 里调用, 交易池 (index == -1) 不接收关掉的执行器的交易, 区块里的交易不检查
+func CheckExecEnabled(tx *types.Transaction, index int) error {
+	if index == -1 && ExecDisabled(tx) {
+		return ErrExecDisabled
+	}
+	return nil
+}
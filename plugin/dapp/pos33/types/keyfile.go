@@ -0,0 +1,154 @@
+package types
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	wcom "github.com/33cn/chain33/wallet/common"
+	"golang.org/x/crypto/argon2"
+)
+
+// DefaultKeyPasswordEnv 默认从这个环境变量读取挖矿私钥文件的密码
+const DefaultKeyPasswordEnv = "YCC_MINER_PASSWORD"
+
+// KeyFileVersion 当前的私钥文件格式: argon2id 从密码和随机 salt 得到密钥, aes-gcm 加密并校验
+const KeyFileVersion = 1
+
+// argon2id 的默认参数, 写在文件里, 以后调整参数老文件还能读
+const (
+	keyFileTime    = 3
+	keyFileMemory  = 64 * 1024
+	keyFileThreads = 4
+	keyFileSaltLen = 16
+)
+
+// KeyFile 加密保存的挖矿私钥, 不需要运行钱包模块就可以挖矿.
+// 没有 version 的是老格式 (sha256(密码) + cbc, 没有校验), 只能读, 重新用 keyfile 命令生成就是新格式
+type KeyFile struct {
+	Addr    string `json:"addr"`
+	Cipher  string `json:"cipher"`
+	Version int    `json:"version,omitempty"`
+	Salt    string `json:"salt,omitempty"`
+	Nonce   string `json:"nonce,omitempty"`
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+func legacyKeyOfPassword(password string) []byte {
+	return common.Sha256([]byte(password))
+}
+
+func (kf *KeyFile) aead(password string, salt []byte) (cipher.AEAD, error) {
+	key := argon2.IDKey([]byte(password), salt, kf.Time, kf.Memory, kf.Threads, 32)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// EncryptKeyFile 用 password 加密私钥, 地址作为附加数据一起校验
+func EncryptKeyFile(priv crypto.PrivKey, password string) (*KeyFile, error) {
+	kf := &KeyFile{
+		Addr:    address.PubKeyToAddr(EthAddrID, priv.PubKey().Bytes()),
+		Version: KeyFileVersion,
+		Time:    keyFileTime,
+		Memory:  keyFileMemory,
+		Threads: keyFileThreads,
+	}
+	salt := make([]byte, keyFileSaltLen)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := kf.aead(password, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+	kf.Salt = common.ToHex(salt)
+	kf.Nonce = common.ToHex(nonce)
+	kf.Cipher = common.ToHex(aead.Seal(nil, nonce, priv.Bytes(), []byte(kf.Addr)))
+	return kf, nil
+}
+
+// plain 解密出私钥的字节, 密码错或者文件被改过返回 ErrKeyFile
+func (kf *KeyFile) plain(password string) ([]byte, error) {
+	data, err := common.FromHex(kf.Cipher)
+	if err != nil {
+		return nil, err
+	}
+	switch kf.Version {
+	case 0:
+		return wcom.CBCDecrypterPrivkey(legacyKeyOfPassword(password), data), nil
+	case KeyFileVersion:
+	default:
+		return nil, ErrKeyFile
+	}
+	salt, err := common.FromHex(kf.Salt)
+	if err != nil || len(salt) < keyFileSaltLen || kf.Time == 0 || kf.Memory == 0 || kf.Threads == 0 {
+		return nil, ErrKeyFile
+	}
+	nonce, err := common.FromHex(kf.Nonce)
+	if err != nil {
+		return nil, ErrKeyFile
+	}
+	aead, err := kf.aead(password, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, ErrKeyFile
+	}
+	b, err := aead.Open(nil, nonce, data, []byte(kf.Addr))
+	if err != nil {
+		return nil, ErrKeyFile
+	}
+	return b, nil
+}
+
+// Decrypt 用 password 解密私钥, 并检查地址是否一致
+func (kf *KeyFile) Decrypt(password string) (crypto.PrivKey, error) {
+	b, err := kf.plain(password)
+	if err != nil {
+		return nil, err
+	}
+	cr, err := crypto.Load(types.GetSignName("", types.SECP256K1), -1)
+	if err != nil {
+		return nil, err
+	}
+	priv, err := cr.PrivKeyFromBytes(b)
+	if err != nil {
+		return nil, ErrKeyFile
+	}
+	if address.PubKeyToAddr(EthAddrID, priv.PubKey().Bytes()) != kf.Addr {
+		return nil, ErrKeyFile
+	}
+	return priv, nil
+}
+
+// ReadKeyFile 读取私钥文件
+func ReadKeyFile(path string) (*KeyFile, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	kf := new(KeyFile)
+	err = json.Unmarshal(data, kf)
+	if err != nil {
+		return nil, err
+	}
+	return kf, nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"github.com/33cn/chain33/common/crypto"
+	bls33 "github.com/33cn/plugin/plugin/crypto/bls"
+)
+
+// lateVoterTy 投票人抽签的类型, 和共识里的 Voter 一样
+const lateVoterTy = 1
+
+// CheckLate 检查 miner 交易里的迟到投票, height 是区块高度, parent 是上一个区块的 miner 交易,
+// size 是上一个区块的委员会大小
+func (m *Pos33MinerMsg) CheckLate(height int64, parent *Pos33MinerMsg, size int) error {
+	l := m.Late
+	if l == nil {
+		return nil
+	}
+	if l.Height != height-1 || parent == nil || string(l.Hash) != string(parent.Hash) {
+		return ErrLateVotes
+	}
+	pvs := parent.Voters()
+	if len(l.BlsPkList) == 0 || len(pvs)+len(l.BlsPkList) > size || len(l.Sorts) != len(l.BlsPkList) {
+		return ErrLateVotes
+	}
+	voted := make(map[string]bool)
+	for _, pk := range pvs {
+		voted[string(pk)] = true
+	}
+	round := parent.GetSort().GetProof().GetInput().GetRound()
+	sorts := make(map[string]bool)
+	for i, pk := range l.BlsPkList {
+		if voted[string(pk)] {
+			return ErrLateVotes
+		}
+		// 每个投票人只能有一票
+		voted[string(pk)] = true
+		s := l.Sorts[i]
+		if s == nil || s.SortHash == nil || s.Proof == nil || s.Proof.Input == nil {
+			return ErrLateVotes
+		}
+		// 抽签的 vrf 和难度由共识验证, 这里检查是上一个区块同一个轮次的投票人抽签
+		in := s.Proof.Input
+		if in.Ty != lateVoterTy || in.Round != round || in.Height > height-1 || sorts[string(s.SortHash.Hash)] {
+			return ErrLateVotes
+		}
+		sorts[string(s.SortHash.Hash)] = true
+	}
+	return l.Verify()
+}
+
+// Verify 验证迟到投票的聚合签名
+func (l *Pos33LateVotes) Verify() error {
+	var pks []crypto.PubKey
+	for _, b := range l.BlsPkList {
+		pk := bls33.PubKeyBLS{}
+		copy(pk[:], b)
+		pks = append(pks, pk)
+	}
+	var sig bls33.SignatureBLS
+	copy(sig[:], l.BlsSig)
+	return new(bls33.Driver).VerifyAggregatedOne(pks, l.Hash, sig)
+}
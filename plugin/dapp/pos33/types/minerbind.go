@@ -0,0 +1,27 @@
+package types
+
+// At height 生效的绑定, 绑定在修改的区块之后生效, 更早的高度用上一次的绑定. 没有绑定返回 nil
+func (b *Pos33MinerBind) At(height int64) *Pos33MinerBind {
+	if b == nil {
+		return nil
+	}
+	if height < b.Height {
+		b = b.Prev
+	}
+	if b == nil || b.Miner == "" {
+		return nil
+	}
+	return b
+}
+
+// StakeAddr height 抽签时 addr 的票属于哪个地址: 绑定的热地址用冷地址的票, 绑定了的冷地址返回空, 没有绑定返回 addr
+func (b *Pos33MinerBind) StakeAddr(addr string, height int64) string {
+	e := b.At(height)
+	if e == nil {
+		return addr
+	}
+	if e.Miner == addr {
+		return e.ReturnAddr
+	}
+	return ""
+}
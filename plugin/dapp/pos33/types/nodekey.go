@@ -0,0 +1,58 @@
+package types
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+)
+
+// 节点的网络身份私钥, 和挖矿私钥分开保存, 用 hex 明文保存在文件里.
+// 挖矿私钥签名一个声明把两者绑定起来, 网络身份私钥泄露只影响网络身份, 可以随时轮换
+
+func nodeKeyCrypto() (crypto.Crypto, error) {
+	return crypto.Load(types.GetSignName("", types.SECP256K1), -1)
+}
+
+// ReadNodeKey 读取网络身份私钥
+func ReadNodeKey(path string) (crypto.PrivKey, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	b, err := common.FromHex(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, err
+	}
+	cr, err := nodeKeyCrypto()
+	if err != nil {
+		return nil, err
+	}
+	return cr.PrivKeyFromBytes(b)
+}
+
+// NewNodeKey 生成新的网络身份私钥写到 path, 原来的私钥文件改名为 path.old
+func NewNodeKey(path string) (crypto.PrivKey, error) {
+	cr, err := nodeKeyCrypto()
+	if err != nil {
+		return nil, err
+	}
+	priv, err := cr.GenKey()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(path); err == nil {
+		err = os.Rename(path, path+".old")
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = ioutil.WriteFile(path, []byte(common.ToHex(priv.Bytes())), 0600)
+	if err != nil {
+		return nil, err
+	}
+	return priv, nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"github.com/33cn/chain33/types"
+)
+
+// NonceTx 是否按账户 nonce 检查的交易: coins 和 evm 的非交易组交易, eth 签名的交易由 evm 自己检查 nonce
+func NonceTx(tx *types.Transaction) bool {
+	if tx.GroupCount > 0 || tx.Signature == nil || types.IsEthSignID(tx.Signature.Ty) {
+		return false
+	}
+	execer := string(tx.Execer)
+	return execer == "coins" || execer == "evm"
+}
+
+// Nonces 按顺序检查一个区块里交易的 nonce.
+// 账户的下一个 nonce 为 0 表示还没有使用 nonce 模式, 这时 nonce 为 0 的交易开启 nonce 模式,
+// 之后这个账户的交易 nonce 必须依次加 1.
+type Nonces struct {
+	next map[string]int64
+	get  func(addr string) (int64, error)
+}
+
+// NewNonces get 返回账户在区块之前的下一个 nonce
+func NewNonces(get func(addr string) (int64, error)) *Nonces {
+	return &Nonces{next: make(map[string]int64), get: get}
+}
+
+// Check 检查交易的 nonce, 通过后更新账户的下一个 nonce
+func (ns *Nonces) Check(tx *types.Transaction) error {
+	if !NonceTx(tx) {
+		return nil
+	}
+	addr := tx.From()
+	next, ok := ns.next[addr]
+	if !ok {
+		var err error
+		next, err = ns.get(addr)
+		if err != nil {
+			return err
+		}
+	}
+	if next == 0 && tx.Nonce != 0 {
+		return nil
+	}
+	if tx.Nonce != next {
+		return ErrTxNonce
+	}
+	ns.next[addr] = next + 1
+	return nil
+}
+
+// Changed 这个区块里更新过 nonce 的账户
+func (ns *Nonces) Changed() map[string]int64 {
+	return ns.next
+}
@@ -0,0 +1,220 @@
+package types
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+)
+
+// PoolServiceName 矿池服务的 rpc 名字
+const PoolServiceName = "Pos33Pool"
+
+// 主 worker 超过这个时间没有订阅, 由下一个订阅的 worker 接替
+const poolPrimaryTimeout = time.Second * 15
+
+// PoolWorkerStat 矿池 worker 的统计
+type PoolWorkerStat struct {
+	Name     string `json:"name"`
+	Primary  bool   `json:"primary"`
+	LastSeen int64  `json:"lastSeen"`
+	Vrf      int64  `json:"vrf"`
+	BlsSign  int64  `json:"blsSign"`
+	Sign     int64  `json:"sign"`
+	Refused  int64  `json:"refused"`
+}
+
+// PoolStats 矿池所有 worker 的统计
+type PoolStats struct {
+	Workers []*PoolWorkerStat `json:"workers"`
+}
+
+// PoolService 矿池服务, 类似 stratum: 矿池只保存受托挖矿(entrust)的挖矿私钥,
+// 委托人的资金只有委托人能提取, 矿池不签名 pos33 miner 交易以外的交易, 没有提取的权限.
+// worker 节点用 worker token 订阅矿池, 每个 worker 都同步区块和验证抽签, 抽签的 vrf 由矿池计算;
+// 同时只有一个主 worker 投票和出块, 矿池对同一个高度和轮次只签名一个区块,
+// 多个 worker 不会重复投票或者出块被罚没. 主 worker 停止订阅后由别的 worker 接替
+type PoolService struct {
+	s       *LocalSigner
+	workers map[string]string // token => worker name
+	admin   string
+
+	mu      sync.Mutex
+	primary string
+	stats   map[string]*PoolWorkerStat
+	g       *signGuard
+}
+
+// NewPoolService workers 是 worker token => worker 名字, admin 用来查询统计
+func NewPoolService(priv crypto.PrivKey, workers map[string]string, admin string) *PoolService {
+	ps := &PoolService{
+		s:       NewLocalSigner(priv),
+		workers: workers,
+		admin:   admin,
+		stats:   make(map[string]*PoolWorkerStat),
+	}
+	ps.g, _ = newSignGuard("")
+	for _, name := range workers {
+		ps.stats[name] = &PoolWorkerStat{Name: name}
+	}
+	return ps
+}
+
+// worker 验证 token, 返回 worker 的统计和是否是主 worker
+func (ps *PoolService) worker(args *SignerArgs, seen bool) (*PoolWorkerStat, bool, error) {
+	var name string
+	for token, n := range ps.workers {
+		if subtle.ConstantTimeCompare([]byte(args.Token), []byte(token)) == 1 {
+			name = n
+		}
+	}
+	if name == "" {
+		return nil, false, ErrSignerToken
+	}
+	st := ps.stats[name]
+	now := time.Now()
+	if seen {
+		st.LastSeen = now.Unix()
+	}
+	if ps.primary != name {
+		cur := ps.stats[ps.primary]
+		if seen && (cur == nil || now.Sub(time.Unix(cur.LastSeen, 0)) > poolPrimaryTimeout) {
+			ps.primary = name
+		}
+	}
+	for n, s := range ps.stats {
+		s.Primary = n == ps.primary
+	}
+	return st, ps.primary == name, nil
+}
+
+func (ps *PoolService) primaryWorker(args *SignerArgs) (*PoolWorkerStat, error) {
+	st, primary, err := ps.worker(args, false)
+	if err != nil {
+		return nil, err
+	}
+	if !primary {
+		st.Refused++
+		return nil, ErrPoolStandby
+	}
+	return st, nil
+}
+
+// Subscribe worker 定期订阅, 返回公钥和是不是主 worker
+func (ps *PoolService) Subscribe(args *SignerArgs, reply *SignerReply) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	_, primary, err := ps.worker(args, true)
+	if err != nil {
+		return err
+	}
+	reply.Pubkey = ps.s.PubKey()
+	reply.BlsPubkey = ps.s.BlsPubKey()
+	reply.Primary = primary
+	return nil
+}
+
+// PubKey 和 Subscribe 一样, worker 可以直接作为远程签名服务使用
+func (ps *PoolService) PubKey(args *SignerArgs, reply *SignerReply) error {
+	return ps.Subscribe(args, reply)
+}
+
+// Vrf 所有 worker 都可以抽签
+func (ps *PoolService) Vrf(args *SignerArgs, reply *SignerReply) error {
+	ps.mu.Lock()
+	st, _, err := ps.worker(args, false)
+	if err == nil {
+		st.Vrf++
+	}
+	ps.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	hash, proof, err := ps.s.Vrf(args.Msg)
+	reply.Hash = hash
+	reply.Proof = proof
+	return err
+}
+
+// BlsSign 只有主 worker 可以投票, 同一个高度和轮次只投一个区块
+func (ps *PoolService) BlsSign(args *SignerArgs, reply *SignerReply) error {
+	if _, err := BlsSignData(args.Kind, args.Msg); err != nil {
+		return err
+	}
+	ps.mu.Lock()
+	st, err := ps.primaryWorker(args)
+	if err == nil {
+		err = ps.g.guard(args.Kind, args.Msg, poolTx)
+		if err != nil {
+			st.Refused++
+		} else {
+			st.BlsSign++
+		}
+	}
+	ps.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	sig, err := ps.s.BlsSign(args.Kind, args.Msg)
+	reply.Sig = sig
+	return err
+}
+
+// Sign 只有主 worker 可以签名, 交易只签名 pos33 的 miner 交易, 同一个高度和轮次只签名一个
+func (ps *PoolService) Sign(args *SignerArgs, reply *SignerReply) error {
+	if _, err := SignData(args.Kind, args.Msg); err != nil {
+		return err
+	}
+	ps.mu.Lock()
+	st, err := ps.primaryWorker(args)
+	if err == nil {
+		err = ps.g.guard(args.Kind, args.Msg, poolTx)
+		if err == ErrSignerConflict {
+			err = ErrPoolConflict
+		}
+		if err != nil {
+			st.Refused++
+		} else {
+			st.Sign++
+		}
+	}
+	ps.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	sig, err := ps.s.Sign(args.Kind, args.Msg)
+	reply.Sig = sig
+	return err
+}
+
+// poolTx 矿池只签名 pos33 的 miner 交易
+func poolTx(tx *types.Transaction, act *Pos33TicketAction) error {
+	if act.GetTy() != Pos33TicketActionMiner || act.GetMiner() == nil {
+		return ErrPoolTx
+	}
+	return nil
+}
+
+// Stats 用 admin token 查询 worker 的统计
+func (ps *PoolService) Stats(args *SignerArgs, reply *PoolStats) error {
+	if ps.admin == "" || args.Token != ps.admin {
+		return ErrSignerToken
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	for _, s := range ps.stats {
+		c := *s
+		reply.Workers = append(reply.Workers, &c)
+	}
+	sort.Slice(reply.Workers, func(i, j int) bool { return reply.Workers[i].Name < reply.Workers[j].Name })
+	return nil
+}
+
+// ServePool 在 laddr 上提供矿池服务, tls:// 需要 conf
+func ServePool(ps *PoolService, laddr string, conf *tls.Config) error {
+	return serveRPC(PoolServiceName, ps, laddr, conf)
+}
@@ -28,23 +28,33 @@ const (
 type Pos33Msg_Ty int32
 
 const (
-	Pos33Msg_MV Pos33Msg_Ty = 0 // maker vote msg
-	Pos33Msg_BV Pos33Msg_Ty = 1 // block vote msg
-	Pos33Msg_B  Pos33Msg_Ty = 2 // block msg
-	Pos33Msg_MS Pos33Msg_Ty = 3 // maker sort msg
-	Pos33Msg_VS Pos33Msg_Ty = 4 // voter sort msg
-	Pos33Msg_CV Pos33Msg_Ty = 5 // comm vote msg
+	Pos33Msg_MV Pos33Msg_Ty = 0  // maker vote msg
+	Pos33Msg_BV Pos33Msg_Ty = 1  // block vote msg
+	Pos33Msg_B  Pos33Msg_Ty = 2  // block msg
+	Pos33Msg_MS Pos33Msg_Ty = 3  // maker sort msg
+	Pos33Msg_VS Pos33Msg_Ty = 4  // voter sort msg
+	Pos33Msg_CV Pos33Msg_Ty = 5  // comm vote msg
+	Pos33Msg_AD Pos33Msg_Ty = 6  // advisory msg
+	Pos33Msg_EV Pos33Msg_Ty = 7  // evidence msg
+	Pos33Msg_CP Pos33Msg_Ty = 8  // checkpoint vote msg
+	Pos33Msg_TM Pos33Msg_Ty = 9  // telemetry heartbeat msg
+	Pos33Msg_ID Pos33Msg_Ty = 10 // node identity msg
 )
 
 // Enum value maps for Pos33Msg_Ty.
 var (
 	Pos33Msg_Ty_name = map[int32]string{
-		0: "MV",
-		1: "BV",
-		2: "B",
-		3: "MS",
-		4: "VS",
-		5: "CV",
+		0:  "MV",
+		1:  "BV",
+		2:  "B",
+		3:  "MS",
+		4:  "VS",
+		5:  "CV",
+		6:  "AD",
+		7:  "EV",
+		8:  "CP",
+		9:  "TM",
+		10: "ID",
 	}
 	Pos33Msg_Ty_value = map[string]int32{
 		"MV": 0,
@@ -53,6 +63,11 @@ var (
 		"MS": 3,
 		"VS": 4,
 		"CV": 5,
+		"AD": 6,
+		"EV": 7,
+		"CP": 8,
+		"TM": 9,
+		"ID": 10,
 	}
 )
 
@@ -211,13 +226,22 @@ type Pos33TicketAction struct {
 	//	*Pos33TicketAction_Topen
 	//	*Pos33TicketAction_Genesis
 	//	*Pos33TicketAction_Tclose
-	//	*Pos33TicketAction_Tbind
+	//	*Pos33TicketAction_MinerBind
 	//	*Pos33TicketAction_Miner
 	//	*Pos33TicketAction_Entrust
 	//	*Pos33TicketAction_Migrate
 	//	*Pos33TicketAction_BlsBind
 	//	*Pos33TicketAction_FeeRate
 	//	*Pos33TicketAction_Withdraw
+	//	*Pos33TicketAction_MinerInfo
+	//	*Pos33TicketAction_Slash
+	//	*Pos33TicketAction_Delegate
+	//	*Pos33TicketAction_Undelegate
+	//	*Pos33TicketAction_SetCommission
+	//	*Pos33TicketAction_SetCompound
+	//	*Pos33TicketAction_OpenTickets
+	//	*Pos33TicketAction_CloseTickets
+	//	*Pos33TicketAction_BlockSigner
 	Value isPos33TicketAction_Value `protobuf_oneof:"value"`
 	Ty    int32                     `protobuf:"varint,10,opt,name=ty,proto3" json:"ty,omitempty"`
 }
@@ -282,9 +306,9 @@ func (x *Pos33TicketAction) GetTclose() *Pos33TicketClose {
 	return nil
 }
 
-func (x *Pos33TicketAction) GetTbind() *Pos33TicketBind {
-	if x, ok := x.GetValue().(*Pos33TicketAction_Tbind); ok {
-		return x.Tbind
+func (x *Pos33TicketAction) GetMinerBind() *Pos33TicketBind {
+	if x, ok := x.GetValue().(*Pos33TicketAction_MinerBind); ok {
+		return x.MinerBind
 	}
 	return nil
 }
@@ -331,6 +355,69 @@ func (x *Pos33TicketAction) GetWithdraw() *Pos33WithdrawReward {
 	return nil
 }
 
+func (x *Pos33TicketAction) GetMinerInfo() *Pos33MinerInfo {
+	if x, ok := x.GetValue().(*Pos33TicketAction_MinerInfo); ok {
+		return x.MinerInfo
+	}
+	return nil
+}
+
+func (x *Pos33TicketAction) GetSlash() *Pos33Evidence {
+	if x, ok := x.GetValue().(*Pos33TicketAction_Slash); ok {
+		return x.Slash
+	}
+	return nil
+}
+
+func (x *Pos33TicketAction) GetDelegate() *Pos33Delegate {
+	if x, ok := x.GetValue().(*Pos33TicketAction_Delegate); ok {
+		return x.Delegate
+	}
+	return nil
+}
+
+func (x *Pos33TicketAction) GetUndelegate() *Pos33Undelegate {
+	if x, ok := x.GetValue().(*Pos33TicketAction_Undelegate); ok {
+		return x.Undelegate
+	}
+	return nil
+}
+
+func (x *Pos33TicketAction) GetSetCommission() *Pos33SetCommission {
+	if x, ok := x.GetValue().(*Pos33TicketAction_SetCommission); ok {
+		return x.SetCommission
+	}
+	return nil
+}
+
+func (x *Pos33TicketAction) GetSetCompound() *Pos33SetCompound {
+	if x, ok := x.GetValue().(*Pos33TicketAction_SetCompound); ok {
+		return x.SetCompound
+	}
+	return nil
+}
+
+func (x *Pos33TicketAction) GetOpenTickets() *Pos33OpenTickets {
+	if x, ok := x.GetValue().(*Pos33TicketAction_OpenTickets); ok {
+		return x.OpenTickets
+	}
+	return nil
+}
+
+func (x *Pos33TicketAction) GetCloseTickets() *Pos33CloseTickets {
+	if x, ok := x.GetValue().(*Pos33TicketAction_CloseTickets); ok {
+		return x.CloseTickets
+	}
+	return nil
+}
+
+func (x *Pos33TicketAction) GetBlockSigner() *Pos33BlockSignerBind {
+	if x, ok := x.GetValue().(*Pos33TicketAction_BlockSigner); ok {
+		return x.BlockSigner
+	}
+	return nil
+}
+
 func (x *Pos33TicketAction) GetTy() int32 {
 	if x != nil {
 		return x.Ty
@@ -354,8 +441,8 @@ type Pos33TicketAction_Tclose struct {
 	Tclose *Pos33TicketClose `protobuf:"bytes,3,opt,name=tclose,proto3,oneof"`
 }
 
-type Pos33TicketAction_Tbind struct {
-	Tbind *Pos33TicketBind `protobuf:"bytes,5,opt,name=tbind,proto3,oneof"`
+type Pos33TicketAction_MinerBind struct {
+	MinerBind *Pos33TicketBind `protobuf:"bytes,5,opt,name=minerBind,proto3,oneof"`
 }
 
 type Pos33TicketAction_Miner struct {
@@ -382,13 +469,49 @@ type Pos33TicketAction_Withdraw struct {
 	Withdraw *Pos33WithdrawReward `protobuf:"bytes,11,opt,name=withdraw,proto3,oneof"`
 }
 
+type Pos33TicketAction_MinerInfo struct {
+	MinerInfo *Pos33MinerInfo `protobuf:"bytes,13,opt,name=minerInfo,proto3,oneof"`
+}
+
+type Pos33TicketAction_Slash struct {
+	Slash *Pos33Evidence `protobuf:"bytes,14,opt,name=slash,proto3,oneof"`
+}
+
+type Pos33TicketAction_Delegate struct {
+	Delegate *Pos33Delegate `protobuf:"bytes,15,opt,name=delegate,proto3,oneof"`
+}
+
+type Pos33TicketAction_Undelegate struct {
+	Undelegate *Pos33Undelegate `protobuf:"bytes,16,opt,name=undelegate,proto3,oneof"`
+}
+
+type Pos33TicketAction_SetCommission struct {
+	SetCommission *Pos33SetCommission `protobuf:"bytes,17,opt,name=setCommission,proto3,oneof"`
+}
+
+type Pos33TicketAction_SetCompound struct {
+	SetCompound *Pos33SetCompound `protobuf:"bytes,18,opt,name=setCompound,proto3,oneof"`
+}
+
+type Pos33TicketAction_OpenTickets struct {
+	OpenTickets *Pos33OpenTickets `protobuf:"bytes,19,opt,name=openTickets,proto3,oneof"`
+}
+
+type Pos33TicketAction_CloseTickets struct {
+	CloseTickets *Pos33CloseTickets `protobuf:"bytes,20,opt,name=closeTickets,proto3,oneof"`
+}
+
+type Pos33TicketAction_BlockSigner struct {
+	BlockSigner *Pos33BlockSignerBind `protobuf:"bytes,21,opt,name=blockSigner,proto3,oneof"`
+}
+
 func (*Pos33TicketAction_Topen) isPos33TicketAction_Value() {}
 
 func (*Pos33TicketAction_Genesis) isPos33TicketAction_Value() {}
 
 func (*Pos33TicketAction_Tclose) isPos33TicketAction_Value() {}
 
-func (*Pos33TicketAction_Tbind) isPos33TicketAction_Value() {}
+func (*Pos33TicketAction_MinerBind) isPos33TicketAction_Value() {}
 
 func (*Pos33TicketAction_Miner) isPos33TicketAction_Value() {}
 
@@ -402,6 +525,24 @@ func (*Pos33TicketAction_FeeRate) isPos33TicketAction_Value() {}
 
 func (*Pos33TicketAction_Withdraw) isPos33TicketAction_Value() {}
 
+func (*Pos33TicketAction_MinerInfo) isPos33TicketAction_Value() {}
+
+func (*Pos33TicketAction_Slash) isPos33TicketAction_Value() {}
+
+func (*Pos33TicketAction_Delegate) isPos33TicketAction_Value() {}
+
+func (*Pos33TicketAction_Undelegate) isPos33TicketAction_Value() {}
+
+func (*Pos33TicketAction_SetCommission) isPos33TicketAction_Value() {}
+
+func (*Pos33TicketAction_SetCompound) isPos33TicketAction_Value() {}
+
+func (*Pos33TicketAction_OpenTickets) isPos33TicketAction_Value() {}
+
+func (*Pos33TicketAction_CloseTickets) isPos33TicketAction_Value() {}
+
+func (*Pos33TicketAction_BlockSigner) isPos33TicketAction_Value() {}
+
 type Pos33Msg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -995,6 +1136,8 @@ type Pos33VoteMsg struct {
 	Hash  []byte           `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 	Round int32            `protobuf:"varint,4,opt,name=round,proto3" json:"round,omitempty"`
 	Sig   *types.Signature `protobuf:"bytes,3,opt,name=sig,proto3" json:"sig,omitempty"`
+	// ForkEpochCommittee 之后投票的区块高度, 第 0 轮的抽签是 epoch 第一个高度的
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (x *Pos33VoteMsg) Reset() {
@@ -1057,6 +1200,13 @@ func (x *Pos33VoteMsg) GetSig() *types.Signature {
 	return nil
 }
 
+func (x *Pos33VoteMsg) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 type Pos33DepositMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1068,6 +1218,7 @@ type Pos33DepositMsg struct {
 	PreCount    int64  `protobuf:"varint,5,opt,name=pre_count,json=preCount,proto3" json:"pre_count,omitempty"`
 	CloseHeight int64  `protobuf:"varint,6,opt,name=close_height,json=closeHeight,proto3" json:"close_height,omitempty"`
 	Reward      int64  `protobuf:"varint,3,opt,name=reward,proto3" json:"reward,omitempty"`
+	Amount      int64  `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
 func (x *Pos33DepositMsg) Reset() {
@@ -1144,6 +1295,13 @@ func (x *Pos33DepositMsg) GetReward() int64 {
 	return 0
 }
 
+func (x *Pos33DepositMsg) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
 type Pos33SortsVote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1437,6 +1595,21 @@ type Pos33MinerMsg struct {
 	Hash      []byte        `protobuf:"bytes,3,opt,name=Hash,proto3" json:"Hash,omitempty"`
 	Sort      *Pos33SortMsg `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
 	BlockTime int64         `protobuf:"varint,4,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	// 制作人对上一个种子的 vrf 输出, 作为下一个种子
+	SeedHash  []byte `protobuf:"bytes,6,opt,name=seed_hash,json=seedHash,proto3" json:"seed_hash,omitempty"`
+	SeedProof []byte `protobuf:"bytes,7,opt,name=seed_proof,json=seedProof,proto3" json:"seed_proof,omitempty"`
+	// ForkBlsAggregate 之后 BlsPkList 去重, bls_counts[i] 是 BlsPkList[i] 的票数
+	BlsCounts []int32 `protobuf:"varint,8,rep,packed,name=bls_counts,json=blsCounts,proto3" json:"bls_counts,omitempty"`
+	// 委员会对最近 checkpoint 的聚合签名
+	Checkpoint *Pos33Checkpoint `protobuf:"bytes,9,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	// 出块插件添加的附加数据
+	Extras []*Pos33BlockExtra `protobuf:"bytes,10,rep,name=extras,proto3" json:"extras,omitempty"`
+	// ForkMissedMaker 之后, 前面每个没有出块的轮次里 hash 最小的 maker 抽签
+	Missed []*Pos33SortMsg `protobuf:"bytes,11,rep,name=missed,proto3" json:"missed,omitempty"`
+	// ForkVoteCommitment 之后对投票的承诺, 轻客户端用它验证区块
+	Commitment *Pos33VoteCommitment `protobuf:"bytes,12,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// ForkLateVotes 之后, 上一个区块没有包含的投票
+	Late *Pos33LateVotes `protobuf:"bytes,13,opt,name=late,proto3" json:"late,omitempty"`
 }
 
 func (x *Pos33MinerMsg) Reset() {
@@ -1506,17 +1679,80 @@ func (x *Pos33MinerMsg) GetBlockTime() int64 {
 	return 0
 }
 
-type Pos33MinerFlag struct {
+func (x *Pos33MinerMsg) GetSeedHash() []byte {
+	if x != nil {
+		return x.SeedHash
+	}
+	return nil
+}
+
+func (x *Pos33MinerMsg) GetSeedProof() []byte {
+	if x != nil {
+		return x.SeedProof
+	}
+	return nil
+}
+
+func (x *Pos33MinerMsg) GetBlsCounts() []int32 {
+	if x != nil {
+		return x.BlsCounts
+	}
+	return nil
+}
+
+func (x *Pos33MinerMsg) GetCheckpoint() *Pos33Checkpoint {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return nil
+}
+
+func (x *Pos33MinerMsg) GetExtras() []*Pos33BlockExtra {
+	if x != nil {
+		return x.Extras
+	}
+	return nil
+}
+
+func (x *Pos33MinerMsg) GetMissed() []*Pos33SortMsg {
+	if x != nil {
+		return x.Missed
+	}
+	return nil
+}
+
+func (x *Pos33MinerMsg) GetCommitment() *Pos33VoteCommitment {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *Pos33MinerMsg) GetLate() *Pos33LateVotes {
+	if x != nil {
+		return x.Late
+	}
+	return nil
+}
+
+// 对上一个区块的投票, 到得太晚或者超过了委员会大小 没有放进上一个区块, 放到下一个区块里只拿投票的奖励.
+// 投票人不能在上一个区块的投票人里, 每个投票人只能有一票, 两个区块的投票加起来不超过上一个区块的委员会大小
+type Pos33LateVotes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Flag    int32 `protobuf:"varint,1,opt,name=flag,proto3" json:"flag,omitempty"`
-	Reserve int64 `protobuf:"varint,2,opt,name=reserve,proto3" json:"reserve,omitempty"`
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// 上一个区块的 maker 抽签 hash, 也就是投票签名的内容
+	Hash      []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	BlsPkList [][]byte `protobuf:"bytes,3,rep,name=BlsPkList,proto3" json:"BlsPkList,omitempty"`
+	BlsSig    []byte   `protobuf:"bytes,4,opt,name=BlsSig,proto3" json:"BlsSig,omitempty"`
+	// sorts[i] 是 BlsPkList[i] 的投票人抽签, 证明投票人在上一个区块的委员会里
+	Sorts []*Pos33SortMsg `protobuf:"bytes,5,rep,name=sorts,proto3" json:"sorts,omitempty"`
 }
 
-func (x *Pos33MinerFlag) Reset() {
-	*x = Pos33MinerFlag{}
+func (x *Pos33LateVotes) Reset() {
+	*x = Pos33LateVotes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1524,13 +1760,13 @@ func (x *Pos33MinerFlag) Reset() {
 	}
 }
 
-func (x *Pos33MinerFlag) String() string {
+func (x *Pos33LateVotes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33MinerFlag) ProtoMessage() {}
+func (*Pos33LateVotes) ProtoMessage() {}
 
-func (x *Pos33MinerFlag) ProtoReflect() protoreflect.Message {
+func (x *Pos33LateVotes) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1542,35 +1778,64 @@ func (x *Pos33MinerFlag) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33MinerFlag.ProtoReflect.Descriptor instead.
-func (*Pos33MinerFlag) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33LateVotes.ProtoReflect.Descriptor instead.
+func (*Pos33LateVotes) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Pos33MinerFlag) GetFlag() int32 {
+func (x *Pos33LateVotes) GetHeight() int64 {
 	if x != nil {
-		return x.Flag
+		return x.Height
 	}
 	return 0
 }
 
-func (x *Pos33MinerFlag) GetReserve() int64 {
+func (x *Pos33LateVotes) GetHash() []byte {
 	if x != nil {
-		return x.Reserve
+		return x.Hash
 	}
-	return 0
+	return nil
 }
 
-type Pos33PrivMsg struct {
+func (x *Pos33LateVotes) GetBlsPkList() [][]byte {
+	if x != nil {
+		return x.BlsPkList
+	}
+	return nil
+}
+
+func (x *Pos33LateVotes) GetBlsSig() []byte {
+	if x != nil {
+		return x.BlsSig
+	}
+	return nil
+}
+
+func (x *Pos33LateVotes) GetSorts() []*Pos33SortMsg {
+	if x != nil {
+		return x.Sorts
+	}
+	return nil
+}
+
+// 投票的承诺. 叶子是 sha256(bls 公钥 || 票数), 按公钥排序, 和上一个区块的 votersRoot 连成一条链,
+// 轻客户端信任上一个区块的投票人以后, 只要 miner 交易和它在区块里的 merkle 证明就能验证这个区块
+type Pos33VoteCommitment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Priv []byte `protobuf:"bytes,1,opt,name=priv,proto3" json:"priv,omitempty"`
+	// 投票人和票数的 merkle root
+	VotersRoot []byte `protobuf:"bytes,1,opt,name=votersRoot,proto3" json:"votersRoot,omitempty"`
+	// 聚合签名的 sha256
+	SigHash []byte `protobuf:"bytes,2,opt,name=sigHash,proto3" json:"sigHash,omitempty"`
+	Votes   int32  `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`
+	// 上一个区块的 votersRoot
+	PrevVotersRoot []byte `protobuf:"bytes,4,opt,name=prevVotersRoot,proto3" json:"prevVotersRoot,omitempty"`
 }
 
-func (x *Pos33PrivMsg) Reset() {
-	*x = Pos33PrivMsg{}
+func (x *Pos33VoteCommitment) Reset() {
+	*x = Pos33VoteCommitment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1578,13 +1843,13 @@ func (x *Pos33PrivMsg) Reset() {
 	}
 }
 
-func (x *Pos33PrivMsg) String() string {
+func (x *Pos33VoteCommitment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33PrivMsg) ProtoMessage() {}
+func (*Pos33VoteCommitment) ProtoMessage() {}
 
-func (x *Pos33PrivMsg) ProtoReflect() protoreflect.Message {
+func (x *Pos33VoteCommitment) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1596,29 +1861,51 @@ func (x *Pos33PrivMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33PrivMsg.ProtoReflect.Descriptor instead.
-func (*Pos33PrivMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33VoteCommitment.ProtoReflect.Descriptor instead.
+func (*Pos33VoteCommitment) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Pos33PrivMsg) GetPriv() []byte {
+func (x *Pos33VoteCommitment) GetVotersRoot() []byte {
 	if x != nil {
-		return x.Priv
+		return x.VotersRoot
 	}
 	return nil
 }
 
-type Pos33TicketBind struct {
+func (x *Pos33VoteCommitment) GetSigHash() []byte {
+	if x != nil {
+		return x.SigHash
+	}
+	return nil
+}
+
+func (x *Pos33VoteCommitment) GetVotes() int32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *Pos33VoteCommitment) GetPrevVotersRoot() []byte {
+	if x != nil {
+		return x.PrevVotersRoot
+	}
+	return nil
+}
+
+// 出块插件的附加数据, name 是插件注册的名字
+type Pos33BlockExtra struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MinerAddress  string `protobuf:"bytes,1,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
-	ReturnAddress string `protobuf:"bytes,2,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *Pos33TicketBind) Reset() {
-	*x = Pos33TicketBind{}
+func (x *Pos33BlockExtra) Reset() {
+	*x = Pos33BlockExtra{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1626,13 +1913,13 @@ func (x *Pos33TicketBind) Reset() {
 	}
 }
 
-func (x *Pos33TicketBind) String() string {
+func (x *Pos33BlockExtra) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33TicketBind) ProtoMessage() {}
+func (*Pos33BlockExtra) ProtoMessage() {}
 
-func (x *Pos33TicketBind) ProtoReflect() protoreflect.Message {
+func (x *Pos33BlockExtra) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1644,44 +1931,39 @@ func (x *Pos33TicketBind) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33TicketBind.ProtoReflect.Descriptor instead.
-func (*Pos33TicketBind) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33BlockExtra.ProtoReflect.Descriptor instead.
+func (*Pos33BlockExtra) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Pos33TicketBind) GetMinerAddress() string {
+func (x *Pos33BlockExtra) GetName() string {
 	if x != nil {
-		return x.MinerAddress
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Pos33TicketBind) GetReturnAddress() string {
+func (x *Pos33BlockExtra) GetData() []byte {
 	if x != nil {
-		return x.ReturnAddress
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type Pos33TicketOpen struct {
+// 交易池为空时制作人等待的心跳, 放在 heartbeat 出块插件的附加数据里
+type Pos33Heartbeat struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//用户挖矿的ticket 地址
-	MinerAddress string `protobuf:"bytes,1,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
-	//币实际存储的地址
-	ReturnAddress string `protobuf:"bytes,3,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
-	//聚合签名的地址
-	BlsAddress string `protobuf:"bytes,6,opt,name=blsAddress,proto3" json:"blsAddress,omitempty"`
-	//购买ticket的数目
-	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	//随机种子
-	RandSeed int64 `protobuf:"varint,4,opt,name=randSeed,proto3" json:"randSeed,omitempty"`
+	// 等待的时间(毫秒)
+	Delay int64 `protobuf:"varint,1,opt,name=delay,proto3" json:"delay,omitempty"`
+	// 连续等满 emptyBlockDelay 的区块数
+	Idle int64 `protobuf:"varint,2,opt,name=idle,proto3" json:"idle,omitempty"`
 }
 
-func (x *Pos33TicketOpen) Reset() {
-	*x = Pos33TicketOpen{}
+func (x *Pos33Heartbeat) Reset() {
+	*x = Pos33Heartbeat{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1689,13 +1971,13 @@ func (x *Pos33TicketOpen) Reset() {
 	}
 }
 
-func (x *Pos33TicketOpen) String() string {
+func (x *Pos33Heartbeat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33TicketOpen) ProtoMessage() {}
+func (*Pos33Heartbeat) ProtoMessage() {}
 
-func (x *Pos33TicketOpen) ProtoReflect() protoreflect.Message {
+func (x *Pos33Heartbeat) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1707,59 +1989,40 @@ func (x *Pos33TicketOpen) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33TicketOpen.ProtoReflect.Descriptor instead.
-func (*Pos33TicketOpen) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33Heartbeat.ProtoReflect.Descriptor instead.
+func (*Pos33Heartbeat) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Pos33TicketOpen) GetMinerAddress() string {
-	if x != nil {
-		return x.MinerAddress
-	}
-	return ""
-}
-
-func (x *Pos33TicketOpen) GetReturnAddress() string {
-	if x != nil {
-		return x.ReturnAddress
-	}
-	return ""
-}
-
-func (x *Pos33TicketOpen) GetBlsAddress() string {
-	if x != nil {
-		return x.BlsAddress
-	}
-	return ""
-}
-
-func (x *Pos33TicketOpen) GetCount() int32 {
+func (x *Pos33Heartbeat) GetDelay() int64 {
 	if x != nil {
-		return x.Count
+		return x.Delay
 	}
 	return 0
 }
 
-func (x *Pos33TicketOpen) GetRandSeed() int64 {
+func (x *Pos33Heartbeat) GetIdle() int64 {
 	if x != nil {
-		return x.RandSeed
+		return x.Idle
 	}
 	return 0
 }
 
-type Pos33TicketGenesis struct {
+// ForkSmoothDiff 之后每个区块更新的抽签难度系数
+type Pos33DiffRetarget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MinerAddress  string `protobuf:"bytes,1,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
-	ReturnAddress string `protobuf:"bytes,2,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
-	BlsAddress    string `protobuf:"bytes,6,opt,name=blsAddress,proto3" json:"blsAddress,omitempty"`
-	Count         int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// 难度系数, 百万分之一
+	Ratio int64 `protobuf:"varint,2,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	// 最近 diffWindow 个区块投票人数占委员会人数比例的移动平均, 百万分之一
+	Fill int64 `protobuf:"varint,3,opt,name=fill,proto3" json:"fill,omitempty"`
 }
 
-func (x *Pos33TicketGenesis) Reset() {
-	*x = Pos33TicketGenesis{}
+func (x *Pos33DiffRetarget) Reset() {
+	*x = Pos33DiffRetarget{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1767,13 +2030,13 @@ func (x *Pos33TicketGenesis) Reset() {
 	}
 }
 
-func (x *Pos33TicketGenesis) String() string {
+func (x *Pos33DiffRetarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33TicketGenesis) ProtoMessage() {}
+func (*Pos33DiffRetarget) ProtoMessage() {}
 
-func (x *Pos33TicketGenesis) ProtoReflect() protoreflect.Message {
+func (x *Pos33DiffRetarget) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1785,51 +2048,48 @@ func (x *Pos33TicketGenesis) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33TicketGenesis.ProtoReflect.Descriptor instead.
-func (*Pos33TicketGenesis) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33DiffRetarget.ProtoReflect.Descriptor instead.
+func (*Pos33DiffRetarget) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Pos33TicketGenesis) GetMinerAddress() string {
+func (x *Pos33DiffRetarget) GetHeight() int64 {
 	if x != nil {
-		return x.MinerAddress
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *Pos33TicketGenesis) GetReturnAddress() string {
+func (x *Pos33DiffRetarget) GetRatio() int64 {
 	if x != nil {
-		return x.ReturnAddress
+		return x.Ratio
 	}
-	return ""
-}
-
-func (x *Pos33TicketGenesis) GetBlsAddress() string {
-	if x != nil {
-		return x.BlsAddress
-	}
-	return ""
+	return 0
 }
 
-func (x *Pos33TicketGenesis) GetCount() int32 {
+func (x *Pos33DiffRetarget) GetFill() int64 {
 	if x != nil {
-		return x.Count
+		return x.Fill
 	}
 	return 0
 }
 
-type Pos33TicketClose struct {
+// ForkVrfSeed 之后每个区块的种子, 制作人对上一个种子的 vrf 输出. random 执行器用它完成随机数请求
+type Pos33BlockSeed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repeated string ticketId = 1;
-	MinerAddress string `protobuf:"bytes,2,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
-	Count        int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Height int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Seed   []byte `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	Proof  []byte `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// 制作人的 vrf 公钥和地址
+	Pubkey []byte `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Maker  string `protobuf:"bytes,5,opt,name=maker,proto3" json:"maker,omitempty"`
 }
 
-func (x *Pos33TicketClose) Reset() {
-	*x = Pos33TicketClose{}
+func (x *Pos33BlockSeed) Reset() {
+	*x = Pos33BlockSeed{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1837,13 +2097,13 @@ func (x *Pos33TicketClose) Reset() {
 	}
 }
 
-func (x *Pos33TicketClose) String() string {
+func (x *Pos33BlockSeed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33TicketClose) ProtoMessage() {}
+func (*Pos33BlockSeed) ProtoMessage() {}
 
-func (x *Pos33TicketClose) ProtoReflect() protoreflect.Message {
+func (x *Pos33BlockSeed) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1855,36 +2115,65 @@ func (x *Pos33TicketClose) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33TicketClose.ProtoReflect.Descriptor instead.
-func (*Pos33TicketClose) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33BlockSeed.ProtoReflect.Descriptor instead.
+func (*Pos33BlockSeed) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Pos33TicketClose) GetMinerAddress() string {
+func (x *Pos33BlockSeed) GetHeight() int64 {
 	if x != nil {
-		return x.MinerAddress
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *Pos33TicketClose) GetCount() int32 {
+func (x *Pos33BlockSeed) GetSeed() []byte {
 	if x != nil {
-		return x.Count
+		return x.Seed
 	}
-	return 0
+	return nil
 }
 
-type Pos33TicketReward struct {
+func (x *Pos33BlockSeed) GetProof() []byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *Pos33BlockSeed) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *Pos33BlockSeed) GetMaker() string {
+	if x != nil {
+		return x.Maker
+	}
+	return ""
+}
+
+// ForkBaseFee 之后每个区块的基础交易费, 按区块的交易数调整, 基础费销毁, 超出的部分是给制作人和投票人的小费
+type Pos33BaseFee struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// 每 1000 字节的基础交易费
+	BaseFee int64 `protobuf:"varint,2,opt,name=baseFee,proto3" json:"baseFee,omitempty"`
+	// 区块里 miner 交易之外的交易数
+	Txs    int64 `protobuf:"varint,3,opt,name=txs,proto3" json:"txs,omitempty"`
+	Burned int64 `protobuf:"varint,4,opt,name=burned,proto3" json:"burned,omitempty"`
+	Tips   int64 `protobuf:"varint,5,opt,name=tips,proto3" json:"tips,omitempty"`
+	// 付基础交易费的交易的总字节数, 下一个区块的基础交易费按它调整
+	Size int64 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *Pos33TicketReward) Reset() {
-	*x = Pos33TicketReward{}
+func (x *Pos33BaseFee) Reset() {
+	*x = Pos33BaseFee{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1892,13 +2181,13 @@ func (x *Pos33TicketReward) Reset() {
 	}
 }
 
-func (x *Pos33TicketReward) String() string {
+func (x *Pos33BaseFee) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33TicketReward) ProtoMessage() {}
+func (*Pos33BaseFee) ProtoMessage() {}
 
-func (x *Pos33TicketReward) ProtoReflect() protoreflect.Message {
+func (x *Pos33BaseFee) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1910,36 +2199,65 @@ func (x *Pos33TicketReward) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33TicketReward.ProtoReflect.Descriptor instead.
-func (*Pos33TicketReward) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33BaseFee.ProtoReflect.Descriptor instead.
+func (*Pos33BaseFee) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Pos33TicketReward) GetAddr() string {
+func (x *Pos33BaseFee) GetHeight() int64 {
 	if x != nil {
-		return x.Addr
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *Pos33TicketReward) GetHeight() int64 {
+func (x *Pos33BaseFee) GetBaseFee() int64 {
 	if x != nil {
-		return x.Height
+		return x.BaseFee
 	}
 	return 0
 }
 
-type Pos33TicketList struct {
+func (x *Pos33BaseFee) GetTxs() int64 {
+	if x != nil {
+		return x.Txs
+	}
+	return 0
+}
+
+func (x *Pos33BaseFee) GetBurned() int64 {
+	if x != nil {
+		return x.Burned
+	}
+	return 0
+}
+
+func (x *Pos33BaseFee) GetTips() int64 {
+	if x != nil {
+		return x.Tips
+	}
+	return 0
+}
+
+func (x *Pos33BaseFee) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type Pos33BaseFees struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	Status int32  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	Items []*Pos33BaseFee `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// 下一个区块的基础交易费
+	Next int64 `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
 }
 
-func (x *Pos33TicketList) Reset() {
-	*x = Pos33TicketList{}
+func (x *Pos33BaseFees) Reset() {
+	*x = Pos33BaseFees{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1947,13 +2265,13 @@ func (x *Pos33TicketList) Reset() {
 	}
 }
 
-func (x *Pos33TicketList) String() string {
+func (x *Pos33BaseFees) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33TicketList) ProtoMessage() {}
+func (*Pos33BaseFees) ProtoMessage() {}
 
-func (x *Pos33TicketList) ProtoReflect() protoreflect.Message {
+func (x *Pos33BaseFees) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1965,36 +2283,47 @@ func (x *Pos33TicketList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33TicketList.ProtoReflect.Descriptor instead.
-func (*Pos33TicketList) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33BaseFees.ProtoReflect.Descriptor instead.
+func (*Pos33BaseFees) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Pos33TicketList) GetAddr() string {
+func (x *Pos33BaseFees) GetItems() []*Pos33BaseFee {
 	if x != nil {
-		return x.Addr
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *Pos33TicketList) GetStatus() int32 {
+func (x *Pos33BaseFees) GetNext() int64 {
 	if x != nil {
-		return x.Status
+		return x.Next
 	}
 	return 0
 }
 
-type ReplyPos33TicketReward struct {
+// 每个区块的抽签难度和抵押, 保存在 localdb, 用来画图
+type Pos33ChartPoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VoterReward int64 `protobuf:"varint,1,opt,name=voter_reward,json=voterReward,proto3" json:"voter_reward,omitempty"`
-	MinerReward int64 `protobuf:"varint,2,opt,name=miner_reward,json=minerReward,proto3" json:"miner_reward,omitempty"`
-}
-
-func (x *ReplyPos33TicketReward) Reset() {
-	*x = ReplyPos33TicketReward{}
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Time   int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// 区块的难度
+	Difficulty uint64 `protobuf:"varint,3,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// 抽签难度系数, 百万分之一
+	DiffRatio int64 `protobuf:"varint,4,opt,name=diffRatio,proto3" json:"diffRatio,omitempty"`
+	// 全网的票数
+	Tickets int64 `protobuf:"varint,5,opt,name=tickets,proto3" json:"tickets,omitempty"`
+	// 区块的投票人数
+	Voters int32 `protobuf:"varint,6,opt,name=voters,proto3" json:"voters,omitempty"`
+	// 出块的轮次
+	Round int32 `protobuf:"varint,7,opt,name=round,proto3" json:"round,omitempty"`
+}
+
+func (x *Pos33ChartPoint) Reset() {
+	*x = Pos33ChartPoint{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2002,13 +2331,13 @@ func (x *ReplyPos33TicketReward) Reset() {
 	}
 }
 
-func (x *ReplyPos33TicketReward) String() string {
+func (x *Pos33ChartPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplyPos33TicketReward) ProtoMessage() {}
+func (*Pos33ChartPoint) ProtoMessage() {}
 
-func (x *ReplyPos33TicketReward) ProtoReflect() protoreflect.Message {
+func (x *Pos33ChartPoint) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2020,36 +2349,73 @@ func (x *ReplyPos33TicketReward) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplyPos33TicketReward.ProtoReflect.Descriptor instead.
-func (*ReplyPos33TicketReward) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33ChartPoint.ProtoReflect.Descriptor instead.
+func (*Pos33ChartPoint) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ReplyPos33TicketReward) GetVoterReward() int64 {
+func (x *Pos33ChartPoint) GetHeight() int64 {
 	if x != nil {
-		return x.VoterReward
+		return x.Height
 	}
 	return 0
 }
 
-func (x *ReplyPos33TicketReward) GetMinerReward() int64 {
+func (x *Pos33ChartPoint) GetTime() int64 {
 	if x != nil {
-		return x.MinerReward
+		return x.Time
 	}
 	return 0
 }
 
-type ReplyWalletPos33Count struct {
+func (x *Pos33ChartPoint) GetDifficulty() uint64 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *Pos33ChartPoint) GetDiffRatio() int64 {
+	if x != nil {
+		return x.DiffRatio
+	}
+	return 0
+}
+
+func (x *Pos33ChartPoint) GetTickets() int64 {
+	if x != nil {
+		return x.Tickets
+	}
+	return 0
+}
+
+func (x *Pos33ChartPoint) GetVoters() int32 {
+	if x != nil {
+		return x.Voters
+	}
+	return 0
+}
+
+func (x *Pos33ChartPoint) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+type ReqPos33Chart struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Privkey []byte `protobuf:"bytes,2,opt,name=privkey,proto3" json:"privkey,omitempty"`
-	Count   int64  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	// 最多返回的点数, [start, end] 按这个数量分段取样
+	Points int32 `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
 }
 
-func (x *ReplyWalletPos33Count) Reset() {
-	*x = ReplyWalletPos33Count{}
+func (x *ReqPos33Chart) Reset() {
+	*x = ReqPos33Chart{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2057,13 +2423,13 @@ func (x *ReplyWalletPos33Count) Reset() {
 	}
 }
 
-func (x *ReplyWalletPos33Count) String() string {
+func (x *ReqPos33Chart) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplyWalletPos33Count) ProtoMessage() {}
+func (*ReqPos33Chart) ProtoMessage() {}
 
-func (x *ReplyWalletPos33Count) ProtoReflect() protoreflect.Message {
+func (x *ReqPos33Chart) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2075,36 +2441,42 @@ func (x *ReplyWalletPos33Count) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplyWalletPos33Count.ProtoReflect.Descriptor instead.
-func (*ReplyWalletPos33Count) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReqPos33Chart.ProtoReflect.Descriptor instead.
+func (*ReqPos33Chart) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ReplyWalletPos33Count) GetPrivkey() []byte {
+func (x *ReqPos33Chart) GetStart() int64 {
 	if x != nil {
-		return x.Privkey
+		return x.Start
 	}
-	return nil
+	return 0
 }
 
-func (x *ReplyWalletPos33Count) GetCount() int64 {
+func (x *ReqPos33Chart) GetEnd() int64 {
 	if x != nil {
-		return x.Count
+		return x.End
 	}
 	return 0
 }
 
-type ReceiptPos33Deposit struct {
+func (x *ReqPos33Chart) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type Pos33ChartPoints struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addr  string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	Count int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Points []*Pos33ChartPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
 }
 
-func (x *ReceiptPos33Deposit) Reset() {
-	*x = ReceiptPos33Deposit{}
+func (x *Pos33ChartPoints) Reset() {
+	*x = Pos33ChartPoints{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2112,13 +2484,13 @@ func (x *ReceiptPos33Deposit) Reset() {
 	}
 }
 
-func (x *ReceiptPos33Deposit) String() string {
+func (x *Pos33ChartPoints) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiptPos33Deposit) ProtoMessage() {}
+func (*Pos33ChartPoints) ProtoMessage() {}
 
-func (x *ReceiptPos33Deposit) ProtoReflect() protoreflect.Message {
+func (x *Pos33ChartPoints) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2130,36 +2502,30 @@ func (x *ReceiptPos33Deposit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiptPos33Deposit.ProtoReflect.Descriptor instead.
-func (*ReceiptPos33Deposit) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33ChartPoints.ProtoReflect.Descriptor instead.
+func (*Pos33ChartPoints) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ReceiptPos33Deposit) GetAddr() string {
-	if x != nil {
-		return x.Addr
-	}
-	return ""
-}
-
-func (x *ReceiptPos33Deposit) GetCount() int64 {
+func (x *Pos33ChartPoints) GetPoints() []*Pos33ChartPoint {
 	if x != nil {
-		return x.Count
+		return x.Points
 	}
-	return 0
+	return nil
 }
 
-type ReceiptPos33Miner struct {
+// manage 执行器设置的票价, 从 height 开始生效
+type Pos33TicketPrice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	Reward int64  `protobuf:"varint,3,opt,name=reward,proto3" json:"reward,omitempty"`
+	Price  int64 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *ReceiptPos33Miner) Reset() {
-	*x = ReceiptPos33Miner{}
+func (x *Pos33TicketPrice) Reset() {
+	*x = Pos33TicketPrice{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2167,13 +2533,13 @@ func (x *ReceiptPos33Miner) Reset() {
 	}
 }
 
-func (x *ReceiptPos33Miner) String() string {
+func (x *Pos33TicketPrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiptPos33Miner) ProtoMessage() {}
+func (*Pos33TicketPrice) ProtoMessage() {}
 
-func (x *ReceiptPos33Miner) ProtoReflect() protoreflect.Message {
+func (x *Pos33TicketPrice) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2185,37 +2551,35 @@ func (x *ReceiptPos33Miner) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiptPos33Miner.ProtoReflect.Descriptor instead.
-func (*ReceiptPos33Miner) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33TicketPrice.ProtoReflect.Descriptor instead.
+func (*Pos33TicketPrice) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ReceiptPos33Miner) GetAddr() string {
+func (x *Pos33TicketPrice) GetPrice() int64 {
 	if x != nil {
-		return x.Addr
+		return x.Price
 	}
-	return ""
+	return 0
 }
 
-func (x *ReceiptPos33Miner) GetReward() int64 {
+func (x *Pos33TicketPrice) GetHeight() int64 {
 	if x != nil {
-		return x.Reward
+		return x.Height
 	}
 	return 0
 }
 
-type ReceiptPos33TicketBind struct {
+type Pos33TicketPrices struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OldMinerAddress string `protobuf:"bytes,1,opt,name=oldMinerAddress,proto3" json:"oldMinerAddress,omitempty"`
-	NewMinerAddress string `protobuf:"bytes,2,opt,name=newMinerAddress,proto3" json:"newMinerAddress,omitempty"`
-	ReturnAddress   string `protobuf:"bytes,3,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
+	Items []*Pos33TicketPrice `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *ReceiptPos33TicketBind) Reset() {
-	*x = ReceiptPos33TicketBind{}
+func (x *Pos33TicketPrices) Reset() {
+	*x = Pos33TicketPrices{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2223,13 +2587,13 @@ func (x *ReceiptPos33TicketBind) Reset() {
 	}
 }
 
-func (x *ReceiptPos33TicketBind) String() string {
+func (x *Pos33TicketPrices) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiptPos33TicketBind) ProtoMessage() {}
+func (*Pos33TicketPrices) ProtoMessage() {}
 
-func (x *ReceiptPos33TicketBind) ProtoReflect() protoreflect.Message {
+func (x *Pos33TicketPrices) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2241,43 +2605,34 @@ func (x *ReceiptPos33TicketBind) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiptPos33TicketBind.ProtoReflect.Descriptor instead.
-func (*ReceiptPos33TicketBind) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33TicketPrices.ProtoReflect.Descriptor instead.
+func (*Pos33TicketPrices) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ReceiptPos33TicketBind) GetOldMinerAddress() string {
-	if x != nil {
-		return x.OldMinerAddress
-	}
-	return ""
-}
-
-func (x *ReceiptPos33TicketBind) GetNewMinerAddress() string {
-	if x != nil {
-		return x.NewMinerAddress
-	}
-	return ""
-}
-
-func (x *ReceiptPos33TicketBind) GetReturnAddress() string {
+func (x *Pos33TicketPrices) GetItems() []*Pos33TicketPrice {
 	if x != nil {
-		return x.ReturnAddress
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-type Consignee struct {
+// 每 checkpointBlocks 个区块, 区块 T 的投票人对 T-1 签名, 超过 2/3 签名后不可回滚
+type Pos33Checkpoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount  int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Height    int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash      []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	BlsPkList [][]byte `protobuf:"bytes,3,rep,name=bls_pk_list,json=blsPkList,proto3" json:"bls_pk_list,omitempty"`
+	BlsSig    []byte   `protobuf:"bytes,4,opt,name=bls_sig,json=blsSig,proto3" json:"bls_sig,omitempty"`
+	// pending checkpoint 里每个投票人的票数, 和 bls_pk_list 一一对应
+	Weights []int64 `protobuf:"varint,5,rep,packed,name=weights,proto3" json:"weights,omitempty"`
 }
 
-func (x *Consignee) Reset() {
-	*x = Consignee{}
+func (x *Pos33Checkpoint) Reset() {
+	*x = Pos33Checkpoint{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2285,13 +2640,13 @@ func (x *Consignee) Reset() {
 	}
 }
 
-func (x *Consignee) String() string {
+func (x *Pos33Checkpoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Consignee) ProtoMessage() {}
+func (*Pos33Checkpoint) ProtoMessage() {}
 
-func (x *Consignee) ProtoReflect() protoreflect.Message {
+func (x *Pos33Checkpoint) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2303,38 +2658,58 @@ func (x *Consignee) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Consignee.ProtoReflect.Descriptor instead.
-func (*Consignee) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33Checkpoint.ProtoReflect.Descriptor instead.
+func (*Pos33Checkpoint) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *Consignee) GetAddress() string {
+func (x *Pos33Checkpoint) GetHeight() int64 {
 	if x != nil {
-		return x.Address
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *Consignee) GetAmount() int64 {
+func (x *Pos33Checkpoint) GetHash() []byte {
 	if x != nil {
-		return x.Amount
+		return x.Hash
 	}
-	return 0
+	return nil
 }
 
-type Consignor struct {
+func (x *Pos33Checkpoint) GetBlsPkList() [][]byte {
+	if x != nil {
+		return x.BlsPkList
+	}
+	return nil
+}
+
+func (x *Pos33Checkpoint) GetBlsSig() []byte {
+	if x != nil {
+		return x.BlsSig
+	}
+	return nil
+}
+
+func (x *Pos33Checkpoint) GetWeights() []int64 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+type Pos33CheckpointVote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount       int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                                 // 委托数量
-	Reward       int64  `protobuf:"varint,3,opt,name=reward,proto3" json:"reward,omitempty"`                                 // 获取奖励的总额
-	RemainReward int64  `protobuf:"varint,4,opt,name=remain_reward,json=remainReward,proto3" json:"remain_reward,omitempty"` // 返给用户后的剩余奖励
+	Height int64            `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash   []byte           `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Sig    *types.Signature `protobuf:"bytes,3,opt,name=sig,proto3" json:"sig,omitempty"`
 }
 
-func (x *Consignor) Reset() {
-	*x = Consignor{}
+func (x *Pos33CheckpointVote) Reset() {
+	*x = Pos33CheckpointVote{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2342,13 +2717,13 @@ func (x *Consignor) Reset() {
 	}
 }
 
-func (x *Consignor) String() string {
+func (x *Pos33CheckpointVote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Consignor) ProtoMessage() {}
+func (*Pos33CheckpointVote) ProtoMessage() {}
 
-func (x *Consignor) ProtoReflect() protoreflect.Message {
+func (x *Pos33CheckpointVote) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2360,51 +2735,43 @@ func (x *Consignor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Consignor.ProtoReflect.Descriptor instead.
-func (*Consignor) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33CheckpointVote.ProtoReflect.Descriptor instead.
+func (*Pos33CheckpointVote) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *Consignor) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *Consignor) GetAmount() int64 {
+func (x *Pos33CheckpointVote) GetHeight() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Height
 	}
 	return 0
 }
 
-func (x *Consignor) GetReward() int64 {
+func (x *Pos33CheckpointVote) GetHash() []byte {
 	if x != nil {
-		return x.Reward
+		return x.Hash
 	}
-	return 0
+	return nil
 }
 
-func (x *Consignor) GetRemainReward() int64 {
+func (x *Pos33CheckpointVote) GetSig() *types.Signature {
 	if x != nil {
-		return x.RemainReward
+		return x.Sig
 	}
-	return 0
+	return nil
 }
 
-// 委托人
-type Pos33Consignor struct {
+type Pos33MinerFlag struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address    string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Consignees []*Consignee `protobuf:"bytes,3,rep,name=consignees,proto3" json:"consignees,omitempty"` // 受托人列表
+	Flag    int32 `protobuf:"varint,1,opt,name=flag,proto3" json:"flag,omitempty"`
+	Reserve int64 `protobuf:"varint,2,opt,name=reserve,proto3" json:"reserve,omitempty"`
 }
 
-func (x *Pos33Consignor) Reset() {
-	*x = Pos33Consignor{}
+func (x *Pos33MinerFlag) Reset() {
+	*x = Pos33MinerFlag{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2412,13 +2779,13 @@ func (x *Pos33Consignor) Reset() {
 	}
 }
 
-func (x *Pos33Consignor) String() string {
+func (x *Pos33MinerFlag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33Consignor) ProtoMessage() {}
+func (*Pos33MinerFlag) ProtoMessage() {}
 
-func (x *Pos33Consignor) ProtoReflect() protoreflect.Message {
+func (x *Pos33MinerFlag) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2430,41 +2797,35 @@ func (x *Pos33Consignor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33Consignor.ProtoReflect.Descriptor instead.
-func (*Pos33Consignor) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33MinerFlag.ProtoReflect.Descriptor instead.
+func (*Pos33MinerFlag) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *Pos33Consignor) GetAddress() string {
+func (x *Pos33MinerFlag) GetFlag() int32 {
 	if x != nil {
-		return x.Address
+		return x.Flag
 	}
-	return ""
+	return 0
 }
 
-func (x *Pos33Consignor) GetConsignees() []*Consignee {
+func (x *Pos33MinerFlag) GetReserve() int64 {
 	if x != nil {
-		return x.Consignees
+		return x.Reserve
 	}
-	return nil
+	return 0
 }
 
-// 受托人
-type Pos33Consignee struct {
+type Pos33PrivMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address         string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount          int64        `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	FeeReward       int64        `protobuf:"varint,3,opt,name=fee_reward,json=feeReward,proto3" json:"fee_reward,omitempty"`
-	RemainFeeReward int64        `protobuf:"varint,4,opt,name=remain_fee_reward,json=remainFeeReward,proto3" json:"remain_fee_reward,omitempty"` // 返给后的剩余奖励
-	FeePersent      int64        `protobuf:"varint,5,opt,name=fee_persent,json=feePersent,proto3" json:"fee_persent,omitempty"`
-	Consignors      []*Consignor `protobuf:"bytes,6,rep,name=consignors,proto3" json:"consignors,omitempty"` // 委托人列表
+	Priv []byte `protobuf:"bytes,1,opt,name=priv,proto3" json:"priv,omitempty"`
 }
 
-func (x *Pos33Consignee) Reset() {
-	*x = Pos33Consignee{}
+func (x *Pos33PrivMsg) Reset() {
+	*x = Pos33PrivMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2472,13 +2833,13 @@ func (x *Pos33Consignee) Reset() {
 	}
 }
 
-func (x *Pos33Consignee) String() string {
+func (x *Pos33PrivMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33Consignee) ProtoMessage() {}
+func (*Pos33PrivMsg) ProtoMessage() {}
 
-func (x *Pos33Consignee) ProtoReflect() protoreflect.Message {
+func (x *Pos33PrivMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2490,65 +2851,29 @@ func (x *Pos33Consignee) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33Consignee.ProtoReflect.Descriptor instead.
-func (*Pos33Consignee) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33PrivMsg.ProtoReflect.Descriptor instead.
+func (*Pos33PrivMsg) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *Pos33Consignee) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *Pos33Consignee) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *Pos33Consignee) GetFeeReward() int64 {
-	if x != nil {
-		return x.FeeReward
-	}
-	return 0
-}
-
-func (x *Pos33Consignee) GetRemainFeeReward() int64 {
-	if x != nil {
-		return x.RemainFeeReward
-	}
-	return 0
-}
-
-func (x *Pos33Consignee) GetFeePersent() int64 {
-	if x != nil {
-		return x.FeePersent
-	}
-	return 0
-}
-
-func (x *Pos33Consignee) GetConsignors() []*Consignor {
+func (x *Pos33PrivMsg) GetPriv() []byte {
 	if x != nil {
-		return x.Consignors
+		return x.Priv
 	}
 	return nil
 }
 
-type Pos33Entrust struct {
+type Pos33TicketBind struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Consignee string `protobuf:"bytes,1,opt,name=consignee,proto3" json:"consignee,omitempty"`
-	Consignor string `protobuf:"bytes,2,opt,name=consignor,proto3" json:"consignor,omitempty"`
-	Amount    int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	MinerAddress  string `protobuf:"bytes,1,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
+	ReturnAddress string `protobuf:"bytes,2,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
 }
 
-func (x *Pos33Entrust) Reset() {
-	*x = Pos33Entrust{}
+func (x *Pos33TicketBind) Reset() {
+	*x = Pos33TicketBind{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2556,13 +2881,13 @@ func (x *Pos33Entrust) Reset() {
 	}
 }
 
-func (x *Pos33Entrust) String() string {
+func (x *Pos33TicketBind) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33Entrust) ProtoMessage() {}
+func (*Pos33TicketBind) ProtoMessage() {}
 
-func (x *Pos33Entrust) ProtoReflect() protoreflect.Message {
+func (x *Pos33TicketBind) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2574,42 +2899,44 @@ func (x *Pos33Entrust) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33Entrust.ProtoReflect.Descriptor instead.
-func (*Pos33Entrust) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33TicketBind.ProtoReflect.Descriptor instead.
+func (*Pos33TicketBind) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *Pos33Entrust) GetConsignee() string {
+func (x *Pos33TicketBind) GetMinerAddress() string {
 	if x != nil {
-		return x.Consignee
+		return x.MinerAddress
 	}
 	return ""
 }
 
-func (x *Pos33Entrust) GetConsignor() string {
+func (x *Pos33TicketBind) GetReturnAddress() string {
 	if x != nil {
-		return x.Consignor
+		return x.ReturnAddress
 	}
 	return ""
 }
 
-func (x *Pos33Entrust) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-type Pos33Migrate struct {
+type Pos33TicketOpen struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Miner string `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	//用户挖矿的ticket 地址
+	MinerAddress string `protobuf:"bytes,1,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
+	//币实际存储的地址
+	ReturnAddress string `protobuf:"bytes,3,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
+	//聚合签名的地址
+	BlsAddress string `protobuf:"bytes,6,opt,name=blsAddress,proto3" json:"blsAddress,omitempty"`
+	//购买ticket的数目
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	//随机种子
+	RandSeed int64 `protobuf:"varint,4,opt,name=randSeed,proto3" json:"randSeed,omitempty"`
 }
 
-func (x *Pos33Migrate) Reset() {
-	*x = Pos33Migrate{}
+func (x *Pos33TicketOpen) Reset() {
+	*x = Pos33TicketOpen{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2617,13 +2944,13 @@ func (x *Pos33Migrate) Reset() {
 	}
 }
 
-func (x *Pos33Migrate) String() string {
+func (x *Pos33TicketOpen) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33Migrate) ProtoMessage() {}
+func (*Pos33TicketOpen) ProtoMessage() {}
 
-func (x *Pos33Migrate) ProtoReflect() protoreflect.Message {
+func (x *Pos33TicketOpen) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2635,29 +2962,59 @@ func (x *Pos33Migrate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33Migrate.ProtoReflect.Descriptor instead.
-func (*Pos33Migrate) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33TicketOpen.ProtoReflect.Descriptor instead.
+func (*Pos33TicketOpen) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *Pos33Migrate) GetMiner() string {
+func (x *Pos33TicketOpen) GetMinerAddress() string {
 	if x != nil {
-		return x.Miner
+		return x.MinerAddress
 	}
 	return ""
 }
 
-type Pos33BlsBind struct {
+func (x *Pos33TicketOpen) GetReturnAddress() string {
+	if x != nil {
+		return x.ReturnAddress
+	}
+	return ""
+}
+
+func (x *Pos33TicketOpen) GetBlsAddress() string {
+	if x != nil {
+		return x.BlsAddress
+	}
+	return ""
+}
+
+func (x *Pos33TicketOpen) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Pos33TicketOpen) GetRandSeed() int64 {
+	if x != nil {
+		return x.RandSeed
+	}
+	return 0
+}
+
+type Pos33TicketGenesis struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlsAddr   string `protobuf:"bytes,1,opt,name=BlsAddr,proto3" json:"BlsAddr,omitempty"`
-	MinerAddr string `protobuf:"bytes,2,opt,name=MinerAddr,proto3" json:"MinerAddr,omitempty"`
+	MinerAddress  string `protobuf:"bytes,1,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
+	ReturnAddress string `protobuf:"bytes,2,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
+	BlsAddress    string `protobuf:"bytes,6,opt,name=blsAddress,proto3" json:"blsAddress,omitempty"`
+	Count         int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *Pos33BlsBind) Reset() {
-	*x = Pos33BlsBind{}
+func (x *Pos33TicketGenesis) Reset() {
+	*x = Pos33TicketGenesis{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2665,13 +3022,13 @@ func (x *Pos33BlsBind) Reset() {
 	}
 }
 
-func (x *Pos33BlsBind) String() string {
+func (x *Pos33TicketGenesis) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33BlsBind) ProtoMessage() {}
+func (*Pos33TicketGenesis) ProtoMessage() {}
 
-func (x *Pos33BlsBind) ProtoReflect() protoreflect.Message {
+func (x *Pos33TicketGenesis) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2683,38 +3040,51 @@ func (x *Pos33BlsBind) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33BlsBind.ProtoReflect.Descriptor instead.
-func (*Pos33BlsBind) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33TicketGenesis.ProtoReflect.Descriptor instead.
+func (*Pos33TicketGenesis) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *Pos33BlsBind) GetBlsAddr() string {
+func (x *Pos33TicketGenesis) GetMinerAddress() string {
 	if x != nil {
-		return x.BlsAddr
+		return x.MinerAddress
 	}
 	return ""
 }
 
-func (x *Pos33BlsBind) GetMinerAddr() string {
+func (x *Pos33TicketGenesis) GetReturnAddress() string {
 	if x != nil {
-		return x.MinerAddr
+		return x.ReturnAddress
 	}
 	return ""
 }
 
-type ReqBindPos33Miner struct {
+func (x *Pos33TicketGenesis) GetBlsAddress() string {
+	if x != nil {
+		return x.BlsAddress
+	}
+	return ""
+}
+
+func (x *Pos33TicketGenesis) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Pos33TicketClose struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BindAddr     string `protobuf:"bytes,1,opt,name=bindAddr,proto3" json:"bindAddr,omitempty"`
-	OriginAddr   string `protobuf:"bytes,2,opt,name=originAddr,proto3" json:"originAddr,omitempty"`
-	Amount       int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	CheckBalance bool   `protobuf:"varint,4,opt,name=checkBalance,proto3" json:"checkBalance,omitempty"`
+	// repeated string ticketId = 1;
+	MinerAddress string `protobuf:"bytes,2,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
+	Count        int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *ReqBindPos33Miner) Reset() {
-	*x = ReqBindPos33Miner{}
+func (x *Pos33TicketClose) Reset() {
+	*x = Pos33TicketClose{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2722,13 +3092,13 @@ func (x *ReqBindPos33Miner) Reset() {
 	}
 }
 
-func (x *ReqBindPos33Miner) String() string {
+func (x *Pos33TicketClose) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReqBindPos33Miner) ProtoMessage() {}
+func (*Pos33TicketClose) ProtoMessage() {}
 
-func (x *ReqBindPos33Miner) ProtoReflect() protoreflect.Message {
+func (x *Pos33TicketClose) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2740,51 +3110,36 @@ func (x *ReqBindPos33Miner) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReqBindPos33Miner.ProtoReflect.Descriptor instead.
-func (*ReqBindPos33Miner) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33TicketClose.ProtoReflect.Descriptor instead.
+func (*Pos33TicketClose) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ReqBindPos33Miner) GetBindAddr() string {
-	if x != nil {
-		return x.BindAddr
-	}
-	return ""
-}
-
-func (x *ReqBindPos33Miner) GetOriginAddr() string {
+func (x *Pos33TicketClose) GetMinerAddress() string {
 	if x != nil {
-		return x.OriginAddr
+		return x.MinerAddress
 	}
 	return ""
 }
 
-func (x *ReqBindPos33Miner) GetAmount() int64 {
+func (x *Pos33TicketClose) GetCount() int32 {
 	if x != nil {
-		return x.Amount
+		return x.Count
 	}
 	return 0
 }
 
-func (x *ReqBindPos33Miner) GetCheckBalance() bool {
-	if x != nil {
-		return x.CheckBalance
-	}
-	return false
-}
-
-type Pos33WithdrawReward struct {
+type Pos33TicketReward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Consignee string `protobuf:"bytes,1,opt,name=consignee,proto3" json:"consignee,omitempty"`
-	Consignor string `protobuf:"bytes,2,opt,name=consignor,proto3" json:"consignor,omitempty"`
-	Amount    int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *Pos33WithdrawReward) Reset() {
-	*x = Pos33WithdrawReward{}
+func (x *Pos33TicketReward) Reset() {
+	*x = Pos33TicketReward{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2792,13 +3147,13 @@ func (x *Pos33WithdrawReward) Reset() {
 	}
 }
 
-func (x *Pos33WithdrawReward) String() string {
+func (x *Pos33TicketReward) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33WithdrawReward) ProtoMessage() {}
+func (*Pos33TicketReward) ProtoMessage() {}
 
-func (x *Pos33WithdrawReward) ProtoReflect() protoreflect.Message {
+func (x *Pos33TicketReward) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2810,43 +3165,36 @@ func (x *Pos33WithdrawReward) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33WithdrawReward.ProtoReflect.Descriptor instead.
-func (*Pos33WithdrawReward) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33TicketReward.ProtoReflect.Descriptor instead.
+func (*Pos33TicketReward) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *Pos33WithdrawReward) GetConsignee() string {
-	if x != nil {
-		return x.Consignee
-	}
-	return ""
-}
-
-func (x *Pos33WithdrawReward) GetConsignor() string {
+func (x *Pos33TicketReward) GetAddr() string {
 	if x != nil {
-		return x.Consignor
+		return x.Addr
 	}
 	return ""
 }
 
-func (x *Pos33WithdrawReward) GetAmount() int64 {
+func (x *Pos33TicketReward) GetHeight() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Height
 	}
 	return 0
 }
 
-type Pos33MinerFeeRate struct {
+type Pos33TicketList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MinerAddr      string `protobuf:"bytes,1,opt,name=miner_addr,json=minerAddr,proto3" json:"miner_addr,omitempty"`
-	FeeRatePersent int32  `protobuf:"varint,2,opt,name=fee_rate_persent,json=feeRatePersent,proto3" json:"fee_rate_persent,omitempty"`
+	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Status int32  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *Pos33MinerFeeRate) Reset() {
-	*x = Pos33MinerFeeRate{}
+func (x *Pos33TicketList) Reset() {
+	*x = Pos33TicketList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2854,13 +3202,13 @@ func (x *Pos33MinerFeeRate) Reset() {
 	}
 }
 
-func (x *Pos33MinerFeeRate) String() string {
+func (x *Pos33TicketList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33MinerFeeRate) ProtoMessage() {}
+func (*Pos33TicketList) ProtoMessage() {}
 
-func (x *Pos33MinerFeeRate) ProtoReflect() protoreflect.Message {
+func (x *Pos33TicketList) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2872,36 +3220,36 @@ func (x *Pos33MinerFeeRate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33MinerFeeRate.ProtoReflect.Descriptor instead.
-func (*Pos33MinerFeeRate) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33TicketList.ProtoReflect.Descriptor instead.
+func (*Pos33TicketList) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *Pos33MinerFeeRate) GetMinerAddr() string {
+func (x *Pos33TicketList) GetAddr() string {
 	if x != nil {
-		return x.MinerAddr
+		return x.Addr
 	}
 	return ""
 }
 
-func (x *Pos33MinerFeeRate) GetFeeRatePersent() int32 {
+func (x *Pos33TicketList) GetStatus() int32 {
 	if x != nil {
-		return x.FeeRatePersent
+		return x.Status
 	}
 	return 0
 }
 
-// message ReplyBindPos33Miner { string txHex = 1; }
-type ReplyTxHex struct {
+type ReplyPos33TicketReward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxHex string `protobuf:"bytes,1,opt,name=txHex,proto3" json:"txHex,omitempty"`
+	VoterReward int64 `protobuf:"varint,1,opt,name=voter_reward,json=voterReward,proto3" json:"voter_reward,omitempty"`
+	MinerReward int64 `protobuf:"varint,2,opt,name=miner_reward,json=minerReward,proto3" json:"miner_reward,omitempty"`
 }
 
-func (x *ReplyTxHex) Reset() {
-	*x = ReplyTxHex{}
+func (x *ReplyPos33TicketReward) Reset() {
+	*x = ReplyPos33TicketReward{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2909,542 +3257,9516 @@ func (x *ReplyTxHex) Reset() {
 	}
 }
 
-func (x *ReplyTxHex) String() string {
+func (x *ReplyPos33TicketReward) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplyTxHex) ProtoMessage() {}
+func (*ReplyPos33TicketReward) ProtoMessage() {}
 
-func (x *ReplyTxHex) ProtoReflect() protoreflect.Message {
+func (x *ReplyPos33TicketReward) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ReplyTxHex.ProtoReflect.Descriptor instead.
-func (*ReplyTxHex) Descriptor() ([]byte, []int) {
-	return file_pos33_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *ReplyTxHex) GetTxHex() string {
-	if x != nil {
-		return x.TxHex
-	}
-	return ""
-}
-
-type ReplyPos33Info struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Price    int64 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
-	AllCount int64 `protobuf:"varint,2,opt,name=all_count,json=allCount,proto3" json:"all_count,omitempty"`
-}
-
-func (x *ReplyPos33Info) Reset() {
-	*x = ReplyPos33Info{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pos33_proto_msgTypes[44]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ReplyPos33Info) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ReplyPos33Info) ProtoMessage() {}
-
-func (x *ReplyPos33Info) ProtoReflect() protoreflect.Message {
-	mi := &file_pos33_proto_msgTypes[44]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyPos33TicketReward.ProtoReflect.Descriptor instead.
+func (*ReplyPos33TicketReward) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReplyPos33TicketReward) GetVoterReward() int64 {
+	if x != nil {
+		return x.VoterReward
+	}
+	return 0
+}
+
+func (x *ReplyPos33TicketReward) GetMinerReward() int64 {
+	if x != nil {
+		return x.MinerReward
+	}
+	return 0
+}
+
+type ReplyWalletPos33Count struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Privkey []byte `protobuf:"bytes,2,opt,name=privkey,proto3" json:"privkey,omitempty"`
+	Count   int64  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReplyWalletPos33Count) Reset() {
+	*x = ReplyWalletPos33Count{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyWalletPos33Count) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyWalletPos33Count) ProtoMessage() {}
+
+func (x *ReplyWalletPos33Count) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyWalletPos33Count.ProtoReflect.Descriptor instead.
+func (*ReplyWalletPos33Count) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReplyWalletPos33Count) GetPrivkey() []byte {
+	if x != nil {
+		return x.Privkey
+	}
+	return nil
+}
+
+func (x *ReplyWalletPos33Count) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReceiptPos33Deposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr  string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Count int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReceiptPos33Deposit) Reset() {
+	*x = ReceiptPos33Deposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptPos33Deposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptPos33Deposit) ProtoMessage() {}
+
+func (x *ReceiptPos33Deposit) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptPos33Deposit.ProtoReflect.Descriptor instead.
+func (*ReceiptPos33Deposit) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReceiptPos33Deposit) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReceiptPos33Deposit) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReceiptPos33Miner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Reward int64  `protobuf:"varint,3,opt,name=reward,proto3" json:"reward,omitempty"`
+}
+
+func (x *ReceiptPos33Miner) Reset() {
+	*x = ReceiptPos33Miner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptPos33Miner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptPos33Miner) ProtoMessage() {}
+
+func (x *ReceiptPos33Miner) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptPos33Miner.ProtoReflect.Descriptor instead.
+func (*ReceiptPos33Miner) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReceiptPos33Miner) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReceiptPos33Miner) GetReward() int64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+// 作恶的证据: 制作人在同一个高度和轮次做了两个不同的区块,
+// 投票人用同一个抽签投了两个不同的制作人, 或者在同一个高度签了两个不同的 checkpoint
+type Pos33Evidence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ty    int32              `protobuf:"varint,1,opt,name=ty,proto3" json:"ty,omitempty"`
+	Tx1   *types.Transaction `protobuf:"bytes,2,opt,name=tx1,proto3" json:"tx1,omitempty"`
+	Tx2   *types.Transaction `protobuf:"bytes,3,opt,name=tx2,proto3" json:"tx2,omitempty"`
+	Vote1 *Pos33VoteMsg      `protobuf:"bytes,4,opt,name=vote1,proto3" json:"vote1,omitempty"`
+	Vote2 *Pos33VoteMsg      `protobuf:"bytes,5,opt,name=vote2,proto3" json:"vote2,omitempty"`
+	// ForkEpochCommittee 之后, 两个投票的制作人抽签, 证明两个投票是同一个高度和轮次的
+	Maker1 *Pos33SortMsg        `protobuf:"bytes,6,opt,name=maker1,proto3" json:"maker1,omitempty"`
+	Maker2 *Pos33SortMsg        `protobuf:"bytes,7,opt,name=maker2,proto3" json:"maker2,omitempty"`
+	Cp1    *Pos33CheckpointVote `protobuf:"bytes,8,opt,name=cp1,proto3" json:"cp1,omitempty"`
+	Cp2    *Pos33CheckpointVote `protobuf:"bytes,9,opt,name=cp2,proto3" json:"cp2,omitempty"`
+}
+
+func (x *Pos33Evidence) Reset() {
+	*x = Pos33Evidence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Evidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Evidence) ProtoMessage() {}
+
+func (x *Pos33Evidence) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Evidence.ProtoReflect.Descriptor instead.
+func (*Pos33Evidence) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Pos33Evidence) GetTy() int32 {
+	if x != nil {
+		return x.Ty
+	}
+	return 0
+}
+
+func (x *Pos33Evidence) GetTx1() *types.Transaction {
+	if x != nil {
+		return x.Tx1
+	}
+	return nil
+}
+
+func (x *Pos33Evidence) GetTx2() *types.Transaction {
+	if x != nil {
+		return x.Tx2
+	}
+	return nil
+}
+
+func (x *Pos33Evidence) GetVote1() *Pos33VoteMsg {
+	if x != nil {
+		return x.Vote1
+	}
+	return nil
+}
+
+func (x *Pos33Evidence) GetVote2() *Pos33VoteMsg {
+	if x != nil {
+		return x.Vote2
+	}
+	return nil
+}
+
+func (x *Pos33Evidence) GetMaker1() *Pos33SortMsg {
+	if x != nil {
+		return x.Maker1
+	}
+	return nil
+}
+
+func (x *Pos33Evidence) GetMaker2() *Pos33SortMsg {
+	if x != nil {
+		return x.Maker2
+	}
+	return nil
+}
+
+func (x *Pos33Evidence) GetCp1() *Pos33CheckpointVote {
+	if x != nil {
+		return x.Cp1
+	}
+	return nil
+}
+
+func (x *Pos33Evidence) GetCp2() *Pos33CheckpointVote {
+	if x != nil {
+		return x.Cp2
+	}
+	return nil
+}
+
+type ReceiptPos33Slash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Round  int32  `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Amount int64  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// ForkSlashTickets 之后罚没的票数
+	Count int64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReceiptPos33Slash) Reset() {
+	*x = ReceiptPos33Slash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptPos33Slash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptPos33Slash) ProtoMessage() {}
+
+func (x *ReceiptPos33Slash) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptPos33Slash.ProtoReflect.Descriptor instead.
+func (*ReceiptPos33Slash) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReceiptPos33Slash) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReceiptPos33Slash) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Slash) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Slash) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Slash) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// height 高度的区块改变委托以前的抵押
+type Pos33StakeSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    int64           `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Consignee *Pos33Consignee `protobuf:"bytes,2,opt,name=consignee,proto3" json:"consignee,omitempty"`
+}
+
+func (x *Pos33StakeSnapshot) Reset() {
+	*x = Pos33StakeSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33StakeSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33StakeSnapshot) ProtoMessage() {}
+
+func (x *Pos33StakeSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33StakeSnapshot.ProtoReflect.Descriptor instead.
+func (*Pos33StakeSnapshot) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Pos33StakeSnapshot) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33StakeSnapshot) GetConsignee() *Pos33Consignee {
+	if x != nil {
+		return x.Consignee
+	}
+	return nil
+}
+
+// 最近 EvidenceWindow 个区块里委托的变化, 罚没时按作恶时的抵押计算
+type Pos33StakeHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshots []*Pos33StakeSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *Pos33StakeHistory) Reset() {
+	*x = Pos33StakeHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33StakeHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33StakeHistory) ProtoMessage() {}
+
+func (x *Pos33StakeHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33StakeHistory.ProtoReflect.Descriptor instead.
+func (*Pos33StakeHistory) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Pos33StakeHistory) GetSnapshots() []*Pos33StakeSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+// 制作人抽中了但是没有出块的轮次
+type ReceiptPos33Missed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr     string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Height   int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Round    int32  `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	SortHash []byte `protobuf:"bytes,4,opt,name=sortHash,proto3" json:"sortHash,omitempty"`
+}
+
+func (x *ReceiptPos33Missed) Reset() {
+	*x = ReceiptPos33Missed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptPos33Missed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptPos33Missed) ProtoMessage() {}
+
+func (x *ReceiptPos33Missed) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptPos33Missed.ProtoReflect.Descriptor instead.
+func (*ReceiptPos33Missed) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ReceiptPos33Missed) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReceiptPos33Missed) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Missed) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Missed) GetSortHash() []byte {
+	if x != nil {
+		return x.SortHash
+	}
+	return nil
+}
+
+// 区块奖励的分配, 增发的每个币去了哪里
+type ReceiptPos33Reward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height      int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BlockReward int64 `protobuf:"varint,2,opt,name=blockReward,proto3" json:"blockReward,omitempty"`
+	// 制作人和制作人的奖励 (包括制作人分到的小费), tips 是整个区块的小费
+	Maker       string `protobuf:"bytes,3,opt,name=maker,proto3" json:"maker,omitempty"`
+	MakerReward int64  `protobuf:"varint,4,opt,name=makerReward,proto3" json:"makerReward,omitempty"`
+	Tips        int64  `protobuf:"varint,5,opt,name=tips,proto3" json:"tips,omitempty"`
+	// 每一票的投票奖励 (不包括小费), 投票数和迟到的投票数
+	VoteReward int64 `protobuf:"varint,6,opt,name=voteReward,proto3" json:"voteReward,omitempty"`
+	Votes      int32 `protobuf:"varint,7,opt,name=votes,proto3" json:"votes,omitempty"`
+	LateVotes  int32 `protobuf:"varint,8,opt,name=lateVotes,proto3" json:"lateVotes,omitempty"`
+	// 剩下的给基金
+	Fund       string `protobuf:"bytes,9,opt,name=fund,proto3" json:"fund,omitempty"`
+	FundReward int64  `protobuf:"varint,10,opt,name=fundReward,proto3" json:"fundReward,omitempty"`
+	// 这个区块的投票人委员会大小
+	VoterSize int32 `protobuf:"varint,11,opt,name=voterSize,proto3" json:"voterSize,omitempty"`
+	// 每一票分到的小费
+	VoteTip int64 `protobuf:"varint,12,opt,name=voteTip,proto3" json:"voteTip,omitempty"`
+}
+
+func (x *ReceiptPos33Reward) Reset() {
+	*x = ReceiptPos33Reward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptPos33Reward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptPos33Reward) ProtoMessage() {}
+
+func (x *ReceiptPos33Reward) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptPos33Reward.ProtoReflect.Descriptor instead.
+func (*ReceiptPos33Reward) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReceiptPos33Reward) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Reward) GetBlockReward() int64 {
+	if x != nil {
+		return x.BlockReward
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Reward) GetMaker() string {
+	if x != nil {
+		return x.Maker
+	}
+	return ""
+}
+
+func (x *ReceiptPos33Reward) GetMakerReward() int64 {
+	if x != nil {
+		return x.MakerReward
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Reward) GetTips() int64 {
+	if x != nil {
+		return x.Tips
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Reward) GetVoteReward() int64 {
+	if x != nil {
+		return x.VoteReward
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Reward) GetVotes() int32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Reward) GetLateVotes() int32 {
+	if x != nil {
+		return x.LateVotes
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Reward) GetFund() string {
+	if x != nil {
+		return x.Fund
+	}
+	return ""
+}
+
+func (x *ReceiptPos33Reward) GetFundReward() int64 {
+	if x != nil {
+		return x.FundReward
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Reward) GetVoterSize() int32 {
+	if x != nil {
+		return x.VoterSize
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Reward) GetVoteTip() int64 {
+	if x != nil {
+		return x.VoteTip
+	}
+	return 0
+}
+
+// 地址没有出块的统计, recent 是最近的记录
+type Pos33MissedSlots struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr   string                `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Count  int64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Recent []*ReceiptPos33Missed `protobuf:"bytes,3,rep,name=recent,proto3" json:"recent,omitempty"`
+}
+
+func (x *Pos33MissedSlots) Reset() {
+	*x = Pos33MissedSlots{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33MissedSlots) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33MissedSlots) ProtoMessage() {}
+
+func (x *Pos33MissedSlots) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33MissedSlots.ProtoReflect.Descriptor instead.
+func (*Pos33MissedSlots) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Pos33MissedSlots) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33MissedSlots) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Pos33MissedSlots) GetRecent() []*ReceiptPos33Missed {
+	if x != nil {
+		return x.Recent
+	}
+	return nil
+}
+
+type ReceiptPos33TicketBind struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldMinerAddress string `protobuf:"bytes,1,opt,name=oldMinerAddress,proto3" json:"oldMinerAddress,omitempty"`
+	NewMinerAddress string `protobuf:"bytes,2,opt,name=newMinerAddress,proto3" json:"newMinerAddress,omitempty"`
+	ReturnAddress   string `protobuf:"bytes,3,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
+}
+
+func (x *ReceiptPos33TicketBind) Reset() {
+	*x = ReceiptPos33TicketBind{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptPos33TicketBind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptPos33TicketBind) ProtoMessage() {}
+
+func (x *ReceiptPos33TicketBind) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptPos33TicketBind.ProtoReflect.Descriptor instead.
+func (*ReceiptPos33TicketBind) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReceiptPos33TicketBind) GetOldMinerAddress() string {
+	if x != nil {
+		return x.OldMinerAddress
+	}
+	return ""
+}
+
+func (x *ReceiptPos33TicketBind) GetNewMinerAddress() string {
+	if x != nil {
+		return x.NewMinerAddress
+	}
+	return ""
+}
+
+func (x *ReceiptPos33TicketBind) GetReturnAddress() string {
+	if x != nil {
+		return x.ReturnAddress
+	}
+	return ""
+}
+
+type Consignee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Consignee) Reset() {
+	*x = Consignee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Consignee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Consignee) ProtoMessage() {}
+
+func (x *Consignee) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Consignee.ProtoReflect.Descriptor instead.
+func (*Consignee) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Consignee) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Consignee) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type Consignor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount       int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                                 // 委托数量
+	Reward       int64  `protobuf:"varint,3,opt,name=reward,proto3" json:"reward,omitempty"`                                 // 获取奖励的总额
+	RemainReward int64  `protobuf:"varint,4,opt,name=remain_reward,json=remainReward,proto3" json:"remain_reward,omitempty"` // 返给用户后的剩余奖励
+}
+
+func (x *Consignor) Reset() {
+	*x = Consignor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Consignor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Consignor) ProtoMessage() {}
+
+func (x *Consignor) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Consignor.ProtoReflect.Descriptor instead.
+func (*Consignor) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Consignor) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Consignor) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Consignor) GetReward() int64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *Consignor) GetRemainReward() int64 {
+	if x != nil {
+		return x.RemainReward
+	}
+	return 0
+}
+
+// 委托人
+type Pos33Consignor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Consignees []*Consignee `protobuf:"bytes,3,rep,name=consignees,proto3" json:"consignees,omitempty"` // 受托人列表
+}
+
+func (x *Pos33Consignor) Reset() {
+	*x = Pos33Consignor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Consignor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Consignor) ProtoMessage() {}
+
+func (x *Pos33Consignor) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Consignor.ProtoReflect.Descriptor instead.
+func (*Pos33Consignor) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Pos33Consignor) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Pos33Consignor) GetConsignees() []*Consignee {
+	if x != nil {
+		return x.Consignees
+	}
+	return nil
+}
+
+// 受托人
+type Pos33Consignee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address         string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount          int64        `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeeReward       int64        `protobuf:"varint,3,opt,name=fee_reward,json=feeReward,proto3" json:"fee_reward,omitempty"`
+	RemainFeeReward int64        `protobuf:"varint,4,opt,name=remain_fee_reward,json=remainFeeReward,proto3" json:"remain_fee_reward,omitempty"` // 返给后的剩余奖励
+	FeePersent      int64        `protobuf:"varint,5,opt,name=fee_persent,json=feePersent,proto3" json:"fee_persent,omitempty"`
+	Consignors      []*Consignor `protobuf:"bytes,6,rep,name=consignors,proto3" json:"consignors,omitempty"` // 委托人列表
+	// ForkDelegation 之后提高的佣金在 fee_active_height 生效
+	PendingFeePersent int64 `protobuf:"varint,7,opt,name=pending_fee_persent,json=pendingFeePersent,proto3" json:"pending_fee_persent,omitempty"`
+	FeeActiveHeight   int64 `protobuf:"varint,8,opt,name=fee_active_height,json=feeActiveHeight,proto3" json:"fee_active_height,omitempty"`
+}
+
+func (x *Pos33Consignee) Reset() {
+	*x = Pos33Consignee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Consignee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Consignee) ProtoMessage() {}
+
+func (x *Pos33Consignee) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Consignee.ProtoReflect.Descriptor instead.
+func (*Pos33Consignee) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Pos33Consignee) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Pos33Consignee) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Pos33Consignee) GetFeeReward() int64 {
+	if x != nil {
+		return x.FeeReward
+	}
+	return 0
+}
+
+func (x *Pos33Consignee) GetRemainFeeReward() int64 {
+	if x != nil {
+		return x.RemainFeeReward
+	}
+	return 0
+}
+
+func (x *Pos33Consignee) GetFeePersent() int64 {
+	if x != nil {
+		return x.FeePersent
+	}
+	return 0
+}
+
+func (x *Pos33Consignee) GetConsignors() []*Consignor {
+	if x != nil {
+		return x.Consignors
+	}
+	return nil
+}
+
+func (x *Pos33Consignee) GetPendingFeePersent() int64 {
+	if x != nil {
+		return x.PendingFeePersent
+	}
+	return 0
+}
+
+func (x *Pos33Consignee) GetFeeActiveHeight() int64 {
+	if x != nil {
+		return x.FeeActiveHeight
+	}
+	return 0
+}
+
+type Pos33Entrust struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Consignee string `protobuf:"bytes,1,opt,name=consignee,proto3" json:"consignee,omitempty"`
+	Consignor string `protobuf:"bytes,2,opt,name=consignor,proto3" json:"consignor,omitempty"`
+	Amount    int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Pos33Entrust) Reset() {
+	*x = Pos33Entrust{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Entrust) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Entrust) ProtoMessage() {}
+
+func (x *Pos33Entrust) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Entrust.ProtoReflect.Descriptor instead.
+func (*Pos33Entrust) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Pos33Entrust) GetConsignee() string {
+	if x != nil {
+		return x.Consignee
+	}
+	return ""
+}
+
+func (x *Pos33Entrust) GetConsignor() string {
+	if x != nil {
+		return x.Consignor
+	}
+	return ""
+}
+
+func (x *Pos33Entrust) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 委托给登记过的矿工 (operator), 矿工用委托的总数抽签
+type Pos33Delegate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	Amount   int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Pos33Delegate) Reset() {
+	*x = Pos33Delegate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Delegate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Delegate) ProtoMessage() {}
+
+func (x *Pos33Delegate) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Delegate.ProtoReflect.Descriptor instead.
+func (*Pos33Delegate) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Pos33Delegate) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Pos33Delegate) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type Pos33Undelegate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	Amount   int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Pos33Undelegate) Reset() {
+	*x = Pos33Undelegate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Undelegate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Undelegate) ProtoMessage() {}
+
+func (x *Pos33Undelegate) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Undelegate.ProtoReflect.Descriptor instead.
+func (*Pos33Undelegate) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Pos33Undelegate) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Pos33Undelegate) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 矿工登记和设置佣金, 委托人的奖励按这个百分比分给矿工
+type Pos33SetCommission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commission int64 `protobuf:"varint,1,opt,name=commission,proto3" json:"commission,omitempty"`
+}
+
+func (x *Pos33SetCommission) Reset() {
+	*x = Pos33SetCommission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33SetCommission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33SetCommission) ProtoMessage() {}
+
+func (x *Pos33SetCommission) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33SetCommission.ProtoReflect.Descriptor instead.
+func (*Pos33SetCommission) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Pos33SetCommission) GetCommission() int64 {
+	if x != nil {
+		return x.Commission
+	}
+	return 0
+}
+
+type Pos33Operator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// 最后设置的佣金, 提高的佣金要等到 consignee 的 fee_active_height 才生效
+	Commission int64 `protobuf:"varint,2,opt,name=commission,proto3" json:"commission,omitempty"`
+	// 登记的高度
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *Pos33Operator) Reset() {
+	*x = Pos33Operator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Operator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Operator) ProtoMessage() {}
+
+func (x *Pos33Operator) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Operator.ProtoReflect.Descriptor instead.
+func (*Pos33Operator) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Pos33Operator) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Pos33Operator) GetCommission() int64 {
+	if x != nil {
+		return x.Commission
+	}
+	return 0
+}
+
+func (x *Pos33Operator) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// 打开以后, 奖励转到 pos33 执行器下面的账户, 够一张票的价格就自动委托给产生奖励的矿工
+type Pos33SetCompound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	On bool `protobuf:"varint,1,opt,name=on,proto3" json:"on,omitempty"`
+}
+
+func (x *Pos33SetCompound) Reset() {
+	*x = Pos33SetCompound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33SetCompound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33SetCompound) ProtoMessage() {}
+
+func (x *Pos33SetCompound) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33SetCompound.ProtoReflect.Descriptor instead.
+func (*Pos33SetCompound) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Pos33SetCompound) GetOn() bool {
+	if x != nil {
+		return x.On
+	}
+	return false
+}
+
+type Pos33Compound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	On      bool   `protobuf:"varint,2,opt,name=on,proto3" json:"on,omitempty"`
+	// 累计自动委托的数量
+	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 成熟以后存到执行器账户, 还没有复投的奖励
+	Pending int64 `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (x *Pos33Compound) Reset() {
+	*x = Pos33Compound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Compound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Compound) ProtoMessage() {}
+
+func (x *Pos33Compound) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Compound.ProtoReflect.Descriptor instead.
+func (*Pos33Compound) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Pos33Compound) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Pos33Compound) GetOn() bool {
+	if x != nil {
+		return x.On
+	}
+	return false
+}
+
+func (x *Pos33Compound) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Pos33Compound) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+type Pos33Migrate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Miner string `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+}
+
+func (x *Pos33Migrate) Reset() {
+	*x = Pos33Migrate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Migrate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Migrate) ProtoMessage() {}
+
+func (x *Pos33Migrate) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Migrate.ProtoReflect.Descriptor instead.
+func (*Pos33Migrate) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Pos33Migrate) GetMiner() string {
+	if x != nil {
+		return x.Miner
+	}
+	return ""
+}
+
+type Pos33BlsBind struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlsAddr   string `protobuf:"bytes,1,opt,name=BlsAddr,proto3" json:"BlsAddr,omitempty"`
+	MinerAddr string `protobuf:"bytes,2,opt,name=MinerAddr,proto3" json:"MinerAddr,omitempty"`
+}
+
+func (x *Pos33BlsBind) Reset() {
+	*x = Pos33BlsBind{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33BlsBind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33BlsBind) ProtoMessage() {}
+
+func (x *Pos33BlsBind) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33BlsBind.ProtoReflect.Descriptor instead.
+func (*Pos33BlsBind) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Pos33BlsBind) GetBlsAddr() string {
+	if x != nil {
+		return x.BlsAddr
+	}
+	return ""
+}
+
+func (x *Pos33BlsBind) GetMinerAddr() string {
+	if x != nil {
+		return x.MinerAddr
+	}
+	return ""
+}
+
+// 矿工的公开信息, addr 和 height 由执行器填写
+type Pos33MinerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Website     string `protobuf:"bytes,2,opt,name=website,proto3" json:"website,omitempty"`
+	ContactHash []byte `protobuf:"bytes,3,opt,name=contact_hash,json=contactHash,proto3" json:"contact_hash,omitempty"`
+	Addr        string `protobuf:"bytes,4,opt,name=addr,proto3" json:"addr,omitempty"`
+	Height      int64  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *Pos33MinerInfo) Reset() {
+	*x = Pos33MinerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33MinerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33MinerInfo) ProtoMessage() {}
+
+func (x *Pos33MinerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33MinerInfo.ProtoReflect.Descriptor instead.
+func (*Pos33MinerInfo) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Pos33MinerInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pos33MinerInfo) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *Pos33MinerInfo) GetContactHash() []byte {
+	if x != nil {
+		return x.ContactHash
+	}
+	return nil
+}
+
+func (x *Pos33MinerInfo) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33MinerInfo) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type ReqBindPos33Miner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BindAddr     string `protobuf:"bytes,1,opt,name=bindAddr,proto3" json:"bindAddr,omitempty"`
+	OriginAddr   string `protobuf:"bytes,2,opt,name=originAddr,proto3" json:"originAddr,omitempty"`
+	Amount       int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	CheckBalance bool   `protobuf:"varint,4,opt,name=checkBalance,proto3" json:"checkBalance,omitempty"`
+}
+
+func (x *ReqBindPos33Miner) Reset() {
+	*x = ReqBindPos33Miner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqBindPos33Miner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqBindPos33Miner) ProtoMessage() {}
+
+func (x *ReqBindPos33Miner) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqBindPos33Miner.ProtoReflect.Descriptor instead.
+func (*ReqBindPos33Miner) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ReqBindPos33Miner) GetBindAddr() string {
+	if x != nil {
+		return x.BindAddr
+	}
+	return ""
+}
+
+func (x *ReqBindPos33Miner) GetOriginAddr() string {
+	if x != nil {
+		return x.OriginAddr
+	}
+	return ""
+}
+
+func (x *ReqBindPos33Miner) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReqBindPos33Miner) GetCheckBalance() bool {
+	if x != nil {
+		return x.CheckBalance
+	}
+	return false
+}
+
+type Pos33WithdrawReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Consignee string `protobuf:"bytes,1,opt,name=consignee,proto3" json:"consignee,omitempty"`
+	Consignor string `protobuf:"bytes,2,opt,name=consignor,proto3" json:"consignor,omitempty"`
+	Amount    int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Pos33WithdrawReward) Reset() {
+	*x = Pos33WithdrawReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33WithdrawReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33WithdrawReward) ProtoMessage() {}
+
+func (x *Pos33WithdrawReward) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33WithdrawReward.ProtoReflect.Descriptor instead.
+func (*Pos33WithdrawReward) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Pos33WithdrawReward) GetConsignee() string {
+	if x != nil {
+		return x.Consignee
+	}
+	return ""
+}
+
+func (x *Pos33WithdrawReward) GetConsignor() string {
+	if x != nil {
+		return x.Consignor
+	}
+	return ""
+}
+
+func (x *Pos33WithdrawReward) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type Pos33MinerFeeRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinerAddr      string `protobuf:"bytes,1,opt,name=miner_addr,json=minerAddr,proto3" json:"miner_addr,omitempty"`
+	FeeRatePersent int32  `protobuf:"varint,2,opt,name=fee_rate_persent,json=feeRatePersent,proto3" json:"fee_rate_persent,omitempty"`
+}
+
+func (x *Pos33MinerFeeRate) Reset() {
+	*x = Pos33MinerFeeRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33MinerFeeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33MinerFeeRate) ProtoMessage() {}
+
+func (x *Pos33MinerFeeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33MinerFeeRate.ProtoReflect.Descriptor instead.
+func (*Pos33MinerFeeRate) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Pos33MinerFeeRate) GetMinerAddr() string {
+	if x != nil {
+		return x.MinerAddr
+	}
+	return ""
+}
+
+func (x *Pos33MinerFeeRate) GetFeeRatePersent() int32 {
+	if x != nil {
+		return x.FeeRatePersent
+	}
+	return 0
+}
+
+// message ReplyBindPos33Miner { string txHex = 1; }
+type ReplyTxHex struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHex string `protobuf:"bytes,1,opt,name=txHex,proto3" json:"txHex,omitempty"`
+}
+
+func (x *ReplyTxHex) Reset() {
+	*x = ReplyTxHex{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyTxHex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyTxHex) ProtoMessage() {}
+
+func (x *ReplyTxHex) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyTxHex.ProtoReflect.Descriptor instead.
+func (*ReplyTxHex) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ReplyTxHex) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
+
+type ReplyPos33Info struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price    int64 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	AllCount int64 `protobuf:"varint,2,opt,name=all_count,json=allCount,proto3" json:"all_count,omitempty"`
+}
+
+func (x *ReplyPos33Info) Reset() {
+	*x = ReplyPos33Info{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyPos33Info) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyPos33Info) ProtoMessage() {}
+
+func (x *ReplyPos33Info) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyPos33Info.ProtoReflect.Descriptor instead.
+func (*ReplyPos33Info) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ReplyPos33Info) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ReplyPos33Info) GetAllCount() int64 {
+	if x != nil {
+		return x.AllCount
+	}
+	return 0
+}
+
+// 网络公告, 由 manage 的 superManager 签名
+type Pos33Advisory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// 相关的区块高度, 比如升级的高度
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time   int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	// 过期时间(unix 秒), 0 表示不过期
+	Expire int64            `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"`
+	Sig    *types.Signature `protobuf:"bytes,5,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (x *Pos33Advisory) Reset() {
+	*x = Pos33Advisory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Advisory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Advisory) ProtoMessage() {}
+
+func (x *Pos33Advisory) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Advisory.ProtoReflect.Descriptor instead.
+func (*Pos33Advisory) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Pos33Advisory) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Pos33Advisory) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33Advisory) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Pos33Advisory) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+func (x *Pos33Advisory) GetSig() *types.Signature {
+	if x != nil {
+		return x.Sig
+	}
+	return nil
+}
+
+type Pos33Advisories struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Pos33Advisory `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *Pos33Advisories) Reset() {
+	*x = Pos33Advisories{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Advisories) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Advisories) ProtoMessage() {}
+
+func (x *Pos33Advisories) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Advisories.ProtoReflect.Descriptor instead.
+func (*Pos33Advisories) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Pos33Advisories) GetItems() []*Pos33Advisory {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 共识消息验证失败太多被禁止的发送者
+type Pos33BannedPeer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr  string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Fails int32  `protobuf:"varint,2,opt,name=fails,proto3" json:"fails,omitempty"`
+	Total int32  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// 解禁的时间(unix 秒)
+	Until int64 `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (x *Pos33BannedPeer) Reset() {
+	*x = Pos33BannedPeer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33BannedPeer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33BannedPeer) ProtoMessage() {}
+
+func (x *Pos33BannedPeer) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33BannedPeer.ProtoReflect.Descriptor instead.
+func (*Pos33BannedPeer) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Pos33BannedPeer) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33BannedPeer) GetFails() int32 {
+	if x != nil {
+		return x.Fails
+	}
+	return 0
+}
+
+func (x *Pos33BannedPeer) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Pos33BannedPeer) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+type Pos33BannedPeers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Pos33BannedPeer `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *Pos33BannedPeers) Reset() {
+	*x = Pos33BannedPeers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33BannedPeers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33BannedPeers) ProtoMessage() {}
+
+func (x *Pos33BannedPeers) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33BannedPeers.ProtoReflect.Descriptor instead.
+func (*Pos33BannedPeers) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Pos33BannedPeers) GetItems() []*Pos33BannedPeer {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 节点当前的共识状态, 用来调试卡住的高度
+type Pos33ConsensusState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 正在出块的高度和轮次
+	Height     int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round      int32 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	LastHeight int64 `protobuf:"varint,3,opt,name=lastHeight,proto3" json:"lastHeight,omitempty"`
+	Synced     bool  `protobuf:"varint,4,opt,name=synced,proto3" json:"synced,omitempty"`
+	// 热备模式下是否工作
+	Active bool `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	// 本轮是否抽中制作人, 我的制作人抽签收到的投票数
+	IsMaker    bool  `protobuf:"varint,6,opt,name=isMaker,proto3" json:"isMaker,omitempty"`
+	MakerVotes int32 `protobuf:"varint,7,opt,name=makerVotes,proto3" json:"makerVotes,omitempty"`
+	// 本轮收到投票最多的制作人的投票数
+	BestMakerVotes int32 `protobuf:"varint,8,opt,name=bestMakerVotes,proto3" json:"bestMakerVotes,omitempty"`
+	// 我在本轮的投票人抽签数
+	VoterSorts int32 `protobuf:"varint,9,opt,name=voterSorts,proto3" json:"voterSorts,omitempty"`
+	// 等待的定时器: next(下一个区块), block(出块超时), resort(重新抽签), 到期时间(unix 毫秒)
+	Timer         string `protobuf:"bytes,10,opt,name=timer,proto3" json:"timer,omitempty"`
+	TimerDeadline int64  `protobuf:"varint,11,opt,name=timerDeadline,proto3" json:"timerDeadline,omitempty"`
+	LastError     string `protobuf:"bytes,12,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	LastErrorTime int64  `protobuf:"varint,13,opt,name=lastErrorTime,proto3" json:"lastErrorTime,omitempty"`
+	// 主循环没有在 1 秒内响应, 只有定时器和错误是准确的
+	LoopBlocked bool `protobuf:"varint,14,opt,name=loopBlocked,proto3" json:"loopBlocked,omitempty"`
+	// 挖矿被 SetPos33MinerPause 暂停
+	Paused bool `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (x *Pos33ConsensusState) Reset() {
+	*x = Pos33ConsensusState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33ConsensusState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33ConsensusState) ProtoMessage() {}
+
+func (x *Pos33ConsensusState) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33ConsensusState.ProtoReflect.Descriptor instead.
+func (*Pos33ConsensusState) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Pos33ConsensusState) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33ConsensusState) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Pos33ConsensusState) GetLastHeight() int64 {
+	if x != nil {
+		return x.LastHeight
+	}
+	return 0
+}
+
+func (x *Pos33ConsensusState) GetSynced() bool {
+	if x != nil {
+		return x.Synced
+	}
+	return false
+}
+
+func (x *Pos33ConsensusState) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Pos33ConsensusState) GetIsMaker() bool {
+	if x != nil {
+		return x.IsMaker
+	}
+	return false
+}
+
+func (x *Pos33ConsensusState) GetMakerVotes() int32 {
+	if x != nil {
+		return x.MakerVotes
+	}
+	return 0
+}
+
+func (x *Pos33ConsensusState) GetBestMakerVotes() int32 {
+	if x != nil {
+		return x.BestMakerVotes
+	}
+	return 0
+}
+
+func (x *Pos33ConsensusState) GetVoterSorts() int32 {
+	if x != nil {
+		return x.VoterSorts
+	}
+	return 0
+}
+
+func (x *Pos33ConsensusState) GetTimer() string {
+	if x != nil {
+		return x.Timer
+	}
+	return ""
+}
+
+func (x *Pos33ConsensusState) GetTimerDeadline() int64 {
+	if x != nil {
+		return x.TimerDeadline
+	}
+	return 0
+}
+
+func (x *Pos33ConsensusState) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Pos33ConsensusState) GetLastErrorTime() int64 {
+	if x != nil {
+		return x.LastErrorTime
+	}
+	return 0
+}
+
+func (x *Pos33ConsensusState) GetLoopBlocked() bool {
+	if x != nil {
+		return x.LoopBlocked
+	}
+	return false
+}
+
+func (x *Pos33ConsensusState) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+// 本节点一个挖矿地址的状态, 监控系统定时查询判断验证者是否正常
+type Pos33ValidatorStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// 抽签高度上可以用的票数, 大于 0 才能参加抽签
+	Tickets  int64 `protobuf:"varint,2,opt,name=tickets,proto3" json:"tickets,omitempty"`
+	Eligible bool  `protobuf:"varint,3,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	// 最近出块和投票进区块的高度和时间(unix 秒), 节点启动以后统计, 0 表示没有
+	LastMadeHeight  int64 `protobuf:"varint,4,opt,name=lastMadeHeight,proto3" json:"lastMadeHeight,omitempty"`
+	LastMadeTime    int64 `protobuf:"varint,5,opt,name=lastMadeTime,proto3" json:"lastMadeTime,omitempty"`
+	LastVotedHeight int64 `protobuf:"varint,6,opt,name=lastVotedHeight,proto3" json:"lastVotedHeight,omitempty"`
+	LastVotedTime   int64 `protobuf:"varint,7,opt,name=lastVotedTime,proto3" json:"lastVotedTime,omitempty"`
+}
+
+func (x *Pos33ValidatorStatus) Reset() {
+	*x = Pos33ValidatorStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33ValidatorStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33ValidatorStatus) ProtoMessage() {}
+
+func (x *Pos33ValidatorStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33ValidatorStatus.ProtoReflect.Descriptor instead.
+func (*Pos33ValidatorStatus) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Pos33ValidatorStatus) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33ValidatorStatus) GetTickets() int64 {
+	if x != nil {
+		return x.Tickets
+	}
+	return 0
+}
+
+func (x *Pos33ValidatorStatus) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
+}
+
+func (x *Pos33ValidatorStatus) GetLastMadeHeight() int64 {
+	if x != nil {
+		return x.LastMadeHeight
+	}
+	return 0
+}
+
+func (x *Pos33ValidatorStatus) GetLastMadeTime() int64 {
+	if x != nil {
+		return x.LastMadeTime
+	}
+	return 0
+}
+
+func (x *Pos33ValidatorStatus) GetLastVotedHeight() int64 {
+	if x != nil {
+		return x.LastVotedHeight
+	}
+	return 0
+}
+
+func (x *Pos33ValidatorStatus) GetLastVotedTime() int64 {
+	if x != nil {
+		return x.LastVotedTime
+	}
+	return 0
+}
+
+type Pos33ValidatorStatuses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// 同步到可以投票的高度
+	Synced bool `protobuf:"varint,2,opt,name=synced,proto3" json:"synced,omitempty"`
+	// 有挖矿私钥
+	HasKey bool `protobuf:"varint,3,opt,name=hasKey,proto3" json:"hasKey,omitempty"`
+	// 没有暂停挖矿, 热备模式下是活动节点
+	Active bool `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	// 有私钥, 有票, 已同步并且在工作
+	Healthy bool                    `protobuf:"varint,5,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Items   []*Pos33ValidatorStatus `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *Pos33ValidatorStatuses) Reset() {
+	*x = Pos33ValidatorStatuses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33ValidatorStatuses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33ValidatorStatuses) ProtoMessage() {}
+
+func (x *Pos33ValidatorStatuses) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33ValidatorStatuses.ProtoReflect.Descriptor instead.
+func (*Pos33ValidatorStatuses) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Pos33ValidatorStatuses) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33ValidatorStatuses) GetSynced() bool {
+	if x != nil {
+		return x.Synced
+	}
+	return false
+}
+
+func (x *Pos33ValidatorStatuses) GetHasKey() bool {
+	if x != nil {
+		return x.HasKey
+	}
+	return false
+}
+
+func (x *Pos33ValidatorStatuses) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Pos33ValidatorStatuses) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *Pos33ValidatorStatuses) GetItems() []*Pos33ValidatorStatus {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 运行时暂停或者恢复挖矿, 只有管理员可以调用
+type ReqPos33MinerPause struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminToken string `protobuf:"bytes,1,opt,name=adminToken,proto3" json:"adminToken,omitempty"`
+	Pause      bool   `protobuf:"varint,2,opt,name=pause,proto3" json:"pause,omitempty"`
+}
+
+func (x *ReqPos33MinerPause) Reset() {
+	*x = ReqPos33MinerPause{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33MinerPause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33MinerPause) ProtoMessage() {}
+
+func (x *ReqPos33MinerPause) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33MinerPause.ProtoReflect.Descriptor instead.
+func (*ReqPos33MinerPause) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ReqPos33MinerPause) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+func (x *ReqPos33MinerPause) GetPause() bool {
+	if x != nil {
+		return x.Pause
+	}
+	return false
+}
+
+// 区块的制作人或者投票人
+type Pos33CommitteeMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// 制作人是 secp256k1 公钥, 投票人是 bls 公钥
+	Pubkey []byte `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// 制作人抽签的票号和 hash, 区块里没有投票人的抽签
+	Index    int64  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	SortHash []byte `protobuf:"bytes,4,opt,name=sortHash,proto3" json:"sortHash,omitempty"`
+	// 投票人在区块里的票数
+	Votes int32 `protobuf:"varint,5,opt,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (x *Pos33CommitteeMember) Reset() {
+	*x = Pos33CommitteeMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33CommitteeMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33CommitteeMember) ProtoMessage() {}
+
+func (x *Pos33CommitteeMember) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33CommitteeMember.ProtoReflect.Descriptor instead.
+func (*Pos33CommitteeMember) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Pos33CommitteeMember) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33CommitteeMember) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *Pos33CommitteeMember) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Pos33CommitteeMember) GetSortHash() []byte {
+	if x != nil {
+		return x.SortHash
+	}
+	return nil
+}
+
+func (x *Pos33CommitteeMember) GetVotes() int32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+// 从链上区块恢复的某个高度的制作人和投票人
+type Pos33Committee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64                   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round  int32                   `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Maker  *Pos33CommitteeMember   `protobuf:"bytes,3,opt,name=maker,proto3" json:"maker,omitempty"`
+	Voters []*Pos33CommitteeMember `protobuf:"bytes,4,rep,name=voters,proto3" json:"voters,omitempty"`
+}
+
+func (x *Pos33Committee) Reset() {
+	*x = Pos33Committee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Committee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Committee) ProtoMessage() {}
+
+func (x *Pos33Committee) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Committee.ProtoReflect.Descriptor instead.
+func (*Pos33Committee) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Pos33Committee) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33Committee) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Pos33Committee) GetMaker() *Pos33CommitteeMember {
+	if x != nil {
+		return x.Maker
+	}
+	return nil
+}
+
+func (x *Pos33Committee) GetVoters() []*Pos33CommitteeMember {
+	if x != nil {
+		return x.Voters
+	}
+	return nil
+}
+
+// 手机钱包注册推送: 地址收到转账或者地址的矿工被罚没时通知设备, 用地址的私钥签名
+type Pos33PushDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// fcm 或者 apns 的设备 token
+	Token    string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Platform string `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	// 签名时间(unix 秒), 和节点时间相差不能超过 10 分钟
+	Time int64 `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	// true 表示取消注册
+	Remove bool             `protobuf:"varint,5,opt,name=remove,proto3" json:"remove,omitempty"`
+	Sig    *types.Signature `protobuf:"bytes,6,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (x *Pos33PushDevice) Reset() {
+	*x = Pos33PushDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33PushDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33PushDevice) ProtoMessage() {}
+
+func (x *Pos33PushDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33PushDevice.ProtoReflect.Descriptor instead.
+func (*Pos33PushDevice) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Pos33PushDevice) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33PushDevice) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Pos33PushDevice) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *Pos33PushDevice) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Pos33PushDevice) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+func (x *Pos33PushDevice) GetSig() *types.Signature {
+	if x != nil {
+		return x.Sig
+	}
+	return nil
+}
+
+// 一个地址在某个 round 的抽签
+type Pos33SortAuditItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr     string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Round    int32  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Num      int32  `protobuf:"varint,3,opt,name=num,proto3" json:"num,omitempty"`
+	Count    int32  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Selected bool   `protobuf:"varint,5,opt,name=selected,proto3" json:"selected,omitempty"`
+}
+
+func (x *Pos33SortAuditItem) Reset() {
+	*x = Pos33SortAuditItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33SortAuditItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33SortAuditItem) ProtoMessage() {}
+
+func (x *Pos33SortAuditItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33SortAuditItem.ProtoReflect.Descriptor instead.
+func (*Pos33SortAuditItem) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Pos33SortAuditItem) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33SortAuditItem) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Pos33SortAuditItem) GetNum() int32 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+func (x *Pos33SortAuditItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Pos33SortAuditItem) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+// 本节点看到的某个高度的抽签结果
+type Pos33SortAudit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Maker  string                `protobuf:"bytes,2,opt,name=maker,proto3" json:"maker,omitempty"`
+	Round  int32                 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Makers []*Pos33SortAuditItem `protobuf:"bytes,4,rep,name=makers,proto3" json:"makers,omitempty"`
+	Voters []*Pos33SortAuditItem `protobuf:"bytes,5,rep,name=voters,proto3" json:"voters,omitempty"`
+}
+
+func (x *Pos33SortAudit) Reset() {
+	*x = Pos33SortAudit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33SortAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33SortAudit) ProtoMessage() {}
+
+func (x *Pos33SortAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33SortAudit.ProtoReflect.Descriptor instead.
+func (*Pos33SortAudit) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *Pos33SortAudit) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33SortAudit) GetMaker() string {
+	if x != nil {
+		return x.Maker
+	}
+	return ""
+}
+
+func (x *Pos33SortAudit) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Pos33SortAudit) GetMakers() []*Pos33SortAuditItem {
+	if x != nil {
+		return x.Makers
+	}
+	return nil
+}
+
+func (x *Pos33SortAudit) GetVoters() []*Pos33SortAuditItem {
+	if x != nil {
+		return x.Voters
+	}
+	return nil
+}
+
+// 本节点看到的地址在 [from, to] 高度的参与情况: 出块数和应该出块的轮次数(抽签 hash 最小的制作人),
+// 区块里的投票数和选进委员会的票数
+type Pos33Participation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr     string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	From     int64  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To       int64  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	Made     int64  `protobuf:"varint,4,opt,name=made,proto3" json:"made,omitempty"`
+	Expected int64  `protobuf:"varint,5,opt,name=expected,proto3" json:"expected,omitempty"`
+	Cast     int64  `protobuf:"varint,6,opt,name=cast,proto3" json:"cast,omitempty"`
+	Elected  int64  `protobuf:"varint,7,opt,name=elected,proto3" json:"elected,omitempty"`
+}
+
+func (x *Pos33Participation) Reset() {
+	*x = Pos33Participation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Participation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Participation) ProtoMessage() {}
+
+func (x *Pos33Participation) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Participation.ProtoReflect.Descriptor instead.
+func (*Pos33Participation) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *Pos33Participation) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33Participation) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *Pos33Participation) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *Pos33Participation) GetMade() int64 {
+	if x != nil {
+		return x.Made
+	}
+	return 0
+}
+
+func (x *Pos33Participation) GetExpected() int64 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
+}
+
+func (x *Pos33Participation) GetCast() int64 {
+	if x != nil {
+		return x.Cast
+	}
+	return 0
+}
+
+func (x *Pos33Participation) GetElected() int64 {
+	if x != nil {
+		return x.Elected
+	}
+	return 0
+}
+
+type ReqPos33Participation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// 最近多少个区块, 0 使用节点配置的 participationWindow
+	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (x *ReqPos33Participation) Reset() {
+	*x = ReqPos33Participation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33Participation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33Participation) ProtoMessage() {}
+
+func (x *ReqPos33Participation) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33Participation.ProtoReflect.Descriptor instead.
+func (*ReqPos33Participation) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ReqPos33Participation) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReqPos33Participation) GetWindow() int64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+// 验证者之间的遥测心跳, 由挖矿私钥签名
+type Pos33Telemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 发送者的最新区块高度
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// 发送时间(unix 毫秒)
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// 发送者连接的 peer 数
+	Peers int32            `protobuf:"varint,3,opt,name=peers,proto3" json:"peers,omitempty"`
+	Sig   *types.Signature `protobuf:"bytes,4,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (x *Pos33Telemetry) Reset() {
+	*x = Pos33Telemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Telemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Telemetry) ProtoMessage() {}
+
+func (x *Pos33Telemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Telemetry.ProtoReflect.Descriptor instead.
+func (*Pos33Telemetry) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Pos33Telemetry) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33Telemetry) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Pos33Telemetry) GetPeers() int32 {
+	if x != nil {
+		return x.Peers
+	}
+	return 0
+}
+
+func (x *Pos33Telemetry) GetSig() *types.Signature {
+	if x != nil {
+		return x.Sig
+	}
+	return nil
+}
+
+// 挖矿私钥对节点网络身份公钥的签名声明, 网络身份和挖矿私钥分开, 网络身份泄露不会暴露挖矿私钥
+type Pos33NodeIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 节点网络身份的 secp256k1 公钥
+	NodePub []byte `protobuf:"bytes,1,opt,name=nodePub,proto3" json:"nodePub,omitempty"`
+	// 签名时间(unix 秒), 同一个挖矿公钥使用最新的声明
+	Time int64            `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Sig  *types.Signature `protobuf:"bytes,3,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (x *Pos33NodeIdentity) Reset() {
+	*x = Pos33NodeIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33NodeIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33NodeIdentity) ProtoMessage() {}
+
+func (x *Pos33NodeIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33NodeIdentity.ProtoReflect.Descriptor instead.
+func (*Pos33NodeIdentity) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Pos33NodeIdentity) GetNodePub() []byte {
+	if x != nil {
+		return x.NodePub
+	}
+	return nil
+}
+
+func (x *Pos33NodeIdentity) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Pos33NodeIdentity) GetSig() *types.Signature {
+	if x != nil {
+		return x.Sig
+	}
+	return nil
+}
+
+// 本节点收到的一个验证者的心跳
+type Pos33Liveness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// 最近一次收到心跳的时间(unix 秒)
+	LastSeen int64 `protobuf:"varint,3,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	// 心跳从发送到收到的毫秒数, 包含两边的时钟误差
+	Delay int64 `protobuf:"varint,4,opt,name=delay,proto3" json:"delay,omitempty"`
+	Peers int32 `protobuf:"varint,5,opt,name=peers,proto3" json:"peers,omitempty"`
+	Alive bool  `protobuf:"varint,6,opt,name=alive,proto3" json:"alive,omitempty"`
+}
+
+func (x *Pos33Liveness) Reset() {
+	*x = Pos33Liveness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Liveness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Liveness) ProtoMessage() {}
+
+func (x *Pos33Liveness) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Liveness.ProtoReflect.Descriptor instead.
+func (*Pos33Liveness) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *Pos33Liveness) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33Liveness) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33Liveness) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *Pos33Liveness) GetDelay() int64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *Pos33Liveness) GetPeers() int32 {
+	if x != nil {
+		return x.Peers
+	}
+	return 0
+}
+
+func (x *Pos33Liveness) GetAlive() bool {
+	if x != nil {
+		return x.Alive
+	}
+	return false
+}
+
+// 本节点看到的验证者在线情况. status: ok; partitioned 收不到 2/3 验证者的心跳, 是本节点的网络问题;
+// stalled 能收到大部分验证者的心跳, 但是很久没有新的区块, 是整个网络的问题
+type Pos33LivenessMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64            `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Peers  int32            `protobuf:"varint,2,opt,name=peers,proto3" json:"peers,omitempty"`
+	Alive  int32            `protobuf:"varint,3,opt,name=alive,proto3" json:"alive,omitempty"`
+	Total  int32            `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Status string           `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Items  []*Pos33Liveness `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *Pos33LivenessMap) Reset() {
+	*x = Pos33LivenessMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33LivenessMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33LivenessMap) ProtoMessage() {}
+
+func (x *Pos33LivenessMap) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33LivenessMap.ProtoReflect.Descriptor instead.
+func (*Pos33LivenessMap) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *Pos33LivenessMap) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33LivenessMap) GetPeers() int32 {
+	if x != nil {
+		return x.Peers
+	}
+	return 0
+}
+
+func (x *Pos33LivenessMap) GetAlive() int32 {
+	if x != nil {
+		return x.Alive
+	}
+	return 0
+}
+
+func (x *Pos33LivenessMap) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Pos33LivenessMap) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Pos33LivenessMap) GetItems() []*Pos33Liveness {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 试打包区块, 只组装不执行也不广播
+type ReqPos33DryRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminToken string `protobuf:"bytes,1,opt,name=adminToken,proto3" json:"adminToken,omitempty"`
+}
+
+func (x *ReqPos33DryRun) Reset() {
+	*x = ReqPos33DryRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33DryRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33DryRun) ProtoMessage() {}
+
+func (x *ReqPos33DryRun) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33DryRun.ProtoReflect.Descriptor instead.
+func (*ReqPos33DryRun) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ReqPos33DryRun) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+// 交易池里一个交易的打包结果
+type Pos33DryRunTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash   string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	From   string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Fee    int64  `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	Packed bool   `protobuf:"varint,4,opt,name=packed,proto3" json:"packed,omitempty"`
+	// 没有打包的原因
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *Pos33DryRunTx) Reset() {
+	*x = Pos33DryRunTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33DryRunTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33DryRunTx) ProtoMessage() {}
+
+func (x *Pos33DryRunTx) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33DryRunTx.ProtoReflect.Descriptor instead.
+func (*Pos33DryRunTx) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *Pos33DryRunTx) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Pos33DryRunTx) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Pos33DryRunTx) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *Pos33DryRunTx) GetPacked() bool {
+	if x != nil {
+		return x.Packed
+	}
+	return false
+}
+
+func (x *Pos33DryRunTx) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type Pos33DryRunBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 没有 miner 交易的候选区块
+	Block *types.Block     `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Txs   []*Pos33DryRunTx `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (x *Pos33DryRunBlock) Reset() {
+	*x = Pos33DryRunBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33DryRunBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33DryRunBlock) ProtoMessage() {}
+
+func (x *Pos33DryRunBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33DryRunBlock.ProtoReflect.Descriptor instead.
+func (*Pos33DryRunBlock) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Pos33DryRunBlock) GetBlock() *types.Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *Pos33DryRunBlock) GetTxs() []*Pos33DryRunTx {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+type ReqPos33Diagnostics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminToken string `protobuf:"bytes,1,opt,name=adminToken,proto3" json:"adminToken,omitempty"`
+}
+
+func (x *ReqPos33Diagnostics) Reset() {
+	*x = ReqPos33Diagnostics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33Diagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33Diagnostics) ProtoMessage() {}
+
+func (x *ReqPos33Diagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33Diagnostics.ProtoReflect.Descriptor instead.
+func (*ReqPos33Diagnostics) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ReqPos33Diagnostics) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+// 共识内部的 map 或者 channel 的大小
+type Pos33DiagSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Len  int64  `protobuf:"varint,2,opt,name=len,proto3" json:"len,omitempty"`
+	// channel 的容量, map 为 0
+	Cap int64 `protobuf:"varint,3,opt,name=cap,proto3" json:"cap,omitempty"`
+}
+
+func (x *Pos33DiagSize) Reset() {
+	*x = Pos33DiagSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33DiagSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33DiagSize) ProtoMessage() {}
+
+func (x *Pos33DiagSize) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33DiagSize.ProtoReflect.Descriptor instead.
+func (*Pos33DiagSize) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *Pos33DiagSize) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pos33DiagSize) GetLen() int64 {
+	if x != nil {
+		return x.Len
+	}
+	return 0
+}
+
+func (x *Pos33DiagSize) GetCap() int64 {
+	if x != nil {
+		return x.Cap
+	}
+	return 0
+}
+
+// 共识内部的诊断信息, 用来排查节点不出块也不投票的问题
+type Pos33Diagnostics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State *Pos33ConsensusState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// 主循环阻塞时 maps 为空
+	Maps         []*Pos33DiagSize `protobuf:"bytes,2,rep,name=maps,proto3" json:"maps,omitempty"`
+	Chans        []*Pos33DiagSize `protobuf:"bytes,3,rep,name=chans,proto3" json:"chans,omitempty"`
+	NumGoroutine int32            `protobuf:"varint,4,opt,name=numGoroutine,proto3" json:"numGoroutine,omitempty"`
+	// 共识模块的 goroutine 调用栈
+	Goroutines string `protobuf:"bytes,5,opt,name=goroutines,proto3" json:"goroutines,omitempty"`
+}
+
+func (x *Pos33Diagnostics) Reset() {
+	*x = Pos33Diagnostics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Diagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Diagnostics) ProtoMessage() {}
+
+func (x *Pos33Diagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Diagnostics.ProtoReflect.Descriptor instead.
+func (*Pos33Diagnostics) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Pos33Diagnostics) GetState() *Pos33ConsensusState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *Pos33Diagnostics) GetMaps() []*Pos33DiagSize {
+	if x != nil {
+		return x.Maps
+	}
+	return nil
+}
+
+func (x *Pos33Diagnostics) GetChans() []*Pos33DiagSize {
+	if x != nil {
+		return x.Chans
+	}
+	return nil
+}
+
+func (x *Pos33Diagnostics) GetNumGoroutine() int32 {
+	if x != nil {
+		return x.NumGoroutine
+	}
+	return 0
+}
+
+func (x *Pos33Diagnostics) GetGoroutines() string {
+	if x != nil {
+		return x.Goroutines
+	}
+	return ""
+}
+
+// 一个执行器的状态数据占用
+type Pos33StateUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exec string `protobuf:"bytes,1,opt,name=exec,proto3" json:"exec,omitempty"`
+	// 开始统计以来的 key+value 字节数和 key 的个数, 可能是负的
+	Bytes int64 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Keys  int64 `protobuf:"varint,3,opt,name=keys,proto3" json:"keys,omitempty"`
+	// 最近 1 分钟和 15 分钟每秒增长的字节数
+	Rate1  float64 `protobuf:"fixed64,4,opt,name=rate1,proto3" json:"rate1,omitempty"`
+	Rate15 float64 `protobuf:"fixed64,5,opt,name=rate15,proto3" json:"rate15,omitempty"`
+}
+
+func (x *Pos33StateUsage) Reset() {
+	*x = Pos33StateUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33StateUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33StateUsage) ProtoMessage() {}
+
+func (x *Pos33StateUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33StateUsage.ProtoReflect.Descriptor instead.
+func (*Pos33StateUsage) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Pos33StateUsage) GetExec() string {
+	if x != nil {
+		return x.Exec
+	}
+	return ""
+}
+
+func (x *Pos33StateUsage) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *Pos33StateUsage) GetKeys() int64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+func (x *Pos33StateUsage) GetRate1() float64 {
+	if x != nil {
+		return x.Rate1
+	}
+	return 0
+}
+
+func (x *Pos33StateUsage) GetRate15() float64 {
+	if x != nil {
+		return x.Rate15
+	}
+	return 0
+}
+
+type Pos33StateUsages struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 从 since 高度开始统计, 之前的状态不在里面
+	Since  int64              `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"`
+	Height int64              `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Items  []*Pos33StateUsage `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *Pos33StateUsages) Reset() {
+	*x = Pos33StateUsages{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33StateUsages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33StateUsages) ProtoMessage() {}
+
+func (x *Pos33StateUsages) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33StateUsages.ProtoReflect.Descriptor instead.
+func (*Pos33StateUsages) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *Pos33StateUsages) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *Pos33StateUsages) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33StateUsages) GetItems() []*Pos33StateUsage {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 异步索引的交易, 在区块提交以后写到 indexDBPath
+type Pos33IndexedTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash   []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Index  int32  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	From   string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To     string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Execer string `protobuf:"bytes,6,opt,name=execer,proto3" json:"execer,omitempty"`
+	// 交易回执的类型, 见 types.ExecOk
+	ReceiptTy int32 `protobuf:"varint,7,opt,name=receiptTy,proto3" json:"receiptTy,omitempty"`
+	BlockTime int64 `protobuf:"varint,8,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+}
+
+func (x *Pos33IndexedTx) Reset() {
+	*x = Pos33IndexedTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33IndexedTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33IndexedTx) ProtoMessage() {}
+
+func (x *Pos33IndexedTx) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33IndexedTx.ProtoReflect.Descriptor instead.
+func (*Pos33IndexedTx) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *Pos33IndexedTx) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *Pos33IndexedTx) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33IndexedTx) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Pos33IndexedTx) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Pos33IndexedTx) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Pos33IndexedTx) GetExecer() string {
+	if x != nil {
+		return x.Execer
+	}
+	return ""
+}
+
+func (x *Pos33IndexedTx) GetReceiptTy() int32 {
+	if x != nil {
+		return x.ReceiptTy
+	}
+	return 0
+}
+
+func (x *Pos33IndexedTx) GetBlockTime() int64 {
+	if x != nil {
+		return x.BlockTime
+	}
+	return 0
+}
+
+type Pos33IndexedTxs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txs []*Pos33IndexedTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	// 已经索引到的高度
+	Indexed int64 `protobuf:"varint,2,opt,name=indexed,proto3" json:"indexed,omitempty"`
+}
+
+func (x *Pos33IndexedTxs) Reset() {
+	*x = Pos33IndexedTxs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33IndexedTxs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33IndexedTxs) ProtoMessage() {}
+
+func (x *Pos33IndexedTxs) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33IndexedTxs.ProtoReflect.Descriptor instead.
+func (*Pos33IndexedTxs) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *Pos33IndexedTxs) GetTxs() []*Pos33IndexedTx {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+func (x *Pos33IndexedTxs) GetIndexed() int64 {
+	if x != nil {
+		return x.Indexed
+	}
+	return 0
+}
+
+// 地址相关的交易, 从 (height, index) 开始(不包括)按高度从高到低返回, height 为 0 从最新的开始
+type ReqPos33AddrTxs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Index  int32  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// 最多返回的交易数, 0 使用默认值 20, 最大 200
+	Count int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReqPos33AddrTxs) Reset() {
+	*x = ReqPos33AddrTxs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33AddrTxs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33AddrTxs) ProtoMessage() {}
+
+func (x *ReqPos33AddrTxs) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33AddrTxs.ProtoReflect.Descriptor instead.
+func (*ReqPos33AddrTxs) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ReqPos33AddrTxs) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReqPos33AddrTxs) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ReqPos33AddrTxs) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ReqPos33AddrTxs) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 未成熟的挖矿奖励
+type Pos33Immature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Amount int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Pos33Immature) Reset() {
+	*x = Pos33Immature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Immature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Immature) ProtoMessage() {}
+
+func (x *Pos33Immature) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Immature.ProtoReflect.Descriptor instead.
+func (*Pos33Immature) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *Pos33Immature) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33Immature) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 在某个高度成熟的奖励列表
+type Pos33ImmatureList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Pos33Immature `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *Pos33ImmatureList) Reset() {
+	*x = Pos33ImmatureList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33ImmatureList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33ImmatureList) ProtoMessage() {}
+
+func (x *Pos33ImmatureList) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33ImmatureList.ProtoReflect.Descriptor instead.
+func (*Pos33ImmatureList) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *Pos33ImmatureList) GetItems() []*Pos33Immature {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 等待交易上链, timeout 单位秒
+// 异步发送: 交易进入 mempool 以后立即返回 hash, 用它调用 WaitPos33Tx 等待广播确认或者上链
+type ReqPos33SendTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 签好名的交易 hex
+	Tx string `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (x *ReqPos33SendTx) Reset() {
+	*x = ReqPos33SendTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33SendTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33SendTx) ProtoMessage() {}
+
+func (x *ReqPos33SendTx) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33SendTx.ProtoReflect.Descriptor instead.
+func (*ReqPos33SendTx) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ReqPos33SendTx) GetTx() string {
+	if x != nil {
+		return x.Tx
+	}
+	return ""
+}
+
+type ReplyPos33SendTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// 交易进入 mempool 时本节点的高度
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *ReplyPos33SendTx) Reset() {
+	*x = ReplyPos33SendTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyPos33SendTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyPos33SendTx) ProtoMessage() {}
+
+func (x *ReplyPos33SendTx) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyPos33SendTx.ProtoReflect.Descriptor instead.
+func (*ReplyPos33SendTx) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ReplyPos33SendTx) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ReplyPos33SendTx) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type ReqPos33WaitTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash    string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Timeout int64  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// 只等待交易在本节点的 mempool 或者已经上链, 并且本节点有连接的 peer.
+	// p2p 广播没有回执, 这不能说明其他节点收到了交易
+	Broadcast bool `protobuf:"varint,3,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+}
+
+func (x *ReqPos33WaitTx) Reset() {
+	*x = ReqPos33WaitTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33WaitTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33WaitTx) ProtoMessage() {}
+
+func (x *ReqPos33WaitTx) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33WaitTx.ProtoReflect.Descriptor instead.
+func (*ReqPos33WaitTx) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ReqPos33WaitTx) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ReqPos33WaitTx) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *ReqPos33WaitTx) GetBroadcast() bool {
+	if x != nil {
+		return x.Broadcast
+	}
+	return false
+}
+
+type ReplyPos33TxStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash   string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Packed bool   `protobuf:"varint,2,opt,name=packed,proto3" json:"packed,omitempty"`
+	Height int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Index  int64  `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// 交易在本节点的 mempool 或者已经上链, 并且本节点有连接的 peer, 不是其他节点的回执
+	Broadcast bool `protobuf:"varint,5,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+	// 本节点连接的 peer 数量
+	Peers int32 `protobuf:"varint,6,opt,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *ReplyPos33TxStatus) Reset() {
+	*x = ReplyPos33TxStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyPos33TxStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyPos33TxStatus) ProtoMessage() {}
+
+func (x *ReplyPos33TxStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyPos33TxStatus.ProtoReflect.Descriptor instead.
+func (*ReplyPos33TxStatus) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ReplyPos33TxStatus) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ReplyPos33TxStatus) GetPacked() bool {
+	if x != nil {
+		return x.Packed
+	}
+	return false
+}
+
+func (x *ReplyPos33TxStatus) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ReplyPos33TxStatus) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ReplyPos33TxStatus) GetBroadcast() bool {
+	if x != nil {
+		return x.Broadcast
+	}
+	return false
+}
+
+func (x *ReplyPos33TxStatus) GetPeers() int32 {
+	if x != nil {
+		return x.Peers
+	}
+	return 0
+}
+
+// 管理员创建有时效的 session token, 只能调用 methods 里的钱包方法, 转账总额不超过 amount_limit
+type ReqPos33Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminToken  string   `protobuf:"bytes,1,opt,name=admin_token,json=adminToken,proto3" json:"admin_token,omitempty"`
+	Methods     []string `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	AmountLimit int64    `protobuf:"varint,3,opt,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
+	Ttl         int64    `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *ReqPos33Session) Reset() {
+	*x = ReqPos33Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33Session) ProtoMessage() {}
+
+func (x *ReqPos33Session) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33Session.ProtoReflect.Descriptor instead.
+func (*ReqPos33Session) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ReqPos33Session) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+func (x *ReqPos33Session) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *ReqPos33Session) GetAmountLimit() int64 {
+	if x != nil {
+		return x.AmountLimit
+	}
+	return 0
+}
+
+func (x *ReqPos33Session) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ReplyPos33Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token  string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Expire int64  `protobuf:"varint,2,opt,name=expire,proto3" json:"expire,omitempty"`
+}
+
+func (x *ReplyPos33Session) Reset() {
+	*x = ReplyPos33Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyPos33Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyPos33Session) ProtoMessage() {}
+
+func (x *ReplyPos33Session) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyPos33Session.ProtoReflect.Descriptor instead.
+func (*ReplyPos33Session) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ReplyPos33Session) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReplyPos33Session) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type ReqPos33SessionTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token  string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	To     string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note   string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	From   string `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+}
+
+func (x *ReqPos33SessionTransfer) Reset() {
+	*x = ReqPos33SessionTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33SessionTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33SessionTransfer) ProtoMessage() {}
+
+func (x *ReqPos33SessionTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33SessionTransfer.ProtoReflect.Descriptor instead.
+func (*ReqPos33SessionTransfer) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ReqPos33SessionTransfer) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReqPos33SessionTransfer) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ReqPos33SessionTransfer) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReqPos33SessionTransfer) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ReqPos33SessionTransfer) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+type ReqPos33SessionFeeRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string             `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Rate  *Pos33MinerFeeRate `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *ReqPos33SessionFeeRate) Reset() {
+	*x = ReqPos33SessionFeeRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33SessionFeeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33SessionFeeRate) ProtoMessage() {}
+
+func (x *ReqPos33SessionFeeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33SessionFeeRate.ProtoReflect.Descriptor instead.
+func (*ReqPos33SessionFeeRate) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ReqPos33SessionFeeRate) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReqPos33SessionFeeRate) GetRate() *Pos33MinerFeeRate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+// 钱包按照 spend policy 转账, 大额转账需要审批人签名确认
+type ReqPos33Transfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From   string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To     string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note   string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *ReqPos33Transfer) Reset() {
+	*x = ReqPos33Transfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33Transfer) ProtoMessage() {}
+
+func (x *ReqPos33Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33Transfer.ProtoReflect.Descriptor instead.
+func (*ReqPos33Transfer) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ReqPos33Transfer) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ReqPos33Transfer) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ReqPos33Transfer) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReqPos33Transfer) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ReplyPos33Transfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash      []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	PendingId string `protobuf:"bytes,2,opt,name=pending_id,json=pendingId,proto3" json:"pending_id,omitempty"`
+}
+
+func (x *ReplyPos33Transfer) Reset() {
+	*x = ReplyPos33Transfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyPos33Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyPos33Transfer) ProtoMessage() {}
+
+func (x *ReplyPos33Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyPos33Transfer.ProtoReflect.Descriptor instead.
+func (*ReplyPos33Transfer) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ReplyPos33Transfer) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *ReplyPos33Transfer) GetPendingId() string {
+	if x != nil {
+		return x.PendingId
+	}
+	return ""
+}
+
+// 审批人对 pending_id 签名
+type ReqPos33Approve struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PendingId string           `protobuf:"bytes,1,opt,name=pending_id,json=pendingId,proto3" json:"pending_id,omitempty"`
+	Sig       *types.Signature `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (x *ReqPos33Approve) Reset() {
+	*x = ReqPos33Approve{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33Approve) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33Approve) ProtoMessage() {}
+
+func (x *ReqPos33Approve) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33Approve.ProtoReflect.Descriptor instead.
+func (*ReqPos33Approve) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ReqPos33Approve) GetPendingId() string {
+	if x != nil {
+		return x.PendingId
+	}
+	return ""
+}
+
+func (x *ReqPos33Approve) GetSig() *types.Signature {
+	if x != nil {
+		return x.Sig
+	}
+	return nil
+}
+
+// 钱包的审计日志, 每一条包含上一条的 hash
+type Pos33AuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index    int64  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Time     int64  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Op       string `protobuf:"bytes,3,opt,name=op,proto3" json:"op,omitempty"`
+	Caller   string `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`
+	Detail   string `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+	PrevHash []byte `protobuf:"bytes,6,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	Hash     []byte `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *Pos33AuditEntry) Reset() {
+	*x = Pos33AuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33AuditEntry) ProtoMessage() {}
+
+func (x *Pos33AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33AuditEntry.ProtoReflect.Descriptor instead.
+func (*Pos33AuditEntry) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *Pos33AuditEntry) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Pos33AuditEntry) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Pos33AuditEntry) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *Pos33AuditEntry) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *Pos33AuditEntry) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *Pos33AuditEntry) GetPrevHash() []byte {
+	if x != nil {
+		return x.PrevHash
+	}
+	return nil
+}
+
+func (x *Pos33AuditEntry) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+type Pos33AuditEntries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Pos33AuditEntry `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *Pos33AuditEntries) Reset() {
+	*x = Pos33AuditEntries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33AuditEntries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33AuditEntries) ProtoMessage() {}
+
+func (x *Pos33AuditEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33AuditEntries.ProtoReflect.Descriptor instead.
+func (*Pos33AuditEntries) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *Pos33AuditEntries) GetItems() []*Pos33AuditEntry {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ReqPos33AuditLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReqPos33AuditLog) Reset() {
+	*x = ReqPos33AuditLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33AuditLog) ProtoMessage() {}
+
+func (x *ReqPos33AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33AuditLog.ProtoReflect.Descriptor instead.
+func (*ReqPos33AuditLog) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ReqPos33AuditLog) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ReqPos33AuditLog) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 多租户 rpc 代理里一个 api key 的用量
+type Pos33TenantUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Requests int64  `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	// 今天(UTC)的请求数和每天的配额
+	Today int64 `protobuf:"varint,3,opt,name=today,proto3" json:"today,omitempty"`
+	Daily int64 `protobuf:"varint,4,opt,name=daily,proto3" json:"daily,omitempty"`
+	// 因为速率或者配额被拒绝的请求, 因为方法不在范围内被拒绝的请求
+	Limited  int64 `protobuf:"varint,5,opt,name=limited,proto3" json:"limited,omitempty"`
+	Denied   int64 `protobuf:"varint,6,opt,name=denied,proto3" json:"denied,omitempty"`
+	BytesIn  int64 `protobuf:"varint,7,opt,name=bytesIn,proto3" json:"bytesIn,omitempty"`
+	BytesOut int64 `protobuf:"varint,8,opt,name=bytesOut,proto3" json:"bytesOut,omitempty"`
+}
+
+func (x *Pos33TenantUsage) Reset() {
+	*x = Pos33TenantUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TenantUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TenantUsage) ProtoMessage() {}
+
+func (x *Pos33TenantUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TenantUsage.ProtoReflect.Descriptor instead.
+func (*Pos33TenantUsage) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *Pos33TenantUsage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pos33TenantUsage) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *Pos33TenantUsage) GetToday() int64 {
+	if x != nil {
+		return x.Today
+	}
+	return 0
+}
+
+func (x *Pos33TenantUsage) GetDaily() int64 {
+	if x != nil {
+		return x.Daily
+	}
+	return 0
+}
+
+func (x *Pos33TenantUsage) GetLimited() int64 {
+	if x != nil {
+		return x.Limited
+	}
+	return 0
+}
+
+func (x *Pos33TenantUsage) GetDenied() int64 {
+	if x != nil {
+		return x.Denied
+	}
+	return 0
+}
+
+func (x *Pos33TenantUsage) GetBytesIn() int64 {
+	if x != nil {
+		return x.BytesIn
+	}
+	return 0
+}
+
+func (x *Pos33TenantUsage) GetBytesOut() int64 {
+	if x != nil {
+		return x.BytesOut
+	}
+	return 0
+}
+
+type Pos33TenantUsages struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Pos33TenantUsage `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *Pos33TenantUsages) Reset() {
+	*x = Pos33TenantUsages{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TenantUsages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TenantUsages) ProtoMessage() {}
+
+func (x *Pos33TenantUsages) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TenantUsages.ProtoReflect.Descriptor instead.
+func (*Pos33TenantUsages) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *Pos33TenantUsages) GetItems() []*Pos33TenantUsage {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ReqPos33TenantUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminToken string `protobuf:"bytes,1,opt,name=adminToken,proto3" json:"adminToken,omitempty"`
+	// 空表示所有租户
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ReqPos33TenantUsage) Reset() {
+	*x = ReqPos33TenantUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33TenantUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33TenantUsage) ProtoMessage() {}
+
+func (x *ReqPos33TenantUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33TenantUsage.ProtoReflect.Descriptor instead.
+func (*ReqPos33TenantUsage) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ReqPos33TenantUsage) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+func (x *ReqPos33TenantUsage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// 交易的追踪 ID 只保存在节点内存里, 不上链
+type Pos33TraceEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time  int64  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Event string `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *Pos33TraceEvent) Reset() {
+	*x = Pos33TraceEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TraceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TraceEvent) ProtoMessage() {}
+
+func (x *Pos33TraceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TraceEvent.ProtoReflect.Descriptor instead.
+func (*Pos33TraceEvent) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *Pos33TraceEvent) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Pos33TraceEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+type Pos33TxTrace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TraceId string             `protobuf:"bytes,1,opt,name=traceId,proto3" json:"traceId,omitempty"`
+	Hash    string             `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Time    int64              `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	Events  []*Pos33TraceEvent `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	// 查询时填写的交易状态
+	InMempool bool  `protobuf:"varint,5,opt,name=inMempool,proto3" json:"inMempool,omitempty"`
+	Packed    bool  `protobuf:"varint,6,opt,name=packed,proto3" json:"packed,omitempty"`
+	Height    int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Index     int64 `protobuf:"varint,8,opt,name=index,proto3" json:"index,omitempty"`
+	ReceiptTy int32 `protobuf:"varint,9,opt,name=receiptTy,proto3" json:"receiptTy,omitempty"`
+}
+
+func (x *Pos33TxTrace) Reset() {
+	*x = Pos33TxTrace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TxTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TxTrace) ProtoMessage() {}
+
+func (x *Pos33TxTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TxTrace.ProtoReflect.Descriptor instead.
+func (*Pos33TxTrace) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *Pos33TxTrace) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *Pos33TxTrace) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Pos33TxTrace) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Pos33TxTrace) GetEvents() []*Pos33TraceEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Pos33TxTrace) GetInMempool() bool {
+	if x != nil {
+		return x.InMempool
+	}
+	return false
+}
+
+func (x *Pos33TxTrace) GetPacked() bool {
+	if x != nil {
+		return x.Packed
+	}
+	return false
+}
+
+func (x *Pos33TxTrace) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33TxTrace) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Pos33TxTrace) GetReceiptTy() int32 {
+	if x != nil {
+		return x.ReceiptTy
+	}
+	return 0
+}
+
+type ReqPos33TracedTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TraceId string `protobuf:"bytes,1,opt,name=traceId,proto3" json:"traceId,omitempty"`
+	// hex 编码的已签名交易
+	Data string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ReqPos33TracedTx) Reset() {
+	*x = ReqPos33TracedTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33TracedTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33TracedTx) ProtoMessage() {}
+
+func (x *ReqPos33TracedTx) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33TracedTx.ProtoReflect.Descriptor instead.
+func (*ReqPos33TracedTx) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ReqPos33TracedTx) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *ReqPos33TracedTx) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+// 轻节点的 block locator: 已知区块的 hash, 从新到旧, 通常是最近的 10 个然后间隔加倍直到创世区块
+type ReqPos33Locator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hashes []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	// 最多返回的区块头数, 0 使用默认值 500, 最大 2000
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// 返回到这个区块为止(包括), 可以为空
+	Stop string `protobuf:"bytes,3,opt,name=stop,proto3" json:"stop,omitempty"`
+}
+
+func (x *ReqPos33Locator) Reset() {
+	*x = ReqPos33Locator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33Locator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33Locator) ProtoMessage() {}
+
+func (x *ReqPos33Locator) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33Locator.ProtoReflect.Descriptor instead.
+func (*ReqPos33Locator) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ReqPos33Locator) GetHashes() []string {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+func (x *ReqPos33Locator) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReqPos33Locator) GetStop() string {
+	if x != nil {
+		return x.Stop
+	}
+	return ""
+}
+
+type ReplyPos33Locator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// locator 里在主链上的最高区块, -1 表示没有, 从创世区块开始返回
+	ForkHeight int64          `protobuf:"varint,1,opt,name=forkHeight,proto3" json:"forkHeight,omitempty"`
+	ForkHash   []byte         `protobuf:"bytes,2,opt,name=forkHash,proto3" json:"forkHash,omitempty"`
+	Headers    *types.Headers `protobuf:"bytes,3,opt,name=headers,proto3" json:"headers,omitempty"`
+}
+
+func (x *ReplyPos33Locator) Reset() {
+	*x = ReplyPos33Locator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyPos33Locator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyPos33Locator) ProtoMessage() {}
+
+func (x *ReplyPos33Locator) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyPos33Locator.ProtoReflect.Descriptor instead.
+func (*ReplyPos33Locator) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ReplyPos33Locator) GetForkHeight() int64 {
+	if x != nil {
+		return x.ForkHeight
+	}
+	return 0
+}
+
+func (x *ReplyPos33Locator) GetForkHash() []byte {
+	if x != nil {
+		return x.ForkHash
+	}
+	return nil
+}
+
+func (x *ReplyPos33Locator) GetHeaders() *types.Headers {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// 共识消息存档里的一条记录
+type Pos33ArchivedMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 收到的时间 (毫秒)
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// 收到时节点正在共识的高度
+	Height int64     `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Msg    *Pos33Msg `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *Pos33ArchivedMsg) Reset() {
+	*x = Pos33ArchivedMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33ArchivedMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33ArchivedMsg) ProtoMessage() {}
+
+func (x *Pos33ArchivedMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33ArchivedMsg.ProtoReflect.Descriptor instead.
+func (*Pos33ArchivedMsg) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *Pos33ArchivedMsg) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Pos33ArchivedMsg) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33ArchivedMsg) GetMsg() *Pos33Msg {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+// 按票数排序的矿工, cursor 是上一页返回的 next, 第一页为空
+type ReqPos33TopDeposits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 0 使用默认值 20, 最大 100
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReqPos33TopDeposits) Reset() {
+	*x = ReqPos33TopDeposits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33TopDeposits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33TopDeposits) ProtoMessage() {}
+
+func (x *ReqPos33TopDeposits) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33TopDeposits.ProtoReflect.Descriptor instead.
+func (*ReqPos33TopDeposits) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ReqPos33TopDeposits) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ReqPos33TopDeposits) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Pos33TopDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Count   int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Pos33TopDeposit) Reset() {
+	*x = Pos33TopDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TopDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TopDeposit) ProtoMessage() {}
+
+func (x *Pos33TopDeposit) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TopDeposit.ProtoReflect.Descriptor instead.
+func (*Pos33TopDeposit) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *Pos33TopDeposit) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Pos33TopDeposit) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Pos33TopDeposit) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Pos33TopDeposits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Pos33TopDeposit `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// 下一页的 cursor, 为空表示没有了
+	Next string `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *Pos33TopDeposits) Reset() {
+	*x = Pos33TopDeposits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TopDeposits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TopDeposits) ProtoMessage() {}
+
+func (x *Pos33TopDeposits) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TopDeposits.ProtoReflect.Descriptor instead.
+func (*Pos33TopDeposits) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *Pos33TopDeposits) GetItems() []*Pos33TopDeposit {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Pos33TopDeposits) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+// 一次奖励: ty 是 1 制作人, 2 投票人, 3 矿工的佣金
+type Pos33RewardEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Height  int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Ty      int32  `protobuf:"varint,3,opt,name=ty,proto3" json:"ty,omitempty"`
+	Amount  int64  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Pos33RewardEvent) Reset() {
+	*x = Pos33RewardEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33RewardEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33RewardEvent) ProtoMessage() {}
+
+func (x *Pos33RewardEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33RewardEvent.ProtoReflect.Descriptor instead.
+func (*Pos33RewardEvent) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *Pos33RewardEvent) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Pos33RewardEvent) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33RewardEvent) GetTy() int32 {
+	if x != nil {
+		return x.Ty
+	}
+	return 0
+}
+
+func (x *Pos33RewardEvent) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// miner 交易里所有地址的奖励, 同一个地址同一种奖励合并成一条
+type ReceiptPos33Rewards struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Items  []*Pos33RewardEvent `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ReceiptPos33Rewards) Reset() {
+	*x = ReceiptPos33Rewards{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptPos33Rewards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptPos33Rewards) ProtoMessage() {}
+
+func (x *ReceiptPos33Rewards) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptPos33Rewards.ProtoReflect.Descriptor instead.
+func (*ReceiptPos33Rewards) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ReceiptPos33Rewards) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Rewards) GetItems() []*Pos33RewardEvent {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 地址的奖励记录, 从新到旧, cursor 是上一页返回的 next, 第一页为空
+type ReqPos33RewardHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 0 使用默认值 20, 最大 100
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReqPos33RewardHistory) Reset() {
+	*x = ReqPos33RewardHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33RewardHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33RewardHistory) ProtoMessage() {}
+
+func (x *ReqPos33RewardHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33RewardHistory.ProtoReflect.Descriptor instead.
+func (*ReqPos33RewardHistory) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ReqPos33RewardHistory) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReqPos33RewardHistory) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ReqPos33RewardHistory) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Pos33RewardEvents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Pos33RewardEvent `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Next  string              `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *Pos33RewardEvents) Reset() {
+	*x = Pos33RewardEvents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33RewardEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33RewardEvents) ProtoMessage() {}
+
+func (x *Pos33RewardEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33RewardEvents.ProtoReflect.Descriptor instead.
+func (*Pos33RewardEvents) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *Pos33RewardEvents) GetItems() []*Pos33RewardEvent {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Pos33RewardEvents) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+// 按索引查询抵押: index 是 amount, count 或者 close (抵押减少的高度), 返回索引值在 [start, end] 里的矿工,
+// end 为 0 表示不限制. direction 0 从大到小, 1 从小到大
+type ReqPos33Deposits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index  string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Start  int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End    int64  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 0 使用默认值 20, 最大 100
+	Count     int32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Direction int32 `protobuf:"varint,6,opt,name=direction,proto3" json:"direction,omitempty"`
+}
+
+func (x *ReqPos33Deposits) Reset() {
+	*x = ReqPos33Deposits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33Deposits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33Deposits) ProtoMessage() {}
+
+func (x *ReqPos33Deposits) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33Deposits.ProtoReflect.Descriptor instead.
+func (*ReqPos33Deposits) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ReqPos33Deposits) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *ReqPos33Deposits) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ReqPos33Deposits) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *ReqPos33Deposits) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ReqPos33Deposits) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReqPos33Deposits) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+type Pos33Deposits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Pos33DepositMsg `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// 下一页的 cursor, 为空表示没有了
+	Next string `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *Pos33Deposits) Reset() {
+	*x = Pos33Deposits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Deposits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Deposits) ProtoMessage() {}
+
+func (x *Pos33Deposits) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Deposits.ProtoReflect.Descriptor instead.
+func (*Pos33Deposits) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *Pos33Deposits) GetItems() []*Pos33DepositMsg {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Pos33Deposits) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+// 一次打开 count 张票, 委托给 consignee, 为空是自己
+type Pos33OpenTickets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Consignee string `protobuf:"bytes,1,opt,name=consignee,proto3" json:"consignee,omitempty"`
+	Count     int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Pos33OpenTickets) Reset() {
+	*x = Pos33OpenTickets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33OpenTickets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33OpenTickets) ProtoMessage() {}
+
+func (x *Pos33OpenTickets) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33OpenTickets.ProtoReflect.Descriptor instead.
+func (*Pos33OpenTickets) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *Pos33OpenTickets) GetConsignee() string {
+	if x != nil {
+		return x.Consignee
+	}
+	return ""
+}
+
+func (x *Pos33OpenTickets) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 关闭 consignees 里的委托: count 大于 0 时按顺序一共关闭 count 张票, 为 0 时全部关闭.
+// consignees 为空时是所有委托的矿工
+type Pos33CloseTickets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Consignees []string `protobuf:"bytes,1,rep,name=consignees,proto3" json:"consignees,omitempty"`
+	Count      int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Pos33CloseTickets) Reset() {
+	*x = Pos33CloseTickets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33CloseTickets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33CloseTickets) ProtoMessage() {}
+
+func (x *Pos33CloseTickets) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33CloseTickets.ProtoReflect.Descriptor instead.
+func (*Pos33CloseTickets) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *Pos33CloseTickets) GetConsignees() []string {
+	if x != nil {
+		return x.Consignees
+	}
+	return nil
+}
+
+func (x *Pos33CloseTickets) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 批量打开或者关闭票的汇总, count 和 amount 关闭时是负数
+type ReceiptPos33Tickets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Consignor string          `protobuf:"bytes,1,opt,name=consignor,proto3" json:"consignor,omitempty"`
+	Price     int64           `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Count     int64           `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Amount    int64           `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Items     []*Pos33Entrust `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ReceiptPos33Tickets) Reset() {
+	*x = ReceiptPos33Tickets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptPos33Tickets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptPos33Tickets) ProtoMessage() {}
+
+func (x *ReceiptPos33Tickets) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptPos33Tickets.ProtoReflect.Descriptor instead.
+func (*ReceiptPos33Tickets) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ReceiptPos33Tickets) GetConsignor() string {
+	if x != nil {
+		return x.Consignor
+	}
+	return ""
+}
+
+func (x *ReceiptPos33Tickets) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Tickets) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Tickets) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Tickets) GetItems() []*Pos33Entrust {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 冷地址 returnAddr 绑定的热挖矿地址 miner, 两个地址都指向这个绑定. miner 为空表示已经解除
+type Pos33MinerBind struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Miner      string `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	ReturnAddr string `protobuf:"bytes,2,opt,name=returnAddr,proto3" json:"returnAddr,omitempty"`
+	Height     int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// 上一次的绑定, height 以前的抽签用它
+	Prev *Pos33MinerBind `protobuf:"bytes,4,opt,name=prev,proto3" json:"prev,omitempty"`
+}
+
+func (x *Pos33MinerBind) Reset() {
+	*x = Pos33MinerBind{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33MinerBind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33MinerBind) ProtoMessage() {}
+
+func (x *Pos33MinerBind) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33MinerBind.ProtoReflect.Descriptor instead.
+func (*Pos33MinerBind) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *Pos33MinerBind) GetMiner() string {
+	if x != nil {
+		return x.Miner
+	}
+	return ""
+}
+
+func (x *Pos33MinerBind) GetReturnAddr() string {
+	if x != nil {
+		return x.ReturnAddr
+	}
+	return ""
+}
+
+func (x *Pos33MinerBind) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33MinerBind) GetPrev() *Pos33MinerBind {
+	if x != nil {
+		return x.Prev
+	}
+	return nil
+}
+
+// 挖矿地址登记一个只给 miner 交易签名的地址, 私钥可以放在 KMS 里. revoke 为 true 时撤销
+type Pos33BlockSignerBind struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Revoke bool   `protobuf:"varint,2,opt,name=revoke,proto3" json:"revoke,omitempty"`
+}
+
+func (x *Pos33BlockSignerBind) Reset() {
+	*x = Pos33BlockSignerBind{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33BlockSignerBind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33BlockSignerBind) ProtoMessage() {}
+
+func (x *Pos33BlockSignerBind) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33BlockSignerBind.ProtoReflect.Descriptor instead.
+func (*Pos33BlockSignerBind) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *Pos33BlockSignerBind) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *Pos33BlockSignerBind) GetRevoke() bool {
+	if x != nil {
+		return x.Revoke
+	}
+	return false
+}
+
+// 出块签名地址的登记, height 以后替 miner 签 miner 交易, revoked 不为 0 时从那个高度开始失效
+type Pos33BlockSigner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Miner   string `protobuf:"bytes,2,opt,name=miner,proto3" json:"miner,omitempty"`
+	Height  int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Revoked int64  `protobuf:"varint,4,opt,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (x *Pos33BlockSigner) Reset() {
+	*x = Pos33BlockSigner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33BlockSigner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33BlockSigner) ProtoMessage() {}
+
+func (x *Pos33BlockSigner) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33BlockSigner.ProtoReflect.Descriptor instead.
+func (*Pos33BlockSigner) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *Pos33BlockSigner) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *Pos33BlockSigner) GetMiner() string {
+	if x != nil {
+		return x.Miner
+	}
+	return ""
+}
+
+func (x *Pos33BlockSigner) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33BlockSigner) GetRevoked() int64 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+var File_pos33_proto protoreflect.FileDescriptor
+
+var file_pos33_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x33, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x1a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x02, 0x0a, 0x0b, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x69, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6f,
+	0x70, 0x65, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xc0, 0x08,
+	0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f,
+	0x70, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x48,
+	0x00, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x74, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x69,
+	0x6e, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x62, 0x6c, 0x73, 0x42, 0x69, 0x6e, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x73, 0x42, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x07, 0x62,
+	0x6c, 0x73, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x08,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x00, 0x52, 0x08, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x35, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x48, 0x00, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a,
+	0x05, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x32, 0x0a, 0x08, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12,
+	0x38, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x75,
+	0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x73, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0d, 0x73,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b,
+	0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x70, 0x65,
+	0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4f, 0x70, 0x65, 0x6e,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x42, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x9f, 0x01, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x22, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x73, 0x67, 0x2e, 0x54,
+	0x79, 0x52, 0x02, 0x74, 0x79, 0x22, 0x5b, 0x0a, 0x02, 0x54, 0x79, 0x12, 0x06, 0x0a, 0x02, 0x4d,
+	0x56, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x42, 0x56, 0x10, 0x01, 0x12, 0x05, 0x0a, 0x01, 0x42,
+	0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x4d, 0x53, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x53,
+	0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x43, 0x56, 0x10, 0x05, 0x12, 0x06, 0x0a, 0x02, 0x41, 0x44,
+	0x10, 0x06, 0x12, 0x06, 0x0a, 0x02, 0x45, 0x56, 0x10, 0x07, 0x12, 0x06, 0x0a, 0x02, 0x43, 0x50,
+	0x10, 0x08, 0x12, 0x06, 0x0a, 0x02, 0x54, 0x4d, 0x10, 0x09, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x44,
+	0x10, 0x0a, 0x22, 0x5a, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x5c,
+	0x0a, 0x08, 0x56, 0x72, 0x66, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22, 0x82, 0x01, 0x0a,
+	0x09, 0x48, 0x61, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x56, 0x72, 0x66, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x72, 0x66, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x76, 0x72, 0x66, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09,
+	0x76, 0x72, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x22, 0x64, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73,
+	0x67, 0x12, 0x2c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6f, 0x72,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x52, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x26, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x37, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x53, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x05, 0x73, 0x6f, 0x72, 0x74, 0x73,
+	0x22, 0x42, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x6f, 0x72,
+	0x74, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x53,
+	0x6f, 0x72, 0x74, 0x73, 0x22, 0x4b, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x22, 0x0a,
+	0x03, 0x53, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x03, 0x53, 0x69,
+	0x67, 0x22, 0x3d, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d,
+	0x73, 0x67, 0x12, 0x1a, 0x0a, 0x01, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x01, 0x62, 0x12, 0x10,
+	0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x64,
+	0x22, 0x51, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73,
+	0x67, 0x32, 0x12, 0x1a, 0x0a, 0x01, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x01, 0x62, 0x12, 0x23,
+	0x0a, 0x02, 0x76, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52,
+	0x02, 0x76, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74,
+	0x65, 0x4d, 0x73, 0x67, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x03, 0x73, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61,
+	0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x0e, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x08,
+	0x6d, 0x79, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74,
+	0x4d, 0x73, 0x67, 0x52, 0x07, 0x6d, 0x79, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x0a,
+	0x03, 0x73, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x03, 0x73, 0x69,
+	0x67, 0x22, 0x9c, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d,
+	0x61, 0x70, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x1a,
+	0x4f, 0x0a, 0x0c, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f,
+	0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x31, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x02, 0x76, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52,
+	0x02, 0x76, 0x73, 0x22, 0x36, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x61, 0x6b, 0x65,
+	0x72, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x03, 0x6d, 0x76, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x03, 0x6d, 0x76, 0x73, 0x22, 0x7e, 0x0a, 0x10, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x27, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d,
+	0x73, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x02, 0x76, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x02, 0x76, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xf7, 0x03, 0x0a, 0x0d,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x0a,
+	0x09, 0x42, 0x6c, 0x73, 0x50, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x09, 0x42, 0x6c, 0x73, 0x50, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x42,
+	0x6c, 0x73, 0x53, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x42, 0x6c, 0x73,
+	0x53, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x73, 0x65, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x65, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c,
+	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09,
+	0x62, 0x6c, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x06, 0x65, 0x78, 0x74, 0x72, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x74, 0x72, 0x61, 0x52, 0x06, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x73, 0x12, 0x2b, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53,
+	0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x12, 0x3a,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x56, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x61,
+	0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4c, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52,
+	0x04, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4c,
+	0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x6c, 0x73, 0x50, 0x6b, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x42, 0x6c, 0x73, 0x50, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x6c, 0x73, 0x53, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x42, 0x6c, 0x73, 0x53, 0x69, 0x67, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x05,
+	0x73, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56,
+	0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x73, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x70, 0x72, 0x65, 0x76, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x56, 0x6f, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x39, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x45, 0x78, 0x74, 0x72, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x3a, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x22, 0x55, 0x0a, 0x11,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66,
+	0x69, 0x6c, 0x6c, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x78, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x4e, 0x0a, 0x0d, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x0f,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x68, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x69, 0x66, 0x66, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x64, 0x69, 0x66, 0x66, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x22, 0x4f, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x68, 0x61,
+	0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x22, 0x42, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x68, 0x61, 0x72, 0x74,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x43, 0x68, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x40, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x42, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x90, 0x01, 0x0a,
+	0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0b,
+	0x62, 0x6c, 0x73, 0x5f, 0x70, 0x6b, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x09, 0x62, 0x6c, 0x73, 0x50, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x62, 0x6c, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62,
+	0x6c, 0x73, 0x53, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22,
+	0x65, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x22, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0x3e, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d,
+	0x69, 0x6e, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x50,
+	0x72, 0x69, 0x76, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x69, 0x76, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x72, 0x69, 0x76, 0x22, 0x5b, 0x0a, 0x0f, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x73, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x61, 0x6e, 0x64, 0x53, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
+	0x61, 0x6e, 0x64, 0x53, 0x65, 0x65, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x22,
+	0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x73, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c,
+	0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4c,
+	0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x11,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x3d, 0x0a,
+	0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5e, 0x0a, 0x16,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x47, 0x0a, 0x15,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xf7, 0x02, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x03, 0x74, 0x78, 0x31,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x78, 0x31, 0x12,
+	0x24, 0x0a, 0x03, 0x74, 0x78, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x03, 0x74, 0x78, 0x32, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x31, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x31,
+	0x12, 0x29, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74,
+	0x65, 0x4d, 0x73, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x32, 0x12, 0x2b, 0x0a, 0x06, 0x6d,
+	0x61, 0x6b, 0x65, 0x72, 0x31, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67,
+	0x52, 0x06, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x31, 0x12, 0x2b, 0x0a, 0x06, 0x6d, 0x61, 0x6b, 0x65,
+	0x72, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x06, 0x6d,
+	0x61, 0x6b, 0x65, 0x72, 0x32, 0x12, 0x2c, 0x0a, 0x03, 0x63, 0x70, 0x31, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x03,
+	0x63, 0x70, 0x31, 0x12, 0x2c, 0x0a, 0x03, 0x63, 0x70, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x03, 0x63, 0x70,
+	0x32, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x61, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x22, 0x4c, 0x0a, 0x11, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x37, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x72, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64,
+	0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0xda, 0x02, 0x0a,
+	0x12, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61,
+	0x6b, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x75, 0x6e,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x6f, 0x74, 0x65, 0x54, 0x69, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x6f, 0x74, 0x65, 0x54, 0x69, 0x70, 0x22, 0x6f, 0x0a, 0x10, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x16, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x6c, 0x64, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x6f, 0x6c, 0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x3d, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7a,
+	0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x5c, 0x0a, 0x0e, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x73, 0x22, 0xbc, 0x02, 0x0a, 0x0e, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x66, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x2a, 0x0a, 0x11,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x65, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66,
+	0x65, 0x65, 0x50, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x66,
+	0x65, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x62, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67,
+	0x6e, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x43, 0x0a, 0x0d, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x45, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x34, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a,
+	0x0d, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x22, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x02, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x22, 0x24, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x46, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x42, 0x6c, 0x73, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c, 0x73, 0x41, 0x64,
+	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x6c, 0x73, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x22,
+	0x8d, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x8b, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x42, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x69, 0x0a,
+	0x13, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x28, 0x0a, 0x10,
+	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x50,
+	0x65, 0x72, 0x73, 0x65, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54,
+	0x78, 0x48, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x43, 0x0a, 0x0e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x91, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x41, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12,
+	0x22, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x03,
+	0x73, 0x69, 0x67, 0x22, 0x3d, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x41, 0x64, 0x76, 0x69,
+	0x73, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x41, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0x67, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x61, 0x6e, 0x6e, 0x65,
+	0x64, 0x50, 0x65, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x61, 0x69,
+	0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x40, 0x0a, 0x10, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12,
+	0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x61, 0x6e, 0x6e,
+	0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xcf, 0x03,
+	0x0a, 0x13, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x62, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x62, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x6b, 0x65, 0x72,
+	0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x53, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x72,
+	0x53, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x6f, 0x6f, 0x70, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x6f, 0x6f, 0x70,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22,
+	0xfc, 0x01, 0x0a, 0x14, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62,
+	0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x61, 0x64, 0x65, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74,
+	0x4d, 0x61, 0x64, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x4d, 0x61, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x61, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28,
+	0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74,
+	0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x56, 0x6f, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xc5,
+	0x01, 0x0a, 0x16, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73,
+	0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x73, 0x4b, 0x65,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x4a, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x14, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x73, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22,
+	0xa6, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x31, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x05, 0x6d, 0x61,
+	0x6b, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x22,
+	0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x03, 0x73,
+	0x69, 0x67, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xba, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x53, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x31,
+	0x0a, 0x06, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x6d, 0x61, 0x6b, 0x65, 0x72,
+	0x73, 0x12, 0x31, 0x0a, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53,
+	0x6f, 0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x6d, 0x61, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x63, 0x61, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x22, 0x43, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x76, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x03, 0x73, 0x69,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0x65,
+	0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0x99, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4c,
+	0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x6c, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x76,
+	0x65, 0x22, 0xb0, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4c, 0x69, 0x76, 0x65, 0x6e,
+	0x65, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x30, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x79, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x44,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x66, 0x65,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x5e, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x78, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x54, 0x78, 0x52, 0x03, 0x74, 0x78,
+	0x73, 0x22, 0x35, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x44, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x47, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x44, 0x69, 0x61, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6c, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x63, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x61,
+	0x70, 0x22, 0xde, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6d, 0x61, 0x70, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x44, 0x69, 0x61, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x6d, 0x61,
+	0x70, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x44,
+	0x69, 0x61, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x05, 0x63, 0x68, 0x61, 0x6e, 0x73, 0x12, 0x22,
+	0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x65, 0x73, 0x22, 0x7d, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x31, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x31, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74,
+	0x65, 0x31, 0x35, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x65, 0x31,
+	0x35, 0x22, 0x6e, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x74, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0xca, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x64, 0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78,
+	0x65, 0x63, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x54, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x54, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x54,
+	0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x54, 0x78,
+	0x73, 0x12, 0x27, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x64, 0x54, 0x78, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x64, 0x22, 0x69, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x41, 0x64, 0x64, 0x72, 0x54, 0x78, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x3b, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x49, 0x6d, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x11,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x49, 0x6d, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x49, 0x6d,
+	0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x20, 0x0a,
+	0x0e, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x78, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x78, 0x22,
+	0x3e, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x65, 0x6e,
+	0x64, 0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x5c, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x57, 0x61, 0x69, 0x74, 0x54,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x22, 0xa2, 0x01,
+	0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x78, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c,
+	0x0a, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x41, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x7f, 0x0a, 0x17, 0x52, 0x65, 0x71,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x5c, 0x0a, 0x16, 0x52, 0x65,
+	0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x62, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x47, 0x0a, 0x12,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0xac, 0x01, 0x0a, 0x0f,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65,
+	0x76, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x72,
+	0x65, 0x76, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x41, 0x0a, 0x11, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3e, 0x0a,
+	0x10, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd6, 0x01,
+	0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x69, 0x6c,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x6e, 0x69,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x79, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x62, 0x79, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x42, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x49, 0x0a, 0x13, 0x52, 0x65,
+	0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x82, 0x02, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x78, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x4d, 0x65, 0x6d, 0x70, 0x6f,
+	0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x4d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x54, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x54, 0x79, 0x22, 0x40, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x54, 0x72, 0x61, 0x63, 0x65, 0x64, 0x54, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x53, 0x0a, 0x0f, 0x52, 0x65, 0x71,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74,
+	0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x22, 0x79,
+	0x0a, 0x11, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x6f, 0x72, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x28, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x61, 0x0a, 0x10, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x4d, 0x73, 0x67, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x43, 0x0a, 0x13,
+	0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x6f, 0x70, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x59, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x6f, 0x70, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x10,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x6f, 0x70, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
+	0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x6f, 0x70,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65,
+	0x78, 0x74, 0x22, 0x6c, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x5c, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x2d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x59,
+	0x0a, 0x15, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x11, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78,
+	0x74, 0x22, 0x9c, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x51, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x65, 0x78, 0x74, 0x22, 0x46, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4f, 0x70, 0x65, 0x6e,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x11, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x6e, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x0e,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64,
+	0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x04,
+	0x70, 0x72, 0x65, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x69, 0x6e,
+	0x64, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76, 0x22, 0x46, 0x0a, 0x14, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x42, 0x69, 0x6e, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x22,
+	0x72, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x32, 0x44, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x33, 0x33, 0x12, 0x3b, 0x0a, 0x0f,
+	0x53, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x12,
+	0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x6e, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x54, 0x78, 0x48, 0x65, 0x78, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pos33_proto_rawDescOnce sync.Once
+	file_pos33_proto_rawDescData = file_pos33_proto_rawDesc
+)
+
+func file_pos33_proto_rawDescGZIP() []byte {
+	file_pos33_proto_rawDescOnce.Do(func() {
+		file_pos33_proto_rawDescData = protoimpl.X.CompressGZIP(file_pos33_proto_rawDescData)
+	})
+	return file_pos33_proto_rawDescData
+}
+
+var file_pos33_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pos33_proto_msgTypes = make([]protoimpl.MessageInfo, 145)
+var file_pos33_proto_goTypes = []interface{}{
+	(Pos33Msg_Ty)(0),                // 0: types.Pos33Msg.Ty
+	(*Pos33Ticket)(nil),             // 1: types.Pos33Ticket
+	(*Pos33TicketAction)(nil),       // 2: types.Pos33TicketAction
+	(*Pos33Msg)(nil),                // 3: types.Pos33Msg
+	(*SortHash)(nil),                // 4: types.SortHash
+	(*VrfInput)(nil),                // 5: types.VrfInput
+	(*HashProof)(nil),               // 6: types.HashProof
+	(*Pos33SortMsg)(nil),            // 7: types.Pos33SortMsg
+	(*Pos33Sorts)(nil),              // 8: types.Pos33Sorts
+	(*Pos33VoteSorts)(nil),          // 9: types.Pos33VoteSorts
+	(*Pos33Online)(nil),             // 10: types.Pos33Online
+	(*Pos33BlockMsg)(nil),           // 11: types.Pos33BlockMsg
+	(*Pos33BlockMsg2)(nil),          // 12: types.Pos33BlockMsg2
+	(*Pos33VoteMsg)(nil),            // 13: types.Pos33VoteMsg
+	(*Pos33DepositMsg)(nil),         // 14: types.Pos33DepositMsg
+	(*Pos33SortsVote)(nil),          // 15: types.Pos33SortsVote
+	(*Pos33SortMap)(nil),            // 16: types.Pos33SortMap
+	(*Pos33Votes)(nil),              // 17: types.Pos33Votes
+	(*Pos33MakerVotes)(nil),         // 18: types.Pos33MakerVotes
+	(*Pos33TicketMiner)(nil),        // 19: types.Pos33TicketMiner
+	(*Pos33MinerMsg)(nil),           // 20: types.Pos33MinerMsg
+	(*Pos33LateVotes)(nil),          // 21: types.Pos33LateVotes
+	(*Pos33VoteCommitment)(nil),     // 22: types.Pos33VoteCommitment
+	(*Pos33BlockExtra)(nil),         // 23: types.Pos33BlockExtra
+	(*Pos33Heartbeat)(nil),          // 24: types.Pos33Heartbeat
+	(*Pos33DiffRetarget)(nil),       // 25: types.Pos33DiffRetarget
+	(*Pos33BlockSeed)(nil),          // 26: types.Pos33BlockSeed
+	(*Pos33BaseFee)(nil),            // 27: types.Pos33BaseFee
+	(*Pos33BaseFees)(nil),           // 28: types.Pos33BaseFees
+	(*Pos33ChartPoint)(nil),         // 29: types.Pos33ChartPoint
+	(*ReqPos33Chart)(nil),           // 30: types.ReqPos33Chart
+	(*Pos33ChartPoints)(nil),        // 31: types.Pos33ChartPoints
+	(*Pos33TicketPrice)(nil),        // 32: types.Pos33TicketPrice
+	(*Pos33TicketPrices)(nil),       // 33: types.Pos33TicketPrices
+	(*Pos33Checkpoint)(nil),         // 34: types.Pos33Checkpoint
+	(*Pos33CheckpointVote)(nil),     // 35: types.Pos33CheckpointVote
+	(*Pos33MinerFlag)(nil),          // 36: types.Pos33MinerFlag
+	(*Pos33PrivMsg)(nil),            // 37: types.Pos33PrivMsg
+	(*Pos33TicketBind)(nil),         // 38: types.Pos33TicketBind
+	(*Pos33TicketOpen)(nil),         // 39: types.Pos33TicketOpen
+	(*Pos33TicketGenesis)(nil),      // 40: types.Pos33TicketGenesis
+	(*Pos33TicketClose)(nil),        // 41: types.Pos33TicketClose
+	(*Pos33TicketReward)(nil),       // 42: types.Pos33TicketReward
+	(*Pos33TicketList)(nil),         // 43: types.Pos33TicketList
+	(*ReplyPos33TicketReward)(nil),  // 44: types.ReplyPos33TicketReward
+	(*ReplyWalletPos33Count)(nil),   // 45: types.ReplyWalletPos33Count
+	(*ReceiptPos33Deposit)(nil),     // 46: types.ReceiptPos33Deposit
+	(*ReceiptPos33Miner)(nil),       // 47: types.ReceiptPos33Miner
+	(*Pos33Evidence)(nil),           // 48: types.Pos33Evidence
+	(*ReceiptPos33Slash)(nil),       // 49: types.ReceiptPos33Slash
+	(*Pos33StakeSnapshot)(nil),      // 50: types.Pos33StakeSnapshot
+	(*Pos33StakeHistory)(nil),       // 51: types.Pos33StakeHistory
+	(*ReceiptPos33Missed)(nil),      // 52: types.ReceiptPos33Missed
+	(*ReceiptPos33Reward)(nil),      // 53: types.ReceiptPos33Reward
+	(*Pos33MissedSlots)(nil),        // 54: types.Pos33MissedSlots
+	(*ReceiptPos33TicketBind)(nil),  // 55: types.ReceiptPos33TicketBind
+	(*Consignee)(nil),               // 56: types.Consignee
+	(*Consignor)(nil),               // 57: types.Consignor
+	(*Pos33Consignor)(nil),          // 58: types.Pos33Consignor
+	(*Pos33Consignee)(nil),          // 59: types.Pos33Consignee
+	(*Pos33Entrust)(nil),            // 60: types.Pos33Entrust
+	(*Pos33Delegate)(nil),           // 61: types.Pos33Delegate
+	(*Pos33Undelegate)(nil),         // 62: types.Pos33Undelegate
+	(*Pos33SetCommission)(nil),      // 63: types.Pos33SetCommission
+	(*Pos33Operator)(nil),           // 64: types.Pos33Operator
+	(*Pos33SetCompound)(nil),        // 65: types.Pos33SetCompound
+	(*Pos33Compound)(nil),           // 66: types.Pos33Compound
+	(*Pos33Migrate)(nil),            // 67: types.Pos33Migrate
+	(*Pos33BlsBind)(nil),            // 68: types.Pos33BlsBind
+	(*Pos33MinerInfo)(nil),          // 69: types.Pos33MinerInfo
+	(*ReqBindPos33Miner)(nil),       // 70: types.ReqBindPos33Miner
+	(*Pos33WithdrawReward)(nil),     // 71: types.Pos33WithdrawReward
+	(*Pos33MinerFeeRate)(nil),       // 72: types.Pos33MinerFeeRate
+	(*ReplyTxHex)(nil),              // 73: types.ReplyTxHex
+	(*ReplyPos33Info)(nil),          // 74: types.ReplyPos33Info
+	(*Pos33Advisory)(nil),           // 75: types.Pos33Advisory
+	(*Pos33Advisories)(nil),         // 76: types.Pos33Advisories
+	(*Pos33BannedPeer)(nil),         // 77: types.Pos33BannedPeer
+	(*Pos33BannedPeers)(nil),        // 78: types.Pos33BannedPeers
+	(*Pos33ConsensusState)(nil),     // 79: types.Pos33ConsensusState
+	(*Pos33ValidatorStatus)(nil),    // 80: types.Pos33ValidatorStatus
+	(*Pos33ValidatorStatuses)(nil),  // 81: types.Pos33ValidatorStatuses
+	(*ReqPos33MinerPause)(nil),      // 82: types.ReqPos33MinerPause
+	(*Pos33CommitteeMember)(nil),    // 83: types.Pos33CommitteeMember
+	(*Pos33Committee)(nil),          // 84: types.Pos33Committee
+	(*Pos33PushDevice)(nil),         // 85: types.Pos33PushDevice
+	(*Pos33SortAuditItem)(nil),      // 86: types.Pos33SortAuditItem
+	(*Pos33SortAudit)(nil),          // 87: types.Pos33SortAudit
+	(*Pos33Participation)(nil),      // 88: types.Pos33Participation
+	(*ReqPos33Participation)(nil),   // 89: types.ReqPos33Participation
+	(*Pos33Telemetry)(nil),          // 90: types.Pos33Telemetry
+	(*Pos33NodeIdentity)(nil),       // 91: types.Pos33NodeIdentity
+	(*Pos33Liveness)(nil),           // 92: types.Pos33Liveness
+	(*Pos33LivenessMap)(nil),        // 93: types.Pos33LivenessMap
+	(*ReqPos33DryRun)(nil),          // 94: types.ReqPos33DryRun
+	(*Pos33DryRunTx)(nil),           // 95: types.Pos33DryRunTx
+	(*Pos33DryRunBlock)(nil),        // 96: types.Pos33DryRunBlock
+	(*ReqPos33Diagnostics)(nil),     // 97: types.ReqPos33Diagnostics
+	(*Pos33DiagSize)(nil),           // 98: types.Pos33DiagSize
+	(*Pos33Diagnostics)(nil),        // 99: types.Pos33Diagnostics
+	(*Pos33StateUsage)(nil),         // 100: types.Pos33StateUsage
+	(*Pos33StateUsages)(nil),        // 101: types.Pos33StateUsages
+	(*Pos33IndexedTx)(nil),          // 102: types.Pos33IndexedTx
+	(*Pos33IndexedTxs)(nil),         // 103: types.Pos33IndexedTxs
+	(*ReqPos33AddrTxs)(nil),         // 104: types.ReqPos33AddrTxs
+	(*Pos33Immature)(nil),           // 105: types.Pos33Immature
+	(*Pos33ImmatureList)(nil),       // 106: types.Pos33ImmatureList
+	(*ReqPos33SendTx)(nil),          // 107: types.ReqPos33SendTx
+	(*ReplyPos33SendTx)(nil),        // 108: types.ReplyPos33SendTx
+	(*ReqPos33WaitTx)(nil),          // 109: types.ReqPos33WaitTx
+	(*ReplyPos33TxStatus)(nil),      // 110: types.ReplyPos33TxStatus
+	(*ReqPos33Session)(nil),         // 111: types.ReqPos33Session
+	(*ReplyPos33Session)(nil),       // 112: types.ReplyPos33Session
+	(*ReqPos33SessionTransfer)(nil), // 113: types.ReqPos33SessionTransfer
+	(*ReqPos33SessionFeeRate)(nil),  // 114: types.ReqPos33SessionFeeRate
+	(*ReqPos33Transfer)(nil),        // 115: types.ReqPos33Transfer
+	(*ReplyPos33Transfer)(nil),      // 116: types.ReplyPos33Transfer
+	(*ReqPos33Approve)(nil),         // 117: types.ReqPos33Approve
+	(*Pos33AuditEntry)(nil),         // 118: types.Pos33AuditEntry
+	(*Pos33AuditEntries)(nil),       // 119: types.Pos33AuditEntries
+	(*ReqPos33AuditLog)(nil),        // 120: types.ReqPos33AuditLog
+	(*Pos33TenantUsage)(nil),        // 121: types.Pos33TenantUsage
+	(*Pos33TenantUsages)(nil),       // 122: types.Pos33TenantUsages
+	(*ReqPos33TenantUsage)(nil),     // 123: types.ReqPos33TenantUsage
+	(*Pos33TraceEvent)(nil),         // 124: types.Pos33TraceEvent
+	(*Pos33TxTrace)(nil),            // 125: types.Pos33TxTrace
+	(*ReqPos33TracedTx)(nil),        // 126: types.ReqPos33TracedTx
+	(*ReqPos33Locator)(nil),         // 127: types.ReqPos33Locator
+	(*ReplyPos33Locator)(nil),       // 128: types.ReplyPos33Locator
+	(*Pos33ArchivedMsg)(nil),        // 129: types.Pos33ArchivedMsg
+	(*ReqPos33TopDeposits)(nil),     // 130: types.ReqPos33TopDeposits
+	(*Pos33TopDeposit)(nil),         // 131: types.Pos33TopDeposit
+	(*Pos33TopDeposits)(nil),        // 132: types.Pos33TopDeposits
+	(*Pos33RewardEvent)(nil),        // 133: types.Pos33RewardEvent
+	(*ReceiptPos33Rewards)(nil),     // 134: types.ReceiptPos33Rewards
+	(*ReqPos33RewardHistory)(nil),   // 135: types.ReqPos33RewardHistory
+	(*Pos33RewardEvents)(nil),       // 136: types.Pos33RewardEvents
+	(*ReqPos33Deposits)(nil),        // 137: types.ReqPos33Deposits
+	(*Pos33Deposits)(nil),           // 138: types.Pos33Deposits
+	(*Pos33OpenTickets)(nil),        // 139: types.Pos33OpenTickets
+	(*Pos33CloseTickets)(nil),       // 140: types.Pos33CloseTickets
+	(*ReceiptPos33Tickets)(nil),     // 141: types.ReceiptPos33Tickets
+	(*Pos33MinerBind)(nil),          // 142: types.Pos33MinerBind
+	(*Pos33BlockSignerBind)(nil),    // 143: types.Pos33BlockSignerBind
+	(*Pos33BlockSigner)(nil),        // 144: types.Pos33BlockSigner
+	nil,                             // 145: types.Pos33SortMap.SortMapEntry
+	(*types.Signature)(nil),         // 146: types.Signature
+	(*types.Block)(nil),             // 147: types.Block
+	(*types.Transaction)(nil),       // 148: types.Transaction
+	(*types.Headers)(nil),           // 149: types.Headers
+}
+var file_pos33_proto_depIdxs = []int32{
+	39,  // 0: types.Pos33TicketAction.topen:type_name -> types.Pos33TicketOpen
+	40,  // 1: types.Pos33TicketAction.genesis:type_name -> types.Pos33TicketGenesis
+	41,  // 2: types.Pos33TicketAction.tclose:type_name -> types.Pos33TicketClose
+	38,  // 3: types.Pos33TicketAction.minerBind:type_name -> types.Pos33TicketBind
+	20,  // 4: types.Pos33TicketAction.miner:type_name -> types.Pos33MinerMsg
+	60,  // 5: types.Pos33TicketAction.entrust:type_name -> types.Pos33Entrust
+	67,  // 6: types.Pos33TicketAction.migrate:type_name -> types.Pos33Migrate
+	68,  // 7: types.Pos33TicketAction.blsBind:type_name -> types.Pos33BlsBind
+	72,  // 8: types.Pos33TicketAction.feeRate:type_name -> types.Pos33MinerFeeRate
+	71,  // 9: types.Pos33TicketAction.withdraw:type_name -> types.Pos33WithdrawReward
+	69,  // 10: types.Pos33TicketAction.minerInfo:type_name -> types.Pos33MinerInfo
+	48,  // 11: types.Pos33TicketAction.slash:type_name -> types.Pos33Evidence
+	61,  // 12: types.Pos33TicketAction.delegate:type_name -> types.Pos33Delegate
+	62,  // 13: types.Pos33TicketAction.undelegate:type_name -> types.Pos33Undelegate
+	63,  // 14: types.Pos33TicketAction.setCommission:type_name -> types.Pos33SetCommission
+	65,  // 15: types.Pos33TicketAction.setCompound:type_name -> types.Pos33SetCompound
+	139, // 16: types.Pos33TicketAction.openTickets:type_name -> types.Pos33OpenTickets
+	140, // 17: types.Pos33TicketAction.closeTickets:type_name -> types.Pos33CloseTickets
+	143, // 18: types.Pos33TicketAction.blockSigner:type_name -> types.Pos33BlockSignerBind
+	0,   // 19: types.Pos33Msg.ty:type_name -> types.Pos33Msg.Ty
+	5,   // 20: types.HashProof.input:type_name -> types.VrfInput
+	4,   // 21: types.Pos33SortMsg.sort_hash:type_name -> types.SortHash
+	6,   // 22: types.Pos33SortMsg.proof:type_name -> types.HashProof
+	7,   // 23: types.Pos33Sorts.sorts:type_name -> types.Pos33SortMsg
+	8,   // 24: types.Pos33VoteSorts.vote_sorts:type_name -> types.Pos33Sorts
+	146, // 25: types.Pos33Online.Sig:type_name -> types.Signature
+	147, // 26: types.Pos33BlockMsg.b:type_name -> types.Block
+	147, // 27: types.Pos33BlockMsg2.b:type_name -> types.Block
+	13,  // 28: types.Pos33BlockMsg2.vs:type_name -> types.Pos33VoteMsg
+	7,   // 29: types.Pos33VoteMsg.sort:type_name -> types.Pos33SortMsg
+	146, // 30: types.Pos33VoteMsg.sig:type_name -> types.Signature
+	7,   // 31: types.Pos33SortsVote.my_sorts:type_name -> types.Pos33SortMsg
+	146, // 32: types.Pos33SortsVote.sig:type_name -> types.Signature
+	145, // 33: types.Pos33SortMap.sort_map:type_name -> types.Pos33SortMap.SortMapEntry
+	13,  // 34: types.Pos33Votes.vs:type_name -> types.Pos33VoteMsg
+	17,  // 35: types.Pos33MakerVotes.mvs:type_name -> types.Pos33Votes
+	7,   // 36: types.Pos33TicketMiner.sort:type_name -> types.Pos33SortMsg
+	13,  // 37: types.Pos33TicketMiner.vs:type_name -> types.Pos33VoteMsg
+	7,   // 38: types.Pos33MinerMsg.sort:type_name -> types.Pos33SortMsg
+	34,  // 39: types.Pos33MinerMsg.checkpoint:type_name -> types.Pos33Checkpoint
+	23,  // 40: types.Pos33MinerMsg.extras:type_name -> types.Pos33BlockExtra
+	7,   // 41: types.Pos33MinerMsg.missed:type_name -> types.Pos33SortMsg
+	22,  // 42: types.Pos33MinerMsg.commitment:type_name -> types.Pos33VoteCommitment
+	21,  // 43: types.Pos33MinerMsg.late:type_name -> types.Pos33LateVotes
+	7,   // 44: types.Pos33LateVotes.sorts:type_name -> types.Pos33SortMsg
+	27,  // 45: types.Pos33BaseFees.items:type_name -> types.Pos33BaseFee
+	29,  // 46: types.Pos33ChartPoints.points:type_name -> types.Pos33ChartPoint
+	32,  // 47: types.Pos33TicketPrices.items:type_name -> types.Pos33TicketPrice
+	146, // 48: types.Pos33CheckpointVote.sig:type_name -> types.Signature
+	148, // 49: types.Pos33Evidence.tx1:type_name -> types.Transaction
+	148, // 50: types.Pos33Evidence.tx2:type_name -> types.Transaction
+	13,  // 51: types.Pos33Evidence.vote1:type_name -> types.Pos33VoteMsg
+	13,  // 52: types.Pos33Evidence.vote2:type_name -> types.Pos33VoteMsg
+	7,   // 53: types.Pos33Evidence.maker1:type_name -> types.Pos33SortMsg
+	7,   // 54: types.Pos33Evidence.maker2:type_name -> types.Pos33SortMsg
+	35,  // 55: types.Pos33Evidence.cp1:type_name -> types.Pos33CheckpointVote
+	35,  // 56: types.Pos33Evidence.cp2:type_name -> types.Pos33CheckpointVote
+	59,  // 57: types.Pos33StakeSnapshot.consignee:type_name -> types.Pos33Consignee
+	50,  // 58: types.Pos33StakeHistory.snapshots:type_name -> types.Pos33StakeSnapshot
+	52,  // 59: types.Pos33MissedSlots.recent:type_name -> types.ReceiptPos33Missed
+	56,  // 60: types.Pos33Consignor.consignees:type_name -> types.Consignee
+	57,  // 61: types.Pos33Consignee.consignors:type_name -> types.Consignor
+	146, // 62: types.Pos33Advisory.sig:type_name -> types.Signature
+	75,  // 63: types.Pos33Advisories.items:type_name -> types.Pos33Advisory
+	77,  // 64: types.Pos33BannedPeers.items:type_name -> types.Pos33BannedPeer
+	80,  // 65: types.Pos33ValidatorStatuses.items:type_name -> types.Pos33ValidatorStatus
+	83,  // 66: types.Pos33Committee.maker:type_name -> types.Pos33CommitteeMember
+	83,  // 67: types.Pos33Committee.voters:type_name -> types.Pos33CommitteeMember
+	146, // 68: types.Pos33PushDevice.sig:type_name -> types.Signature
+	86,  // 69: types.Pos33SortAudit.makers:type_name -> types.Pos33SortAuditItem
+	86,  // 70: types.Pos33SortAudit.voters:type_name -> types.Pos33SortAuditItem
+	146, // 71: types.Pos33Telemetry.sig:type_name -> types.Signature
+	146, // 72: types.Pos33NodeIdentity.sig:type_name -> types.Signature
+	92,  // 73: types.Pos33LivenessMap.items:type_name -> types.Pos33Liveness
+	147, // 74: types.Pos33DryRunBlock.block:type_name -> types.Block
+	95,  // 75: types.Pos33DryRunBlock.txs:type_name -> types.Pos33DryRunTx
+	79,  // 76: types.Pos33Diagnostics.state:type_name -> types.Pos33ConsensusState
+	98,  // 77: types.Pos33Diagnostics.maps:type_name -> types.Pos33DiagSize
+	98,  // 78: types.Pos33Diagnostics.chans:type_name -> types.Pos33DiagSize
+	100, // 79: types.Pos33StateUsages.items:type_name -> types.Pos33StateUsage
+	102, // 80: types.Pos33IndexedTxs.txs:type_name -> types.Pos33IndexedTx
+	105, // 81: types.Pos33ImmatureList.items:type_name -> types.Pos33Immature
+	72,  // 82: types.ReqPos33SessionFeeRate.rate:type_name -> types.Pos33MinerFeeRate
+	146, // 83: types.ReqPos33Approve.sig:type_name -> types.Signature
+	118, // 84: types.Pos33AuditEntries.items:type_name -> types.Pos33AuditEntry
+	121, // 85: types.Pos33TenantUsages.items:type_name -> types.Pos33TenantUsage
+	124, // 86: types.Pos33TxTrace.events:type_name -> types.Pos33TraceEvent
+	149, // 87: types.ReplyPos33Locator.headers:type_name -> types.Headers
+	3,   // 88: types.Pos33ArchivedMsg.msg:type_name -> types.Pos33Msg
+	131, // 89: types.Pos33TopDeposits.items:type_name -> types.Pos33TopDeposit
+	133, // 90: types.ReceiptPos33Rewards.items:type_name -> types.Pos33RewardEvent
+	133, // 91: types.Pos33RewardEvents.items:type_name -> types.Pos33RewardEvent
+	14,  // 92: types.Pos33Deposits.items:type_name -> types.Pos33DepositMsg
+	60,  // 93: types.ReceiptPos33Tickets.items:type_name -> types.Pos33Entrust
+	142, // 94: types.Pos33MinerBind.prev:type_name -> types.Pos33MinerBind
+	7,   // 95: types.Pos33SortMap.SortMapEntry.value:type_name -> types.Pos33SortMsg
+	60,  // 96: types.pos33.SetPos33Entrust:input_type -> types.Pos33Entrust
+	73,  // 97: types.pos33.SetPos33Entrust:output_type -> types.ReplyTxHex
+	97,  // [97:98] is the sub-list for method output_type
+	96,  // [96:97] is the sub-list for method input_type
+	96,  // [96:96] is the sub-list for extension type_name
+	96,  // [96:96] is the sub-list for extension extendee
+	0,   // [0:96] is the sub-list for field type_name
+}
+
+func init() { file_pos33_proto_init() }
+func file_pos33_proto_init() {
+	if File_pos33_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pos33_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Ticket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Msg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortHash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SortMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Sorts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33VoteSorts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Online); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BlockMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BlockMsg2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33VoteMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33DepositMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SortsVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SortMap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Votes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33MakerVotes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketMiner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33MinerMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33LateVotes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33VoteCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BlockExtra); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Heartbeat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33DiffRetarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BlockSeed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BaseFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BaseFees); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33ChartPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33Chart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33ChartPoints); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketPrices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Checkpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33CheckpointVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33MinerFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33PrivMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketBind); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketOpen); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketGenesis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketClose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyPos33TicketReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyWalletPos33Count); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptPos33Deposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptPos33Miner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Evidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptPos33Slash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33StakeSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33StakeHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptPos33Missed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptPos33Reward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33MissedSlots); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptPos33TicketBind); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Consignee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Consignor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Consignor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Consignee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Entrust); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Delegate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Undelegate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SetCommission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Operator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SetCompound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Compound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Migrate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BlsBind); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33MinerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqBindPos33Miner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33WithdrawReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33MinerFeeRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyTxHex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyPos33Info); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Advisory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Advisories); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BannedPeer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BannedPeers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33ConsensusState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33ValidatorStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33ValidatorStatuses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33MinerPause); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33CommitteeMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Committee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33PushDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SortAuditItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SortAudit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Participation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33Participation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Telemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33NodeIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Liveness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33LivenessMap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33DryRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33DryRunTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33DryRunBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33Diagnostics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33DiagSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Diagnostics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ReplyPos33Info.ProtoReflect.Descriptor instead.
-func (*ReplyPos33Info) Descriptor() ([]byte, []int) {
-	return file_pos33_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *ReplyPos33Info) GetPrice() int64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *ReplyPos33Info) GetAllCount() int64 {
-	if x != nil {
-		return x.AllCount
-	}
-	return 0
-}
-
-var File_pos33_proto protoreflect.FileDescriptor
-
-var file_pos33_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x33, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x1a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x02, 0x0a, 0x0b, 0x50, 0x6f,
-	0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x69, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x69, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6f,
-	0x70, 0x65, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63,
-	0x6c, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a,
-	0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xa7, 0x04,
-	0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
-	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f,
-	0x70, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73,
-	0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x48,
-	0x00, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x63,
-	0x6c, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x74, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x2e, 0x0a,
-	0x05, 0x74, 0x62, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x42, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x05, 0x74, 0x62, 0x69, 0x6e, 0x64, 0x12, 0x2c, 0x0a,
-	0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x4d,
-	0x73, 0x67, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x07, 0x65,
-	0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73,
-	0x74, 0x48, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x07,
-	0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x67, 0x72, 0x61,
-	0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a,
-	0x07, 0x62, 0x6c, 0x73, 0x42, 0x69, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x73, 0x42,
-	0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6c, 0x73, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x34,
-	0x0a, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e,
-	0x65, 0x72, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x66, 0x65, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
-	0x6f, 0x73, 0x33, 0x33, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x48, 0x00, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x0e,
-	0x0a, 0x02, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x77, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x33, 0x33,
-	0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33,
-	0x33, 0x4d, 0x73, 0x67, 0x2e, 0x54, 0x79, 0x52, 0x02, 0x74, 0x79, 0x22, 0x33, 0x0a, 0x02, 0x54,
-	0x79, 0x12, 0x06, 0x0a, 0x02, 0x4d, 0x56, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x42, 0x56, 0x10,
-	0x01, 0x12, 0x05, 0x0a, 0x01, 0x42, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x4d, 0x53, 0x10, 0x03,
-	0x12, 0x06, 0x0a, 0x02, 0x56, 0x53, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x43, 0x56, 0x10, 0x05,
-	0x22, 0x5a, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x08,
-	0x56, 0x72, 0x66, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x09, 0x48,
-	0x61, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x56, 0x72, 0x66, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x76, 0x72, 0x66, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x76, 0x72, 0x66, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x72,
-	0x66, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x76,
-	0x72, 0x66, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22,
-	0x64, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x12,
-	0x2c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x48,
-	0x61, 0x73, 0x68, 0x52, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a,
-	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05,
-	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x37, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f,
-	0x72, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
-	0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x05, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x42,
-	0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x73,
-	0x12, 0x30, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73,
-	0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x53, 0x6f, 0x72,
-	0x74, 0x73, 0x22, 0x4b, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4f, 0x6e, 0x6c, 0x69, 0x6e,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x53,
-	0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x03, 0x53, 0x69, 0x67, 0x22,
-	0x3d, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x67,
-	0x12, 0x1a, 0x0a, 0x01, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x01, 0x62, 0x12, 0x10, 0x0a, 0x03,
-	0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x64, 0x22, 0x51,
-	0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x32,
-	0x12, 0x1a, 0x0a, 0x01, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x01, 0x62, 0x12, 0x23, 0x0a, 0x02,
-	0x76, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x02, 0x76,
-	0x73, 0x22, 0x85, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d,
-	0x73, 0x67, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f,
-	0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
-	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0xab, 0x01, 0x0a, 0x0f, 0x50, 0x6f,
-	0x73, 0x33, 0x33, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a,
-	0x05, 0x6d, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61,
-	0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
-	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xb5, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33,
-	0x33, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x6d, 0x79,
-	0x5f, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73,
-	0x67, 0x52, 0x07, 0x6d, 0x79, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c,
-	0x52, 0x0b, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x73,
-	0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22,
-	0x9c, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70,
-	0x12, 0x3b, 0x0a, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
-	0x53, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x1a, 0x4f, 0x0a,
-	0x0c, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74,
-	0x4d, 0x73, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x31,
-	0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x02,
-	0x76, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x02, 0x76,
-	0x73, 0x22, 0x36, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x56,
-	0x6f, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x03, 0x6d, 0x76, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56,
-	0x6f, 0x74, 0x65, 0x73, 0x52, 0x03, 0x6d, 0x76, 0x73, 0x22, 0x7e, 0x0a, 0x10, 0x50, 0x6f, 0x73,
-	0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x27, 0x0a,
-	0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67,
-	0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x02, 0x76, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
-	0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x02, 0x76, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x0d, 0x50, 0x6f,
-	0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x42,
-	0x6c, 0x73, 0x50, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09,
-	0x42, 0x6c, 0x73, 0x50, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x6c, 0x73,
-	0x53, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x42, 0x6c, 0x73, 0x53, 0x69,
-	0x67, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33,
-	0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x0e,
-	0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x12,
-	0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x6c,
-	0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x22, 0x22, 0x0a, 0x0c,
-	0x50, 0x6f, 0x73, 0x33, 0x33, 0x50, 0x72, 0x69, 0x76, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x72, 0x69, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x72, 0x69, 0x76,
-	0x22, 0x5b, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42,
-	0x69, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xad, 0x01,
-	0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x70, 0x65,
-	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
-	0x6c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x62, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x64, 0x53, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x64, 0x53, 0x65, 0x65, 0x64, 0x22, 0x94, 0x01,
-	0x0a, 0x12, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e,
-	0x0a, 0x0a, 0x62, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4c, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x22, 0x3d, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x5e, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x33, 0x33,
-	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x22, 0x47, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x72,
-	0x69, 0x76, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x13, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x11,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65,
-	0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x92, 0x01,
-	0x0a, 0x16, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x6c, 0x64, 0x4d,
-	0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x6f, 0x6c, 0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x77,
-	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0x3d, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x7a, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x61,
-	0x69, 0x6e, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x5c, 0x0a,
-	0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x52,
-	0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x0e,
-	0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
-	0x2a, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x61,
-	0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
-	0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e,
-	0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x73, 0x22, 0x62,
-	0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x24, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x67, 0x72, 0x61,
-	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x46, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33,
-	0x33, 0x42, 0x6c, 0x73, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c, 0x73, 0x41,
-	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x6c, 0x73, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x22, 0x8b, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x42, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x33,
-	0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x6e, 0x64, 0x41, 0x64,
-	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x64, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x69,
-	0x0a, 0x13, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x11, 0x50, 0x6f, 0x73,
-	0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x28, 0x0a,
-	0x10, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65,
-	0x50, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x54, 0x78, 0x48, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x43, 0x0a, 0x0e, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x32, 0x44, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x33, 0x33, 0x12, 0x3b, 0x0a, 0x0f, 0x53, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73,
-	0x74, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54,
-	0x78, 0x48, 0x65, 0x78, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_pos33_proto_rawDescOnce sync.Once
-	file_pos33_proto_rawDescData = file_pos33_proto_rawDesc
-)
-
-func file_pos33_proto_rawDescGZIP() []byte {
-	file_pos33_proto_rawDescOnce.Do(func() {
-		file_pos33_proto_rawDescData = protoimpl.X.CompressGZIP(file_pos33_proto_rawDescData)
-	})
-	return file_pos33_proto_rawDescData
-}
-
-var file_pos33_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pos33_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
-var file_pos33_proto_goTypes = []interface{}{
-	(Pos33Msg_Ty)(0),               // 0: types.Pos33Msg.Ty
-	(*Pos33Ticket)(nil),            // 1: types.Pos33Ticket
-	(*Pos33TicketAction)(nil),      // 2: types.Pos33TicketAction
-	(*Pos33Msg)(nil),               // 3: types.Pos33Msg
-	(*SortHash)(nil),               // 4: types.SortHash
-	(*VrfInput)(nil),               // 5: types.VrfInput
-	(*HashProof)(nil),              // 6: types.HashProof
-	(*Pos33SortMsg)(nil),           // 7: types.Pos33SortMsg
-	(*Pos33Sorts)(nil),             // 8: types.Pos33Sorts
-	(*Pos33VoteSorts)(nil),         // 9: types.Pos33VoteSorts
-	(*Pos33Online)(nil),            // 10: types.Pos33Online
-	(*Pos33BlockMsg)(nil),          // 11: types.Pos33BlockMsg
-	(*Pos33BlockMsg2)(nil),         // 12: types.Pos33BlockMsg2
-	(*Pos33VoteMsg)(nil),           // 13: types.Pos33VoteMsg
-	(*Pos33DepositMsg)(nil),        // 14: types.Pos33DepositMsg
-	(*Pos33SortsVote)(nil),         // 15: types.Pos33SortsVote
-	(*Pos33SortMap)(nil),           // 16: types.Pos33SortMap
-	(*Pos33Votes)(nil),             // 17: types.Pos33Votes
-	(*Pos33MakerVotes)(nil),        // 18: types.Pos33MakerVotes
-	(*Pos33TicketMiner)(nil),       // 19: types.Pos33TicketMiner
-	(*Pos33MinerMsg)(nil),          // 20: types.Pos33MinerMsg
-	(*Pos33MinerFlag)(nil),         // 21: types.Pos33MinerFlag
-	(*Pos33PrivMsg)(nil),           // 22: types.Pos33PrivMsg
-	(*Pos33TicketBind)(nil),        // 23: types.Pos33TicketBind
-	(*Pos33TicketOpen)(nil),        // 24: types.Pos33TicketOpen
-	(*Pos33TicketGenesis)(nil),     // 25: types.Pos33TicketGenesis
-	(*Pos33TicketClose)(nil),       // 26: types.Pos33TicketClose
-	(*Pos33TicketReward)(nil),      // 27: types.Pos33TicketReward
-	(*Pos33TicketList)(nil),        // 28: types.Pos33TicketList
-	(*ReplyPos33TicketReward)(nil), // 29: types.ReplyPos33TicketReward
-	(*ReplyWalletPos33Count)(nil),  // 30: types.ReplyWalletPos33Count
-	(*ReceiptPos33Deposit)(nil),    // 31: types.ReceiptPos33Deposit
-	(*ReceiptPos33Miner)(nil),      // 32: types.ReceiptPos33Miner
-	(*ReceiptPos33TicketBind)(nil), // 33: types.ReceiptPos33TicketBind
-	(*Consignee)(nil),              // 34: types.Consignee
-	(*Consignor)(nil),              // 35: types.Consignor
-	(*Pos33Consignor)(nil),         // 36: types.Pos33Consignor
-	(*Pos33Consignee)(nil),         // 37: types.Pos33Consignee
-	(*Pos33Entrust)(nil),           // 38: types.Pos33Entrust
-	(*Pos33Migrate)(nil),           // 39: types.Pos33Migrate
-	(*Pos33BlsBind)(nil),           // 40: types.Pos33BlsBind
-	(*ReqBindPos33Miner)(nil),      // 41: types.ReqBindPos33Miner
-	(*Pos33WithdrawReward)(nil),    // 42: types.Pos33WithdrawReward
-	(*Pos33MinerFeeRate)(nil),      // 43: types.Pos33MinerFeeRate
-	(*ReplyTxHex)(nil),             // 44: types.ReplyTxHex
-	(*ReplyPos33Info)(nil),         // 45: types.ReplyPos33Info
-	nil,                            // 46: types.Pos33SortMap.SortMapEntry
-	(*types.Signature)(nil),        // 47: types.Signature
-	(*types.Block)(nil),            // 48: types.Block
-}
-var file_pos33_proto_depIdxs = []int32{
-	24, // 0: types.Pos33TicketAction.topen:type_name -> types.Pos33TicketOpen
-	25, // 1: types.Pos33TicketAction.genesis:type_name -> types.Pos33TicketGenesis
-	26, // 2: types.Pos33TicketAction.tclose:type_name -> types.Pos33TicketClose
-	23, // 3: types.Pos33TicketAction.tbind:type_name -> types.Pos33TicketBind
-	20, // 4: types.Pos33TicketAction.miner:type_name -> types.Pos33MinerMsg
-	38, // 5: types.Pos33TicketAction.entrust:type_name -> types.Pos33Entrust
-	39, // 6: types.Pos33TicketAction.migrate:type_name -> types.Pos33Migrate
-	40, // 7: types.Pos33TicketAction.blsBind:type_name -> types.Pos33BlsBind
-	43, // 8: types.Pos33TicketAction.feeRate:type_name -> types.Pos33MinerFeeRate
-	42, // 9: types.Pos33TicketAction.withdraw:type_name -> types.Pos33WithdrawReward
-	0,  // 10: types.Pos33Msg.ty:type_name -> types.Pos33Msg.Ty
-	5,  // 11: types.HashProof.input:type_name -> types.VrfInput
-	4,  // 12: types.Pos33SortMsg.sort_hash:type_name -> types.SortHash
-	6,  // 13: types.Pos33SortMsg.proof:type_name -> types.HashProof
-	7,  // 14: types.Pos33Sorts.sorts:type_name -> types.Pos33SortMsg
-	8,  // 15: types.Pos33VoteSorts.vote_sorts:type_name -> types.Pos33Sorts
-	47, // 16: types.Pos33Online.Sig:type_name -> types.Signature
-	48, // 17: types.Pos33BlockMsg.b:type_name -> types.Block
-	48, // 18: types.Pos33BlockMsg2.b:type_name -> types.Block
-	13, // 19: types.Pos33BlockMsg2.vs:type_name -> types.Pos33VoteMsg
-	7,  // 20: types.Pos33VoteMsg.sort:type_name -> types.Pos33SortMsg
-	47, // 21: types.Pos33VoteMsg.sig:type_name -> types.Signature
-	7,  // 22: types.Pos33SortsVote.my_sorts:type_name -> types.Pos33SortMsg
-	47, // 23: types.Pos33SortsVote.sig:type_name -> types.Signature
-	46, // 24: types.Pos33SortMap.sort_map:type_name -> types.Pos33SortMap.SortMapEntry
-	13, // 25: types.Pos33Votes.vs:type_name -> types.Pos33VoteMsg
-	17, // 26: types.Pos33MakerVotes.mvs:type_name -> types.Pos33Votes
-	7,  // 27: types.Pos33TicketMiner.sort:type_name -> types.Pos33SortMsg
-	13, // 28: types.Pos33TicketMiner.vs:type_name -> types.Pos33VoteMsg
-	7,  // 29: types.Pos33MinerMsg.sort:type_name -> types.Pos33SortMsg
-	34, // 30: types.Pos33Consignor.consignees:type_name -> types.Consignee
-	35, // 31: types.Pos33Consignee.consignors:type_name -> types.Consignor
-	7,  // 32: types.Pos33SortMap.SortMapEntry.value:type_name -> types.Pos33SortMsg
-	38, // 33: types.pos33.SetPos33Entrust:input_type -> types.Pos33Entrust
-	44, // 34: types.pos33.SetPos33Entrust:output_type -> types.ReplyTxHex
-	34, // [34:35] is the sub-list for method output_type
-	33, // [33:34] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
-}
-
-func init() { file_pos33_proto_init() }
-func file_pos33_proto_init() {
-	if File_pos33_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_pos33_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Ticket); i {
+		file_pos33_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33StateUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3455,8 +12777,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketAction); i {
+		file_pos33_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33StateUsages); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3467,8 +12789,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Msg); i {
+		file_pos33_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33IndexedTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3479,8 +12801,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SortHash); i {
+		file_pos33_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33IndexedTxs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3491,8 +12813,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VrfInput); i {
+		file_pos33_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33AddrTxs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3503,8 +12825,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HashProof); i {
+		file_pos33_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Immature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3515,8 +12837,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33SortMsg); i {
+		file_pos33_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33ImmatureList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3527,8 +12849,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Sorts); i {
+		file_pos33_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33SendTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3539,8 +12861,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33VoteSorts); i {
+		file_pos33_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyPos33SendTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3551,8 +12873,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Online); i {
+		file_pos33_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33WaitTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3563,8 +12885,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33BlockMsg); i {
+		file_pos33_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyPos33TxStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3575,8 +12897,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33BlockMsg2); i {
+		file_pos33_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33Session); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3587,8 +12909,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33VoteMsg); i {
+		file_pos33_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyPos33Session); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3599,8 +12921,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33DepositMsg); i {
+		file_pos33_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33SessionTransfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3611,8 +12933,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33SortsVote); i {
+		file_pos33_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33SessionFeeRate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3623,8 +12945,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33SortMap); i {
+		file_pos33_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33Transfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3635,8 +12957,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Votes); i {
+		file_pos33_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyPos33Transfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3647,8 +12969,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33MakerVotes); i {
+		file_pos33_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33Approve); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3659,8 +12981,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketMiner); i {
+		file_pos33_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33AuditEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3671,8 +12993,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33MinerMsg); i {
+		file_pos33_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33AuditEntries); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3683,8 +13005,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33MinerFlag); i {
+		file_pos33_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33AuditLog); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3695,8 +13017,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33PrivMsg); i {
+		file_pos33_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TenantUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3707,8 +13029,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketBind); i {
+		file_pos33_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TenantUsages); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3719,8 +13041,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketOpen); i {
+		file_pos33_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33TenantUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3731,8 +13053,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketGenesis); i {
+		file_pos33_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TraceEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3743,8 +13065,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketClose); i {
+		file_pos33_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TxTrace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3755,8 +13077,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketReward); i {
+		file_pos33_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33TracedTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3767,8 +13089,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketList); i {
+		file_pos33_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33Locator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3779,8 +13101,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyPos33TicketReward); i {
+		file_pos33_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyPos33Locator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3791,8 +13113,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyWalletPos33Count); i {
+		file_pos33_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33ArchivedMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3803,8 +13125,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptPos33Deposit); i {
+		file_pos33_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33TopDeposits); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3815,8 +13137,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptPos33Miner); i {
+		file_pos33_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TopDeposit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3827,8 +13149,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptPos33TicketBind); i {
+		file_pos33_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TopDeposits); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3839,8 +13161,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Consignee); i {
+		file_pos33_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33RewardEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3851,8 +13173,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Consignor); i {
+		file_pos33_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptPos33Rewards); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3863,8 +13185,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Consignor); i {
+		file_pos33_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33RewardHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3875,8 +13197,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Consignee); i {
+		file_pos33_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33RewardEvents); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3887,8 +13209,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Entrust); i {
+		file_pos33_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33Deposits); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3899,8 +13221,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Migrate); i {
+		file_pos33_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Deposits); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3911,8 +13233,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33BlsBind); i {
+		file_pos33_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33OpenTickets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3923,8 +13245,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqBindPos33Miner); i {
+		file_pos33_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33CloseTickets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3935,8 +13257,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33WithdrawReward); i {
+		file_pos33_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptPos33Tickets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3947,8 +13269,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33MinerFeeRate); i {
+		file_pos33_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33MinerBind); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3959,8 +13281,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyTxHex); i {
+		file_pos33_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BlockSignerBind); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3971,8 +13293,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyPos33Info); i {
+		file_pos33_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BlockSigner); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3988,13 +13310,22 @@ func file_pos33_proto_init() {
 		(*Pos33TicketAction_Topen)(nil),
 		(*Pos33TicketAction_Genesis)(nil),
 		(*Pos33TicketAction_Tclose)(nil),
-		(*Pos33TicketAction_Tbind)(nil),
+		(*Pos33TicketAction_MinerBind)(nil),
 		(*Pos33TicketAction_Miner)(nil),
 		(*Pos33TicketAction_Entrust)(nil),
 		(*Pos33TicketAction_Migrate)(nil),
 		(*Pos33TicketAction_BlsBind)(nil),
 		(*Pos33TicketAction_FeeRate)(nil),
 		(*Pos33TicketAction_Withdraw)(nil),
+		(*Pos33TicketAction_MinerInfo)(nil),
+		(*Pos33TicketAction_Slash)(nil),
+		(*Pos33TicketAction_Delegate)(nil),
+		(*Pos33TicketAction_Undelegate)(nil),
+		(*Pos33TicketAction_SetCommission)(nil),
+		(*Pos33TicketAction_SetCompound)(nil),
+		(*Pos33TicketAction_OpenTickets)(nil),
+		(*Pos33TicketAction_CloseTickets)(nil),
+		(*Pos33TicketAction_BlockSigner)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4002,7 +13333,7 @@ func file_pos33_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pos33_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   46,
+			NumMessages:   145,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
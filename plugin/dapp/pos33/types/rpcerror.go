@@ -0,0 +1,128 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/33cn/chain33/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rpc 错误的命名空间
+const (
+	ErrNamespacePos33   = "pos33"
+	ErrNamespaceChain33 = "chain33"
+)
+
+// ErrCodeUnknown 没有登记错误码的错误
+const ErrCodeUnknown = 1
+
+// RPCError 带错误码的 rpc 错误, 客户端按 namespace 和 code 处理, 不要解析 message.
+// jsonrpc 的 error 字段是这个结构的 json, grpc 的 status code 按错误类型映射, message 同样是 json
+type RPCError struct {
+	Namespace string      `json:"namespace"`
+	Code      int32       `json:"code"`
+	Message   string      `json:"message"`
+	Data      interface{} `json:"data,omitempty"`
+
+	grpcCode codes.Code
+}
+
+func (e *RPCError) Error() string {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return e.Message
+	}
+	return string(data)
+}
+
+// GRPCStatus grpc 返回错误时使用这个 status
+func (e *RPCError) GRPCStatus() *status.Status {
+	return status.New(e.grpcCode, e.Error())
+}
+
+type errCode struct {
+	namespace string
+	code      int32
+	grpcCode  codes.Code
+}
+
+// 错误码一旦发布就不能修改, 新的错误只能在后面追加
+var errCodes = map[string]errCode{}
+
+func regErrCode(err error, namespace string, code int32, grpcCode codes.Code) {
+	errCodes[err.Error()] = errCode{namespace, code, grpcCode}
+}
+
+func init() {
+	regErrCode(ErrNoPos33Ticket, ErrNamespacePos33, 1001, codes.NotFound)
+	regErrCode(ErrPos33TicketCount, ErrNamespacePos33, 1002, codes.FailedPrecondition)
+	regErrCode(ErrTime, ErrNamespacePos33, 1003, codes.FailedPrecondition)
+	regErrCode(ErrPos33TicketClosed, ErrNamespacePos33, 1004, codes.FailedPrecondition)
+	regErrCode(ErrEmptyMinerTx, ErrNamespacePos33, 1005, codes.InvalidArgument)
+	regErrCode(ErrMinerNotPermit, ErrNamespacePos33, 1006, codes.PermissionDenied)
+	regErrCode(ErrMinerAddr, ErrNamespacePos33, 1007, codes.InvalidArgument)
+	regErrCode(ErrModify, ErrNamespacePos33, 1008, codes.FailedPrecondition)
+	regErrCode(ErrMinerTx, ErrNamespacePos33, 1009, codes.InvalidArgument)
+	regErrCode(ErrNoVrf, ErrNamespacePos33, 1010, codes.InvalidArgument)
+	regErrCode(ErrVrfVerify, ErrNamespacePos33, 1011, codes.InvalidArgument)
+	regErrCode(ErrRequestTooLarge, ErrNamespacePos33, 1012, codes.InvalidArgument)
+	regErrCode(ErrTooManyRequests, ErrNamespacePos33, 1013, codes.ResourceExhausted)
+	regErrCode(ErrMinerInfoSize, ErrNamespacePos33, 1014, codes.InvalidArgument)
+	regErrCode(ErrEvidence, ErrNamespacePos33, 1015, codes.InvalidArgument)
+	regErrCode(ErrSlashed, ErrNamespacePos33, 1016, codes.FailedPrecondition)
+	regErrCode(ErrKeyFile, ErrNamespacePos33, 1017, codes.InvalidArgument)
+	regErrCode(ErrBlsCounts, ErrNamespacePos33, 1018, codes.InvalidArgument)
+	regErrCode(ErrSessionToken, ErrNamespacePos33, 1019, codes.Unauthenticated)
+	regErrCode(ErrSessionScope, ErrNamespacePos33, 1020, codes.PermissionDenied)
+	regErrCode(ErrSessionLimit, ErrNamespacePos33, 1021, codes.ResourceExhausted)
+	regErrCode(ErrSpendNotAllowed, ErrNamespacePos33, 1022, codes.PermissionDenied)
+	regErrCode(ErrSpendDailyLimit, ErrNamespacePos33, 1023, codes.ResourceExhausted)
+	regErrCode(ErrSpendApprove, ErrNamespacePos33, 1024, codes.PermissionDenied)
+	regErrCode(ErrCheckpoint, ErrNamespacePos33, 1025, codes.InvalidArgument)
+	regErrCode(ErrTxNonce, ErrNamespacePos33, 1026, codes.InvalidArgument)
+	regErrCode(ErrSignerToken, ErrNamespacePos33, 1027, codes.Unauthenticated)
+	regErrCode(ErrFaucetLimit, ErrNamespacePos33, 1028, codes.ResourceExhausted)
+	regErrCode(ErrTraceID, ErrNamespacePos33, 1029, codes.InvalidArgument)
+	regErrCode(ErrPoolStandby, ErrNamespacePos33, 1030, codes.FailedPrecondition)
+	regErrCode(ErrPoolTx, ErrNamespacePos33, 1031, codes.PermissionDenied)
+	regErrCode(ErrPoolConflict, ErrNamespacePos33, 1032, codes.AlreadyExists)
+	regErrCode(ErrMissedMaker, ErrNamespacePos33, 1033, codes.InvalidArgument)
+	regErrCode(ErrReorgTooDeep, ErrNamespacePos33, 1034, codes.FailedPrecondition)
+	regErrCode(ErrVoteCommitment, ErrNamespacePos33, 1035, codes.InvalidArgument)
+	regErrCode(ErrExecDisabled, ErrNamespacePos33, 1036, codes.Unimplemented)
+	regErrCode(ErrLateVotes, ErrNamespacePos33, 1037, codes.InvalidArgument)
+	regErrCode(ErrNotOperator, ErrNamespacePos33, 1038, codes.NotFound)
+	regErrCode(ErrCommission, ErrNamespacePos33, 1039, codes.InvalidArgument)
+	regErrCode(ErrSignerKind, ErrNamespacePos33, 1040, codes.PermissionDenied)
+	regErrCode(ErrSignerConflict, ErrNamespacePos33, 1041, codes.AlreadyExists)
+	regErrCode(ErrMinerBound, ErrNamespacePos33, 1042, codes.FailedPrecondition)
+	regErrCode(ErrBindCooldown, ErrNamespacePos33, 1043, codes.FailedPrecondition)
+	regErrCode(ErrBlockSigner, ErrNamespacePos33, 1044, codes.PermissionDenied)
+
+	// rpc 常见的 chain33 错误
+	regErrCode(types.ErrNotFound, ErrNamespaceChain33, 101, codes.NotFound)
+	regErrCode(types.ErrInvalidParam, ErrNamespaceChain33, 102, codes.InvalidArgument)
+	regErrCode(types.ErrTimeout, ErrNamespaceChain33, 103, codes.DeadlineExceeded)
+	regErrCode(types.ErrAmount, ErrNamespaceChain33, 104, codes.InvalidArgument)
+	regErrCode(types.ErrNoBalance, ErrNamespaceChain33, 105, codes.FailedPrecondition)
+	regErrCode(types.ErrInvalidAddress, ErrNamespaceChain33, 106, codes.InvalidArgument)
+	regErrCode(types.ErrWalletIsLocked, ErrNamespaceChain33, 107, codes.FailedPrecondition)
+	regErrCode(types.ErrActionNotSupport, ErrNamespaceChain33, 108, codes.Unimplemented)
+	regErrCode(types.ErrQueryNotSupport, ErrNamespaceChain33, 109, codes.Unimplemented)
+}
+
+// NewRPCError 把错误转换成带错误码的 rpc 错误, 没有登记的错误使用 ErrCodeUnknown
+func NewRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*RPCError); ok {
+		return err
+	}
+	c, ok := errCodes[err.Error()]
+	if !ok {
+		return &RPCError{Namespace: ErrNamespacePos33, Code: ErrCodeUnknown, Message: err.Error(), grpcCode: codes.Unknown}
+	}
+	return &RPCError{Namespace: c.namespace, Code: c.code, Message: err.Error(), grpcCode: c.grpcCode}
+}
@@ -0,0 +1,513 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/33cn/chain33/common/crypto"
+	vrf "github.com/33cn/chain33/common/vrf/secp256k1"
+	"github.com/33cn/chain33/types"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+	rt "github.com/yccproject/ycc/plugin/dapp/random/types"
+)
+
+// Signer 挖矿私钥的签名接口: 抽签的 vrf, 投票的 bls 签名和交易的 secp256k1 签名.
+// 私钥可以在节点上(LocalSigner), 也可以在远程的签名服务里.
+// 签名的 msg 是 kind 类型的消息, 由 SignData 和 BlsSignData 算出真正签名的数据
+type Signer interface {
+	PubKey() []byte
+	BlsPubKey() []byte
+	Sign(kind string, msg []byte) ([]byte, error)
+	BlsSign(kind string, msg []byte) ([]byte, error)
+	Vrf(input []byte) ([]byte, []byte, error)
+}
+
+// 签名的消息类型, 远程签名服务按类型解析消息, 不签名别的数据
+const (
+	// SignTx 没有签名的交易: pos33 的 miner 和 slash 交易, random 的 fulfill 交易
+	SignTx = "tx"
+	// SignSorts 没有签名的 Pos33SortsVote
+	SignSorts = "sorts"
+	// SignIdentity 没有签名的 Pos33NodeIdentity
+	SignIdentity = "identity"
+	// SignTelemetry 没有签名的 Pos33Telemetry
+	SignTelemetry = "telemetry"
+	// SignValidators 导出的验证人集合的 json
+	SignValidators = "validators"
+	// SignP2P libp2p 的握手, pubsub 消息和 peer record
+	SignP2P = "p2p"
+	// BlsVote 投票, 消息是制作人的 Pos33SortMsg, 签名它的 SortHash.Hash
+	BlsVote = "vote"
+	// BlsCheckpoint checkpoint 投票, 消息是 Pos33Checkpoint
+	BlsCheckpoint = "checkpoint"
+)
+
+// libp2p 签名的数据都有自己的前缀
+var p2pSignPrefixes = []string{"noise-libp2p-static-key:", "libp2p-tls-handshake:", "libp2p-pubsub:", "\x12libp2p-peer-record"}
+
+// SignData kind 类型的消息 secp256k1 签名的数据
+func SignData(kind string, msg []byte) ([]byte, error) {
+	switch kind {
+	case SignTx:
+		var tx types.Transaction
+		if types.Decode(msg, &tx) != nil || tx.Signature != nil {
+			return nil, ErrSignerKind
+		}
+		return msg, nil
+	case SignSorts, SignIdentity, SignTelemetry:
+		var m types.Message
+		switch kind {
+		case SignSorts:
+			m = &Pos33SortsVote{}
+		case SignIdentity:
+			m = &Pos33NodeIdentity{}
+		default:
+			m = &Pos33Telemetry{}
+		}
+		if types.Decode(msg, m) != nil {
+			return nil, ErrSignerKind
+		}
+		return crypto.Sha256(msg), nil
+	case SignValidators:
+		if !json.Valid(msg) {
+			return nil, ErrSignerKind
+		}
+		return crypto.Sha256(msg), nil
+	case SignP2P:
+		for _, p := range p2pSignPrefixes {
+			if strings.HasPrefix(string(msg), p) {
+				return msg, nil
+			}
+		}
+	}
+	return nil, ErrSignerKind
+}
+
+// BlsSignData kind 类型的消息 bls 签名的数据
+func BlsSignData(kind string, msg []byte) ([]byte, error) {
+	switch kind {
+	case BlsVote:
+		var m Pos33SortMsg
+		if types.Decode(msg, &m) != nil || len(m.GetSortHash().GetHash()) == 0 {
+			return nil, ErrSignerKind
+		}
+		return m.SortHash.Hash, nil
+	case BlsCheckpoint:
+		var m Pos33Checkpoint
+		if types.Decode(msg, &m) != nil {
+			return nil, ErrSignerKind
+		}
+		return CheckpointMsg(m.Height, m.Hash), nil
+	}
+	return nil, ErrSignerKind
+}
+
+// LocalSigner 本地私钥签名
+type LocalSigner struct {
+	priv  crypto.PrivKey
+	blsSk crypto.PrivKey
+}
+
+// NewLocalSigner bls 私钥由挖矿私钥生成
+func NewLocalSigner(priv crypto.PrivKey) *LocalSigner {
+	return &LocalSigner{priv: priv, blsSk: Hash2BlsSk(crypto.Sha256(priv.Bytes()))}
+}
+
+// PrivKey 挖矿私钥
+func (s *LocalSigner) PrivKey() crypto.PrivKey {
+	return s.priv
+}
+
+// PubKey secp256k1 公钥
+func (s *LocalSigner) PubKey() []byte {
+	return s.priv.PubKey().Bytes()
+}
+
+// BlsPubKey bls 公钥
+func (s *LocalSigner) BlsPubKey() []byte {
+	return s.blsSk.PubKey().Bytes()
+}
+
+// Sign secp256k1 签名
+func (s *LocalSigner) Sign(kind string, msg []byte) ([]byte, error) {
+	data, err := SignData(kind, msg)
+	if err != nil {
+		return nil, err
+	}
+	return s.priv.Sign(data).Bytes(), nil
+}
+
+// BlsSign bls 签名
+func (s *LocalSigner) BlsSign(kind string, msg []byte) ([]byte, error) {
+	data, err := BlsSignData(kind, msg)
+	if err != nil {
+		return nil, err
+	}
+	return s.blsSk.Sign(data).Bytes(), nil
+}
+
+// Vrf 返回 vrf hash 和 proof
+func (s *LocalSigner) Vrf(input []byte) ([]byte, []byte, error) {
+	privKey, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), s.priv.Bytes())
+	vrfPriv := &vrf.PrivateKey{PrivateKey: (*ecdsa.PrivateKey)(privKey)}
+	vrfHash, vrfProof := vrfPriv.Evaluate(input)
+	return vrfHash[:], vrfProof, nil
+}
+
+// SignerServiceName 远程签名服务的 rpc 名字
+const SignerServiceName = "Pos33Signer"
+
+// SignerArgs 远程签名的请求
+type SignerArgs struct {
+	Token string `json:"token"`
+	Kind  string `json:"kind,omitempty"`
+	Msg   []byte `json:"msg"`
+}
+
+// SignerReply 远程签名的结果
+type SignerReply struct {
+	Pubkey    []byte `json:"pubkey,omitempty"`
+	BlsPubkey []byte `json:"blsPubkey,omitempty"`
+	Sig       []byte `json:"sig,omitempty"`
+	Hash      []byte `json:"hash,omitempty"`
+	Proof     []byte `json:"proof,omitempty"`
+	// 矿池的 worker 是否是现在负责投票和出块的 worker
+	Primary bool `json:"primary,omitempty"`
+}
+
+// 签名记录保留的高度数, 比最高签名高度低这么多的消息不再签名
+const signerKeep = 100
+
+// signGuard 按 (类型, 高度, 轮次) 记录签过的消息, 同一个位置只签一个, 防止重复出块和投票.
+// 每种类型签过的最高高度保存在 file 里, 签名服务重启以后不再签名不高于它的消息
+type signGuard struct {
+	file   string
+	signed map[string]map[int64]map[int32][]byte
+	top    map[string]int64
+	saved  map[string]int64
+}
+
+func newSignGuard(file string) (*signGuard, error) {
+	g := &signGuard{
+		file:   file,
+		signed: make(map[string]map[int64]map[int32][]byte),
+		top:    make(map[string]int64),
+		saved:  make(map[string]int64),
+	}
+	if file == "" {
+		return g, nil
+	}
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return g, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, &g.saved)
+	if err != nil {
+		return nil, err
+	}
+	for k, h := range g.saved {
+		g.top[k] = h
+	}
+	return g, nil
+}
+
+func (g *signGuard) save() error {
+	if g.file == "" {
+		return nil
+	}
+	data, err := json.Marshal(g.top)
+	if err != nil {
+		return err
+	}
+	tmp := g.file + ".tmp"
+	err = ioutil.WriteFile(tmp, data, 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, g.file)
+}
+
+// check 同一个 (kind, height, round) 已经签过别的消息, 或者高度太低时返回 ErrSignerConflict
+func (g *signGuard) check(kind string, height int64, round int32, msg []byte) error {
+	if height <= g.saved[kind] || height < g.top[kind]-signerKeep {
+		return ErrSignerConflict
+	}
+	hash := crypto.Sha256(msg)
+	hm, ok := g.signed[kind]
+	if !ok {
+		hm = make(map[int64]map[int32][]byte)
+		g.signed[kind] = hm
+	}
+	rm, ok := hm[height]
+	if !ok {
+		rm = make(map[int32][]byte)
+		hm[height] = rm
+	}
+	if old, ok := rm[round]; ok {
+		if !bytes.Equal(old, hash) {
+			return ErrSignerConflict
+		}
+		return nil
+	}
+	rm[round] = hash
+	if height <= g.top[kind] {
+		return nil
+	}
+	g.top[kind] = height
+	for h := range hm {
+		if h < height-signerKeep {
+			delete(hm, h)
+		}
+	}
+	return g.save()
+}
+
+// guard 解析 kind 类型的消息, 有高度和轮次的消息检查是否重复签名. allowTx 决定签名哪些交易
+func (g *signGuard) guard(kind string, msg []byte, allowTx func(*types.Transaction, *Pos33TicketAction) error) error {
+	switch kind {
+	case SignTx:
+		var tx types.Transaction
+		err := types.Decode(msg, &tx)
+		if err != nil {
+			return ErrSignerKind
+		}
+		var act *Pos33TicketAction
+		execer := string(tx.Execer)
+		if execer == Pos33TicketX || strings.HasSuffix(execer, "."+Pos33TicketX) {
+			act = new(Pos33TicketAction)
+			if types.Decode(tx.Payload, act) != nil {
+				return ErrSignerKind
+			}
+		}
+		err = allowTx(&tx, act)
+		if err != nil {
+			return err
+		}
+		if act.GetMiner() == nil {
+			return nil
+		}
+		in := act.GetMiner().GetSort().GetProof().GetInput()
+		if in == nil {
+			return ErrSignerKind
+		}
+		return g.check(kind, in.Height, in.Round, msg)
+	case SignSorts:
+		var m Pos33SortsVote
+		if types.Decode(msg, &m) != nil {
+			return ErrSignerKind
+		}
+		return g.check(kind, m.Height, m.Round, msg)
+	case BlsVote:
+		var m Pos33SortMsg
+		if types.Decode(msg, &m) != nil || m.GetProof().GetInput() == nil {
+			return ErrSignerKind
+		}
+		in := m.Proof.Input
+		return g.check(kind, in.Height, in.Round, msg)
+	case BlsCheckpoint:
+		var m Pos33Checkpoint
+		if types.Decode(msg, &m) != nil {
+			return ErrSignerKind
+		}
+		return g.check(kind, m.Height, 0, msg)
+	}
+	return nil
+}
+
+// signerTx 签名服务只签名节点自己发的交易: miner, slash 和 random 的 fulfill
+func signerTx(tx *types.Transaction, act *Pos33TicketAction) error {
+	if act != nil {
+		if act.Ty == Pos33TicketActionMiner && act.GetMiner() != nil || act.Ty == Pos33ActionSlash && act.GetSlash() != nil {
+			return nil
+		}
+		return ErrSignerKind
+	}
+	execer := string(tx.Execer)
+	if execer != rt.RandomX && !strings.HasSuffix(execer, "."+rt.RandomX) {
+		return ErrSignerKind
+	}
+	if !isRandomFulfill(tx) {
+		return ErrSignerKind
+	}
+	return nil
+}
+
+// SignerService 远程签名服务, 挖矿私钥只保存在签名服务的机器上, 通过 unix socket 或者 tls 提供 jsonrpc.
+// 只签名解析以后的 pos33 消息, 同一个高度和轮次的区块, 抽签和投票只签一个
+type SignerService struct {
+	s     *LocalSigner
+	token []byte
+
+	mu sync.Mutex
+	g  *signGuard
+}
+
+// NewSignerService 请求必须带同样的 token, token 至少 16 个字符. 签过的最高高度保存在 hwmFile
+func NewSignerService(priv crypto.PrivKey, token, hwmFile string) (*SignerService, error) {
+	if len(token) < 16 {
+		return nil, ErrSignerToken
+	}
+	g, err := newSignGuard(hwmFile)
+	if err != nil {
+		return nil, err
+	}
+	return &SignerService{s: NewLocalSigner(priv), token: []byte(token), g: g}, nil
+}
+
+func (ss *SignerService) check(args *SignerArgs) error {
+	if subtle.ConstantTimeCompare([]byte(args.Token), ss.token) != 1 {
+		return ErrSignerToken
+	}
+	return nil
+}
+
+func (ss *SignerService) guard(args *SignerArgs) error {
+	if err := ss.check(args); err != nil {
+		return err
+	}
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	return ss.g.guard(args.Kind, args.Msg, signerTx)
+}
+
+// PubKey 返回 secp256k1 和 bls 公钥
+func (ss *SignerService) PubKey(args *SignerArgs, reply *SignerReply) error {
+	if err := ss.check(args); err != nil {
+		return err
+	}
+	reply.Pubkey = ss.s.PubKey()
+	reply.BlsPubkey = ss.s.BlsPubKey()
+	return nil
+}
+
+// Sign secp256k1 签名
+func (ss *SignerService) Sign(args *SignerArgs, reply *SignerReply) error {
+	if _, err := SignData(args.Kind, args.Msg); err != nil {
+		return err
+	}
+	if err := ss.guard(args); err != nil {
+		return err
+	}
+	sig, err := ss.s.Sign(args.Kind, args.Msg)
+	reply.Sig = sig
+	return err
+}
+
+// BlsSign bls 签名
+func (ss *SignerService) BlsSign(args *SignerArgs, reply *SignerReply) error {
+	if _, err := BlsSignData(args.Kind, args.Msg); err != nil {
+		return err
+	}
+	if err := ss.guard(args); err != nil {
+		return err
+	}
+	sig, err := ss.s.BlsSign(args.Kind, args.Msg)
+	reply.Sig = sig
+	return err
+}
+
+// Vrf 计算 vrf
+func (ss *SignerService) Vrf(args *SignerArgs, reply *SignerReply) error {
+	if err := ss.check(args); err != nil {
+		return err
+	}
+	hash, proof, err := ss.s.Vrf(args.Msg)
+	reply.Hash = hash
+	reply.Proof = proof
+	return err
+}
+
+// SignerNetAddr 解析 unix:///path/to/sock, tls://host:port 或者 tcp://host:port
+func SignerNetAddr(laddr string) (string, string) {
+	for _, n := range []string{"unix", "tls", "tcp"} {
+		if strings.HasPrefix(laddr, n+"://") {
+			return n, strings.TrimPrefix(laddr, n+"://")
+		}
+	}
+	return "tcp", laddr
+}
+
+// ServerTLS tls:// 监听用的证书
+func ServerTLS(certFile, keyFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}, nil
+}
+
+// ClientTLS 连接 tls:// 的服务, caFile 为空时用系统的根证书验证服务的证书
+func ClientTLS(caFile string) (*tls.Config, error) {
+	conf := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile == "" {
+		return conf, nil
+	}
+	pem, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	conf.RootCAs = x509.NewCertPool()
+	if !conf.RootCAs.AppendCertsFromPEM(pem) {
+		return nil, errors.New("no certificate in " + caFile)
+	}
+	return conf, nil
+}
+
+// ServeSigner 在 laddr 上提供签名服务, 只能是 unix socket 或者 tls
+func ServeSigner(ss *SignerService, laddr string, conf *tls.Config) error {
+	if network, _ := SignerNetAddr(laddr); network == "tcp" {
+		return errors.New("signer must listen on unix:// or tls://")
+	}
+	return serveRPC(SignerServiceName, ss, laddr, conf)
+}
+
+func serveRPC(name string, rcvr interface{}, laddr string, conf *tls.Config) error {
+	server := rpc.NewServer()
+	err := server.RegisterName(name, rcvr)
+	if err != nil {
+		return err
+	}
+	network, addr := SignerNetAddr(laddr)
+	var l net.Listener
+	if network == "tls" {
+		if conf == nil {
+			return errors.New("tls listen without certificate")
+		}
+		l, err = tls.Listen("tcp", addr, conf)
+	} else {
+		l, err = net.Listen(network, addr)
+	}
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+	if network == "unix" {
+		// 只有同一个用户的进程可以连接
+		err = os.Chmod(addr, 0600)
+		if err != nil {
+			return err
+		}
+	}
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go server.ServeCodec(jsonrpc.NewServerCodec(conn))
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"sync"
+	"time"
+)
+
+// store 模块发现 leveldb 写入停顿时记录下来, 共识模块在同一个进程里读取,
+// 轮次超时的时候如果刚好有写入停顿就告警
+var (
+	stallMu   sync.Mutex
+	stallAt   time.Time
+	stallTime time.Duration
+)
+
+// NoteWriteStall 记录一次写入停顿, d 是停顿的时间
+func NoteWriteStall(d time.Duration) {
+	stallMu.Lock()
+	defer stallMu.Unlock()
+	stallAt = time.Now()
+	stallTime = d
+}
+
+// WriteStallSince since 之后发生过写入停顿时返回最近一次停顿的时间
+func WriteStallSince(since time.Time) (time.Duration, bool) {
+	stallMu.Lock()
+	defer stallMu.Unlock()
+	if stallAt.IsZero() || stallAt.Before(since) {
+		return 0, false
+	}
+	return stallTime, true
+}
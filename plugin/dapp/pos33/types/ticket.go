@@ -29,6 +29,18 @@ const (
 	TyLogVoterPos33Ticket = 335
 	// TyLogPos33TicketBind bind ticket log type
 	TyLogPos33TicketBind = 334
+	// TyLogPos33Slash slash log type
+	TyLogPos33Slash = 336
+	// TyLogPos33Missed missed maker slot log type
+	TyLogPos33Missed = 337
+	// TyLogPos33Reward 区块奖励分配的 log type
+	TyLogPos33Reward = 338
+	// TyLogPos33Rewards 每个地址的奖励的 log type
+	TyLogPos33Rewards = 339
+	// TyLogPos33Tickets 批量打开或者关闭票的 log type
+	TyLogPos33Tickets = 340
+	// TyLogPos33BlockSigner 登记或者撤销出块签名地址的 log type
+	TyLogPos33BlockSigner = 341
 )
 
 //ticket
@@ -58,6 +70,43 @@ const (
 	Pos33ActionMinerFeeRate = 21
 	// Pos33ActionWithdrawReward action withdraw reward
 	Pos33ActionWithdrawReward = 22
+	// Pos33ActionMinerInfo action set miner info
+	Pos33ActionMinerInfo = 23
+	// Pos33ActionSlash action slash
+	Pos33ActionSlash = 24
+	// Pos33ActionDelegate action delegate
+	Pos33ActionDelegate = 25
+	// Pos33ActionUndelegate action undelegate
+	Pos33ActionUndelegate = 26
+	// Pos33ActionSetCommission action set commission
+	Pos33ActionSetCommission = 27
+	// Pos33ActionSetCompound action set compound
+	Pos33ActionSetCompound = 28
+	// Pos33ActionOpenTickets action open tickets
+	Pos33ActionOpenTickets = 29
+	// Pos33ActionCloseTickets action close tickets
+	Pos33ActionCloseTickets = 30
+	// Pos33ActionBlockSigner action bind block signer
+	Pos33ActionBlockSigner = 31
+)
+
+// MaxCommission 矿工佣金的最大百分比
+const MaxCommission = 99
+
+// 奖励记录的种类
+const (
+	Pos33RewardMaker      = 1
+	Pos33RewardVoter      = 2
+	Pos33RewardCommission = 3
+)
+
+const (
+	// MinerInfoNameSize 矿工名字最大长度
+	MinerInfoNameSize = 32
+	// MinerInfoWebsiteSize 矿工网址最大长度
+	MinerInfoWebsiteSize = 128
+	// MinerInfoContactSize 联系方式 hash 最大长度
+	MinerInfoContactSize = 32
 )
 
 // Pos33TicketX dapp name
@@ -75,6 +124,32 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(Pos33TicketX, "ForkReward15", 725000)
 	cfg.RegisterDappFork(Pos33TicketX, "ForkFixReward", 5000000)
 	cfg.RegisterDappFork(Pos33TicketX, "UseEntrust", 7000000)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkVrfSeed", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkRewardMature", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkMinerInfo", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkSlash", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkBlsAggregate", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkCheckpoint", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkAccountNonce", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkStakeEpoch", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkSmoothDiff", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkMissedMaker", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkEpochCommittee", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkBlockTime", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkVoteCommitment", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkLateVotes", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkEVMStake", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkDelegation", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkBaseFee", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkCompound", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkManagePrice", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkRewardSplit", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkBatchTickets", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkDynamicCommittee", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkMinerBind", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkTieBreak", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkSlashTickets", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkBlockSigner", types.MaxHeight)
 }
 
 func InitExecutor(cfg *types.Chain33Config) {
@@ -107,6 +182,12 @@ func (ticket *Pos33TicketType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogMinerPos33Ticket: {Ty: reflect.TypeOf(ReceiptPos33Miner{}), Name: "LogMinerPos33Ticket"},
 		TyLogVoterPos33Ticket: {Ty: reflect.TypeOf(ReceiptPos33Miner{}), Name: "LogVoterPos33Ticket"},
 		TyLogPos33TicketBind:  {Ty: reflect.TypeOf(ReceiptPos33TicketBind{}), Name: "LogPos33TicketBind"},
+		TyLogPos33Slash:       {Ty: reflect.TypeOf(ReceiptPos33Slash{}), Name: "LogPos33Slash"},
+		TyLogPos33Missed:      {Ty: reflect.TypeOf(ReceiptPos33Missed{}), Name: "LogPos33Missed"},
+		TyLogPos33Reward:      {Ty: reflect.TypeOf(ReceiptPos33Reward{}), Name: "LogPos33Reward"},
+		TyLogPos33Rewards:     {Ty: reflect.TypeOf(ReceiptPos33Rewards{}), Name: "LogPos33Rewards"},
+		TyLogPos33Tickets:     {Ty: reflect.TypeOf(ReceiptPos33Tickets{}), Name: "LogPos33Tickets"},
+		TyLogPos33BlockSigner: {Ty: reflect.TypeOf(Pos33BlockSigner{}), Name: "LogPos33BlockSigner"},
 	}
 }
 
@@ -125,7 +206,7 @@ func (ticket Pos33TicketType) Amount(tx *types.Transaction) (int64, error) {
 		if ticketMiner == nil {
 			return 0, nil
 		}
-		nvs := len(ticketMiner.BlsPkList)
+		nvs := len(ticketMiner.Voters())
 		bpr := reward * int64(nvs)
 		return bpr, nil
 	}
@@ -142,11 +223,20 @@ func (ticket *Pos33TicketType) GetTypeMap() map[string]int32 {
 	return map[string]int32{
 		"Genesis": Pos33TicketActionGenesis,
 		// "Topen":   Pos33TicketActionOpen,
-		// "Tbind":   Pos33TicketActionBind,
 		// "Tclose":  Pos33TicketActionClose,
-		"Miner":   Pos33TicketActionMiner,
-		"Entrust": Pos33ActionEntrust,
-		"BlsBind": Pos33ActionBlsBind,
+		"Miner":         Pos33TicketActionMiner,
+		"Entrust":       Pos33ActionEntrust,
+		"BlsBind":       Pos33ActionBlsBind,
+		"MinerInfo":     Pos33ActionMinerInfo,
+		"Slash":         Pos33ActionSlash,
+		"Delegate":      Pos33ActionDelegate,
+		"Undelegate":    Pos33ActionUndelegate,
+		"SetCommission": Pos33ActionSetCommission,
+		"SetCompound":   Pos33ActionSetCompound,
+		"OpenTickets":   Pos33ActionOpenTickets,
+		"CloseTickets":  Pos33ActionCloseTickets,
+		"MinerBind":     Pos33TicketActionBind,
+		"BlockSigner":   Pos33ActionBlockSigner,
 		// "FeeRate": Pos33ActionMinerFeeRate,
 		// "Withdraw": Pos33ActionWithdrawReward,
 		// "Migrate":  Pos33ActionMigrate,
@@ -162,6 +252,36 @@ type Pos33MineParam struct {
 	BlockReward     int64
 	VoteReward      int64
 	MineReward      int64
+	// 制作人抽签的次数, 前面的 Num 都没抽中时用下一个 Num 重试
+	SortRetries int64
+	// 挖矿奖励锁定的区块数
+	RewardMaturity int64
+	// 设置矿工信息需要的最低交易费
+	MinerInfoFee int64
+	// 作恶罚没的抵押比例
+	SlashPersent int64
+	// 多少区块做一次 checkpoint
+	CheckpointBlocks int64
+	// 每个 epoch 的区块数, epoch 开始时记录全网的抵押, 整个 epoch 的抽签难度都用这个快照
+	StakeEpochBlocks int64
+	// 抽签难度系数的移动平均窗口(区块数)
+	DiffWindow int64
+	// 第 0 轮的投票人委员会多少区块抽签一次
+	CommitteeEpoch int64
+	// ForkBlockTime 之后, 上一个区块上链后等待多少毫秒开始出下一个区块
+	BlockTime int64
+	// ForkBaseFee 之后, 基础交易费调整的目标: 每个区块付基础交易费的交易的总字节数
+	BaseFeeTarget int64
+	// ForkManagePrice 之后, manage 执行器修改的票价在多少区块以后生效
+	TicketPriceDelay int64
+	// ForkDynamicCommittee 之后, 投票人委员会的大小随全网票数在 [VoterSizeMin, VoterSizeMax] 之间变化,
+	// 每 TicketsPerVoter 张票一个位置, 0 表示固定为 Pos33VoterSize
+	VoterSizeMin    int64
+	VoterSizeMax    int64
+	TicketsPerVoter int64
+	// ForkDelegation 之后, 矿工每次最多提高 CommissionStep 个百分点的佣金, 在 CommissionDelay 个区块以后生效
+	CommissionStep  int64
+	CommissionDelay int64
 
 	cfg    *types.Chain33Config
 	height int64
@@ -178,11 +298,83 @@ func GetPos33MineParam(cfg *types.Chain33Config, height int64) *Pos33MineParam {
 	c.BlockReward = conf.MGInt("blockReward", height) * cfg.GetCoinPrecision()
 	c.VoteReward = conf.MGInt("voteRewardPersent", height) * cfg.GetCoinPrecision() / 100
 	c.MineReward = conf.MGInt("mineRewardPersent", height) * cfg.GetCoinPrecision() / 100
+	c.SortRetries = conf.MGInt("sortRetries", height)
+	if c.SortRetries <= 0 {
+		c.SortRetries = Pos33SortRetries
+	}
+	c.RewardMaturity = conf.MGInt("rewardMaturity", height)
+	c.MinerInfoFee = conf.MGInt("minerInfoFee", height) * cfg.GetCoinPrecision()
+	c.SlashPersent = conf.MGInt("slashPersent", height)
+	c.CheckpointBlocks = conf.MGInt("checkpointBlocks", height)
+	if c.CheckpointBlocks <= 0 {
+		c.CheckpointBlocks = Pos33CheckpointBlocks
+	}
+	c.StakeEpochBlocks = conf.MGInt("stakeEpochBlocks", height)
+	if c.StakeEpochBlocks <= 0 {
+		c.StakeEpochBlocks = Pos33StakeEpochBlocks
+	}
+	c.DiffWindow = conf.MGInt("diffWindow", height)
+	if c.DiffWindow <= 0 {
+		c.DiffWindow = Pos33DiffWindow
+	}
+	c.CommitteeEpoch = conf.MGInt("committeeEpoch", height)
+	if c.CommitteeEpoch <= 0 {
+		c.CommitteeEpoch = Pos33CommitteeEpoch
+	}
+	c.BlockTime = conf.MGInt("blockTime", height)
+	if c.BlockTime <= 0 || !cfg.IsDappFork(height, Pos33TicketX, "ForkBlockTime") {
+		c.BlockTime = Pos33BlockTime
+	}
+	c.BaseFeeTarget = conf.MGInt("baseFeeTarget", height)
+	if c.BaseFeeTarget <= 0 {
+		c.BaseFeeTarget = Pos33BaseFeeTarget
+	}
+	c.TicketPriceDelay = conf.MGInt("ticketPriceDelay", height)
+	if c.TicketPriceDelay <= 0 {
+		c.TicketPriceDelay = Pos33TicketPriceDelay
+	}
+	c.CommissionStep = conf.MGInt("commissionStep", height)
+	if c.CommissionStep <= 0 {
+		c.CommissionStep = Pos33CommissionStep
+	}
+	c.CommissionDelay = conf.MGInt("commissionDelay", height)
+	if c.CommissionDelay <= 0 {
+		c.CommissionDelay = Pos33CommissionDelay
+	}
+	c.VoterSizeMin = conf.MGInt("voterSizeMin", height)
+	if c.VoterSizeMin <= 0 {
+		c.VoterSizeMin = Pos33VoterSize
+	}
+	c.VoterSizeMax = conf.MGInt("voterSizeMax", height)
+	if c.VoterSizeMax < c.VoterSizeMin {
+		c.VoterSizeMax = c.VoterSizeMin
+	}
+	c.TicketsPerVoter = conf.MGInt("ticketsPerVoter", height)
 	c.cfg = cfg
 	c.height = height
 	return c
 }
 
+// VoterSize 全网 count 张票时投票人委员会的大小
+func (mp *Pos33MineParam) VoterSize(count int64) int {
+	if mp.TicketsPerVoter <= 0 || count <= 0 {
+		return Pos33VoterSize
+	}
+	size := count / mp.TicketsPerVoter
+	if size < mp.VoterSizeMin {
+		size = mp.VoterSizeMin
+	}
+	if size > mp.VoterSizeMax {
+		size = mp.VoterSizeMax
+	}
+	return int(size)
+}
+
+// MustVotes 委员会大小是 size 时区块必须达到的票数, 超过 2/3
+func MustVotes(size int) int {
+	return size*2/3 + 1
+}
+
 func (mp *Pos33MineParam) ChangeTicketPrice() bool {
 	return mp.cfg.GetDappFork("pos33", "UseEntrust") == mp.height
 }
@@ -197,12 +389,47 @@ func (mp *Pos33MineParam) GetTicketPrice() int64 {
 const (
 	// Pos33SortBlocks 多少区块做一次抽签
 	Pos33SortBlocks = 10
+	// Pos33BindCooldown 冷热绑定两次修改之间至少间隔的区块, 不能小于 Pos33SortBlocks,
+	// 这样抽签高度上的绑定最多往前找一次
+	Pos33BindCooldown = Pos33SortBlocks * 10
 	// Pos33MakeerSize 候选区块maker数量
 	Pos33MakerSize = 15
 	// Pos33VoterSize  候选区块voter数量
 	Pos33VoterSize = 25
 	// Pos33MustVotes 必须达到的票数
 	Pos33MustVotes = 17
+	// Pos33SortRetries 默认的制作人抽签次数
+	Pos33SortRetries = 3
+	// Pos33CheckpointBlocks 默认多少区块做一次 checkpoint
+	Pos33CheckpointBlocks = 100
+	// Pos33StakeEpochBlocks 默认每个 epoch 的区块数
+	Pos33StakeEpochBlocks = 1000
+	// Pos33DiffWindow 默认的难度系数移动平均窗口
+	Pos33DiffWindow = 100
+	// Pos33MaxMissedMakers miner 交易里最多记录的没有出块的轮次
+	Pos33MaxMissedMakers = 8
+	// Pos33MissedRecent 每个地址保存最近多少次没有出块的记录
+	Pos33MissedRecent = 32
+	// Pos33CommitteeEpoch 默认多少区块抽签一次投票人委员会
+	Pos33CommitteeEpoch = 10
+	// Pos33BlockTime 默认的出块等待时间(毫秒), 加上打包和传播的时间大约 1s 一个区块
+	Pos33BlockTime = 900
+	// Pos33BaseFeeTarget 默认的基础交易费目标字节数, 区块最大字节数的一半
+	Pos33BaseFeeTarget = types.MaxBlockSize / 2
+	// Pos33BaseFeeDenominator 基础交易费每个区块最多变化 1/Pos33BaseFeeDenominator
+	Pos33BaseFeeDenominator = 8
+	// Pos33BaseFeeRing 基础交易费只保存最近的 Pos33BaseFeeRing 个高度
+	Pos33BaseFeeRing = 256
+	// Pos33TicketPriceDelay 默认的票价修改生效的延迟区块数
+	Pos33TicketPriceDelay = 10000
+	// Pos33CommissionStep 默认每次最多提高的佣金百分点
+	Pos33CommissionStep = 5
+	// Pos33CommissionDelay 默认提高佣金生效的延迟区块数
+	Pos33CommissionDelay = 10000
+	// ManageTicketPriceKey manage 执行器里票价的配置项, 值是整数个币, 最后一个值有效
+	ManageTicketPriceKey = "pos33-ticketPrice"
+	// ManageRewardSplitKey manage 执行器里奖励分配的配置项, 值是 "voteRewardPersent:mineRewardPersent", 最后一个值有效
+	ManageRewardSplitKey = "pos33-rewardSplit"
 )
 
 // Verify is verify msg
@@ -222,6 +449,84 @@ func (v *Pos33SortsVote) Sign(priv crypto.PrivKey) {
 	v.Sig = &types.Signature{Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes(), Signature: sig.Bytes()}
 }
 
+// Verify is verify advisory msg
+func (v *Pos33Advisory) Verify() bool {
+	if v.Sig == nil {
+		return false
+	}
+	s := v.Sig
+	v.Sig = nil
+	b := crypto.Sha256(types.Encode(v))
+	v.Sig = s
+	return types.CheckSign(b, "", s, -1)
+}
+
+// Sign is sign advisory msg
+func (v *Pos33Advisory) Sign(priv crypto.PrivKey) {
+	v.Sig = nil
+	b := crypto.Sha256(types.Encode(v))
+	sig := priv.Sign(b)
+	v.Sig = &types.Signature{Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes(), Signature: sig.Bytes()}
+}
+
+// Verify is verify telemetry heartbeat msg
+func (v *Pos33Telemetry) Verify() bool {
+	if v.Sig == nil {
+		return false
+	}
+	s := v.Sig
+	v.Sig = nil
+	b := crypto.Sha256(types.Encode(v))
+	v.Sig = s
+	return types.CheckSign(b, "", s, -1)
+}
+
+// Verify is verify node identity msg
+func (v *Pos33NodeIdentity) Verify() bool {
+	if v.Sig == nil {
+		return false
+	}
+	s := v.Sig
+	v.Sig = nil
+	b := crypto.Sha256(types.Encode(v))
+	v.Sig = s
+	return types.CheckSign(b, "", s, -1)
+}
+
+// Verify is verify push device msg
+func (v *Pos33PushDevice) Verify() bool {
+	if v.Sig == nil {
+		return false
+	}
+	s := v.Sig
+	v.Sig = nil
+	b := crypto.Sha256(types.Encode(v))
+	v.Sig = s
+	return types.CheckSign(b, "", s, -1)
+}
+
+// Sign is sign push device msg
+func (v *Pos33PushDevice) Sign(priv crypto.PrivKey) {
+	v.Sig = nil
+	b := crypto.Sha256(types.Encode(v))
+	sig := priv.Sign(b)
+	v.Sig = &types.Signature{Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes(), Signature: sig.Bytes()}
+}
+
+// Verify is verify approve msg
+func (v *ReqPos33Approve) Verify() bool {
+	if v.Sig == nil {
+		return false
+	}
+	return types.CheckSign(crypto.Sha256([]byte(v.PendingId)), "", v.Sig, -1)
+}
+
+// Sign is sign approve msg
+func (v *ReqPos33Approve) Sign(priv crypto.PrivKey) {
+	sig := priv.Sign(crypto.Sha256([]byte(v.PendingId)))
+	v.Sig = &types.Signature{Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes(), Signature: sig.Bytes()}
+}
+
 // Verify is verify vote msg
 func (v *Pos33VoteMsg) Verify() bool {
 	return types.CheckSign(v.Hash, Pos33TicketX, v.Sig, v.Sort.Proof.Input.Height)
@@ -250,6 +555,9 @@ type Sorts []*Pos33SortMsg
 
 func (m Sorts) Len() int { return len(m) }
 func (m Sorts) Less(i, j int) bool {
+	if m[i].SortHash.Num != m[j].SortHash.Num {
+		return m[i].SortHash.Num < m[j].SortHash.Num
+	}
 	return string(m[i].SortHash.Hash) < string(m[j].SortHash.Hash)
 }
 func (m Sorts) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
@@ -274,13 +582,89 @@ func Hash2BlsSk(hash []byte) crypto.PrivKey {
 	return bls33.PrivKeyBLS(sk.Serialize())
 }
 
+// Voters 每一票的 bls 公钥, 如果 BlsPkList 是去重的, 按 BlsCounts 展开
+func (m *Pos33MinerMsg) Voters() [][]byte {
+	if len(m.BlsCounts) == 0 || len(m.BlsCounts) != len(m.BlsPkList) {
+		return m.BlsPkList
+	}
+	var pks [][]byte
+	for i, pk := range m.BlsPkList {
+		for j := int32(0); j < m.BlsCounts[i]; j++ {
+			pks = append(pks, pk)
+		}
+	}
+	return pks
+}
+
+// CheckCounts 在展开 Voters 之前检查 BlsCounts: 和 BlsPkList 一一对应, 每个都大于 0, 总票数不超过委员会大小 size
+func (m *Pos33MinerMsg) CheckCounts(size int) error {
+	if len(m.BlsCounts) == 0 {
+		return nil
+	}
+	if len(m.BlsCounts) != len(m.BlsPkList) {
+		return ErrBlsCounts
+	}
+	sum := 0
+	for _, c := range m.BlsCounts {
+		if c <= 0 || int(c) > size-sum {
+			return ErrBlsCounts
+		}
+		sum += int(c)
+	}
+	return nil
+}
+
+// CheckMissed 检查没有出块的 maker 抽签: 同一个高度, 轮次递增并且小于出块的轮次
+func (m *Pos33MinerMsg) CheckMissed(height int64) error {
+	if len(m.Missed) > Pos33MaxMissedMakers {
+		return ErrMissedMaker
+	}
+	round := m.GetSort().GetProof().GetInput().GetRound()
+	last := int32(-1)
+	for _, s := range m.Missed {
+		in := s.GetProof().GetInput()
+		if in == nil || s.SortHash == nil || in.Height != height || in.Round <= last || in.Round >= round {
+			return ErrMissedMaker
+		}
+		last = in.Round
+	}
+	return nil
+}
+
+// CompactVoters 把重复的 bls 公钥合并, 同一个投票人的多张票只保存一次公钥
+func (m *Pos33MinerMsg) CompactVoters() {
+	var pks [][]byte
+	var counts []int32
+	mp := make(map[string]int)
+	for _, pk := range m.Voters() {
+		i, ok := mp[string(pk)]
+		if !ok {
+			i = len(pks)
+			mp[string(pk)] = i
+			pks = append(pks, pk)
+			counts = append(counts, 0)
+		}
+		counts[i]++
+	}
+	m.BlsPkList = pks
+	m.BlsCounts = counts
+}
+
 func (m *Pos33MinerMsg) Verify() error {
+	if len(m.BlsCounts) != 0 && len(m.BlsCounts) != len(m.BlsPkList) {
+		return ErrBlsCounts
+	}
+	for _, c := range m.BlsCounts {
+		if c <= 0 {
+			return ErrBlsCounts
+		}
+	}
 	if len(m.BlsPkList) == 0 {
 		return nil
 	}
 	d := new(bls33.Driver)
 	var pks []crypto.PubKey
-	for _, b := range m.BlsPkList {
+	for _, b := range m.Voters() {
 		pk := bls33.PubKeyBLS{}
 		copy(pk[:], b)
 		pks = append(pks, pk)
@@ -289,3 +673,15 @@ func (m *Pos33MinerMsg) Verify() error {
 	copy(sig[:], m.BlsSig)
 	return d.VerifyAggregatedOne(pks, m.Hash, sig)
 }
+
+// PriceAt height 生效的票价, 没有修改过返回 def
+func (ps *Pos33TicketPrices) PriceAt(height, def int64) int64 {
+	price := def
+	for _, p := range ps.GetItems() {
+		if p.Height > height {
+			break
+		}
+		price = p.Price
+	}
+	return price
+}
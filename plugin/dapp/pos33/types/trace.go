@@ -0,0 +1,94 @@
+package types
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/common"
+)
+
+// 交易的追踪 ID 只保存在本节点的内存里, 不上链, 也不随交易广播.
+// rpc 收到带追踪 ID 的交易时登记, mempool 和共识模块处理这笔交易时记录事件并打印日志,
+// 钱包和节点可以用同一个 ID 排查丢失的交易
+const (
+	maxTraces      = 100000
+	maxTraceEvents = 32
+	maxTraceIDLen  = 64
+)
+
+type txTraces struct {
+	mu     sync.Mutex
+	ids    map[string]*Pos33TxTrace
+	hashes map[string]*Pos33TxTrace
+	order  []string // 按登记顺序, 超过 maxTraces 时删除最早的
+	count  int32
+}
+
+var traces = &txTraces{
+	ids:    make(map[string]*Pos33TxTrace),
+	hashes: make(map[string]*Pos33TxTrace),
+}
+
+// AddTxTrace 登记交易的追踪 ID, 同一个 ID 再次登记时指向新的交易
+func AddTxTrace(id string, hash []byte) error {
+	if id == "" || len(id) > maxTraceIDLen {
+		return ErrTraceID
+	}
+	t := traces
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if old, ok := t.ids[id]; ok {
+		delete(t.hashes, old.Hash)
+	} else {
+		t.order = append(t.order, id)
+	}
+	tr := &Pos33TxTrace{TraceId: id, Hash: common.ToHex(hash), Time: time.Now().Unix()}
+	t.ids[id] = tr
+	t.hashes[tr.Hash] = tr
+	for len(t.order) > maxTraces {
+		if old, ok := t.ids[t.order[0]]; ok {
+			delete(t.hashes, old.Hash)
+			delete(t.ids, t.order[0])
+		}
+		t.order = t.order[1:]
+	}
+	atomic.StoreInt32(&t.count, int32(len(t.ids)))
+	return nil
+}
+
+// TracingTx 是否有登记过的交易, 没有时不必计算交易 hash
+func TracingTx() bool {
+	return atomic.LoadInt32(&traces.count) > 0
+}
+
+// TraceTx 给登记过的交易记录一个事件, 返回追踪 ID, 没有登记的交易返回空
+func TraceTx(hash []byte, event string) string {
+	t := traces
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tr, ok := t.hashes[common.ToHex(hash)]
+	if !ok {
+		return ""
+	}
+	if len(tr.Events) < maxTraceEvents {
+		tr.Events = append(tr.Events, &Pos33TraceEvent{Time: time.Now().Unix(), Event: event})
+	}
+	return tr.TraceId
+}
+
+// GetTxTrace 返回追踪 ID 对应的交易和事件
+func GetTxTrace(id string) (*Pos33TxTrace, bool) {
+	t := traces
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tr, ok := t.ids[id]
+	if !ok {
+		return nil, false
+	}
+	events := make([]*Pos33TraceEvent, len(tr.Events))
+	for i, e := range tr.Events {
+		events[i] = &Pos33TraceEvent{Time: e.Time, Event: e.Event}
+	}
+	return &Pos33TxTrace{TraceId: tr.TraceId, Hash: tr.Hash, Time: tr.Time, Events: events}, true
+}
@@ -5,13 +5,20 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
+	"sort"
 	"testing"
 
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
+	wcom "github.com/33cn/chain33/wallet/common"
+	bls33 "github.com/33cn/plugin/plugin/crypto/bls"
 	"github.com/stretchr/testify/assert"
+	rt "github.com/yccproject/ycc/plugin/dapp/random/types"
 )
 
 func init() {
@@ -79,3 +86,340 @@ func TestHash2BlsSk(t *testing.T) {
 		t.Error("diffrent hash must diffrent sk")
 	}
 }
+
+func TestNextBaseFee(t *testing.T) {
+	assert.Equal(t, int64(100000), NextBaseFee(nil, 1000, 100000))
+	assert.Equal(t, int64(112500), NextBaseFee(&Pos33BaseFee{BaseFee: 100000, Size: 2000}, 1000, 100000))
+	// 超过 2 倍目标按 2 倍算
+	assert.Equal(t, int64(112500), NextBaseFee(&Pos33BaseFee{BaseFee: 100000, Size: 9000, Txs: 1}, 1000, 100000))
+	assert.Equal(t, int64(105000), NextBaseFee(&Pos33BaseFee{BaseFee: 120000, Size: 0, Txs: 5000}, 1000, 100000))
+	assert.Equal(t, int64(100000), NextBaseFee(&Pos33BaseFee{BaseFee: 100000, Size: 0}, 1000, 100000))
+
+	tx := &types.Transaction{Execer: []byte("coins"), Fee: 300000}
+	burned, tips, size, under := SplitBaseFee([]*types.Transaction{tx}, 100000, "")
+	assert.Equal(t, 0, under)
+	assert.Equal(t, int64(100000), burned)
+	assert.Equal(t, int64(200000), tips)
+	assert.Equal(t, int64(types.Size(tx)), size)
+	_, _, _, under = SplitBaseFee([]*types.Transaction{tx}, 400000, "")
+	assert.Equal(t, 1, under)
+}
+
+func TestBaseFeeExempt(t *testing.T) {
+	cr, err := crypto.Load("secp256k1", -1)
+	assert.Nil(t, err)
+	priv, err := cr.GenKey()
+	assert.Nil(t, err)
+	fulfill := &rt.RandomAction{Ty: rt.RandomActionFulfill, Value: &rt.RandomAction_Fulfill{Fulfill: &rt.RandomFulfill{Id: "1"}}}
+	request := &rt.RandomAction{Ty: rt.RandomActionRequest, Value: &rt.RandomAction_Request{Request: &rt.RandomRequest{}}}
+	tx := &types.Transaction{Execer: []byte(rt.RandomX), Payload: types.Encode(fulfill), Fee: 1}
+	tx.Sign(types.SECP256K1, priv)
+	other := &types.Transaction{Execer: []byte(rt.RandomX), Payload: types.Encode(request), Fee: 1}
+	other.Sign(types.SECP256K1, priv)
+	maker := tx.From()
+
+	assert.True(t, BaseFeeExempt(tx, maker))
+	assert.False(t, BaseFeeExempt(tx, "1NotMaker"))
+	assert.False(t, BaseFeeExempt(other, maker))
+
+	// 免除基础交易费的交易不算大小, 交易费都是小费
+	burned, tips, size, under := SplitBaseFee([]*types.Transaction{tx}, 400000, maker)
+	assert.Equal(t, 0, under)
+	assert.Equal(t, int64(0), burned)
+	assert.Equal(t, int64(1), tips)
+	assert.Equal(t, int64(0), size)
+	_, _, _, under = SplitBaseFee([]*types.Transaction{tx}, 400000, "")
+	assert.Equal(t, 1, under)
+}
+
+func TestArchivedMsgs(t *testing.T) {
+	var buf bytes.Buffer
+	for i := int64(1); i <= 3; i++ {
+		m := &Pos33ArchivedMsg{Time: i, Height: 10, Msg: &Pos33Msg{Ty: Pos33Msg_MV, Data: []byte{byte(i)}}}
+		assert.Nil(t, WriteArchivedMsg(&buf, m))
+	}
+	// 最后一条没有写完整
+	data := buf.Bytes()[:buf.Len()-1]
+
+	var ms []*Pos33ArchivedMsg
+	err := ReadArchivedMsgs(bytes.NewReader(data), func(m *Pos33ArchivedMsg) error {
+		ms = append(ms, m)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ms))
+	assert.Equal(t, int64(2), ms[1].Time)
+	assert.Equal(t, Pos33Msg_MV, ms[1].Msg.Ty)
+}
+
+func TestForgedVoterEvidence(t *testing.T) {
+	cr, err := crypto.Load("secp256k1", -1)
+	assert.Nil(t, err)
+	priv, err := cr.GenKey()
+	assert.Nil(t, err)
+	sort := &Pos33SortMsg{
+		SortHash: &SortHash{Hash: crypto.Sha256([]byte("sort"))},
+		Proof:    &HashProof{Input: &VrfInput{Height: 10, Round: 1, Ty: 1}, Pubkey: priv.PubKey().Bytes()},
+	}
+	v1 := &Pos33VoteMsg{Hash: crypto.Sha256([]byte("maker1")), Sort: sort}
+	v2 := &Pos33VoteMsg{Hash: crypto.Sha256([]byte("maker2")), Sort: sort}
+	v1.Sign(priv)
+	v2.Sign(priv)
+	assert.True(t, v1.Verify())
+	assert.True(t, v2.Verify())
+
+	// 没有制作人的抽签, 不能证明两个签名是同一个高度和轮次
+	e := &Pos33Evidence{Ty: EvidenceVoter, Vote1: v1, Vote2: v2}
+	_, _, err = e.Check()
+	assert.Equal(t, ErrEvidence, err)
+
+	// 制作人的抽签和投票的 hash 对不上
+	e.Maker1 = &Pos33SortMsg{SortHash: &SortHash{Hash: v2.Hash}, Proof: &HashProof{Input: &VrfInput{Height: 10, Round: 1}}}
+	e.Maker2 = &Pos33SortMsg{SortHash: &SortHash{Hash: v2.Hash}, Proof: &HashProof{Input: &VrfInput{Height: 10, Round: 1}}}
+	_, _, err = e.Check()
+	assert.Equal(t, ErrEvidence, err)
+}
+
+func TestCheckpointWeights(t *testing.T) {
+	cr, err := crypto.Load("secp256k1", -1)
+	assert.Nil(t, err)
+	var votes []*Pos33CheckpointVote
+	var voters [][]byte
+	for _, n := range []int{5, 1, 1} {
+		priv, err := cr.GenKey()
+		assert.Nil(t, err)
+		v := &Pos33CheckpointVote{Height: 99, Hash: []byte("h")}
+		v.Sign(priv)
+		votes = append(votes, v)
+		for j := 0; j < n; j++ {
+			voters = append(voters, v.Sig.Pubkey)
+		}
+	}
+	pks, weights := CheckpointWeights(voters)
+	assert.Equal(t, 3, len(pks))
+	assert.Equal(t, []int64{5, 1, 1}, weights)
+	pending := &Pos33Checkpoint{Height: 99, Hash: []byte("h"), BlsPkList: pks, Weights: weights}
+
+	aggregate := func(vs ...*Pos33CheckpointVote) *Pos33Checkpoint {
+		cp := &Pos33Checkpoint{Height: 99, Hash: []byte("h")}
+		var sigs []crypto.Signature
+		for _, v := range vs {
+			var sig bls33.SignatureBLS
+			copy(sig[:], v.Sig.Signature)
+			sigs = append(sigs, sig)
+			cp.BlsPkList = append(cp.BlsPkList, v.Sig.Pubkey)
+		}
+		sig, err := bls33.Driver{}.Aggregate(sigs)
+		assert.Nil(t, err)
+		cp.BlsSig = sig.Bytes()
+		return cp
+	}
+	// 按票数算, 一个有 5 票的投票人超过 2/3, 两个 1 票的投票人不够
+	assert.Nil(t, aggregate(votes[0]).Verify(pending))
+	assert.Equal(t, ErrCheckpoint, aggregate(votes[1], votes[2]).Verify(pending))
+	assert.Equal(t, ErrCheckpoint, aggregate(votes[0], votes[0]).Verify(pending))
+}
+
+func TestCheckpointEvidence(t *testing.T) {
+	cr, err := crypto.Load("secp256k1", -1)
+	assert.Nil(t, err)
+	priv, err := cr.GenKey()
+	assert.Nil(t, err)
+	other, err := cr.GenKey()
+	assert.Nil(t, err)
+	vote := func(priv crypto.PrivKey, height int64, hash string) *Pos33CheckpointVote {
+		v := &Pos33CheckpointVote{Height: height, Hash: []byte(hash)}
+		v.Sign(priv)
+		return v
+	}
+	cases := []struct {
+		name string
+		e    *Pos33Evidence
+		err  error
+	}{
+		{"conflict", &Pos33Evidence{Ty: EvidenceCheckpoint, Cp1: vote(priv, 99, "a"), Cp2: vote(priv, 99, "b")}, nil},
+		{"same hash", &Pos33Evidence{Ty: EvidenceCheckpoint, Cp1: vote(priv, 99, "a"), Cp2: vote(priv, 99, "a")}, ErrEvidence},
+		{"other height", &Pos33Evidence{Ty: EvidenceCheckpoint, Cp1: vote(priv, 99, "a"), Cp2: vote(priv, 199, "b")}, ErrEvidence},
+		{"two voters", &Pos33Evidence{Ty: EvidenceCheckpoint, Cp1: vote(priv, 99, "a"), Cp2: vote(other, 99, "b")}, ErrEvidence},
+		{"missing", &Pos33Evidence{Ty: EvidenceCheckpoint, Cp1: vote(priv, 99, "a")}, ErrEvidence},
+	}
+	for _, c := range cases {
+		h, _, err := c.e.Check()
+		assert.Equal(t, c.err, err, c.name)
+		if c.err == nil {
+			assert.Equal(t, int64(99), h, c.name)
+		}
+	}
+}
+
+func TestCheckCounts(t *testing.T) {
+	pks := [][]byte{[]byte("a"), []byte("b")}
+	m := &Pos33MinerMsg{BlsPkList: pks}
+	assert.Nil(t, m.CheckCounts(10))
+
+	m.BlsCounts = []int32{3, 7}
+	assert.Nil(t, m.CheckCounts(10))
+	assert.Equal(t, ErrBlsCounts, m.CheckCounts(9))
+
+	m.BlsCounts = []int32{1, 1 << 30}
+	assert.Equal(t, ErrBlsCounts, m.CheckCounts(10))
+	m.BlsCounts = []int32{1, 0}
+	assert.Equal(t, ErrBlsCounts, m.CheckCounts(10))
+	m.BlsCounts = []int32{1}
+	assert.Equal(t, ErrBlsCounts, m.CheckCounts(10))
+}
+
+func TestMinerBindAt(t *testing.T) {
+	first := &Pos33MinerBind{Miner: "hot1", ReturnAddr: "cold", Height: 100}
+	second := &Pos33MinerBind{Miner: "hot2", ReturnAddr: "cold", Height: 300, Prev: first}
+	unbound := &Pos33MinerBind{Height: 500, Prev: &Pos33MinerBind{Miner: "hot2", ReturnAddr: "cold", Height: 300}}
+	cases := []struct {
+		name   string
+		b      *Pos33MinerBind
+		addr   string
+		height int64
+		want   string
+	}{
+		{"before bind", first, "hot1", 99, "hot1"},
+		{"bound", first, "hot1", 100, "cold"},
+		{"cold can not sort", first, "cold", 200, ""},
+		{"previous bind", second, "hot1", 299, "cold"},
+		{"old miner", &Pos33MinerBind{Height: 300, Prev: first}, "hot1", 300, "hot1"},
+		{"new miner", second, "hot2", 300, "cold"},
+		{"unbound before", unbound, "hot2", 499, "cold"},
+		{"unbound", unbound, "hot2", 500, "hot2"},
+		{"nil", nil, "a", 1, "a"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, c.b.StakeAddr(c.addr, c.height), c.name)
+	}
+}
+
+func TestSortsOrder(t *testing.T) {
+	a := &Pos33SortMsg{SortHash: &SortHash{Hash: []byte{1}, Num: 1}}
+	b := &Pos33SortMsg{SortHash: &SortHash{Hash: []byte{2}}}
+	c := &Pos33SortMsg{SortHash: &SortHash{Hash: []byte{3}}}
+	ss := Sorts{a, c, b}
+	sort.Sort(ss)
+	// 重试抽中的排在没有重试的后面
+	assert.Equal(t, Sorts{b, c, a}, ss)
+}
+
+func TestBlockSignerValidAt(t *testing.T) {
+	s := &Pos33BlockSigner{Signer: "kms", Miner: "m", Height: 100}
+	revoked := &Pos33BlockSigner{Signer: "kms", Miner: "m", Height: 100, Revoked: 200}
+	cases := []struct {
+		name   string
+		s      *Pos33BlockSigner
+		miner  string
+		height int64
+		want   bool
+	}{
+		{"registering block", s, "m", 100, false},
+		{"registered", s, "m", 101, true},
+		{"other miner", s, "other", 101, false},
+		{"revoking block", revoked, "m", 200, true},
+		{"revoked", revoked, "m", 201, false},
+		{"nil", nil, "m", 101, false},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, c.s.ValidAt(c.miner, c.height), c.name)
+	}
+}
+
+func TestDisabledExecs(t *testing.T) {
+	defer RegisterDisabledExecs(nil)
+	assert.Equal(t, []string{"evm"}, RegisterDisabledExecs([]string{"evm", "token", Pos33TicketX, "evm"}))
+	evm := &types.Transaction{Execer: []byte("evm")}
+	assert.Equal(t, ErrExecDisabled, CheckExecEnabled(evm, -1))
+	assert.Nil(t, CheckExecEnabled(evm, 1))
+	assert.Nil(t, CheckExecEnabled(&types.Transaction{Execer: []byte("random")}, -1))
+	assert.False(t, ExecNameDisabled("token"))
+}
+
+func TestKeyFile(t *testing.T) {
+	cr, err := crypto.Load("secp256k1", -1)
+	assert.Nil(t, err)
+	priv, err := cr.GenKey()
+	assert.Nil(t, err)
+	kf, err := EncryptKeyFile(priv, "pass")
+	assert.Nil(t, err)
+	assert.Equal(t, KeyFileVersion, kf.Version)
+	p, err := kf.Decrypt("pass")
+	assert.Nil(t, err)
+	assert.Equal(t, priv.Bytes(), p.Bytes())
+	_, err = kf.Decrypt("wrong")
+	assert.Equal(t, ErrKeyFile, err)
+
+	// 同一个私钥和密码每次的 salt 不一样
+	kf2, err := EncryptKeyFile(priv, "pass")
+	assert.Nil(t, err)
+	assert.NotEqual(t, kf.Salt, kf2.Salt)
+	assert.NotEqual(t, kf.Cipher, kf2.Cipher)
+
+	// 改了地址 gcm 校验不过
+	other, err := cr.GenKey()
+	assert.Nil(t, err)
+	bad := *kf
+	bad.Addr = address.PubKeyToAddr(EthAddrID, other.PubKey().Bytes())
+	_, err = bad.Decrypt("pass")
+	assert.Equal(t, ErrKeyFile, err)
+
+	// 老格式还能读
+	legacy := &KeyFile{Addr: kf.Addr, Cipher: common.ToHex(wcom.CBCEncrypterPrivkey(legacyKeyOfPassword("pass"), priv.Bytes()))}
+	p, err = legacy.Decrypt("pass")
+	assert.Nil(t, err)
+	assert.Equal(t, priv.Bytes(), p.Bytes())
+}
+
+func TestSignerService(t *testing.T) {
+	cr, err := crypto.Load("secp256k1", -1)
+	assert.Nil(t, err)
+	priv, err := cr.GenKey()
+	assert.Nil(t, err)
+	_, err = NewSignerService(priv, "short", "")
+	assert.Equal(t, ErrSignerToken, err)
+	hwm := t.TempDir() + "/pos33.key.hwm"
+	token := "0123456789abcdef"
+	ss, err := NewSignerService(priv, token, hwm)
+	assert.Nil(t, err)
+
+	maker := func(hash string, height int64, round int32) []byte {
+		return types.Encode(&Pos33SortMsg{SortHash: &SortHash{Hash: []byte(hash)}, Proof: &HashProof{Input: &VrfInput{Height: height, Round: round}}})
+	}
+	cases := []struct {
+		name  string
+		token string
+		kind  string
+		msg   []byte
+		err   error
+	}{
+		{"bad token", "0123456789abcdeX", BlsVote, maker("a", 10, 0), ErrSignerToken},
+		{"raw bytes", token, "", []byte("anything"), ErrSignerKind},
+		{"vote", token, BlsVote, maker("a", 10, 0), nil},
+		{"same vote", token, BlsVote, maker("a", 10, 0), nil},
+		{"double vote", token, BlsVote, maker("b", 10, 0), ErrSignerConflict},
+		{"next round", token, BlsVote, maker("b", 10, 1), nil},
+		{"higher", token, BlsVote, maker("c", 10+signerKeep+1, 0), nil},
+		{"below mark", token, BlsVote, maker("d", 9, 0), ErrSignerConflict},
+		{"checkpoint", token, BlsCheckpoint, types.Encode(&Pos33Checkpoint{Height: 10, Hash: []byte("h")}), nil},
+	}
+	for _, c := range cases {
+		var reply SignerReply
+		err := ss.BlsSign(&SignerArgs{Token: c.token, Kind: c.kind, Msg: c.msg}, &reply)
+		assert.Equal(t, c.err, err, c.name)
+	}
+
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: []byte("x")}
+	var reply SignerReply
+	err = ss.Sign(&SignerArgs{Token: token, Kind: SignTx, Msg: types.Encode(tx)}, &reply)
+	assert.Equal(t, ErrSignerKind, err)
+	err = ss.Sign(&SignerArgs{Token: token, Kind: SignP2P, Msg: []byte("libp2p-pubsub:msg")}, &reply)
+	assert.Nil(t, err)
+
+	// 重启以后不再签名不高于保存的高度的消息
+	ss, err = NewSignerService(priv, token, hwm)
+	assert.Nil(t, err)
+	err = ss.BlsSign(&SignerArgs{Token: token, Kind: BlsVote, Msg: maker("c", 10+signerKeep+1, 0)}, &reply)
+	assert.Equal(t, ErrSignerConflict, err)
+}
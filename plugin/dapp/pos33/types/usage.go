@@ -0,0 +1,28 @@
+package types
+
+import "sync"
+
+// 按执行器统计的状态数据占用由 store 模块(name = "usage")在提交区块时记录,
+// rpc 在同一个进程里直接读取, 不经过消息队列
+var (
+	usageMu sync.Mutex
+	usageFn func() *Pos33StateUsages
+)
+
+// RegisterStateUsage store 模块注册状态数据占用的查询
+func RegisterStateUsage(f func() *Pos33StateUsages) {
+	usageMu.Lock()
+	defer usageMu.Unlock()
+	usageFn = f
+}
+
+// GetStateUsage 按执行器的状态数据占用, 没有使用 usage store 时返回 false
+func GetStateUsage() (*Pos33StateUsages, bool) {
+	usageMu.Lock()
+	f := usageFn
+	usageMu.Unlock()
+	if f == nil {
+		return nil, false
+	}
+	return f(), true
+}
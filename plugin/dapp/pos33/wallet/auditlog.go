@@ -0,0 +1,117 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const (
+	auditLastKey    = "pos33-audit-last"
+	maxAuditEntries = 1000
+
+	auditOpConfig  = "config"
+	auditOpUnlock  = "unlock"
+	auditOpLock    = "lock"
+	auditOpSign    = "sign"
+	auditOpKey     = "key"
+	auditOpApprove = "approve"
+	auditOpAdmin   = "admin"
+)
+
+func auditEntryKey(index int64) []byte {
+	return []byte(fmt.Sprintf("pos33-audit-%012d", index))
+}
+
+// auditLog 只追加的审计日志, 记录钱包解锁, 签名, 管理操作和配置,
+// 每一条的 hash 包含上一条的 hash, 修改或删除中间的记录都能发现
+type auditLog struct {
+	mu   sync.Mutex
+	db   db.DB
+	last *ty.Pos33AuditEntry
+}
+
+func newAuditLog(db db.DB) *auditLog {
+	a := &auditLog{db: db}
+	val, err := db.Get([]byte(auditLastKey))
+	if err == nil && len(val) > 0 {
+		last := new(ty.Pos33AuditEntry)
+		if types.Decode(val, last) == nil {
+			a.last = last
+		}
+	}
+	return a
+}
+
+func auditHash(e *ty.Pos33AuditEntry) []byte {
+	h := e.Hash
+	e.Hash = nil
+	b := common.Sha256(types.Encode(e))
+	e.Hash = h
+	return b
+}
+
+func (a *auditLog) append(op, caller, detail string) {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	e := &ty.Pos33AuditEntry{Time: time.Now().Unix(), Op: op, Caller: caller, Detail: detail}
+	if a.last != nil {
+		e.Index = a.last.Index + 1
+		e.PrevHash = a.last.Hash
+	}
+	e.Hash = auditHash(e)
+	val := types.Encode(e)
+
+	batch := a.db.NewBatch(true)
+	batch.Set(auditEntryKey(e.Index), val)
+	batch.Set([]byte(auditLastKey), val)
+	err := batch.Write()
+	if err != nil {
+		bizlog.Error("audit log write error", "err", err, "op", op)
+		return
+	}
+	a.last = e
+}
+
+// list 从 start 开始最多 count 条, 并检查 hash 链
+func (a *auditLog) list(req *ty.ReqPos33AuditLog) (*ty.Pos33AuditEntries, error) {
+	if a == nil {
+		return nil, types.ErrNotFound
+	}
+	count := int(req.Count)
+	if count <= 0 || count > maxAuditEntries {
+		count = maxAuditEntries
+	}
+	es := new(ty.Pos33AuditEntries)
+	var prev []byte
+	for i := req.Start; len(es.Items) < count; i++ {
+		val, err := a.db.Get(auditEntryKey(i))
+		if err != nil || len(val) == 0 {
+			break
+		}
+		e := new(ty.Pos33AuditEntry)
+		err = types.Decode(val, e)
+		if err != nil {
+			return nil, err
+		}
+		if string(auditHash(e)) != string(e.Hash) || (prev != nil && string(prev) != string(e.PrevHash)) {
+			return nil, fmt.Errorf("audit log broken at %d", i)
+		}
+		prev = e.Hash
+		es.Items = append(es.Items, e)
+	}
+	return es, nil
+}
@@ -5,6 +5,7 @@
 package wallet
 
 import (
+	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/types"
 	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
 )
@@ -111,6 +112,7 @@ func (policy *ticketPolicy) On_WalletGetMiner(req *types.ReqNil) (types.Message,
 		return nil, err
 	}
 	// addr := address.PubKeyToAddr(ethID, priv.PubKey().Bytes())
+	policy.audit.append(auditOpKey, address.PubKeyToAddr(ethID, priv.PubKey().Bytes()), "WalletGetMiner")
 	return &types.ReplyString{Data: string(priv.Bytes())}, nil
 }
 
@@ -121,3 +123,37 @@ func (policy *ticketPolicy) On_WalletGetMiner(req *types.ReqNil) (types.Message,
 // 	FlushPos33Ticket(policy.getAPI())
 // 	return &types.Reply{IsOk: true}, nil
 // }
+
+// On_Pos33Transfer 按照 spend policy 转账
+func (policy *ticketPolicy) On_Pos33Transfer(req *ty.ReqPos33Transfer) (types.Message, error) {
+	id, err := policy.spend.check(req)
+	if err != nil {
+		return nil, err
+	}
+	if id != "" {
+		bizlog.Info("pos33 transfer need approve", "id", id, "to", req.To, "amount", req.Amount)
+		return &ty.ReplyPos33Transfer{PendingId: id}, nil
+	}
+	return policy.transfer(req)
+}
+
+// On_Pos33ApproveTransfer 审批人确认大额转账
+func (policy *ticketPolicy) On_Pos33ApproveTransfer(req *ty.ReqPos33Approve) (types.Message, error) {
+	tr, err := policy.spend.approved(req)
+	if err != nil {
+		return nil, err
+	}
+	policy.audit.append(auditOpApprove, address.PubKeyToAddr(ethID, req.Sig.Pubkey), req.PendingId)
+	return policy.transfer(tr)
+}
+
+// On_Pos33AuditRecord 记录 rpc 的管理操作
+func (policy *ticketPolicy) On_Pos33AuditRecord(req *ty.Pos33AuditEntry) (types.Message, error) {
+	policy.audit.append(auditOpAdmin, req.Caller, req.Detail)
+	return &types.Reply{IsOk: true}, nil
+}
+
+// On_Pos33AuditLog 导出审计日志
+func (policy *ticketPolicy) On_Pos33AuditLog(req *ty.ReqPos33AuditLog) (types.Message, error) {
+	return policy.audit.list(req)
+}
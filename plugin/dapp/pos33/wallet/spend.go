@@ -0,0 +1,145 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const pendingExpire = 24 * 3600
+
+type pendingTransfer struct {
+	req     *ty.ReqPos33Transfer
+	created int64
+}
+
+// spendPolicy 钱包转账的限制: 每日限额, 目标地址白名单, 大额转账需要审批人签名
+type spendPolicy struct {
+	mu        sync.Mutex
+	limit     int64
+	allow     map[string]bool
+	approve   int64
+	approvers map[string]bool
+	day       string
+	spent     int64
+	pending   map[string]*pendingTransfer
+}
+
+func newSpendPolicy(cfg *subConfig) *spendPolicy {
+	p := &spendPolicy{
+		limit:     cfg.DailyLimit,
+		approve:   cfg.ApproveAmount,
+		allow:     make(map[string]bool),
+		approvers: make(map[string]bool),
+		pending:   make(map[string]*pendingTransfer),
+	}
+	for _, a := range cfg.Allowlist {
+		p.allow[a] = true
+	}
+	for _, a := range cfg.Approvers {
+		p.approvers[a] = true
+	}
+	return p
+}
+
+func today() string {
+	return time.Now().UTC().Format("2006-01-02")
+}
+
+func (p *spendPolicy) checkDaily(amount int64) error {
+	if p.day != today() {
+		p.day = today()
+		p.spent = 0
+	}
+	if p.limit > 0 && p.spent+amount > p.limit {
+		return ty.ErrSpendDailyLimit
+	}
+	return nil
+}
+
+// check 检查转账, 如果需要审批, 返回 pending id
+func (p *spendPolicy) check(req *ty.ReqPos33Transfer) (string, error) {
+	if req.Amount <= 0 {
+		return "", types.ErrAmount
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.allow) > 0 && !p.allow[req.To] {
+		return "", ty.ErrSpendNotAllowed
+	}
+	err := p.checkDaily(req.Amount)
+	if err != nil {
+		return "", err
+	}
+	if p.approve > 0 && req.Amount >= p.approve {
+		id := hex.EncodeToString(common.Sha256(types.Encode(req))[:8]) + hex.EncodeToString(common.Sha256([]byte(time.Now().String()))[:8])
+		p.pending[id] = &pendingTransfer{req: req, created: time.Now().Unix()}
+		return id, nil
+	}
+	p.spent += req.Amount
+	return "", nil
+}
+
+// approved 审批人签名确认后, 取出 pending 的转账
+func (p *spendPolicy) approved(m *ty.ReqPos33Approve) (*ty.ReqPos33Transfer, error) {
+	if !m.Verify() {
+		return nil, types.ErrSign
+	}
+	if !p.approvers[address.PubKeyToAddr(ethID, m.Sig.Pubkey)] {
+		return nil, ty.ErrSpendApprove
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now().Unix()
+	for id, pt := range p.pending {
+		if pt.created+pendingExpire < now {
+			delete(p.pending, id)
+		}
+	}
+	pt, ok := p.pending[m.PendingId]
+	if !ok {
+		return nil, types.ErrNotFound
+	}
+	err := p.checkDaily(pt.req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	delete(p.pending, m.PendingId)
+	p.spent += pt.req.Amount
+	return pt.req, nil
+}
+
+// refund 转账失败时退回今天的额度
+func (p *spendPolicy) refund(amount int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.spent -= amount
+}
+
+func (policy *ticketPolicy) transfer(req *ty.ReqPos33Transfer) (*ty.ReplyPos33Transfer, error) {
+	priv, err := policy.getWalletOperate().GetPrivKeyByAddr(req.From)
+	if err != nil {
+		policy.spend.refund(req.Amount)
+		return nil, err
+	}
+	r, err := policy.getWalletOperate().SendToAddress(priv, req.To, req.Amount, req.Note, false, "")
+	if err != nil {
+		policy.spend.refund(req.Amount)
+		return nil, err
+	}
+	bizlog.Info("pos33 transfer", "from", req.From, "to", req.To, "amount", req.Amount)
+	policy.audit.append(auditOpSign, req.From, fmt.Sprintf("Transfer to=%s amount=%d %s", req.To, req.Amount, common.ToHex(r.Hash)))
+	return &ty.ReplyPos33Transfer{Hash: r.Hash}, nil
+}
@@ -11,6 +11,7 @@ import (
 	"sync/atomic"
 
 	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/common/db"
@@ -40,6 +41,8 @@ type ticketPolicy struct {
 	walletOperate       wcom.WalletOperate
 	isPos33TicketLocked int32
 	cfg                 *subConfig
+	spend               *spendPolicy
+	audit               *auditLog
 }
 
 type subConfig struct {
@@ -47,6 +50,13 @@ type subConfig struct {
 	ForceMining    bool     `json:"forceMining"`
 	Minerdisable   bool     `json:"minerdisable"`
 	Minerwhitelist []string `json:"minerwhitelist"`
+	// 每天最多转出的金额, 0 表示不限制
+	DailyLimit int64 `json:"dailyLimit,omitempty"`
+	// 只能转账到这些地址, 空表示不限制
+	Allowlist []string `json:"allowlist,omitempty"`
+	// 转账金额达到这个数需要审批人签名确认, 0 表示不需要
+	ApproveAmount int64    `json:"approveAmount,omitempty"`
+	Approvers     []string `json:"approvers,omitempty"`
 }
 
 func (policy *ticketPolicy) setWalletOperate(walletBiz wcom.WalletOperate) {
@@ -92,6 +102,9 @@ func (policy *ticketPolicy) Init(walletBiz wcom.WalletOperate, sub []byte) {
 		types.MustDecode(sub, &subcfg)
 	}
 	policy.cfg = &subcfg
+	policy.spend = newSpendPolicy(&subcfg)
+	policy.audit = newAuditLog(walletBiz.GetDBStore())
+	policy.audit.append(auditOpConfig, "", "wallet.sub.pos33 "+common.ToHex(common.Sha256(sub)))
 	walletBiz.RegisterMineStatusReporter(policy)
 }
 
@@ -162,7 +175,7 @@ func (policy *ticketPolicy) onAddOrDeleteBlockTx(block *types.BlockDetail, tx *t
 		}
 		mact := pact.GetMiner()
 		n := int64(0)
-		for _, pk := range mact.BlsPkList {
+		for _, pk := range mact.Voters() {
 			addr := address.PubKeyToAddr(ethID, pk)
 			msg, err := policy.getAPI().Query(ty.Pos33TicketX, "Pos33BlsAddr", &types.ReqAddr{Addr: addr})
 			if err != nil {
@@ -209,10 +222,12 @@ func (policy *ticketPolicy) SignTransaction(key crypto.PrivKey, req *types.ReqSi
 
 // OnWalletLocked process lock event
 func (policy *ticketPolicy) OnWalletLocked() {
+	policy.audit.append(auditOpLock, "", "")
 }
 
 // OnWalletUnlocked process unlock event
 func (policy *ticketPolicy) OnWalletUnlocked(param *types.WalletUnLock) {
+	policy.audit.append(auditOpUnlock, "", fmt.Sprintf("timeout=%d walletOrTicket=%v", param.Timeout, param.WalletOrTicket))
 }
 
 // OnCreateNewAccount process create new account event
@@ -246,6 +261,7 @@ func (policy *ticketPolicy) setMinerFeeRate(priv crypto.PrivKey, fr *ty.Pos33Min
 
 	signID := types.EncodeSignID(types.SECP256K1, ethID)
 	tx.Sign(signID, priv)
+	policy.audit.append(auditOpSign, tx.From(), "SetMinerFeeRate "+common.ToHex(tx.Hash()))
 	r, err := policy.getAPI().SendTx(tx)
 	if err != nil {
 		return nil, err
@@ -271,6 +287,7 @@ func (policy *ticketPolicy) migrate(priv crypto.PrivKey) ([]byte, error) {
 	}
 	act.Ty = ty.Pos33ActionMigrate
 	bizlog.Info("pos33 migrate", "miner", addr)
+	policy.audit.append(auditOpSign, addr, "Migrate")
 	return policy.walletOperate.SendTransaction(act, []byte(ty.Pos33TicketX), priv, "")
 }
 
@@ -303,6 +320,7 @@ func (policy *ticketPolicy) blsBind(priv crypto.PrivKey) ([]byte, error) {
 	signID := types.EncodeSignID(types.SECP256K1, ethID)
 	tx.Sign(signID, priv)
 	bizlog.Info("bind blsaddr", "blsaddr", blsaddr, "addr", addr)
+	policy.audit.append(auditOpSign, addr, "BlsBind "+common.ToHex(tx.Hash()))
 	r, err := policy.getAPI().SendTx(tx)
 	if err != nil {
 		return nil, err
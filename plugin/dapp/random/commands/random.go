@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	cmdtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	"github.com/33cn/chain33/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	ty "github.com/yccproject/ycc/plugin/dapp/random/types"
+)
+
+// RandomCmd random command
+func RandomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "random",
+		Short: "on-chain randomness service",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		RequestCmd(),
+		RefundCmd(),
+		GetCmd(),
+		PendingCmd(),
+	)
+	return cmd
+}
+
+// createTx 输出 random 交易的 hex, 用 signrawtx 签名
+func createTx(cmd *cobra.Command, act *ty.RandomAction) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
+	}
+	rawTx := &types.Transaction{Payload: types.Encode(act)}
+	tx, err := types.FormatTxExt(cfg.ChainID, len(paraName) > 0, cfg.MinTxFeeRate, ty.RandomX, rawTx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(hex.EncodeToString(types.Encode(tx)))
+}
+
+// RequestCmd request randomness
+func RequestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request",
+		Short: "request randomness, fee must be transferred to random exec first",
+		Run: func(cmd *cobra.Command, args []string) {
+			rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+			cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+				return
+			}
+			seed, _ := cmd.Flags().GetString("seed")
+			fee, _ := cmd.Flags().GetFloat64("fee")
+			timeout, _ := cmd.Flags().GetInt64("timeout")
+			req := &ty.RandomRequest{Seed: []byte(seed), Fee: int64(fee * float64(cfg.CoinPrecision)), Timeout: timeout}
+			createTx(cmd, &ty.RandomAction{Ty: ty.RandomActionRequest, Value: &ty.RandomAction_Request{Request: req}})
+		},
+	}
+	cmd.Flags().StringP("seed", "s", "", "seed mixed into the request input")
+	cmd.Flags().Float64P("fee", "f", 0, "fee paid to the maker of the block after the request")
+	cmd.Flags().Int64P("timeout", "t", 0, "blocks before the request can be refunded, 0 for default")
+	cmd.MarkFlagRequired("fee")
+	return cmd
+}
+
+// RefundCmd refund timed out request
+func RefundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund",
+		Short: "refund the fee of a timed out request",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, _ := cmd.Flags().GetString("id")
+			createTx(cmd, &ty.RandomAction{Ty: ty.RandomActionRefund, Value: &ty.RandomAction_Refund{Refund: &ty.RandomRefund{Id: id}}})
+		},
+	}
+	cmd.Flags().StringP("id", "i", "", "request id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func query(cmd *cobra.Command, funcName string, req, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	params := rpctypes.Query4Jrpc{Execer: ty.RandomX, FuncName: funcName, Payload: types.MustPBToJSON(req)}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// GetCmd show request
+func GetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "show request status and output",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, _ := cmd.Flags().GetString("id")
+			query(cmd, "GetRandom", &types.ReqString{Data: id}, &ty.RandomRecord{})
+		},
+	}
+	cmd.Flags().StringP("id", "i", "", "request id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+// PendingCmd show pending requests
+func PendingCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending",
+		Short: "show pending requests",
+		Run: func(cmd *cobra.Command, args []string) {
+			query(cmd, "PendingRandoms", &types.ReqNil{}, &ty.ReplyRandomRecords{})
+		},
+	}
+}
@@ -0,0 +1,208 @@
+package executor
+
+import (
+	"encoding/hex"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/random/types"
+)
+
+func recordKey(id string) []byte {
+	return []byte("mavl-random-record-" + id)
+}
+
+func pendingKey() []byte {
+	return []byte("mavl-random-pending")
+}
+
+func getRecord(db dbm.KV, id string) (*ty.RandomRecord, error) {
+	val, err := db.Get(recordKey(id))
+	if err == types.ErrNotFound {
+		return nil, ty.ErrRequestNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	r := new(ty.RandomRecord)
+	err = types.Decode(val, r)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func getPending(db dbm.KV) (*ty.RandomPending, error) {
+	val, err := db.Get(pendingKey())
+	if err == types.ErrNotFound || (err == nil && len(val) == 0) {
+		return &ty.RandomPending{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	p := new(ty.RandomPending)
+	err = types.Decode(val, p)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+type action struct {
+	t        *Random
+	coins    *account.DB
+	db       dbm.KV
+	tx       *types.Transaction
+	fromaddr string
+	height   int64
+	execaddr string
+}
+
+func newAction(t *Random, tx *types.Transaction) *action {
+	return &action{t: t, coins: t.GetCoinsAccount(), db: t.GetStateDB(), tx: tx, fromaddr: tx.From(),
+		height: t.GetHeight(), execaddr: drivers.ExecAddress(driverName)}
+}
+
+// save 保存请求和等待列表, add 为 false 时从等待列表删除
+func (a *action) save(logTy int32, r *ty.RandomRecord, add bool) (*types.Receipt, error) {
+	pending, err := getPending(a.db)
+	if err != nil {
+		return nil, err
+	}
+	if add {
+		if len(pending.Ids) >= ty.MaxPending {
+			return nil, ty.ErrTooManyPending
+		}
+		pending.Ids = append(pending.Ids, r.Id)
+	} else {
+		for i, id := range pending.Ids {
+			if id == r.Id {
+				pending.Ids = append(pending.Ids[:i], pending.Ids[i+1:]...)
+				break
+			}
+		}
+	}
+	kvs := []*types.KeyValue{
+		{Key: recordKey(r.Id), Value: types.Encode(r)},
+		{Key: pendingKey(), Value: types.Encode(pending)},
+	}
+	log := &types.ReceiptLog{Ty: logTy, Log: types.Encode(&ty.ReceiptRandom{Record: r})}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: []*types.ReceiptLog{log}}, nil
+}
+
+func mergeReceipt(r *types.Receipt, r1 *types.Receipt) *types.Receipt {
+	r.KV = append(r.KV, r1.KV...)
+	r.Logs = append(r.Logs, r1.Logs...)
+	return r
+}
+
+// requestID 请求的 id 由 key 和请求人得到, 同一个 key 只能请求一次
+func requestID(key, requester string) string {
+	return hex.EncodeToString(common.Sha256([]byte(key + requester)))
+}
+
+// request requester 请求随机数
+func (a *action) request(requester, key string, req *ty.RandomRequest) (*types.Receipt, error) {
+	if req.Fee <= 0 {
+		return nil, types.ErrAmount
+	}
+	if len(req.Seed) > ty.MaxSeedSize {
+		return nil, ty.ErrSeedSize
+	}
+	timeout := req.Timeout
+	if timeout <= 0 {
+		timeout = ty.DefaultTimeout
+	}
+	if timeout > ty.MaxTimeout {
+		timeout = ty.MaxTimeout
+	}
+	id := requestID(key, requester)
+	if _, err := getRecord(a.db, id); err == nil {
+		return nil, ty.ErrRequestExists
+	}
+	r1, err := a.coins.ExecFrozen(requester, a.execaddr, req.Fee)
+	if err != nil {
+		return nil, err
+	}
+	r := &ty.RandomRecord{
+		Id:        id,
+		Requester: requester,
+		Seed:      req.Seed,
+		Fee:       req.Fee,
+		Height:    a.height,
+		Deadline:  a.height + timeout,
+	}
+	receipt, err := a.save(ty.TyLogRandomRequest, r, true)
+	if err != nil {
+		return nil, err
+	}
+	rlog.Debug("random request", "id", id, "requester", requester, "fee", req.Fee, "height", a.height)
+	return mergeReceipt(receipt, r1), nil
+}
+
+// fulfill 完成请求, 谁都可以发起. 输出由请求之后第一个区块的种子得到, 已经确定, 只是写进记录.
+// fee 给那个区块的制作人. payload 里的 hash 和 proof 不使用
+func (a *action) fulfill(f *ty.RandomFulfill) (*types.Receipt, error) {
+	r, err := getRecord(a.db, f.Id)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status != ty.StatusPending {
+		return nil, ty.ErrRequestStatus
+	}
+	if a.height <= r.Height || a.height > r.Deadline {
+		return nil, ty.ErrRequestTimeout
+	}
+	seed, err := pt.GetBlockSeed(a.db, r.Height+1)
+	if err == types.ErrNotFound {
+		return nil, ty.ErrNoSeed
+	}
+	if err != nil {
+		return nil, err
+	}
+	r1, err := a.coins.ExecTransferFrozen(r.Requester, seed.Maker, a.execaddr, r.Fee)
+	if err != nil {
+		return nil, err
+	}
+	r.Status = ty.StatusFulfilled
+	r.Hash = r.Output(seed.Seed)
+	r.BlockSeed = seed.Seed
+	r.Proof = seed.Proof
+	r.Pubkey = seed.Pubkey
+	r.Maker = seed.Maker
+	r.FulfillHeight = a.height
+	receipt, err := a.save(ty.TyLogRandomFulfill, r, false)
+	if err != nil {
+		return nil, err
+	}
+	rlog.Debug("random fulfill", "id", r.Id, "maker", seed.Maker, "from", a.fromaddr, "height", a.height)
+	return mergeReceipt(receipt, r1), nil
+}
+
+// refund 超时没有完成的请求退回 fee, 谁都可以发起, fee 退给请求人
+func (a *action) refund(rf *ty.RandomRefund) (*types.Receipt, error) {
+	r, err := getRecord(a.db, rf.Id)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status != ty.StatusPending {
+		return nil, ty.ErrRequestStatus
+	}
+	if a.height <= r.Deadline {
+		return nil, ty.ErrRequestTimeout
+	}
+	r1, err := a.coins.ExecActive(r.Requester, a.execaddr, r.Fee)
+	if err != nil {
+		return nil, err
+	}
+	r.Status = ty.StatusRefunded
+	receipt, err := a.save(ty.TyLogRandomRefund, r, false)
+	if err != nil {
+		return nil, err
+	}
+	return mergeReceipt(receipt, r1), nil
+}
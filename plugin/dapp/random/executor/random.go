@@ -0,0 +1,118 @@
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/random/types"
+)
+
+// random 随机数服务: 用户或者合约付 fee 请求随机数, 输出由请求之后第一个区块的种子 (制作人的 vrf 输出)
+// 和请求的 id, 种子得到, 区块提交以后就确定了, 制作人不能挑选或者扣下. 谁都可以完成请求, fee 给那个区块的制作人,
+// 任何人可以用制作人的公钥验证种子. 超时没有完成的请求可以退回 fee
+
+var rlog = log.New("module", "execs.random")
+var driverName = ty.RandomX
+
+// Init initial
+func Init(name string, cfg *types.Chain33Config, sub []byte) {
+	drivers.Register(cfg, GetName(), newRandom, cfg.GetDappFork(driverName, "Enable"))
+	InitExecType()
+}
+
+// InitExecType reg types
+func InitExecType() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Random{}))
+}
+
+// GetName get name
+func GetName() string {
+	return newRandom().GetName()
+}
+
+// Random driver type
+type Random struct {
+	drivers.DriverBase
+}
+
+func newRandom() drivers.Driver {
+	t := &Random{}
+	t.SetChild(t)
+	t.SetExecutorType(types.LoadExecutorType(driverName))
+	return t
+}
+
+// GetDriverName ...
+func (t *Random) GetDriverName() string {
+	return driverName
+}
+
+// CheckTx 本节点关掉 random 时交易池不接收
+func (t *Random) CheckTx(tx *types.Transaction, index int) error {
+	return pt.CheckExecEnabled(tx, index)
+}
+
+// ExecLocal 本节点关掉 random 时不写本地索引
+func (t *Random) ExecLocal(tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if pt.ExecNameDisabled(driverName) {
+		return &types.LocalDBSet{}, nil
+	}
+	return t.DriverBase.ExecLocal(tx, receipt, index)
+}
+
+// ExecDelLocal 本节点关掉 random 时不写本地索引
+func (t *Random) ExecDelLocal(tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if pt.ExecNameDisabled(driverName) {
+		return &types.LocalDBSet{}, nil
+	}
+	return t.DriverBase.ExecDelLocal(tx, receipt, index)
+}
+
+// Query 本节点关掉 random 时不回答查询
+func (t *Random) Query(funcname string, params []byte) (types.Message, error) {
+	if pt.ExecNameDisabled(driverName) {
+		return nil, pt.ErrExecDisabled
+	}
+	return t.DriverBase.Query(funcname, params)
+}
+
+// Exec_Request exec request
+func (t *Random) Exec_Request(payload *ty.RandomRequest, tx *types.Transaction, index int) (*types.Receipt, error) {
+	a := newAction(t, tx)
+	return a.request(tx.From(), string(tx.Hash()), payload)
+}
+
+// Exec_Fulfill exec fulfill
+func (t *Random) Exec_Fulfill(payload *ty.RandomFulfill, tx *types.Transaction, index int) (*types.Receipt, error) {
+	return newAction(t, tx).fulfill(payload)
+}
+
+// Exec_Refund exec refund
+func (t *Random) Exec_Refund(payload *ty.RandomRefund, tx *types.Transaction, index int) (*types.Receipt, error) {
+	return newAction(t, tx).refund(payload)
+}
+
+// Query_GetRandom 请求的状态和输出
+func (t *Random) Query_GetRandom(req *types.ReqString) (types.Message, error) {
+	return getRecord(t.GetStateDB(), req.Data)
+}
+
+// Query_PendingRandoms 等待完成的请求, 制作人用来完成请求
+func (t *Random) Query_PendingRandoms(*types.ReqNil) (types.Message, error) {
+	db := t.GetStateDB()
+	pending, err := getPending(db)
+	if err != nil {
+		return nil, err
+	}
+	reply := &ty.ReplyRandomRecords{}
+	for _, id := range pending.Ids {
+		r, err := getRecord(db, id)
+		if err != nil {
+			return nil, err
+		}
+		reply.Records = append(reply.Records, r)
+	}
+	return reply, nil
+}
@@ -0,0 +1,49 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/account"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/random/types"
+	"github.com/yccproject/ycc/plugin/dapp/xcall"
+)
+
+// 合约请求随机数和读取结果
+func init() {
+	xcall.Register(driverName, "Request", xcallRequest, false)
+	xcall.Register(driverName, "Get", xcallGet, true)
+}
+
+// xcallRequest 调用者请求随机数, fee 从调用者在 random 下面的账户冻结, payload 是 ty.RandomRequest, 返回请求的 id (types.ReqString)
+func xcallRequest(ctx *xcall.Context, payload []byte) ([]byte, *types.Receipt, error) {
+	var req ty.RandomRequest
+	err := types.Decode(payload, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	coins := account.NewCoinsAccount(ctx.GetAPI().GetConfig())
+	coins.SetDB(ctx.GetStateDB())
+	a := &action{coins: coins, db: ctx.GetStateDB(), tx: ctx.Tx, fromaddr: ctx.Caller, height: ctx.GetHeight(),
+		execaddr: drivers.ExecAddress(driverName)}
+	// 同一笔交易里可以用不同的种子请求多次
+	key := string(ctx.Tx.Hash()) + string(req.Seed)
+	receipt, err := a.request(ctx.Caller, key, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return types.Encode(&types.ReqString{Data: requestID(key, ctx.Caller)}), receipt, nil
+}
+
+// xcallGet 请求的状态和输出, payload 是 types.ReqString, 返回 ty.RandomRecord
+func xcallGet(ctx *xcall.Context, payload []byte) ([]byte, *types.Receipt, error) {
+	var req types.ReqString
+	err := types.Decode(payload, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := getRecord(ctx.GetStateDB(), req.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return types.Encode(r), nil, nil
+}
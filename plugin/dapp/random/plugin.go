@@ -0,0 +1,17 @@
+package random
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/yccproject/ycc/plugin/dapp/random/commands"
+	"github.com/yccproject/ycc/plugin/dapp/random/executor"
+	"github.com/yccproject/ycc/plugin/dapp/random/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.RandomX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.RandomCmd,
+	})
+}
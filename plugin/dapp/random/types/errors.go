@@ -0,0 +1,20 @@
+package types
+
+import "errors"
+
+var (
+	// ErrNoSeed 请求之后的区块没有种子, 不能完成, 只能超时退款
+	ErrNoSeed = errors.New("ErrRandomNoSeed")
+	// ErrRequestNotFound 请求不存在
+	ErrRequestNotFound = errors.New("ErrRandomRequestNotFound")
+	// ErrRequestExists 请求已经存在
+	ErrRequestExists = errors.New("ErrRandomRequestExists")
+	// ErrRequestStatus 请求已经完成或者退款
+	ErrRequestStatus = errors.New("ErrRandomRequestStatus")
+	// ErrRequestTimeout 请求已经超时, 或者还没有超时不能退款
+	ErrRequestTimeout = errors.New("ErrRandomRequestTimeout")
+	// ErrTooManyPending 等待的请求太多
+	ErrTooManyPending = errors.New("ErrRandomTooManyPending")
+	// ErrSeedSize 种子太长
+	ErrSeedSize = errors.New("ErrRandomSeedSize")
+)
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+)
+
+// RandomX dapp name
+const RandomX = "random"
+
+const (
+	// RandomActionRequest action type
+	RandomActionRequest = 1
+	// RandomActionFulfill action type
+	RandomActionFulfill = 2
+	// RandomActionRefund action type
+	RandomActionRefund = 3
+)
+
+const (
+	// TyLogRandomRequest request log type
+	TyLogRandomRequest = 351
+	// TyLogRandomFulfill fulfill log type
+	TyLogRandomFulfill = 352
+	// TyLogRandomRefund refund log type
+	TyLogRandomRefund = 353
+)
+
+const (
+	// StatusPending 等待完成
+	StatusPending = 0
+	// StatusFulfilled 已完成
+	StatusFulfilled = 1
+	// StatusRefunded 已退款
+	StatusRefunded = 2
+)
+
+const (
+	// DefaultTimeout 默认多少个区块内没有完成可以退款
+	DefaultTimeout = 20
+	// MaxTimeout 最长的超时
+	MaxTimeout = 1000
+	// MaxSeedSize 请求的种子最大长度
+	MaxSeedSize = 64
+	// MaxPending 最多同时等待的请求
+	MaxPending = 1024
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(RandomX))
+	types.RegFork(RandomX, InitFork)
+	types.RegExec(RandomX, InitExecutor)
+}
+
+// InitFork init fork
+func InitFork(cfg *types.Chain33Config) {
+	cfg.RegisterDappFork(RandomX, "Enable", types.MaxHeight)
+}
+
+// InitExecutor init executor type
+func InitExecutor(cfg *types.Chain33Config) {
+	types.RegistorExecutor(RandomX, NewType(cfg))
+}
+
+// RandomType random exec type
+type RandomType struct {
+	types.ExecTypeBase
+}
+
+// NewType new type
+func NewType(cfg *types.Chain33Config) *RandomType {
+	c := &RandomType{}
+	c.SetChild(c)
+	c.SetConfig(cfg)
+	return c
+}
+
+// GetPayload get payload
+func (t *RandomType) GetPayload() types.Message {
+	return &RandomAction{}
+}
+
+// GetName get name
+func (t *RandomType) GetName() string {
+	return RandomX
+}
+
+// GetLogMap get log map
+func (t *RandomType) GetLogMap() map[int64]*types.LogInfo {
+	return map[int64]*types.LogInfo{
+		TyLogRandomRequest: {Ty: reflect.TypeOf(ReceiptRandom{}), Name: "LogRandomRequest"},
+		TyLogRandomFulfill: {Ty: reflect.TypeOf(ReceiptRandom{}), Name: "LogRandomFulfill"},
+		TyLogRandomRefund:  {Ty: reflect.TypeOf(ReceiptRandom{}), Name: "LogRandomRefund"},
+	}
+}
+
+// GetTypeMap get type map
+func (t *RandomType) GetTypeMap() map[string]int32 {
+	return map[string]int32{
+		"Request": RandomActionRequest,
+		"Fulfill": RandomActionFulfill,
+		"Refund":  RandomActionRefund,
+	}
+}
+
+// Input 请求的输入, 和请求的 id 和种子绑定
+func (r *RandomRecord) Input() []byte {
+	return types.Encode(&RandomInput{Id: r.Id, Seed: r.Seed})
+}
+
+// Output 请求的输出, 由请求之后第一个区块的种子和请求的输入得到.
+// 区块种子是制作人对上一个种子的 vrf 输出, 区块提交以后输出就确定了
+func (r *RandomRecord) Output(seed []byte) []byte {
+	return common.Sha256(append(append([]byte{}, seed...), r.Input()...))
+}
@@ -0,0 +1,887 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: random.proto
+
+package types
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// message for execs.random
+type RandomAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*RandomAction_Request
+	//	*RandomAction_Fulfill
+	//	*RandomAction_Refund
+	Value isRandomAction_Value `protobuf_oneof:"value"`
+	Ty    int32                `protobuf:"varint,10,opt,name=ty,proto3" json:"ty,omitempty"`
+}
+
+func (x *RandomAction) Reset() {
+	*x = RandomAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_random_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomAction) ProtoMessage() {}
+
+func (x *RandomAction) ProtoReflect() protoreflect.Message {
+	mi := &file_random_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomAction.ProtoReflect.Descriptor instead.
+func (*RandomAction) Descriptor() ([]byte, []int) {
+	return file_random_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *RandomAction) GetValue() isRandomAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *RandomAction) GetRequest() *RandomRequest {
+	if x, ok := x.GetValue().(*RandomAction_Request); ok {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *RandomAction) GetFulfill() *RandomFulfill {
+	if x, ok := x.GetValue().(*RandomAction_Fulfill); ok {
+		return x.Fulfill
+	}
+	return nil
+}
+
+func (x *RandomAction) GetRefund() *RandomRefund {
+	if x, ok := x.GetValue().(*RandomAction_Refund); ok {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *RandomAction) GetTy() int32 {
+	if x != nil {
+		return x.Ty
+	}
+	return 0
+}
+
+type isRandomAction_Value interface {
+	isRandomAction_Value()
+}
+
+type RandomAction_Request struct {
+	Request *RandomRequest `protobuf:"bytes,1,opt,name=request,proto3,oneof"`
+}
+
+type RandomAction_Fulfill struct {
+	Fulfill *RandomFulfill `protobuf:"bytes,2,opt,name=fulfill,proto3,oneof"`
+}
+
+type RandomAction_Refund struct {
+	Refund *RandomRefund `protobuf:"bytes,3,opt,name=refund,proto3,oneof"`
+}
+
+func (*RandomAction_Request) isRandomAction_Value() {}
+
+func (*RandomAction_Fulfill) isRandomAction_Value() {}
+
+func (*RandomAction_Refund) isRandomAction_Value() {}
+
+// 请求随机数, fee 先转到 random 执行器下面, 请求时冻结, 完成时给请求之后第一个区块的制作人
+type RandomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seed []byte `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	Fee  int64  `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	// 多少个区块内没有完成可以退款, 0 用默认值
+	Timeout int64 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *RandomRequest) Reset() {
+	*x = RandomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_random_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomRequest) ProtoMessage() {}
+
+func (x *RandomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_random_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomRequest.ProtoReflect.Descriptor instead.
+func (*RandomRequest) Descriptor() ([]byte, []int) {
+	return file_random_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RandomRequest) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *RandomRequest) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *RandomRequest) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+// 完成请求, 谁都可以发起. 输出由区块种子得到, hash 和 proof 不再使用, 只为兼容保留
+type RandomFulfill struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hash  []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Proof []byte `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *RandomFulfill) Reset() {
+	*x = RandomFulfill{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_random_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomFulfill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomFulfill) ProtoMessage() {}
+
+func (x *RandomFulfill) ProtoReflect() protoreflect.Message {
+	mi := &file_random_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomFulfill.ProtoReflect.Descriptor instead.
+func (*RandomFulfill) Descriptor() ([]byte, []int) {
+	return file_random_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RandomFulfill) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RandomFulfill) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *RandomFulfill) GetProof() []byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+// 超时以后请求人取回 fee
+type RandomRefund struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RandomRefund) Reset() {
+	*x = RandomRefund{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_random_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomRefund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomRefund) ProtoMessage() {}
+
+func (x *RandomRefund) ProtoReflect() protoreflect.Message {
+	mi := &file_random_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomRefund.ProtoReflect.Descriptor instead.
+func (*RandomRefund) Descriptor() ([]byte, []int) {
+	return file_random_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RandomRefund) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 请求的输入, 和区块种子一起得到输出
+type RandomInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seed []byte `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (x *RandomInput) Reset() {
+	*x = RandomInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_random_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomInput) ProtoMessage() {}
+
+func (x *RandomInput) ProtoReflect() protoreflect.Message {
+	mi := &file_random_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomInput.ProtoReflect.Descriptor instead.
+func (*RandomInput) Descriptor() ([]byte, []int) {
+	return file_random_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RandomInput) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RandomInput) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+type RandomRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Requester string `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	Seed      []byte `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	Fee       int64  `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	Height    int64  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// 超过这个高度没有完成可以退款
+	Deadline int64 `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// 0 等待 1 完成 2 已退款
+	Status int32 `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	// 输出, 以及请求之后第一个区块的种子, 种子的 vrf 证明和制作人
+	Hash          []byte `protobuf:"bytes,8,opt,name=hash,proto3" json:"hash,omitempty"`
+	Proof         []byte `protobuf:"bytes,9,opt,name=proof,proto3" json:"proof,omitempty"`
+	Pubkey        []byte `protobuf:"bytes,10,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Maker         string `protobuf:"bytes,11,opt,name=maker,proto3" json:"maker,omitempty"`
+	FulfillHeight int64  `protobuf:"varint,12,opt,name=fulfillHeight,proto3" json:"fulfillHeight,omitempty"`
+	BlockSeed     []byte `protobuf:"bytes,13,opt,name=blockSeed,proto3" json:"blockSeed,omitempty"`
+}
+
+func (x *RandomRecord) Reset() {
+	*x = RandomRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_random_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomRecord) ProtoMessage() {}
+
+func (x *RandomRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_random_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomRecord.ProtoReflect.Descriptor instead.
+func (*RandomRecord) Descriptor() ([]byte, []int) {
+	return file_random_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RandomRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RandomRecord) GetRequester() string {
+	if x != nil {
+		return x.Requester
+	}
+	return ""
+}
+
+func (x *RandomRecord) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *RandomRecord) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *RandomRecord) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *RandomRecord) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *RandomRecord) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *RandomRecord) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *RandomRecord) GetProof() []byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *RandomRecord) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *RandomRecord) GetMaker() string {
+	if x != nil {
+		return x.Maker
+	}
+	return ""
+}
+
+func (x *RandomRecord) GetFulfillHeight() int64 {
+	if x != nil {
+		return x.FulfillHeight
+	}
+	return 0
+}
+
+func (x *RandomRecord) GetBlockSeed() []byte {
+	if x != nil {
+		return x.BlockSeed
+	}
+	return nil
+}
+
+type RandomPending struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *RandomPending) Reset() {
+	*x = RandomPending{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_random_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomPending) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomPending) ProtoMessage() {}
+
+func (x *RandomPending) ProtoReflect() protoreflect.Message {
+	mi := &file_random_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomPending.ProtoReflect.Descriptor instead.
+func (*RandomPending) Descriptor() ([]byte, []int) {
+	return file_random_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RandomPending) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ReplyRandomRecords struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*RandomRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *ReplyRandomRecords) Reset() {
+	*x = ReplyRandomRecords{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_random_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyRandomRecords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyRandomRecords) ProtoMessage() {}
+
+func (x *ReplyRandomRecords) ProtoReflect() protoreflect.Message {
+	mi := &file_random_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyRandomRecords.ProtoReflect.Descriptor instead.
+func (*ReplyRandomRecords) Descriptor() ([]byte, []int) {
+	return file_random_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReplyRandomRecords) GetRecords() []*RandomRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type ReceiptRandom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record *RandomRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+}
+
+func (x *ReceiptRandom) Reset() {
+	*x = ReceiptRandom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_random_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptRandom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptRandom) ProtoMessage() {}
+
+func (x *ReceiptRandom) ProtoReflect() protoreflect.Message {
+	mi := &file_random_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptRandom.ProtoReflect.Descriptor instead.
+func (*ReceiptRandom) Descriptor() ([]byte, []int) {
+	return file_random_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReceiptRandom) GetRecord() *RandomRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+var File_random_proto protoreflect.FileDescriptor
+
+var file_random_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x75, 0x6c, 0x66,
+	0x69, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x48,
+	0x00, 0x52, 0x07, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x48,
+	0x00, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x4f, 0x0a, 0x0d, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x22, 0x49, 0x0a, 0x0d, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46, 0x75, 0x6c,
+	0x66, 0x69, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x1e,
+	0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x31,
+	0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65,
+	0x64, 0x22, 0xca, 0x02, 0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x73, 0x65, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x75,
+	0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x22, 0x21,
+	0x0a, 0x0d, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64,
+	0x73, 0x22, 0x43, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x3c, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_random_proto_rawDescOnce sync.Once
+	file_random_proto_rawDescData = file_random_proto_rawDesc
+)
+
+func file_random_proto_rawDescGZIP() []byte {
+	file_random_proto_rawDescOnce.Do(func() {
+		file_random_proto_rawDescData = protoimpl.X.CompressGZIP(file_random_proto_rawDescData)
+	})
+	return file_random_proto_rawDescData
+}
+
+var file_random_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_random_proto_goTypes = []interface{}{
+	(*RandomAction)(nil),       // 0: types.RandomAction
+	(*RandomRequest)(nil),      // 1: types.RandomRequest
+	(*RandomFulfill)(nil),      // 2: types.RandomFulfill
+	(*RandomRefund)(nil),       // 3: types.RandomRefund
+	(*RandomInput)(nil),        // 4: types.RandomInput
+	(*RandomRecord)(nil),       // 5: types.RandomRecord
+	(*RandomPending)(nil),      // 6: types.RandomPending
+	(*ReplyRandomRecords)(nil), // 7: types.ReplyRandomRecords
+	(*ReceiptRandom)(nil),      // 8: types.ReceiptRandom
+}
+var file_random_proto_depIdxs = []int32{
+	1, // 0: types.RandomAction.request:type_name -> types.RandomRequest
+	2, // 1: types.RandomAction.fulfill:type_name -> types.RandomFulfill
+	3, // 2: types.RandomAction.refund:type_name -> types.RandomRefund
+	5, // 3: types.ReplyRandomRecords.records:type_name -> types.RandomRecord
+	5, // 4: types.ReceiptRandom.record:type_name -> types.RandomRecord
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_random_proto_init() }
+func file_random_proto_init() {
+	if File_random_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_random_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_random_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_random_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomFulfill); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_random_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomRefund); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_random_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_random_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_random_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomPending); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_random_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyRandomRecords); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_random_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptRandom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_random_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*RandomAction_Request)(nil),
+		(*RandomAction_Fulfill)(nil),
+		(*RandomAction_Refund)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_random_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_random_proto_goTypes,
+		DependencyIndexes: file_random_proto_depIdxs,
+		MessageInfos:      file_random_proto_msgTypes,
+	}.Build()
+	File_random_proto = out.File
+	file_random_proto_rawDesc = nil
+	file_random_proto_goTypes = nil
+	file_random_proto_depIdxs = nil
+}
@@ -0,0 +1,163 @@
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	cmdtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	"github.com/33cn/chain33/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	ty "github.com/yccproject/ycc/plugin/dapp/stycc/types"
+)
+
+// StyccCmd stycc command
+func StyccCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stycc",
+		Short: "liquid staking (stYCC) management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		DepositCmd(),
+		RedeemCmd(),
+		ClaimCmd(),
+		TransferCmd(),
+		PoolCmd(),
+		AccountCmd(),
+	)
+	return cmd
+}
+
+// createTx 输出 stycc 交易的 hex, 用 signrawtx 签名
+func createTx(cmd *cobra.Command, act *ty.StyccAction) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
+	}
+	rawTx := &types.Transaction{Payload: types.Encode(act)}
+	tx, err := types.FormatTxExt(cfg.ChainID, len(paraName) > 0, cfg.MinTxFeeRate, ty.StyccX, rawTx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(hex.EncodeToString(types.Encode(tx)))
+}
+
+func coinsAmount(cmd *cobra.Command, flag string) (int64, bool) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return 0, false
+	}
+	amount, _ := cmd.Flags().GetFloat64(flag)
+	return int64(amount * float64(cfg.CoinPrecision)), true
+}
+
+// DepositCmd deposit YCC for stYCC
+func DepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit",
+		Short: "deposit YCC (already transferred to stycc exec) for stYCC",
+		Run: func(cmd *cobra.Command, args []string) {
+			amount, ok := coinsAmount(cmd, "amount")
+			if !ok {
+				return
+			}
+			createTx(cmd, &ty.StyccAction{Ty: ty.StyccActionDeposit, Value: &ty.StyccAction_Deposit{Deposit: &ty.StyccDeposit{Amount: amount}}})
+		},
+	}
+	cmd.Flags().Float64P("amount", "a", 0, "amount of YCC")
+	cmd.MarkFlagRequired("amount")
+	return cmd
+}
+
+// RedeemCmd redeem stYCC
+func RedeemCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem",
+		Short: "redeem stYCC, YCC can be claimed after the unbonding period",
+		Run: func(cmd *cobra.Command, args []string) {
+			shares, ok := coinsAmount(cmd, "shares")
+			if !ok {
+				return
+			}
+			createTx(cmd, &ty.StyccAction{Ty: ty.StyccActionRedeem, Value: &ty.StyccAction_Redeem{Redeem: &ty.StyccRedeem{Shares: shares}}})
+		},
+	}
+	cmd.Flags().Float64P("shares", "s", 0, "amount of stYCC")
+	cmd.MarkFlagRequired("shares")
+	return cmd
+}
+
+// ClaimCmd claim unbonded redemptions
+func ClaimCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim",
+		Short: "claim unbonded redemptions to stycc exec account",
+		Run: func(cmd *cobra.Command, args []string) {
+			createTx(cmd, &ty.StyccAction{Ty: ty.StyccActionClaim, Value: &ty.StyccAction_Claim{Claim: &ty.StyccClaim{}}})
+		},
+	}
+}
+
+// TransferCmd transfer stYCC
+func TransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer",
+		Short: "transfer stYCC",
+		Run: func(cmd *cobra.Command, args []string) {
+			shares, ok := coinsAmount(cmd, "shares")
+			if !ok {
+				return
+			}
+			to, _ := cmd.Flags().GetString("to")
+			createTx(cmd, &ty.StyccAction{Ty: ty.StyccActionTransfer, Value: &ty.StyccAction_Transfer{Transfer: &ty.StyccTransfer{To: to, Shares: shares}}})
+		},
+	}
+	cmd.Flags().StringP("to", "t", "", "receiver address")
+	cmd.Flags().Float64P("shares", "s", 0, "amount of stYCC")
+	cmd.MarkFlagRequired("to")
+	cmd.MarkFlagRequired("shares")
+	return cmd
+}
+
+func query(cmd *cobra.Command, funcName string, req, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	params := rpctypes.Query4Jrpc{Execer: ty.StyccX, FuncName: funcName, Payload: types.MustPBToJSON(req)}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// PoolCmd show pool and exchange rate
+func PoolCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pool",
+		Short: "show stYCC pool and exchange rate",
+		Run: func(cmd *cobra.Command, args []string) {
+			query(cmd, "StyccPool", &types.ReqNil{}, &ty.ReplyStyccPool{})
+		},
+	}
+}
+
+// AccountCmd show stYCC account
+func AccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account",
+		Short: "show stYCC balance and redemptions of address",
+		Run: func(cmd *cobra.Command, args []string) {
+			addr, _ := cmd.Flags().GetString("addr")
+			query(cmd, "StyccAccount", &types.ReqAddr{Addr: addr}, &ty.ReplyStyccAccount{})
+		},
+	}
+	cmd.Flags().StringP("addr", "a", "", "address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
@@ -0,0 +1,383 @@
+package executor
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	pos33 "github.com/yccproject/ycc/plugin/dapp/pos33/executor"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/stycc/types"
+	"github.com/yccproject/ycc/plugin/dapp/xcall"
+)
+
+func poolKey() []byte {
+	return []byte("mavl-stycc-pool")
+}
+
+func accountKey(addr string) []byte {
+	return []byte("mavl-stycc-account-" + string(address.FormatAddrKey(addr)))
+}
+
+func getPool(db dbm.KV) (*ty.StyccPool, error) {
+	val, err := db.Get(poolKey())
+	if err == types.ErrNotFound {
+		return &ty.StyccPool{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	pool := new(ty.StyccPool)
+	err = types.Decode(val, pool)
+	if err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+func getAccount(db dbm.KV, addr string) (*ty.StyccAccount, error) {
+	val, err := db.Get(accountKey(addr))
+	if err == types.ErrNotFound {
+		return &ty.StyccAccount{Addr: addr}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	acc := new(ty.StyccAccount)
+	err = types.Decode(val, acc)
+	if err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
+
+// freeAmount 池子里没有委托也没有被赎回占用的 YCC, 主要是委托的奖励
+func freeAmount(coins *account.DB, db dbm.KV, pool *ty.StyccPool) int64 {
+	coins.SetDB(db)
+	acc := coins.LoadExecAccount(ty.PoolAddr, drivers.ExecAddress(driverName))
+	free := acc.Balance - pool.Pending
+	if free < 0 {
+		return 0
+	}
+	return free
+}
+
+// stakedAmount 池子在 pos33 的委托, 矿工被罚没以后比 pool.Staked 少
+func stakedAmount(db dbm.KV, pool *ty.StyccPool) (int64, error) {
+	if pool.Consignee == "" {
+		return 0, nil
+	}
+	return pos33.EntrustAmount(db, pool.Consignee, ty.PoolAddr)
+}
+
+// poolTotal 池子的 YCC 总值, 用来算汇率
+func poolTotal(coins *account.DB, db dbm.KV, pool *ty.StyccPool) (int64, error) {
+	staked, err := stakedAmount(db, pool)
+	if err != nil {
+		return 0, err
+	}
+	if staked > pool.Staked {
+		staked = pool.Staked
+	}
+	return staked + freeAmount(coins, db, pool), nil
+}
+
+// mulDiv a * b / c, 中间结果不会溢出
+func mulDiv(a, b, c int64) int64 {
+	r := new(big.Int).Mul(big.NewInt(a), big.NewInt(b))
+	return r.Div(r, big.NewInt(c)).Int64()
+}
+
+type action struct {
+	t        *Stycc
+	coins    *account.DB
+	db       dbm.KV
+	tx       *types.Transaction
+	fromaddr string
+	height   int64
+	execaddr string
+}
+
+func newAction(t *Stycc, tx *types.Transaction) *action {
+	return &action{t: t, coins: t.GetCoinsAccount(), db: t.GetStateDB(), tx: tx, fromaddr: tx.From(),
+		height: t.GetHeight(), execaddr: drivers.ExecAddress(string(tx.Execer))}
+}
+
+func mergeReceipt(r *types.Receipt, rs ...*types.Receipt) {
+	for _, r1 := range rs {
+		if r1 == nil {
+			continue
+		}
+		r.KV = append(r.KV, r1.KV...)
+		r.Logs = append(r.Logs, r1.Logs...)
+	}
+}
+
+// manageValue manage 执行器里 key 的最后一个值, 没有配置返回空
+func manageValue(db dbm.KV, key string) string {
+	val, err := db.Get([]byte(types.ManageKey(key)))
+	if err != nil || len(val) == 0 {
+		return ""
+	}
+	var item types.ConfigItem
+	if types.Decode(val, &item) != nil {
+		return ""
+	}
+	vals := item.GetArr().GetValue()
+	if len(vals) == 0 {
+		return ""
+	}
+	return vals[len(vals)-1]
+}
+
+// entrust 调用 pos33 改池子在 consignee 的委托, 币由调用者冻结或者解冻
+func (a *action) entrust(consignee string, amount int64) (*types.Receipt, error) {
+	ctx := xcall.NewContext(a.t, a.tx, driverName, ty.PoolAddr, false)
+	pe := &pt.Pos33Entrust{Consignee: consignee, Consignor: ty.PoolAddr, Amount: amount}
+	_, receipt, err := ctx.Call(pt.Pos33TicketX, "Entrust", types.Encode(pe))
+	return receipt, err
+}
+
+// syncConfig 把 manage 执行器的配置存到池子里, 执行只用池子里的配置.
+// 换了矿工以后先结算原来矿工的罚没, 再把池子的委托整个转给新的矿工
+func (a *action) syncConfig(pool *ty.StyccPool) (*types.Receipt, error) {
+	if pool.UnbondBlocks <= 0 {
+		pool.UnbondBlocks = ty.DefaultUnbondBlocks
+	}
+	if v := manageValue(a.db, ty.ManageUnbondBlocksKey); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err == nil && n > 0 {
+			pool.UnbondBlocks = n
+		} else {
+			slog.Error("manage stycc unbondBlocks error", "value", v, "height", a.height)
+		}
+	}
+	consignee := manageValue(a.db, ty.ManageConsigneeKey)
+	if consignee == "" || consignee == pool.Consignee {
+		return nil, nil
+	}
+	if err := address.CheckAddress(consignee, a.height); err != nil {
+		slog.Error("manage stycc consignee error", "value", consignee, "height", a.height)
+		return nil, nil
+	}
+	receipt, err := a.settle(pool)
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		receipt = &types.Receipt{Ty: types.ExecOk}
+	}
+	if pool.Staked > 0 {
+		r1, err := a.entrust(pool.Consignee, -pool.Staked)
+		if err != nil {
+			return nil, err
+		}
+		r2, err := a.entrust(consignee, pool.Staked)
+		if err != nil {
+			return nil, err
+		}
+		mergeReceipt(receipt, r1, r2)
+	}
+	slog.Info("stycc consignee change", "from", pool.Consignee, "to", consignee, "staked", pool.Staked, "height", a.height)
+	pool.Consignee = consignee
+	return receipt, nil
+}
+
+// settle 矿工被罚没以后 pos33 的委托比 pool.Staked 少, 把差额销毁, 由所有 stYCC 分摊
+func (a *action) settle(pool *ty.StyccPool) (*types.Receipt, error) {
+	staked, err := stakedAmount(a.db, pool)
+	if err != nil {
+		return nil, err
+	}
+	slashed := pool.Staked - staked
+	if slashed <= 0 {
+		return nil, nil
+	}
+	receipt, err := a.coins.ExecTransferFrozen(ty.PoolAddr, pos33.BurnAddr(), a.execaddr, slashed)
+	if err != nil {
+		return nil, err
+	}
+	pool.Staked = staked
+	slog.Info("stycc slashed", "amount", slashed, "height", a.height)
+	return receipt, nil
+}
+
+func (a *action) save(logTy int32, pool *ty.StyccPool, shares, amount int64, accs ...*ty.StyccAccount) *types.Receipt {
+	kvs := []*types.KeyValue{{Key: poolKey(), Value: types.Encode(pool)}}
+	for _, acc := range accs {
+		kvs = append(kvs, &types.KeyValue{Key: accountKey(acc.Addr), Value: types.Encode(acc)})
+	}
+	log := &ty.ReceiptStycc{Addr: a.fromaddr, Shares: shares, Amount: amount, Pool: pool}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: []*types.ReceiptLog{{Ty: logTy, Log: types.Encode(log)}}}
+}
+
+// deposit 存入执行器下面的 YCC, 按当前汇率换 stYCC, 存入的 YCC 全部委托
+func (a *action) deposit(d *ty.StyccDeposit) (*types.Receipt, error) {
+	if d.Amount <= 0 {
+		return nil, types.ErrAmount
+	}
+	pool, err := getPool(a.db)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := a.syncConfig(pool)
+	if err != nil {
+		return nil, err
+	}
+	if pool.Consignee == "" {
+		return nil, ty.ErrNoConsignee
+	}
+	acc, err := getAccount(a.db, a.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	r0, err := a.settle(pool)
+	if err != nil {
+		return nil, err
+	}
+	shares := d.Amount
+	total := pool.Staked + freeAmount(a.coins, a.db, pool)
+	if pool.Shares > 0 && total > 0 {
+		shares = mulDiv(d.Amount, pool.Shares, total)
+	}
+	if shares <= 0 {
+		return nil, ty.ErrShares
+	}
+
+	r1, err := a.coins.ExecTransfer(a.fromaddr, ty.PoolAddr, a.execaddr, d.Amount)
+	if err != nil {
+		return nil, err
+	}
+	r2, err := a.coins.ExecFrozen(ty.PoolAddr, a.execaddr, d.Amount)
+	if err != nil {
+		return nil, err
+	}
+	r3, err := a.entrust(pool.Consignee, d.Amount)
+	if err != nil {
+		return nil, err
+	}
+	pool.Shares += shares
+	pool.Staked += d.Amount
+	acc.Shares += shares
+	receipt := a.save(ty.TyLogStyccDeposit, pool, shares, d.Amount, acc)
+	mergeReceipt(receipt, rc, r0, r1, r2, r3)
+	slog.Info("stycc deposit", "addr", a.fromaddr, "amount", d.Amount, "shares", shares, "height", a.height)
+	return receipt, nil
+}
+
+// redeem 按当前汇率赎回 stYCC, 先用池子里没有委托的 YCC, 不够的取消委托. 赎回的 YCC 记在地址的赎回队列里,
+// pool.UnbondBlocks 以后才能领取, 等待期间不算在池子的总值里, 也没有奖励
+func (a *action) redeem(r *ty.StyccRedeem) (*types.Receipt, error) {
+	pool, err := getPool(a.db)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := a.syncConfig(pool)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := getAccount(a.db, a.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	if r.Shares <= 0 || r.Shares > acc.Shares {
+		return nil, ty.ErrShares
+	}
+	r0, err := a.settle(pool)
+	if err != nil {
+		return nil, err
+	}
+	free := freeAmount(a.coins, a.db, pool)
+	amount := mulDiv(r.Shares, pool.Staked+free, pool.Shares)
+	if amount <= 0 {
+		return nil, types.ErrAmount
+	}
+
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	mergeReceipt(receipt, rc, r0)
+	if unstake := amount - free; unstake > 0 {
+		r1, err := a.entrust(pool.Consignee, -unstake)
+		if err != nil {
+			return nil, err
+		}
+		r2, err := a.coins.ExecActive(ty.PoolAddr, a.execaddr, unstake)
+		if err != nil {
+			return nil, err
+		}
+		mergeReceipt(receipt, r1, r2)
+		pool.Staked -= unstake
+	}
+	pool.Shares -= r.Shares
+	pool.Pending += amount
+	acc.Shares -= r.Shares
+	acc.Redemptions = append(acc.Redemptions, &ty.StyccRedemption{Amount: amount, Height: a.height + pool.UnbondBlocks})
+	r3 := a.save(ty.TyLogStyccRedeem, pool, r.Shares, amount, acc)
+	mergeReceipt(r3, receipt)
+	slog.Info("stycc redeem", "addr", a.fromaddr, "shares", r.Shares, "amount", amount, "height", a.height)
+	return r3, nil
+}
+
+// claim 领取解锁的赎回, YCC 转到地址在 stycc 执行器下面的账户
+func (a *action) claim(c *ty.StyccClaim) (*types.Receipt, error) {
+	pool, err := getPool(a.db)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := getAccount(a.db, a.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	var amount int64
+	var left []*ty.StyccRedemption
+	for _, rd := range acc.Redemptions {
+		if rd.Height <= a.height {
+			amount += rd.Amount
+		} else {
+			left = append(left, rd)
+		}
+	}
+	if amount == 0 {
+		return nil, ty.ErrNothingToClaim
+	}
+	r1, err := a.coins.ExecTransfer(ty.PoolAddr, a.fromaddr, a.execaddr, amount)
+	if err != nil {
+		return nil, err
+	}
+	pool.Pending -= amount
+	acc.Redemptions = left
+	receipt := a.save(ty.TyLogStyccClaim, pool, 0, amount, acc)
+	mergeReceipt(receipt, r1)
+	return receipt, nil
+}
+
+// transfer 转 stYCC
+func (a *action) transfer(t *ty.StyccTransfer) (*types.Receipt, error) {
+	if t.To == "" || t.To == a.fromaddr || t.To == ty.PoolAddr {
+		return nil, types.ErrInvalidAddress
+	}
+	if err := address.CheckAddress(t.To, a.height); err != nil {
+		return nil, err
+	}
+	pool, err := getPool(a.db)
+	if err != nil {
+		return nil, err
+	}
+	from, err := getAccount(a.db, a.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	if t.Shares <= 0 || t.Shares > from.Shares {
+		return nil, ty.ErrShares
+	}
+	to, err := getAccount(a.db, t.To)
+	if err != nil {
+		return nil, err
+	}
+	from.Shares -= t.Shares
+	to.Shares += t.Shares
+	return a.save(ty.TyLogStyccTransfer, pool, t.Shares, 0, from, to), nil
+}
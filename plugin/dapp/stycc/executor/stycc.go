@@ -0,0 +1,142 @@
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	pos33 "github.com/yccproject/ycc/plugin/dapp/pos33/executor"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/stycc/types"
+)
+
+// stycc 流动质押: 存入 YCC 得到可以转账的 stYCC, 池子里的 YCC 用 ty.PoolAddr 委托给 manage 执行器配置的矿工.
+// 委托的奖励转回池子, stYCC 的汇率 (池子的 YCC / stYCC 总量) 随奖励上涨.
+// 赎回时按汇率换回 YCC, 先用池子里没有委托的 YCC, 不够的取消委托, 都要等 unbondBlocks 个区块以后才能领取
+
+var slog = log.New("module", "execs.stycc")
+var driverName = ty.StyccX
+
+// Init initial
+func Init(name string, cfg *types.Chain33Config, sub []byte) {
+	pos33.RegisterExecPool(driverName, ty.PoolAddr)
+	drivers.Register(cfg, GetName(), newStycc, cfg.GetDappFork(driverName, "Enable"))
+	InitExecType()
+}
+
+// InitExecType reg types
+func InitExecType() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Stycc{}))
+}
+
+// GetName get name
+func GetName() string {
+	return newStycc().GetName()
+}
+
+// Stycc driver type
+type Stycc struct {
+	drivers.DriverBase
+}
+
+func newStycc() drivers.Driver {
+	t := &Stycc{}
+	t.SetChild(t)
+	t.SetExecutorType(types.LoadExecutorType(driverName))
+	return t
+}
+
+// GetDriverName ...
+func (t *Stycc) GetDriverName() string {
+	return driverName
+}
+
+// CheckTx 本节点关掉 stycc 时交易池不接收
+func (t *Stycc) CheckTx(tx *types.Transaction, index int) error {
+	return pt.CheckExecEnabled(tx, index)
+}
+
+// ExecLocal 本节点关掉 stycc 时不写本地索引
+func (t *Stycc) ExecLocal(tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if pt.ExecNameDisabled(driverName) {
+		return &types.LocalDBSet{}, nil
+	}
+	return t.DriverBase.ExecLocal(tx, receipt, index)
+}
+
+// ExecDelLocal 本节点关掉 stycc 时不写本地索引
+func (t *Stycc) ExecDelLocal(tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if pt.ExecNameDisabled(driverName) {
+		return &types.LocalDBSet{}, nil
+	}
+	return t.DriverBase.ExecDelLocal(tx, receipt, index)
+}
+
+// Query 本节点关掉 stycc 时不回答查询
+func (t *Stycc) Query(funcname string, params []byte) (types.Message, error) {
+	if pt.ExecNameDisabled(driverName) {
+		return nil, pt.ErrExecDisabled
+	}
+	return t.DriverBase.Query(funcname, params)
+}
+
+// Exec_Deposit exec deposit
+func (t *Stycc) Exec_Deposit(payload *ty.StyccDeposit, tx *types.Transaction, index int) (*types.Receipt, error) {
+	return newAction(t, tx).deposit(payload)
+}
+
+// Exec_Redeem exec redeem
+func (t *Stycc) Exec_Redeem(payload *ty.StyccRedeem, tx *types.Transaction, index int) (*types.Receipt, error) {
+	return newAction(t, tx).redeem(payload)
+}
+
+// Exec_Claim exec claim
+func (t *Stycc) Exec_Claim(payload *ty.StyccClaim, tx *types.Transaction, index int) (*types.Receipt, error) {
+	return newAction(t, tx).claim(payload)
+}
+
+// Exec_Transfer exec transfer
+func (t *Stycc) Exec_Transfer(payload *ty.StyccTransfer, tx *types.Transaction, index int) (*types.Receipt, error) {
+	return newAction(t, tx).transfer(payload)
+}
+
+// Query_StyccPool 池子的状态和汇率
+func (t *Stycc) Query_StyccPool(*types.ReqNil) (types.Message, error) {
+	db := t.GetStateDB()
+	pool, err := getPool(db)
+	if err != nil {
+		return nil, err
+	}
+	free := freeAmount(t.GetCoinsAccount(), db, pool)
+	total, err := poolTotal(t.GetCoinsAccount(), db, pool)
+	if err != nil {
+		return nil, err
+	}
+	reply := &ty.ReplyStyccPool{Pool: pool, Free: free, Total: total, Addr: ty.PoolAddr, Consignee: pool.Consignee}
+	if pool.Shares > 0 {
+		reply.Rate = mulDiv(ty.RateUnit, total, pool.Shares)
+	}
+	return reply, nil
+}
+
+// Query_StyccAccount 地址的 stYCC 和赎回
+func (t *Stycc) Query_StyccAccount(req *types.ReqAddr) (types.Message, error) {
+	db := t.GetStateDB()
+	acc, err := getAccount(db, req.Addr)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := getPool(db)
+	if err != nil {
+		return nil, err
+	}
+	reply := &ty.ReplyStyccAccount{Account: acc}
+	if pool.Shares > 0 {
+		total, err := poolTotal(t.GetCoinsAccount(), db, pool)
+		if err != nil {
+			return nil, err
+		}
+		reply.Value = mulDiv(acc.Shares, total, pool.Shares)
+	}
+	return reply, nil
+}
@@ -0,0 +1,17 @@
+package stycc
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/yccproject/ycc/plugin/dapp/stycc/commands"
+	"github.com/yccproject/ycc/plugin/dapp/stycc/executor"
+	"github.com/yccproject/ycc/plugin/dapp/stycc/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.StyccX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.StyccCmd,
+	})
+}
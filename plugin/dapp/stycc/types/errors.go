@@ -0,0 +1,12 @@
+package types
+
+import "errors"
+
+var (
+	// ErrNoConsignee 没有配置委托的矿工
+	ErrNoConsignee = errors.New("ErrNoConsignee")
+	// ErrShares stYCC 数量错误或者不够
+	ErrShares = errors.New("ErrShares")
+	// ErrNothingToClaim 没有解锁的赎回
+	ErrNothingToClaim = errors.New("ErrNothingToClaim")
+)
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+)
+
+// StyccX dapp name
+const StyccX = "stycc"
+
+// PoolAddr 委托池的地址, 没有私钥, 只能由 stycc 执行器动用
+var PoolAddr = address.ExecAddress(StyccX + "-pool")
+
+const (
+	// StyccActionDeposit action type
+	StyccActionDeposit = 1
+	// StyccActionRedeem action type
+	StyccActionRedeem = 2
+	// StyccActionClaim action type
+	StyccActionClaim = 3
+	// StyccActionTransfer action type
+	StyccActionTransfer = 4
+)
+
+const (
+	// TyLogStyccDeposit deposit log type
+	TyLogStyccDeposit = 341
+	// TyLogStyccRedeem redeem log type
+	TyLogStyccRedeem = 342
+	// TyLogStyccClaim claim log type
+	TyLogStyccClaim = 343
+	// TyLogStyccTransfer transfer log type
+	TyLogStyccTransfer = 344
+)
+
+// RateUnit 汇率按每 RateUnit 个 stYCC 值多少 YCC 表示
+const RateUnit = 1e8
+
+const (
+	// ManageConsigneeKey manage 执行器里池子委托的矿工地址, 最后一个值有效
+	ManageConsigneeKey = "stycc-consignee"
+	// ManageUnbondBlocksKey manage 执行器里赎回等待的区块数, 最后一个值有效
+	ManageUnbondBlocksKey = "stycc-unbondBlocks"
+	// DefaultUnbondBlocks 没有配置时赎回以后等这么多区块才能领取
+	DefaultUnbondBlocks = 10000
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(StyccX))
+	types.RegFork(StyccX, InitFork)
+	types.RegExec(StyccX, InitExecutor)
+}
+
+// InitFork init fork
+func InitFork(cfg *types.Chain33Config) {
+	cfg.RegisterDappFork(StyccX, "Enable", types.MaxHeight)
+}
+
+// InitExecutor init executor type
+func InitExecutor(cfg *types.Chain33Config) {
+	types.RegistorExecutor(StyccX, NewType(cfg))
+}
+
+// StyccType stycc exec type
+type StyccType struct {
+	types.ExecTypeBase
+}
+
+// NewType new type
+func NewType(cfg *types.Chain33Config) *StyccType {
+	c := &StyccType{}
+	c.SetChild(c)
+	c.SetConfig(cfg)
+	return c
+}
+
+// GetPayload get payload
+func (t *StyccType) GetPayload() types.Message {
+	return &StyccAction{}
+}
+
+// GetName get name
+func (t *StyccType) GetName() string {
+	return StyccX
+}
+
+// GetLogMap get log map
+func (t *StyccType) GetLogMap() map[int64]*types.LogInfo {
+	return map[int64]*types.LogInfo{
+		TyLogStyccDeposit:  {Ty: reflect.TypeOf(ReceiptStycc{}), Name: "LogStyccDeposit"},
+		TyLogStyccRedeem:   {Ty: reflect.TypeOf(ReceiptStycc{}), Name: "LogStyccRedeem"},
+		TyLogStyccClaim:    {Ty: reflect.TypeOf(ReceiptStycc{}), Name: "LogStyccClaim"},
+		TyLogStyccTransfer: {Ty: reflect.TypeOf(ReceiptStycc{}), Name: "LogStyccTransfer"},
+	}
+}
+
+// GetTypeMap get type map
+func (t *StyccType) GetTypeMap() map[string]int32 {
+	return map[string]int32{
+		"Deposit":  StyccActionDeposit,
+		"Redeem":   StyccActionRedeem,
+		"Claim":    StyccActionClaim,
+		"Transfer": StyccActionTransfer,
+	}
+}
@@ -0,0 +1,1033 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: stycc.proto
+
+package types
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// message for execs.stycc
+type StyccAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*StyccAction_Deposit
+	//	*StyccAction_Redeem
+	//	*StyccAction_Claim
+	//	*StyccAction_Transfer
+	Value isStyccAction_Value `protobuf_oneof:"value"`
+	Ty    int32               `protobuf:"varint,10,opt,name=ty,proto3" json:"ty,omitempty"`
+}
+
+func (x *StyccAction) Reset() {
+	*x = StyccAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stycc_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StyccAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StyccAction) ProtoMessage() {}
+
+func (x *StyccAction) ProtoReflect() protoreflect.Message {
+	mi := &file_stycc_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StyccAction.ProtoReflect.Descriptor instead.
+func (*StyccAction) Descriptor() ([]byte, []int) {
+	return file_stycc_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *StyccAction) GetValue() isStyccAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *StyccAction) GetDeposit() *StyccDeposit {
+	if x, ok := x.GetValue().(*StyccAction_Deposit); ok {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (x *StyccAction) GetRedeem() *StyccRedeem {
+	if x, ok := x.GetValue().(*StyccAction_Redeem); ok {
+		return x.Redeem
+	}
+	return nil
+}
+
+func (x *StyccAction) GetClaim() *StyccClaim {
+	if x, ok := x.GetValue().(*StyccAction_Claim); ok {
+		return x.Claim
+	}
+	return nil
+}
+
+func (x *StyccAction) GetTransfer() *StyccTransfer {
+	if x, ok := x.GetValue().(*StyccAction_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *StyccAction) GetTy() int32 {
+	if x != nil {
+		return x.Ty
+	}
+	return 0
+}
+
+type isStyccAction_Value interface {
+	isStyccAction_Value()
+}
+
+type StyccAction_Deposit struct {
+	Deposit *StyccDeposit `protobuf:"bytes,1,opt,name=deposit,proto3,oneof"`
+}
+
+type StyccAction_Redeem struct {
+	Redeem *StyccRedeem `protobuf:"bytes,2,opt,name=redeem,proto3,oneof"`
+}
+
+type StyccAction_Claim struct {
+	Claim *StyccClaim `protobuf:"bytes,3,opt,name=claim,proto3,oneof"`
+}
+
+type StyccAction_Transfer struct {
+	Transfer *StyccTransfer `protobuf:"bytes,4,opt,name=transfer,proto3,oneof"`
+}
+
+func (*StyccAction_Deposit) isStyccAction_Value() {}
+
+func (*StyccAction_Redeem) isStyccAction_Value() {}
+
+func (*StyccAction_Claim) isStyccAction_Value() {}
+
+func (*StyccAction_Transfer) isStyccAction_Value() {}
+
+// 存入 YCC 换 stYCC, 币先转到 stycc 执行器下面
+type StyccDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *StyccDeposit) Reset() {
+	*x = StyccDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stycc_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StyccDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StyccDeposit) ProtoMessage() {}
+
+func (x *StyccDeposit) ProtoReflect() protoreflect.Message {
+	mi := &file_stycc_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StyccDeposit.ProtoReflect.Descriptor instead.
+func (*StyccDeposit) Descriptor() ([]byte, []int) {
+	return file_stycc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StyccDeposit) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 赎回 stYCC, 换回的 YCC 在解锁以后才能领取
+type StyccRedeem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shares int64 `protobuf:"varint,1,opt,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *StyccRedeem) Reset() {
+	*x = StyccRedeem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stycc_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StyccRedeem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StyccRedeem) ProtoMessage() {}
+
+func (x *StyccRedeem) ProtoReflect() protoreflect.Message {
+	mi := &file_stycc_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StyccRedeem.ProtoReflect.Descriptor instead.
+func (*StyccRedeem) Descriptor() ([]byte, []int) {
+	return file_stycc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StyccRedeem) GetShares() int64 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+// 领取已经解锁的赎回
+type StyccClaim struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StyccClaim) Reset() {
+	*x = StyccClaim{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stycc_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StyccClaim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StyccClaim) ProtoMessage() {}
+
+func (x *StyccClaim) ProtoReflect() protoreflect.Message {
+	mi := &file_stycc_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StyccClaim.ProtoReflect.Descriptor instead.
+func (*StyccClaim) Descriptor() ([]byte, []int) {
+	return file_stycc_proto_rawDescGZIP(), []int{3}
+}
+
+// 转 stYCC
+type StyccTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	To     string `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	Shares int64  `protobuf:"varint,2,opt,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *StyccTransfer) Reset() {
+	*x = StyccTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stycc_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StyccTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StyccTransfer) ProtoMessage() {}
+
+func (x *StyccTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_stycc_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StyccTransfer.ProtoReflect.Descriptor instead.
+func (*StyccTransfer) Descriptor() ([]byte, []int) {
+	return file_stycc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StyccTransfer) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *StyccTransfer) GetShares() int64 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+type StyccPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// stYCC 总量
+	Shares int64 `protobuf:"varint,1,opt,name=shares,proto3" json:"shares,omitempty"`
+	// 委托给矿工的 YCC
+	Staked int64 `protobuf:"varint,2,opt,name=staked,proto3" json:"staked,omitempty"`
+	// 等待领取的赎回
+	Pending int64 `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	// 池子委托的矿工, 由 manage 执行器的 stycc-consignee 修改
+	Consignee string `protobuf:"bytes,4,opt,name=consignee,proto3" json:"consignee,omitempty"`
+	// 赎回以后等这么多区块才能领取, 由 manage 执行器的 stycc-unbondBlocks 修改
+	UnbondBlocks int64 `protobuf:"varint,5,opt,name=unbondBlocks,proto3" json:"unbondBlocks,omitempty"`
+}
+
+func (x *StyccPool) Reset() {
+	*x = StyccPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stycc_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StyccPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StyccPool) ProtoMessage() {}
+
+func (x *StyccPool) ProtoReflect() protoreflect.Message {
+	mi := &file_stycc_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StyccPool.ProtoReflect.Descriptor instead.
+func (*StyccPool) Descriptor() ([]byte, []int) {
+	return file_stycc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StyccPool) GetShares() int64 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *StyccPool) GetStaked() int64 {
+	if x != nil {
+		return x.Staked
+	}
+	return 0
+}
+
+func (x *StyccPool) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *StyccPool) GetConsignee() string {
+	if x != nil {
+		return x.Consignee
+	}
+	return ""
+}
+
+func (x *StyccPool) GetUnbondBlocks() int64 {
+	if x != nil {
+		return x.UnbondBlocks
+	}
+	return 0
+}
+
+type StyccRedemption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 解锁的高度
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *StyccRedemption) Reset() {
+	*x = StyccRedemption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stycc_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StyccRedemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StyccRedemption) ProtoMessage() {}
+
+func (x *StyccRedemption) ProtoReflect() protoreflect.Message {
+	mi := &file_stycc_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StyccRedemption.ProtoReflect.Descriptor instead.
+func (*StyccRedemption) Descriptor() ([]byte, []int) {
+	return file_stycc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StyccRedemption) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *StyccRedemption) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type StyccAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr        string             `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Shares      int64              `protobuf:"varint,2,opt,name=shares,proto3" json:"shares,omitempty"`
+	Redemptions []*StyccRedemption `protobuf:"bytes,3,rep,name=redemptions,proto3" json:"redemptions,omitempty"`
+}
+
+func (x *StyccAccount) Reset() {
+	*x = StyccAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stycc_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StyccAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StyccAccount) ProtoMessage() {}
+
+func (x *StyccAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_stycc_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StyccAccount.ProtoReflect.Descriptor instead.
+func (*StyccAccount) Descriptor() ([]byte, []int) {
+	return file_stycc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StyccAccount) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *StyccAccount) GetShares() int64 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *StyccAccount) GetRedemptions() []*StyccRedemption {
+	if x != nil {
+		return x.Redemptions
+	}
+	return nil
+}
+
+type ReceiptStycc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr   string     `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Shares int64      `protobuf:"varint,2,opt,name=shares,proto3" json:"shares,omitempty"`
+	Amount int64      `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Pool   *StyccPool `protobuf:"bytes,4,opt,name=pool,proto3" json:"pool,omitempty"`
+}
+
+func (x *ReceiptStycc) Reset() {
+	*x = ReceiptStycc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stycc_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptStycc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptStycc) ProtoMessage() {}
+
+func (x *ReceiptStycc) ProtoReflect() protoreflect.Message {
+	mi := &file_stycc_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptStycc.ProtoReflect.Descriptor instead.
+func (*ReceiptStycc) Descriptor() ([]byte, []int) {
+	return file_stycc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReceiptStycc) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReceiptStycc) GetShares() int64 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *ReceiptStycc) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReceiptStycc) GetPool() *StyccPool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+type ReplyStyccPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pool *StyccPool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	// 奖励和解冻的还没有赎回的 YCC
+	Free int64 `protobuf:"varint,2,opt,name=free,proto3" json:"free,omitempty"`
+	// 池子的 YCC 总值 staked + free
+	Total int64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// 每个 stYCC (1e8) 值多少 YCC
+	Rate      int64  `protobuf:"varint,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	Addr      string `protobuf:"bytes,5,opt,name=addr,proto3" json:"addr,omitempty"`
+	Consignee string `protobuf:"bytes,6,opt,name=consignee,proto3" json:"consignee,omitempty"`
+}
+
+func (x *ReplyStyccPool) Reset() {
+	*x = ReplyStyccPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stycc_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyStyccPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyStyccPool) ProtoMessage() {}
+
+func (x *ReplyStyccPool) ProtoReflect() protoreflect.Message {
+	mi := &file_stycc_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyStyccPool.ProtoReflect.Descriptor instead.
+func (*ReplyStyccPool) Descriptor() ([]byte, []int) {
+	return file_stycc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReplyStyccPool) GetPool() *StyccPool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+func (x *ReplyStyccPool) GetFree() int64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *ReplyStyccPool) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReplyStyccPool) GetRate() int64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ReplyStyccPool) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReplyStyccPool) GetConsignee() string {
+	if x != nil {
+		return x.Consignee
+	}
+	return ""
+}
+
+type ReplyStyccAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *StyccAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// stYCC 按当前汇率值多少 YCC
+	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ReplyStyccAccount) Reset() {
+	*x = ReplyStyccAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stycc_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyStyccAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyStyccAccount) ProtoMessage() {}
+
+func (x *ReplyStyccAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_stycc_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyStyccAccount.ProtoReflect.Descriptor instead.
+func (*ReplyStyccAccount) Descriptor() ([]byte, []int) {
+	return file_stycc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReplyStyccAccount) GetAccount() *StyccAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ReplyStyccAccount) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+var File_stycc_proto protoreflect.FileDescriptor
+
+var file_stycc_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x73, 0x74, 0x79, 0x63, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x22, 0xe4, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x79, 0x63, 0x63, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74,
+	0x79, 0x63, 0x63, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74,
+	0x79, 0x63, 0x63, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x64,
+	0x65, 0x65, 0x6d, 0x12, 0x29, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x79, 0x63, 0x63,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x32,
+	0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x79, 0x63, 0x63, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x26, 0x0a, 0x0c, 0x53,
+	0x74, 0x79, 0x63, 0x63, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x25, 0x0a, 0x0b, 0x53, 0x74, 0x79, 0x63, 0x63, 0x52, 0x65, 0x64, 0x65,
+	0x65, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0x0c, 0x0a, 0x0a, 0x53, 0x74,
+	0x79, 0x63, 0x63, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x22, 0x37, 0x0a, 0x0d, 0x53, 0x74, 0x79, 0x63,
+	0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x22, 0x97, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x79, 0x63, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x6e, 0x62, 0x6f, 0x6e,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x75,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x41, 0x0a, 0x0f, 0x53,
+	0x74, 0x79, 0x63, 0x63, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x74,
+	0x0a, 0x0c, 0x53, 0x74, 0x79, 0x63, 0x63, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64,
+	0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x72, 0x65,
+	0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x79, 0x63, 0x63, 0x52, 0x65, 0x64,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x78, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x53,
+	0x74, 0x79, 0x63, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x74, 0x79, 0x63, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0xa6,
+	0x01, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x79, 0x63, 0x63, 0x50, 0x6f, 0x6f,
+	0x6c, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x79, 0x63, 0x63, 0x50, 0x6f, 0x6f,
+	0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x22, 0x58, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x53, 0x74, 0x79, 0x63, 0x63, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x79, 0x63, 0x63, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_stycc_proto_rawDescOnce sync.Once
+	file_stycc_proto_rawDescData = file_stycc_proto_rawDesc
+)
+
+func file_stycc_proto_rawDescGZIP() []byte {
+	file_stycc_proto_rawDescOnce.Do(func() {
+		file_stycc_proto_rawDescData = protoimpl.X.CompressGZIP(file_stycc_proto_rawDescData)
+	})
+	return file_stycc_proto_rawDescData
+}
+
+var file_stycc_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_stycc_proto_goTypes = []interface{}{
+	(*StyccAction)(nil),       // 0: types.StyccAction
+	(*StyccDeposit)(nil),      // 1: types.StyccDeposit
+	(*StyccRedeem)(nil),       // 2: types.StyccRedeem
+	(*StyccClaim)(nil),        // 3: types.StyccClaim
+	(*StyccTransfer)(nil),     // 4: types.StyccTransfer
+	(*StyccPool)(nil),         // 5: types.StyccPool
+	(*StyccRedemption)(nil),   // 6: types.StyccRedemption
+	(*StyccAccount)(nil),      // 7: types.StyccAccount
+	(*ReceiptStycc)(nil),      // 8: types.ReceiptStycc
+	(*ReplyStyccPool)(nil),    // 9: types.ReplyStyccPool
+	(*ReplyStyccAccount)(nil), // 10: types.ReplyStyccAccount
+}
+var file_stycc_proto_depIdxs = []int32{
+	1, // 0: types.StyccAction.deposit:type_name -> types.StyccDeposit
+	2, // 1: types.StyccAction.redeem:type_name -> types.StyccRedeem
+	3, // 2: types.StyccAction.claim:type_name -> types.StyccClaim
+	4, // 3: types.StyccAction.transfer:type_name -> types.StyccTransfer
+	6, // 4: types.StyccAccount.redemptions:type_name -> types.StyccRedemption
+	5, // 5: types.ReceiptStycc.pool:type_name -> types.StyccPool
+	5, // 6: types.ReplyStyccPool.pool:type_name -> types.StyccPool
+	7, // 7: types.ReplyStyccAccount.account:type_name -> types.StyccAccount
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_stycc_proto_init() }
+func file_stycc_proto_init() {
+	if File_stycc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_stycc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StyccAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stycc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StyccDeposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stycc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StyccRedeem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stycc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StyccClaim); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stycc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StyccTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stycc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StyccPool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stycc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StyccRedemption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stycc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StyccAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stycc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptStycc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stycc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyStyccPool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stycc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyStyccAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_stycc_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*StyccAction_Deposit)(nil),
+		(*StyccAction_Redeem)(nil),
+		(*StyccAction_Claim)(nil),
+		(*StyccAction_Transfer)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_stycc_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_stycc_proto_goTypes,
+		DependencyIndexes: file_stycc_proto_depIdxs,
+		MessageInfos:      file_stycc_proto_msgTypes,
+	}.Build()
+	File_stycc_proto = out.File
+	file_stycc_proto_rawDesc = nil
+	file_stycc_proto_goTypes = nil
+	file_stycc_proto_depIdxs = nil
+}
@@ -0,0 +1,158 @@
+// Package xcall 执行器之间的同步调用. 执行器用 Register 登记给别的执行器调用的方法,
+// 执行交易时用 NewContext 创建调用的上下文, 在同一笔交易里调用别的执行器, 例如合约转 coins 或者读 pos33 的抵押.
+// 被调用的方法返回的 receipt 由调用者合并到自己的 receipt 里, 交易失败时一起回滚, 不需要交易组
+package xcall
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/client"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+// MaxDepth 最多嵌套调用的层数
+const MaxDepth = 4
+
+var (
+	// ErrCallNotFound 没有登记的方法
+	ErrCallNotFound = errors.New("ErrCallNotFound")
+	// ErrCallDepth 嵌套调用太深
+	ErrCallDepth = errors.New("ErrCallDepth")
+	// ErrCallReentrant 调用栈里已经有这个执行器
+	ErrCallReentrant = errors.New("ErrCallReentrant")
+	// ErrCallReadOnly 只读的调用里不能修改状态
+	ErrCallReadOnly = errors.New("ErrCallReadOnly")
+)
+
+// Handler 登记的方法, payload 和返回值是 protobuf 编码的数据
+type Handler func(ctx *Context, payload []byte) ([]byte, *types.Receipt, error)
+
+type method struct {
+	handler  Handler
+	readOnly bool
+}
+
+var (
+	mu      sync.RWMutex
+	methods = make(map[string]*method)
+)
+
+// Register 登记执行器 exec 的方法, readOnly 的方法不能修改状态, 只读的调用里只能调用 readOnly 的方法
+func Register(exec, name string, h Handler, readOnly bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	key := exec + "." + name
+	if _, ok := methods[key]; ok {
+		panic("xcall: method registered twice " + key)
+	}
+	methods[key] = &method{handler: h, readOnly: readOnly}
+}
+
+func getMethod(exec, name string) (*method, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	m, ok := methods[exec+"."+name]
+	return m, ok
+}
+
+// Env 执行器的执行环境, drivers.DriverBase 实现了这些方法
+type Env interface {
+	GetAPI() client.QueueProtocolAPI
+	GetStateDB() dbm.KV
+	GetHeight() int64
+	GetBlockTime() int64
+}
+
+// Context 一次调用的上下文
+type Context struct {
+	Env
+	Tx *types.Transaction
+	// Caller 调用者的地址, 被调用的方法只能动用这个地址的资产
+	Caller   string
+	ReadOnly bool
+
+	stack []string
+}
+
+// NewContext exec 执行 tx 时调用别的执行器, caller 是 exec 里发起调用的地址 (例如合约地址)
+func NewContext(env Env, tx *types.Transaction, exec, caller string, readOnly bool) *Context {
+	return &Context{Env: env, Tx: tx, Caller: caller, ReadOnly: readOnly, stack: []string{exec}}
+}
+
+// Exec 正在执行的执行器
+func (c *Context) Exec() string {
+	return c.stack[len(c.stack)-1]
+}
+
+// Depth 当前的调用层数
+func (c *Context) Depth() int {
+	return len(c.stack) - 1
+}
+
+// Call 调用执行器 exec 的方法 name. 被调用的方法里可以用同一个 ctx 继续调用, 调用栈里的执行器不能再被调用
+func (c *Context) Call(exec, name string, payload []byte) ([]byte, *types.Receipt, error) {
+	m, ok := getMethod(exec, name)
+	if !ok {
+		return nil, nil, ErrCallNotFound
+	}
+	if c.ReadOnly && !m.readOnly {
+		return nil, nil, ErrCallReadOnly
+	}
+	if c.Depth() >= MaxDepth {
+		return nil, nil, ErrCallDepth
+	}
+	for _, e := range c.stack {
+		if e == exec {
+			return nil, nil, ErrCallReentrant
+		}
+	}
+	c.stack = append(c.stack, exec)
+	defer func() { c.stack = c.stack[:len(c.stack)-1] }()
+	ret, receipt, err := m.handler(c, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	if m.readOnly && receipt != nil && len(receipt.KV) > 0 {
+		return nil, nil, ErrCallReadOnly
+	}
+	return ret, receipt, nil
+}
+
+func init() {
+	Register("coins", "Transfer", coinsTransfer, false)
+	Register("coins", "Balance", coinsBalance, true)
+}
+
+// coinsTransfer 从调用者的地址转 coins, payload 是 types.AssetsTransfer
+func coinsTransfer(ctx *Context, payload []byte) ([]byte, *types.Receipt, error) {
+	var req types.AssetsTransfer
+	err := types.Decode(payload, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if req.Amount <= 0 {
+		return nil, nil, types.ErrAmount
+	}
+	acc := account.NewCoinsAccount(ctx.GetAPI().GetConfig())
+	acc.SetDB(ctx.GetStateDB())
+	receipt, err := acc.Transfer(ctx.Caller, req.To, req.Amount)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, receipt, nil
+}
+
+// coinsBalance 地址的 coins 余额, payload 是 types.ReqAddr, 返回 types.Int64
+func coinsBalance(ctx *Context, payload []byte) ([]byte, *types.Receipt, error) {
+	var req types.ReqAddr
+	err := types.Decode(payload, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	acc := account.NewCoinsAccount(ctx.GetAPI().GetConfig())
+	acc.SetDB(ctx.GetStateDB())
+	return types.Encode(&types.Int64{Data: acc.LoadAccount(req.Addr).Balance}), nil, nil
+}
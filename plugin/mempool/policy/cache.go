@@ -0,0 +1,158 @@
+package policy
+
+import (
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/skiplist"
+	"github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// Queue 按策略的优先级排序的队列, 优先级相同时先进入的优先.
+// 共识和治理的交易排在普通交易前面, 交易池满的时候不会被挤出
+type Queue struct {
+	*skiplist.Queue
+	subConfig subConfig
+	policy    Policy
+	fee       *adaptiveFee
+	classes   *classifier
+}
+
+type policyScore struct {
+	*mempool.Item
+	score int64
+}
+
+func (item *policyScore) GetScore() int64 {
+	return item.score
+}
+
+func (item *policyScore) Hash() []byte {
+	return item.Value.Hash()
+}
+
+func (item *policyScore) Compare(cmp skiplist.Scorer) int {
+	it := cmp.(*policyScore)
+	//时间越小，权重越高
+	if item.EnterTime < it.EnterTime {
+		return skiplist.Big
+	}
+	if item.EnterTime == it.EnterTime {
+		return skiplist.Equal
+	}
+	return skiplist.Small
+}
+
+func (item *policyScore) ByteSize() int64 {
+	return int64(proto.Size(item.Value))
+}
+
+// NewQueue 创建队列
+func NewQueue(subcfg subConfig, policy Policy) *Queue {
+	return &Queue{
+		Queue:     skiplist.NewQueue(subcfg.PoolCacheSize),
+		subConfig: subcfg,
+		policy:    policy,
+		fee:       newAdaptiveFee(subcfg),
+		classes:   newClassifier(subcfg),
+	}
+}
+
+// GetItem 获取数据通过 key
+func (cache *Queue) GetItem(hash string) (*mempool.Item, error) {
+	item, err := cache.Queue.GetItem(hash)
+	if err != nil {
+		return nil, err
+	}
+	return item.(*policyScore).Item, nil
+}
+
+// Push 策略准入后加入数据到队列, 队列满时被挤出的交易也通知策略
+func (cache *Queue) Push(item *mempool.Item) error {
+	err := cache.admitFee(item.Value)
+	if err != nil {
+		traceTx(item, "mempool rejected: "+err.Error())
+		return err
+	}
+	err = cache.policy.Admit(item)
+	if err != nil {
+		traceTx(item, "mempool rejected: "+err.Error())
+		return err
+	}
+	var last *mempool.Item
+	if cache.Size() > 0 && cache.MaxSize() <= int64(cache.Size()) {
+		last = cache.Last().(*policyScore).Item
+	}
+	err = cache.Queue.Push(&policyScore{Item: item, score: cache.classes.score(item, cache.policy.Score(item))})
+	if err != nil {
+		if err == types.ErrMemFull {
+			cache.classes.rejected(item)
+		}
+		cache.policy.Removed(item)
+		traceTx(item, "mempool rejected: "+err.Error())
+		return err
+	}
+	traceTx(item, "mempool admitted")
+	if last != nil && !cache.Exist(string(last.Value.Hash())) {
+		cache.classes.evicted(last)
+		cache.policy.Removed(last)
+		traceTx(last, "mempool evicted")
+	}
+	return nil
+}
+
+// Remove 删除数据
+func (cache *Queue) Remove(hash string) error {
+	item, err := cache.GetItem(hash)
+	if err != nil {
+		return err
+	}
+	err = cache.Queue.Remove(hash)
+	if err != nil {
+		return err
+	}
+	cache.policy.Removed(item)
+	traceTx(item, "mempool removed")
+	return nil
+}
+
+// traceTx 带追踪 ID 的交易记录事件和日志
+func traceTx(item *mempool.Item, event string) {
+	if !pt.TracingTx() {
+		return
+	}
+	hash := item.Value.Hash()
+	if id := pt.TraceTx(hash, event); id != "" {
+		mlog.Info("trace tx", "traceId", id, "hash", common.ToHex(hash), "event", event)
+	}
+}
+
+// Walk 获取数据通过 key
+func (cache *Queue) Walk(count int, cb func(tx *mempool.Item) bool) {
+	cache.Queue.Walk(count, func(item skiplist.Scorer) bool {
+		return cb(item.(*policyScore).Item)
+	})
+}
+
+// GetProperFee 获取合适的手续费率,取前100的平均手续费率, 不低于当前的最低手续费率
+func (cache *Queue) GetProperFee() int64 {
+	minRate := cache.minFeeRate()
+	if cache.Size() < 100 {
+		if minRate > cache.subConfig.ProperFee {
+			return minRate
+		}
+		return cache.subConfig.ProperFee
+	}
+	var sumFeeRate int64
+	i := 0
+	cache.Walk(100, func(item *mempool.Item) bool {
+		sumFeeRate += feeRate(item.Value)
+		i++
+		return true
+	})
+	if avg := sumFeeRate / int64(i); avg > minRate {
+		return avg
+	}
+	return minRate
+}
@@ -0,0 +1,66 @@
+package policy
+
+import (
+	"github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+	metrics "github.com/rcrowley/go-metrics"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 交易的类别, 交易池满的时候 consensus 和 governance 的交易不会被挤出
+const (
+	classConsensus  = "consensus"
+	classGovernance = "governance"
+	classNormal     = "normal"
+)
+
+// protectedScore 受保护的交易的优先级加上这个值, 排在所有普通交易前面, 交易池满时挤出的总是普通交易
+const protectedScore = int64(1) << 56
+
+// consensusActions 受保护的 pos33 交易
+var consensusActions = map[string]bool{
+	"miner":   true,
+	"slash":   true,
+	"blsBind": true,
+}
+
+// classifier 按执行器和 action 给交易分类, manage 和 protectedExecs 配置的执行器是 governance
+type classifier struct {
+	governance map[string]bool
+}
+
+func newClassifier(conf subConfig) *classifier {
+	c := &classifier{governance: map[string]bool{"manage": true}}
+	for _, exec := range conf.ProtectedExecs {
+		c.governance[exec] = true
+	}
+	return c
+}
+
+func (c *classifier) class(tx *types.Transaction) string {
+	exec := string(types.GetRealExecName(tx.Execer))
+	switch {
+	case exec == pt.Pos33TicketX && consensusActions[tx.ActionName()]:
+		return classConsensus
+	case c.governance[exec]:
+		return classGovernance
+	}
+	return classNormal
+}
+
+func (c *classifier) score(item *mempool.Item, score int64) int64 {
+	if c.class(item.Value) != classNormal {
+		return protectedScore + score
+	}
+	return score
+}
+
+// evicted 记录被挤出的交易, 发送到 [metrics] 配置的 influxdb
+func (c *classifier) evicted(item *mempool.Item) {
+	metrics.GetOrRegisterCounter("mempool.evicted."+c.class(item.Value), nil).Inc(1)
+}
+
+// rejected 记录交易池满时拒绝的交易
+func (c *classifier) rejected(item *mempool.Item) {
+	metrics.GetOrRegisterCounter("mempool.full.rejected."+c.class(item.Value), nil).Inc(1)
+}
@@ -0,0 +1,111 @@
+package policy
+
+import (
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	defaultFeeAdjustInterval = 10 // 秒
+	defaultFeeRaise          = 25 // 百分比
+	defaultMaxFeeRateTimes   = 100
+)
+
+// adaptiveFee 交易池持续接近满的时候自动提高最低手续费率, 负载下降以后再逐步降回配置的最低手续费率.
+// 每 interval 采样一次交易池的使用率: 超过 highWater 提高 raise%, 低于 lowWater 降低 raise%.
+// 手续费率低于当前最低手续费率的交易直接拒绝, 不用等交易池满了以后硬性拒绝, 形成手续费市场
+type adaptiveFee struct {
+	base, max           int64
+	highWater, lowWater int
+	raise               int64
+	interval            time.Duration
+
+	mu     sync.Mutex
+	rate   int64
+	adjust time.Time
+}
+
+func newAdaptiveFee(conf subConfig) *adaptiveFee {
+	if conf.FeeHighWater <= 0 || conf.ProperFee <= 0 {
+		return nil
+	}
+	f := &adaptiveFee{
+		base:      conf.ProperFee,
+		max:       conf.MaxFeeRate,
+		highWater: conf.FeeHighWater,
+		lowWater:  conf.FeeLowWater,
+		raise:     conf.FeeRaise,
+		interval:  time.Duration(conf.FeeAdjustInterval) * time.Second,
+		rate:      conf.ProperFee,
+	}
+	if f.max <= 0 {
+		f.max = f.base * defaultMaxFeeRateTimes
+	}
+	if f.lowWater <= 0 || f.lowWater >= f.highWater {
+		f.lowWater = f.highWater / 2
+	}
+	if f.raise <= 0 {
+		f.raise = defaultFeeRaise
+	}
+	if f.interval <= 0 {
+		f.interval = defaultFeeAdjustInterval * time.Second
+	}
+	return f
+}
+
+// update 用交易池的使用率调整最低手续费率, 距离上次调整不到 interval 时不变
+func (f *adaptiveFee) update(size, maxSize int64, now time.Time) int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if maxSize <= 0 || now.Sub(f.adjust) < f.interval {
+		return f.rate
+	}
+	f.adjust = now
+	usage := int(size * 100 / maxSize)
+	old := f.rate
+	switch {
+	case usage >= f.highWater:
+		f.rate += f.rate * f.raise / 100
+		if f.rate > f.max {
+			f.rate = f.max
+		}
+	case usage < f.lowWater:
+		f.rate -= f.rate * f.raise / 100
+		if f.rate < f.base {
+			f.rate = f.base
+		}
+	}
+	if f.rate != old {
+		mlog.Info("adaptive min fee rate", "usage", usage, "old", old, "rate", f.rate)
+	}
+	return f.rate
+}
+
+// minFeeRate 当前的最低手续费率, 没有打开时返回 0
+func (cache *Queue) minFeeRate() int64 {
+	if cache.fee == nil {
+		return 0
+	}
+	return cache.fee.update(int64(cache.Size()), cache.MaxSize(), time.Now())
+}
+
+// admitFee 手续费率低于当前最低手续费率的交易拒绝
+func (cache *Queue) admitFee(tx *types.Transaction) error {
+	rate := cache.minFeeRate()
+	if rate > 0 && feeRate(tx) < rate {
+		return types.ErrTxFeeTooLow
+	}
+	return nil
+}
+
+// feeRate 交易的手续费率, 单元个数按 txsize/1000 + 1 计算, 交易组按交易个数
+func feeRate(tx *types.Transaction) int64 {
+	unitFeeNum := proto.Size(tx)/1000 + 1
+	if count := tx.GetGroupCount(); count > 0 {
+		unitFeeNum = int(count)
+	}
+	return tx.Fee / int64(unitFeeNum)
+}
@@ -0,0 +1,48 @@
+package policy
+
+import (
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+)
+
+//--------------------------------------------------------------------------------
+// Module Mempool
+
+type subConfig struct {
+	PoolCacheSize int64 `json:"poolCacheSize"`
+	ProperFee     int64 `json:"properFee"`
+	// 准入和排序策略的名字, 默认 price
+	Policy string `json:"policy"`
+	// 交易池使用率(百分比)达到 feeHighWater 时提高最低手续费率, 0 不打开
+	FeeHighWater int `json:"feeHighWater"`
+	// 使用率低于 feeLowWater 时降低最低手续费率, 默认 feeHighWater 的一半
+	FeeLowWater int `json:"feeLowWater"`
+	// 每次调整的百分比, 默认 25
+	FeeRaise int64 `json:"feeRaise"`
+	// 调整的间隔秒数, 默认 10
+	FeeAdjustInterval int64 `json:"feeAdjustInterval"`
+	// 最低手续费率的上限, 默认 properFee 的 100 倍
+	MaxFeeRate int64 `json:"maxFeeRate"`
+	// 除了 manage 以外, 交易池满时不会被挤出的治理执行器
+	ProtectedExecs []string `json:"protectedExecs"`
+}
+
+func init() {
+	drivers.Reg("policy", New)
+}
+
+// New 创建使用注册的准入和排序策略的 mempool
+func New(cfg *types.Mempool, sub []byte) queue.Module {
+	c := drivers.NewMempool(cfg)
+	var subcfg subConfig
+	types.MustDecode(sub, &subcfg)
+	if subcfg.PoolCacheSize == 0 {
+		subcfg.PoolCacheSize = cfg.PoolCacheSize
+	}
+	if subcfg.ProperFee == 0 {
+		subcfg.ProperFee = cfg.MinTxFeeRate
+	}
+	c.SetQueueCache(NewQueue(subcfg, newPolicy(subcfg.Policy, sub)))
+	return c
+}
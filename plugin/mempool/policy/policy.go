@@ -0,0 +1,65 @@
+package policy
+
+import (
+	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/system/mempool"
+	"github.com/golang/protobuf/proto"
+)
+
+var mlog = log15.New("module", "mempool.policy")
+
+// Policy 交易池的准入和排序策略, 编译时用 RegisterPolicy 注册, [mempool.sub.policy] 的 policy 选择.
+// 研究新的排序规则(例如防 MEV 的排序, 按执行器限额)不需要修改 mempool 模块
+type Policy interface {
+	// Admit 交易进入交易池之前调用, 返回错误拒绝交易
+	Admit(item *mempool.Item) error
+	// Score 交易的优先级, 大的先打包, 相同时先进入的先打包. 交易进入时计算一次
+	Score(item *mempool.Item) int64
+	// Removed 交易离开交易池(打包, 过期或者被挤出)
+	Removed(item *mempool.Item)
+}
+
+// PolicyCreator 用 [mempool.sub.policy] 的配置创建策略
+type PolicyCreator func(sub []byte) Policy
+
+const defaultPolicy = "price"
+
+var policies = make(map[string]PolicyCreator)
+
+// RegisterPolicy register a mempool policy by name
+func RegisterPolicy(name string, create PolicyCreator) {
+	if create == nil {
+		panic("mempool policy: register policy is nil")
+	}
+	if _, ok := policies[name]; ok {
+		panic("mempool policy: register duplicate policy " + name)
+	}
+	policies[name] = create
+}
+
+func newPolicy(name string, sub []byte) Policy {
+	if name == "" {
+		name = defaultPolicy
+	}
+	create, ok := policies[name]
+	if !ok {
+		panic("mempool policy: policy NOT registered: " + name)
+	}
+	mlog.Info("mempool policy", "name", name)
+	return create(sub)
+}
+
+func init() {
+	RegisterPolicy(defaultPolicy, func(sub []byte) Policy { return pricePolicy{} })
+}
+
+// pricePolicy 和 price mempool 一样: 价格=手续费/交易字节数, 价格高者优先
+type pricePolicy struct{}
+
+func (pricePolicy) Admit(item *mempool.Item) error { return nil }
+
+func (pricePolicy) Score(item *mempool.Item) int64 {
+	return item.Value.Fee / int64(proto.Size(item.Value))
+}
+
+func (pricePolicy) Removed(item *mempool.Item) {}
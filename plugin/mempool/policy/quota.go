@@ -0,0 +1,73 @@
+package policy
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+)
+
+// ErrExecQuota 执行器的交易数达到限额
+var ErrExecQuota = errors.New("ErrExecQuota")
+
+func init() {
+	RegisterPolicy("fifo", func(sub []byte) Policy { return fifoPolicy{} })
+	RegisterPolicy("quota", newQuotaPolicy)
+}
+
+// fifoPolicy 只按进入交易池的时间排序, 手续费不能买到更前的位置
+type fifoPolicy struct{}
+
+func (fifoPolicy) Admit(item *mempool.Item) error { return nil }
+
+func (fifoPolicy) Score(item *mempool.Item) int64 { return 0 }
+
+func (fifoPolicy) Removed(item *mempool.Item) {}
+
+type quotaConfig struct {
+	// 每个执行器在交易池里最多的交易数, 没有配置的执行器不限制
+	ExecQuotas map[string]int `json:"execQuotas"`
+}
+
+// quotaPolicy 按执行器限额, 排序和 price 一样
+type quotaPolicy struct {
+	pricePolicy
+	quotas map[string]int
+
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func newQuotaPolicy(sub []byte) Policy {
+	var cfg quotaConfig
+	types.MustDecode(sub, &cfg)
+	return &quotaPolicy{quotas: cfg.ExecQuotas, counts: make(map[string]int)}
+}
+
+func (p *quotaPolicy) Admit(item *mempool.Item) error {
+	exec := string(item.Value.Execer)
+	quota, ok := p.quotas[exec]
+	if !ok {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.counts[exec] >= quota {
+		return ErrExecQuota
+	}
+	p.counts[exec]++
+	return nil
+}
+
+func (p *quotaPolicy) Removed(item *mempool.Item) {
+	exec := string(item.Value.Execer)
+	if _, ok := p.quotas[exec]; !ok {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.counts[exec] > 0 {
+		p.counts[exec]--
+	}
+}
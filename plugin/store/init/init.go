@@ -3,4 +3,5 @@ package init
 import (
 	_ "github.com/33cn/plugin/plugin/store/kvmvcc"     //auto gen
 	_ "github.com/33cn/plugin/plugin/store/kvmvccmavl" //auto gen
+	_ "github.com/yccproject/ycc/plugin/store/usage"   // 按执行器统计状态占用
 )
@@ -0,0 +1,134 @@
+package usage
+
+import (
+	"time"
+
+	dbm "github.com/33cn/chain33/common/db"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/syndtr/goleveldb/leveldb"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const (
+	defaultDbStatsInterval = 10
+	// level0 的表超过这个数 leveldb 开始减慢写入
+	level0SlowdownTables = 8
+	// level1 的目标大小, 每一层是上一层的 10 倍
+	level1TargetSize = 10 * 1024 * 1024
+)
+
+type dbGetter interface {
+	GetDB() dbm.DB
+}
+
+// dbStats 定时读取 leveldb 内部的统计, 写到 metrics:
+// store.leveldb.level0.tables, store.leveldb.compaction.backlog 压缩积压(level0 的表数和超过目标大小的字节数),
+// store.leveldb.write.amplification 写放大(所有层压缩写入 / level0 写入),
+// store.leveldb.stall.count 和 store.leveldb.stall.time 写入停顿
+type dbStats struct {
+	db       *leveldb.DB
+	interval time.Duration
+	quit     chan struct{}
+
+	last leveldb.DBStats
+}
+
+func newDBStats(m interface{}, interval int64) *dbStats {
+	g, ok := m.(dbGetter)
+	if !ok {
+		return nil
+	}
+	ldb, ok := g.GetDB().(*dbm.GoLevelDB)
+	if !ok {
+		slog.Info("db stats only support leveldb")
+		return nil
+	}
+	if interval <= 0 {
+		interval = defaultDbStatsInterval
+	}
+	return &dbStats{db: ldb.DB(), interval: time.Duration(interval) * time.Second, quit: make(chan struct{})}
+}
+
+func (s *dbStats) start() {
+	if s == nil {
+		return
+	}
+	go s.loop()
+}
+
+func (s *dbStats) stop() {
+	if s == nil {
+		return
+	}
+	close(s.quit)
+}
+
+func (s *dbStats) loop() {
+	tc := time.NewTicker(s.interval)
+	defer tc.Stop()
+	for {
+		select {
+		case <-s.quit:
+			return
+		case <-tc.C:
+			s.collect()
+		}
+	}
+}
+
+func sumSizes(sizes leveldb.Sizes) int64 {
+	var n int64
+	for _, v := range sizes {
+		n += v
+	}
+	return n
+}
+
+func (s *dbStats) collect() {
+	var st leveldb.DBStats
+	err := s.db.Stats(&st)
+	if err != nil {
+		slog.Error("leveldb stats error", "err", err)
+		return
+	}
+	defer func() { s.last = st }()
+
+	var backlog, level0 int64
+	if len(st.LevelTablesCounts) > 0 {
+		level0 = int64(st.LevelTablesCounts[0])
+		backlog = level0
+	}
+	target := int64(level1TargetSize)
+	for i := 1; i < len(st.LevelSizes); i++ {
+		if st.LevelSizes[i] > target {
+			backlog += st.LevelSizes[i] - target
+		}
+		target *= 10
+	}
+	metrics.GetOrRegisterGauge("store.leveldb.level0.tables", nil).Update(level0)
+	metrics.GetOrRegisterGauge("store.leveldb.compaction.backlog", nil).Update(backlog)
+	if level0 >= level0SlowdownTables {
+		slog.Warn("leveldb compaction backlog", "level0", level0, "backlog", backlog)
+	}
+
+	if s.last.LevelWrite == nil {
+		return
+	}
+	var flushed int64
+	if len(st.LevelWrite) > 0 && len(s.last.LevelWrite) > 0 {
+		flushed = st.LevelWrite[0] - s.last.LevelWrite[0]
+	}
+	written := sumSizes(st.LevelWrite) - sumSizes(s.last.LevelWrite)
+	if flushed > 0 {
+		metrics.GetOrRegisterGaugeFloat64("store.leveldb.write.amplification", nil).Update(float64(written) / float64(flushed))
+	}
+
+	n := int64(st.WriteDelayCount - s.last.WriteDelayCount)
+	d := st.WriteDelayDuration - s.last.WriteDelayDuration
+	if n > 0 || st.WritePaused {
+		metrics.GetOrRegisterCounter("store.leveldb.stall.count", nil).Inc(n)
+		metrics.GetOrRegisterTimer("store.leveldb.stall.time", nil).Update(d)
+		slog.Warn("leveldb write stall", "delays", n, "time", d, "paused", st.WritePaused)
+		pt.NoteWriteStall(d)
+	}
+}
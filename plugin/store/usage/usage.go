@@ -0,0 +1,270 @@
+// Package usage 包装另一个 store, 提交区块时按执行器统计状态数据占用的字节数
+package usage
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/store"
+	"github.com/33cn/chain33/types"
+	metrics "github.com/rcrowley/go-metrics"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+var slog = log15.New("module", "store.usage")
+
+const (
+	usageFile = "usage.json"
+	// 每提交 saveBlocks 个区块保存一次统计
+	saveBlocks = 100
+	otherExec  = "other"
+)
+
+type subConfig struct {
+	// 实际使用的 store, 它的配置也写在 [store.sub.usage] 里
+	Store string `json:"store"`
+	// 读取 leveldb 统计的间隔(秒), 默认 10, 小于 0 不读取
+	DbStatsInterval int64 `json:"dbStatsInterval"`
+}
+
+func init() {
+	drivers.Reg("usage", New)
+}
+
+type childSetter interface {
+	SetChild(sub drivers.SubStore)
+}
+
+type execUsage struct {
+	Bytes int64 `json:"bytes"`
+	Keys  int64 `json:"keys"`
+}
+
+type usageState struct {
+	Since  int64                 `json:"since"`
+	Height int64                 `json:"height"`
+	Execs  map[string]*execUsage `json:"execs"`
+}
+
+// usageStore MemSet 时用父状态的值计算每个 key 的变化, Commit 时计入统计, Rollback 时丢掉.
+// 统计只包括开始使用以后的变化, 保存在 dbPath/usage.json
+type usageStore struct {
+	drivers.SubStore
+	queue.Module
+	file  string
+	stats *dbStats
+
+	mu      sync.Mutex
+	pending map[string]*pendingSet // key is state hash
+	state   usageState
+	saved   int64
+}
+
+type pendingSet struct {
+	height int64
+	execs  map[string]*execUsage
+}
+
+// New 创建 [store.sub.usage] 的 store 指定的 store 并包装它
+func New(cfg *types.Store, sub []byte, chain33cfg *types.Chain33Config) queue.Module {
+	var subcfg subConfig
+	types.MustDecode(sub, &subcfg)
+	create, err := drivers.Load(subcfg.Store)
+	if err != nil {
+		panic("usage store: " + err.Error())
+	}
+	m := create(cfg, sub, chain33cfg)
+	child, ok := m.(drivers.SubStore)
+	setter, ok2 := m.(childSetter)
+	if !ok || !ok2 {
+		panic("usage store: can't wrap store " + subcfg.Store)
+	}
+	s := &usageStore{
+		SubStore: child,
+		Module:   m,
+		file:     filepath.Join(cfg.DbPath, usageFile),
+		pending:  make(map[string]*pendingSet),
+		state:    usageState{Since: -1, Execs: make(map[string]*execUsage)},
+	}
+	if subcfg.DbStatsInterval >= 0 {
+		s.stats = newDBStats(m, subcfg.DbStatsInterval)
+		s.stats.start()
+	}
+	s.load()
+	setter.SetChild(s)
+	pt.RegisterStateUsage(s.usages)
+	slog.Info("state usage accounting", "store", subcfg.Store, "since", s.state.Since)
+	return s
+}
+
+// execOf 状态 key 所属的执行器, 例如 mavl-coins-bty-exec-xxx 属于 coins
+func execOf(key []byte) string {
+	k := string(key)
+	if !strings.HasPrefix(k, "mavl-") {
+		return otherExec
+	}
+	k = k[len("mavl-"):]
+	i := strings.Index(k, "-")
+	if i <= 0 {
+		return otherExec
+	}
+	return k[:i]
+}
+
+// MemSet 用父状态里的旧值计算变化
+func (s *usageStore) MemSet(datas *types.StoreSet, sync bool) ([]byte, error) {
+	hash, err := s.SubStore.MemSet(datas, sync)
+	if err != nil {
+		return hash, err
+	}
+	keys := make([][]byte, len(datas.KV))
+	for i, kv := range datas.KV {
+		keys[i] = kv.Key
+	}
+	var olds [][]byte
+	if len(keys) > 0 {
+		olds = s.SubStore.Get(&types.StoreGet{StateHash: datas.StateHash, Keys: keys})
+	}
+	p := &pendingSet{height: datas.Height, execs: make(map[string]*execUsage)}
+	for i, kv := range datas.KV {
+		var old []byte
+		if i < len(olds) {
+			old = olds[i]
+		}
+		exec := execOf(kv.Key)
+		u, ok := p.execs[exec]
+		if !ok {
+			u = &execUsage{}
+			p.execs[exec] = u
+		}
+		if len(old) > 0 {
+			u.Bytes -= int64(len(kv.Key) + len(old))
+			u.Keys--
+		}
+		if len(kv.Value) > 0 {
+			u.Bytes += int64(len(kv.Key) + len(kv.Value))
+			u.Keys++
+		}
+	}
+	s.mu.Lock()
+	s.pending[string(hash)] = p
+	s.mu.Unlock()
+	return hash, nil
+}
+
+// Commit 计入统计
+func (s *usageStore) Commit(req *types.ReqHash) ([]byte, error) {
+	hash, err := s.SubStore.Commit(req)
+	if err != nil {
+		return hash, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.pending[string(req.Hash)]
+	if !ok {
+		return hash, err
+	}
+	delete(s.pending, string(req.Hash))
+	// 没有提交的 MemSet 不会再提交
+	for k, o := range s.pending {
+		if o.height <= p.height {
+			delete(s.pending, k)
+		}
+	}
+	if s.state.Since < 0 {
+		s.state.Since = p.height
+	}
+	s.state.Height = p.height
+	for exec, d := range p.execs {
+		u, ok := s.state.Execs[exec]
+		if !ok {
+			u = &execUsage{}
+			s.state.Execs[exec] = u
+		}
+		u.Bytes += d.Bytes
+		u.Keys += d.Keys
+		metrics.GetOrRegisterGauge("store.usage."+exec, nil).Update(u.Bytes)
+		metrics.GetOrRegisterMeter("store.growth."+exec, nil).Mark(d.Bytes)
+	}
+	if p.height-s.saved >= saveBlocks {
+		s.save()
+	}
+	return hash, err
+}
+
+// Rollback 丢掉没有提交的变化
+func (s *usageStore) Rollback(req *types.ReqHash) ([]byte, error) {
+	s.mu.Lock()
+	delete(s.pending, string(req.Hash))
+	s.mu.Unlock()
+	return s.SubStore.Rollback(req)
+}
+
+// Close 停止读取 leveldb 统计, 保存统计
+func (s *usageStore) Close() {
+	s.stats.stop()
+	s.Module.Close()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.save()
+}
+
+func (s *usageStore) load() {
+	data, err := ioutil.ReadFile(s.file)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			slog.Error("load state usage error", "err", err)
+		}
+		return
+	}
+	var st usageState
+	if err := json.Unmarshal(data, &st); err != nil || st.Execs == nil {
+		slog.Error("load state usage error", "err", err)
+		return
+	}
+	s.state = st
+	s.saved = st.Height
+}
+
+// save 需要持有 s.mu
+func (s *usageStore) save() {
+	data, err := json.Marshal(&s.state)
+	if err != nil {
+		return
+	}
+	tmp := s.file + ".tmp"
+	err = ioutil.WriteFile(tmp, data, 0600)
+	if err == nil {
+		err = os.Rename(tmp, s.file)
+	}
+	if err != nil {
+		slog.Error("save state usage error", "err", err)
+		return
+	}
+	s.saved = s.state.Height
+}
+
+func (s *usageStore) usages() *pt.Pos33StateUsages {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := &pt.Pos33StateUsages{Since: s.state.Since, Height: s.state.Height}
+	for exec, u := range s.state.Execs {
+		m := metrics.GetOrRegisterMeter("store.growth."+exec, nil)
+		r.Items = append(r.Items, &pt.Pos33StateUsage{
+			Exec:   exec,
+			Bytes:  u.Bytes,
+			Keys:   u.Keys,
+			Rate1:  m.Rate1(),
+			Rate15: m.Rate15(),
+		})
+	}
+	sort.Slice(r.Items, func(i, j int) bool { return r.Items[i].Bytes > r.Items[j].Bytes })
+	return r
+}
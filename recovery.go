@@ -0,0 +1,211 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tml "github.com/BurntSushi/toml"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/errors"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+// 启动前检查 datadir: 另一个进程还拿着 leveldb 的 LOCK 时给出明确的提示而不是启动到一半出错;
+// CURRENT 丢了但是还有数据文件时先修复 (不修复的话 leveldb 会当成新的 db, 把原来的数据文件删掉);
+// 上次没有正常退出 (RUNNING 文件还在) 时打开每个 db 检查一遍, 能修复的修复, 不能修复的打印处理的办法
+
+var noRecover = flag.Bool("norecover", false, "do not check and repair the leveldb dirs on startup")
+
+// 运行时在区块 db 的目录下面的标记, 正常退出时删掉
+const runningFile = "RUNNING"
+
+// leveldb 目录所在的配置项, 前 4 个和 RunChain33 一样受 -datadir 的影响
+var dbPathKeys = []string{
+	"blockchain.dbPath",
+	"p2p.dbPath",
+	"wallet.dbPath",
+	"store.dbPath",
+	"consensus.sub.pos33.walDBPath",
+	"consensus.sub.pos33.auditDBPath",
+	"consensus.sub.pos33.pushDBPath",
+	"consensus.sub.pos33.participationDBPath",
+	"consensus.sub.pos33.indexDBPath",
+}
+
+// dbPaths 配置里的 db 路径, 配置文件覆盖默认配置, 相对路径是相对程序所在的目录
+func dbPaths(name, defCfg string) ([]string, error) {
+	dir, err := filepath.Abs(filepath.Dir(os.Args[0]))
+	if err != nil {
+		return nil, err
+	}
+	path := flag.Lookup("f").Value.String()
+	if path == "" {
+		path = name + ".toml"
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	user := make(map[string]interface{})
+	if _, err = tml.DecodeFile(path, &user); err != nil {
+		return nil, err
+	}
+	def := make(map[string]interface{})
+	if _, err = tml.Decode(defCfg, &def); err != nil {
+		return nil, err
+	}
+	datadir := ""
+	if f := flag.Lookup("datadir"); f != nil {
+		datadir = f.Value.String()
+	}
+	var paths []string
+	for i, k := range dbPathKeys {
+		v, ok := getKey(user, k)
+		if !ok {
+			v, ok = getKey(def, k)
+		}
+		p, _ := v.(string)
+		if !ok || p == "" {
+			continue
+		}
+		if i < 4 && datadir != "" {
+			p = filepath.Join(datadir, p)
+		}
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(dir, p)
+		}
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
+
+// isLevelDB 目录里有 leveldb 的文件
+func isLevelDB(dir string) bool {
+	for _, pat := range []string{"LOCK", "CURRENT", "MANIFEST-*", "*.ldb", "*.log"} {
+		ms, _ := filepath.Glob(filepath.Join(dir, pat))
+		if len(ms) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// levelDBs path 本身或者下一级 *.db 目录里的 leveldb (chain33 的 db 放在 <dbPath>/<name>.db)
+func levelDBs(path string) []string {
+	var dbs []string
+	if isLevelDB(path) {
+		dbs = append(dbs, path)
+	}
+	fis, err := ioutil.ReadDir(path)
+	if err != nil {
+		return dbs
+	}
+	for _, fi := range fis {
+		if fi.IsDir() && strings.HasSuffix(fi.Name(), ".db") && isLevelDB(filepath.Join(path, fi.Name())) {
+			dbs = append(dbs, filepath.Join(path, fi.Name()))
+		}
+	}
+	return dbs
+}
+
+// checkLock 另一个进程拿着 LOCK 时返回错误
+func checkLock(dir string) error {
+	s, err := storage.OpenFile(dir, false)
+	if err != nil {
+		return fmt.Errorf("%s is locked by another process (%v): stop the other node first, `fuser %s` shows its pid",
+			dir, err, filepath.Join(dir, "LOCK"))
+	}
+	return s.Close()
+}
+
+// lostCurrent CURRENT 丢了或者是空的, 但是还有数据文件
+func lostCurrent(dir string) bool {
+	fi, err := os.Stat(filepath.Join(dir, "CURRENT"))
+	if err == nil && fi.Size() > 0 {
+		return false
+	}
+	ms, _ := filepath.Glob(filepath.Join(dir, "*.ldb"))
+	return len(ms) > 0
+}
+
+func repairLevelDB(dir string) error {
+	db, err := leveldb.RecoverFile(dir, nil)
+	if err != nil {
+		return err
+	}
+	return db.Close()
+}
+
+// checkLevelDB 检查一个 db, dirty 时打开检查一遍
+func checkLevelDB(dir string, dirty bool) error {
+	if lostCurrent(dir) {
+		fmt.Println("recover", dir, ": CURRENT lost")
+		if err := repairLevelDB(dir); err != nil {
+			return fmt.Errorf("recover %s error: %v, move it away (mv %s %s.bak) and resync", dir, err, dir, dir)
+		}
+		return nil
+	}
+	if !dirty {
+		return nil
+	}
+	db, err := leveldb.OpenFile(dir, &opt.Options{ReadOnly: true})
+	if errors.IsCorrupted(err) {
+		fmt.Println("recover", dir, ":", err)
+		err = repairLevelDB(dir)
+		if err != nil {
+			return fmt.Errorf("recover %s error: %v, move it away (mv %s %s.bak) and resync", dir, err, dir, dir)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("open %s error: %v, check the disk and permissions, or move it away (mv %s %s.bak) and resync", dir, err, dir, dir)
+	}
+	return db.Close()
+}
+
+// recoverDataDirs 启动前检查和修复 db, 返回的函数在正常退出时调用
+func recoverDataDirs(name, defCfg string) (func(), error) {
+	if *noRecover {
+		return func() {}, nil
+	}
+	paths, err := dbPaths(name, defCfg)
+	if err != nil || len(paths) == 0 {
+		// 配置文件有问题让 RunChain33 报错
+		return func() {}, nil
+	}
+	err = os.MkdirAll(paths[0], 0755)
+	if err != nil {
+		return nil, err
+	}
+	var dbs []string
+	for _, p := range paths {
+		dbs = append(dbs, levelDBs(p)...)
+	}
+	for _, dir := range dbs {
+		if err := checkLock(dir); err != nil {
+			return nil, err
+		}
+	}
+	marker := filepath.Join(paths[0], runningFile)
+	dirty := false
+	if data, err := ioutil.ReadFile(marker); err == nil {
+		dirty = true
+		fmt.Println("last run did not shut down cleanly:", strings.TrimSpace(string(data)))
+	}
+	for _, dir := range dbs {
+		if err := checkLevelDB(dir, dirty); err != nil {
+			return nil, err
+		}
+	}
+	data := fmt.Sprintf("pid %d started %s\n", os.Getpid(), time.Now().Format(time.RFC3339))
+	err = ioutil.WriteFile(marker, []byte(data), 0644)
+	if err != nil {
+		return nil, err
+	}
+	return func() { os.Remove(marker) }, nil
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tml "github.com/BurntSushi/toml"
+)
+
+// 节点的角色
+const (
+	roleFull      = "full"
+	roleConsensus = "consensus"
+	roleGateway   = "gateway"
+)
+
+var role = flag.String("role", roleFull, "node role: full, consensus (no public rpc, minimal indexes) or gateway (rpc and indexes, no mining)")
+
+// roleSet 角色需要修改的配置, key 用 . 分隔
+type roleSet struct {
+	key   string
+	value interface{}
+}
+
+// roleSets consensus 只在本机提供 rpc, 关闭 rpc 代理, 水龙头和地址索引, 专门抽签, 投票和出块;
+// gateway 不挖矿, 不加载挖矿私钥, 打开索引, 对外提供 rpc. 两种节点都通过 p2p 同步同一条链,
+// 运营者可以分别扩展 gateway, 把 consensus 节点放在防火墙后面
+func roleSets(r string) ([]roleSet, []string, error) {
+	switch r {
+	case roleFull:
+		return nil, nil, nil
+	case roleConsensus:
+		sets := []roleSet{
+			{"rpc.whitelist", []string{"127.0.0.1"}},
+			{"rpc.sub.eth.enable", false},
+			{"blockchain.enablePushSubscribe", false},
+			{"exec.disableAddrIndex", true},
+			{"exec.disableFeeIndex", true},
+		}
+		dels := []string{
+			"rpc.sub.pos33.tenantAddr",
+			"rpc.sub.pos33.tenants",
+			"rpc.sub.pos33.faucetKey",
+			"rpc.sub.pos33.faucetAddr",
+		}
+		return sets, dels, nil
+	case roleGateway:
+		sets := []roleSet{
+			{"consensus.minerstart", false},
+			{"exec.disableAddrIndex", false},
+			{"exec.disableTxIndex", false},
+			{"exec.disableFeeIndex", false},
+		}
+		dels := []string{
+			"consensus.sub.pos33.keyFile",
+			"consensus.sub.pos33.keyFiles",
+			"consensus.sub.pos33.remoteSigner",
+			"consensus.sub.pos33.pool",
+			"consensus.sub.pos33.standby",
+			"consensus.sub.pos33.leaseFile",
+		}
+		return sets, dels, nil
+	}
+	return nil, nil, fmt.Errorf("unknown role %s", r)
+}
+
+func tableOf(m map[string]interface{}, keys []string, create bool) map[string]interface{} {
+	for _, k := range keys {
+		sub, ok := m[k].(map[string]interface{})
+		if !ok {
+			if !create {
+				return nil
+			}
+			sub = make(map[string]interface{})
+			m[k] = sub
+		}
+		m = sub
+	}
+	return m
+}
+
+func getKey(m map[string]interface{}, key string) (interface{}, bool) {
+	ks := strings.Split(key, ".")
+	t := tableOf(m, ks[:len(ks)-1], false)
+	if t == nil {
+		return nil, false
+	}
+	v, ok := t[ks[len(ks)-1]]
+	return v, ok
+}
+
+func setKey(m map[string]interface{}, key string, value interface{}) {
+	ks := strings.Split(key, ".")
+	tableOf(m, ks[:len(ks)-1], true)[ks[len(ks)-1]] = value
+}
+
+func delKey(m map[string]interface{}, key string) {
+	ks := strings.Split(key, ".")
+	if t := tableOf(m, ks[:len(ks)-1], false); t != nil {
+		delete(t, ks[len(ks)-1])
+	}
+}
+
+// localAddr consensus 节点的 rpc 只绑定到本机, 端口不变
+func localAddr(addr string) string {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return net.JoinHostPort("localhost", port)
+}
+
+// applyRole 按角色修改配置文件和默认配置. 默认配置(ycc.go)里的 key 配置文件不能重写, 在默认配置里修改,
+// 其他的写到配置文件旁边的 <name>.<role>.toml, 用 -f 指向这个文件
+func applyRole(r, name, defCfg string) (string, error) {
+	sets, dels, err := roleSets(r)
+	if err != nil || r == roleFull {
+		return defCfg, err
+	}
+	path := flag.Lookup("f").Value.String()
+	if path == "" {
+		path = name + ".toml"
+	}
+	if !filepath.IsAbs(path) {
+		// 和 RunChain33 一样, 相对路径是相对程序所在的目录
+		dir, err := filepath.Abs(filepath.Dir(os.Args[0]))
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(dir, path)
+	}
+	user := make(map[string]interface{})
+	if _, err = tml.DecodeFile(path, &user); err != nil {
+		return "", err
+	}
+	def := make(map[string]interface{})
+	if _, err = tml.Decode(defCfg, &def); err != nil {
+		return "", err
+	}
+
+	for _, s := range sets {
+		if _, ok := getKey(def, s.key); ok {
+			setKey(def, s.key, s.value)
+		} else {
+			setKey(user, s.key, s.value)
+		}
+	}
+	for _, k := range dels {
+		delKey(user, k)
+	}
+	if r == roleConsensus {
+		for _, k := range []string{"rpc.jrpcBindAddr", "rpc.grpcBindAddr"} {
+			if addr, ok := getKey(user, k); ok {
+				setKey(user, k, localAddr(fmt.Sprint(addr)))
+			}
+		}
+	}
+
+	var ubuf, dbuf bytes.Buffer
+	if err = tml.NewEncoder(&ubuf).Encode(user); err != nil {
+		return "", err
+	}
+	if err = tml.NewEncoder(&dbuf).Encode(def); err != nil {
+		return "", err
+	}
+	out := strings.TrimSuffix(path, ".toml") + "." + r + ".toml"
+	if err = ioutil.WriteFile(out, ubuf.Bytes(), 0600); err != nil {
+		return "", err
+	}
+	if err = flag.Set("f", out); err != nil {
+		return "", err
+	}
+	fmt.Println("role", r, "config", out)
+	return dbuf.String(), nil
+}
@@ -119,6 +119,15 @@ rewardTransfer=1
 blockReward=15
 voteRewardPersent=25
 mineRewardPersent=11
+sortRetries=3
+rewardMaturity=100
+minerInfoFee=1
+slashPersent=10
+checkpointBlocks=100
+stakeEpochBlocks=1000
+diffWindow=100
+committeeEpoch=10
+blockTime=900
 
 [store]
 dbCache = 256
@@ -304,6 +313,37 @@ Enable=0
 ForkReward15=0 
 ForkFixReward=0
 UseEntrust=0
+ForkVrfSeed=-1
+ForkRewardMature=-1
+ForkMinerInfo=-1
+ForkSlash=-1
+ForkBlsAggregate=-1
+ForkCheckpoint=-1
+ForkAccountNonce=-1
+ForkStakeEpoch=-1
+ForkSmoothDiff=-1
+ForkMissedMaker=-1
+ForkEpochCommittee=-1
+ForkBlockTime=-1
+ForkVoteCommitment=-1
+ForkLateVotes=-1
+ForkEVMStake=-1
+ForkDelegation=-1
+ForkBaseFee=-1
+ForkCompound=-1
+ForkManagePrice=-1
+ForkRewardSplit=-1
+ForkBatchTickets=-1
+ForkDynamicCommittee=-1
+ForkMinerBind=-1
+ForkTieBreak=-1
+ForkSlashTickets=-1
+
+[fork.sub.stycc]
+Enable=-1
+
+[fork.sub.random]
+Enable=-1
 
 [fork.sub.none]
 ForkUseTimeDelay=0